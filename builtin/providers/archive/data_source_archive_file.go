@@ -2,49 +2,43 @@ package archive
 
 import (
 	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
-	"github.com/hashicorp/terraform/helper/schema"
 	"io/ioutil"
 	"os"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func resourceArchiveFile() *schema.Resource {
+func dataSourceFile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArchiveFileCreate,
-		Read:   resourceArchiveFileRead,
-		Update: resourceArchiveFileUpdate,
-		Delete: resourceArchiveFileDelete,
-		Exists: resourceArchiveFileExists,
+		Read: dataSourceFileRead,
 
 		Schema: map[string]*schema.Schema{
 			"type": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"source_content": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_file", "source_dir"},
 			},
 			"source_content_filename": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_file", "source_dir"},
 			},
 			"source_file": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_content", "source_content_filename", "source_dir"},
 			},
 			"source_dir": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_content", "source_content_filename", "source_file"},
 			},
 			"output_path": &schema.Schema{
@@ -54,46 +48,22 @@ func resourceArchiveFile() *schema.Resource {
 			"output_size": &schema.Schema{
 				Type:     schema.TypeInt,
 				Computed: true,
-				ForceNew: true,
 			},
 			"output_sha": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
-				ForceNew:    true,
 				Description: "SHA1 checksum of output file",
 			},
+			"output_base64sha256": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64 encoded SHA256 checksum of output file",
+			},
 		},
 	}
 }
 
-func resourceArchiveFileCreate(d *schema.ResourceData, meta interface{}) error {
-	if err := resourceArchiveFileUpdate(d, meta); err != nil {
-		return err
-	}
-	return resourceArchiveFileRead(d, meta)
-}
-
-func resourceArchiveFileRead(d *schema.ResourceData, meta interface{}) error {
-	output_path := d.Get("output_path").(string)
-	fi, err := os.Stat(output_path)
-	if os.IsNotExist(err) {
-		d.SetId("")
-		d.MarkNewResource()
-		return nil
-	}
-
-	sha, err := genFileSha1(fi.Name())
-	if err != nil {
-		return fmt.Errorf("could not generate file checksum sha: %s", err)
-	}
-	d.Set("output_sha", sha)
-	d.Set("output_size", fi.Size())
-	d.SetId(d.Get("output_sha").(string))
-
-	return nil
-}
-
-func resourceArchiveFileUpdate(d *schema.ResourceData, meta interface{}) error {
+func dataSourceFileRead(d *schema.ResourceData, meta interface{}) error {
 	archiveType := d.Get("type").(string)
 	outputPath := d.Get("output_path").(string)
 
@@ -120,55 +90,36 @@ func resourceArchiveFileUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Generate archived file stats
-	output_path := d.Get("output_path").(string)
-	fi, err := os.Stat(output_path)
+	fi, err := os.Stat(outputPath)
 	if err != nil {
 		return err
 	}
 
-	sha, err := genFileSha1(fi.Name())
+	sha1, base64sha256, err := genFileShas(outputPath)
 	if err != nil {
 		return fmt.Errorf("could not generate file checksum sha: %s", err)
 	}
-	d.Set("output_sha", sha)
+	d.Set("output_sha", sha1)
+	d.Set("output_base64sha256", base64sha256)
 	d.Set("output_size", fi.Size())
 	d.SetId(d.Get("output_sha").(string))
 
 	return nil
 }
 
-func resourceArchiveFileDelete(d *schema.ResourceData, meta interface{}) error {
-	output_path := d.Get("output_path").(string)
-	fi, err := os.Stat(output_path)
-	if os.IsNotExist(err) {
-		return nil
-	}
-
-	if err := os.Remove(fi.Name()); err != nil {
-		return fmt.Errorf("could not delete zip file '%s': %s", fi.Name(), err)
-	}
-
-	return nil
-}
-
-func resourceArchiveFileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	output_path := d.Get("output_path").(string)
-	_, err := os.Stat(output_path)
-	if os.IsNotExist(err) {
-		return false, nil
-	}
-	if err != nil {
-		return false, err
-	}
-	return true, nil
-}
-
-func genFileSha1(filename string) (string, error) {
+func genFileShas(filename string) (string, string, error) {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
-		return "", fmt.Errorf("could not compute file '%s' checksum: %s", filename, err)
+		return "", "", fmt.Errorf("could not compute file '%s' checksum: %s", filename, err)
 	}
 	h := sha1.New()
 	h.Write([]byte(data))
-	return hex.EncodeToString(h.Sum(nil)), nil
+	sha1 := hex.EncodeToString(h.Sum(nil))
+
+	h256 := sha256.New()
+	h256.Write([]byte(data))
+	shaSum := h256.Sum(nil)
+	sha256base64 := base64.StdEncoding.EncodeToString(shaSum[:])
+
+	return sha1, sha256base64, nil
 }
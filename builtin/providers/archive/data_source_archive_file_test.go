@@ -2,39 +2,41 @@ package archive
 
 import (
 	"fmt"
-	r "github.com/hashicorp/terraform/helper/resource"
-	"github.com/hashicorp/terraform/terraform"
 	"os"
+	"regexp"
 	"testing"
+
+	r "github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccArchiveFile_Basic(t *testing.T) {
 	var fileSize string
+	defer os.Remove("zip_file_acc_test.zip")
+
 	r.Test(t, r.TestCase{
 		Providers: testProviders,
-		CheckDestroy: r.ComposeTestCheckFunc(
-			testAccArchiveFileMissing("zip_file_acc_test.zip"),
-		),
 		Steps: []r.TestStep{
 			r.TestStep{
 				Config: testAccArchiveFileContentConfig,
 				Check: r.ComposeTestCheckFunc(
 					testAccArchiveFileExists("zip_file_acc_test.zip", &fileSize),
-					r.TestCheckResourceAttrPtr("archive_file.foo", "output_size", &fileSize),
+					r.TestCheckResourceAttrPtr("data.archive_file.foo", "output_size", &fileSize),
+					r.TestMatchResourceAttr("data.archive_file.foo", "output_base64sha256", regexp.MustCompile("^[A-Za-z0-9+/]{43}=$")),
 				),
 			},
 			r.TestStep{
 				Config: testAccArchiveFileFileConfig,
 				Check: r.ComposeTestCheckFunc(
 					testAccArchiveFileExists("zip_file_acc_test.zip", &fileSize),
-					r.TestCheckResourceAttrPtr("archive_file.foo", "output_size", &fileSize),
+					r.TestCheckResourceAttrPtr("data.archive_file.foo", "output_size", &fileSize),
 				),
 			},
 			r.TestStep{
 				Config: testAccArchiveFileDirConfig,
 				Check: r.ComposeTestCheckFunc(
 					testAccArchiveFileExists("zip_file_acc_test.zip", &fileSize),
-					r.TestCheckResourceAttrPtr("archive_file.foo", "output_size", &fileSize),
+					r.TestCheckResourceAttrPtr("data.archive_file.foo", "output_size", &fileSize),
 				),
 			},
 		},
@@ -53,21 +55,8 @@ func testAccArchiveFileExists(filename string, fileSize *string) r.TestCheckFunc
 	}
 }
 
-func testAccArchiveFileMissing(filename string) r.TestCheckFunc {
-	return func(s *terraform.State) error {
-		_, err := os.Stat(filename)
-		if err != nil {
-			if os.IsNotExist(err) {
-				return nil
-			}
-			return err
-		}
-		return fmt.Errorf("found file expected to be deleted: %s", filename)
-	}
-}
-
 var testAccArchiveFileContentConfig = `
-resource "archive_file" "foo" {
+data "archive_file" "foo" {
   type                    = "zip"
   source_content          = "This is some content"
   source_content_filename = "content.txt"
@@ -76,7 +65,7 @@ resource "archive_file" "foo" {
 `
 
 var testAccArchiveFileFileConfig = `
-resource "archive_file" "foo" {
+data "archive_file" "foo" {
   type        = "zip"
   source_file = "test-fixtures/test-file.txt"
   output_path = "zip_file_acc_test.zip"
@@ -84,7 +73,7 @@ resource "archive_file" "foo" {
 `
 
 var testAccArchiveFileDirConfig = `
-resource "archive_file" "foo" {
+data "archive_file" "foo" {
   type        = "zip"
   source_dir  = "test-fixtures/test-dir"
   output_path = "zip_file_acc_test.zip"
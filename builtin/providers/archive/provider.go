@@ -9,8 +9,14 @@ func Provider() terraform.ResourceProvider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{},
 
+		DataSourcesMap: map[string]*schema.Resource{
+			"archive_file": dataSourceFile(),
+		},
 		ResourcesMap: map[string]*schema.Resource{
-			"archive_file": resourceArchiveFile(),
+			"archive_file": schema.DataSourceResourceShim(
+				"archive_file",
+				dataSourceFile(),
+			),
 		},
 	}
 }
@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sharedClientForRegion returns a common AWSClient setup needed for the
+// sweeper functions for a given region
+func sharedClientForRegion(region string) (interface{}, error) {
+	if os.Getenv("AWS_ACCESS_KEY_ID") == "" && os.Getenv("AWS_PROFILE") == "" {
+		return nil, fmt.Errorf("empty AWS_ACCESS_KEY_ID or AWS_PROFILE")
+	}
+
+	conf := &Config{
+		AccessKey: os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		Token:     os.Getenv("AWS_SESSION_TOKEN"),
+		Profile:   os.Getenv("AWS_PROFILE"),
+		Region:    region,
+	}
+
+	// configures a default client for the region, using the above env vars
+	client, err := conf.Client()
+	if err != nil {
+		return nil, fmt.Errorf("error getting AWS client: %s", err)
+	}
+
+	return client, nil
+}
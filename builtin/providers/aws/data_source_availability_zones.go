@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func dataSourceAwsAvailabilityZones() *schema.Resource {
@@ -24,7 +25,7 @@ func dataSourceAwsAvailabilityZones() *schema.Resource {
 			"state": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateStateType,
+				ValidateFunc: validation.StringInSlice([]string{"available", "information", "impaired", "unavailable"}, false),
 			},
 		},
 	}
@@ -67,21 +68,3 @@ func dataSourceAwsAvailabilityZonesRead(d *schema.ResourceData, meta interface{}
 
 	return nil
 }
-
-func validateStateType(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-
-	validState := map[string]bool{
-		"available":   true,
-		"information": true,
-		"impaired":    true,
-		"unavailable": true,
-	}
-
-	if !validState[value] {
-		errors = append(errors, fmt.Errorf(
-			"%q contains an invalid Availability Zone state %q. Valid states are: %q, %q, %q and %q.",
-			k, value, "available", "information", "impaired", "unavailable"))
-	}
-	return
-}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsApiGatewayIntegration() *schema.Resource {
@@ -84,7 +85,7 @@ func resourceAwsApiGatewayIntegration() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validateApiGatewayIntegrationPassthroughBehavior,
+				ValidateFunc: validation.StringInSlice([]string{"WHEN_NO_MATCH", "WHEN_NO_TEMPLATES", "NEVER"}, false),
 			},
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -91,7 +92,7 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 						"type": &schema.Schema{
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateTagFilters,
+							ValidateFunc: validation.StringInSlice([]string{"KEY_ONLY", "VALUE_ONLY", "KEY_AND_VALUE"}, false),
 						},
 
 						"value": &schema.Schema{
@@ -116,7 +117,7 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 						"type": &schema.Schema{
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateTagFilters,
+							ValidateFunc: validation.StringInSlice([]string{"KEY_ONLY", "VALUE_ONLY", "KEY_AND_VALUE"}, false),
 						},
 
 						"value": &schema.Schema{
@@ -139,7 +140,7 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 							Set:      schema.HashString,
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: validateTriggerEvent,
+								ValidateFunc: validation.StringInSlice([]string{"DeploymentStart", "DeploymentStop", "DeploymentSuccess", "DeploymentFailure", "InstanceStart", "InstanceSuccess", "InstanceFailure"}, false),
 							},
 						},
 
@@ -506,21 +507,3 @@ func resourceAwsCodeDeployTriggerConfigHash(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
-
-func validateTriggerEvent(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	triggerEvents := map[string]bool{
-		"DeploymentStart":   true,
-		"DeploymentStop":    true,
-		"DeploymentSuccess": true,
-		"DeploymentFailure": true,
-		"InstanceStart":     true,
-		"InstanceSuccess":   true,
-		"InstanceFailure":   true,
-	}
-
-	if !triggerEvents[value] {
-		errors = append(errors, fmt.Errorf("%q must be a valid event type value: %q", k, value))
-	}
-	return
-}
@@ -274,8 +274,9 @@ func TestValidateAWSCodeDeployTriggerEvent(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceAwsCodeDeployDeploymentGroup().Schema["trigger_configuration"].Elem.(*schema.Resource).Schema["trigger_events"].Elem.(*schema.Schema).ValidateFunc
 	for _, tc := range cases {
-		_, errors := validateTriggerEvent(tc.Value, "trigger_event")
+		_, errors := validateFunc(tc.Value, "trigger_event")
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Trigger event validation failed for event type %q: %q", tc.Value, errors)
 		}
@@ -86,8 +86,9 @@ func TestResourceAWSDynamoDbTableStreamViewType_validation(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceAwsDynamoDbTable().Schema["stream_view_type"].ValidateFunc
 	for _, tc := range cases {
-		_, errors := validateStreamViewType(tc.Value, "aws_dynamodb_table_stream_view_type")
+		_, errors := validateFunc(tc.Value, "aws_dynamodb_table_stream_view_type")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the DynamoDB stream_view_type to trigger a validation error")
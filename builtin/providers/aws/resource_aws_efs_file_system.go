@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsEfsFileSystem() *schema.Resource {
@@ -28,7 +29,7 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validateMaxLength(64),
+				ValidateFunc: validation.StringLenBetween(0, 64),
 			},
 
 			"reference_name": &schema.Schema{
@@ -44,7 +45,7 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validatePerformanceModeType,
+				ValidateFunc: validation.StringInSlice([]string{"generalPurpose", "maxIO"}, false),
 			},
 
 			"tags": tagsSchema(),
@@ -215,13 +216,3 @@ func validateReferenceName(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
-
-func validatePerformanceModeType(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	if value != "generalPurpose" && value != "maxIO" {
-		errors = append(errors, fmt.Errorf(
-			"%q contains an invalid Performance Mode %q. Valid modes are either %q or %q",
-			k, value, "generalPurpose", "maxIO"))
-	}
-	return
-}
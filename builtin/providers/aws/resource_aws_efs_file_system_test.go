@@ -37,6 +37,8 @@ func TestResourceAWSEFSPerformanceMode_validation(t *testing.T) {
 		ErrCount int
 	}
 
+	validateFunc := resourceAwsEfsFileSystem().Schema["performance_mode"].ValidateFunc
+
 	invalidCases := []testCase{
 		{
 			Value:    "garrusVakarian",
@@ -49,7 +51,7 @@ func TestResourceAWSEFSPerformanceMode_validation(t *testing.T) {
 	}
 
 	for _, tc := range invalidCases {
-		_, errors := validatePerformanceModeType(tc.Value, "performance_mode")
+		_, errors := validateFunc(tc.Value, "performance_mode")
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected to trigger a validation error")
 		}
@@ -67,7 +69,7 @@ func TestResourceAWSEFSPerformanceMode_validation(t *testing.T) {
 	}
 
 	for _, tc := range validCases {
-		_, errors := validatePerformanceModeType(tc.Value, "aws_efs_file_system")
+		_, errors := validateFunc(tc.Value, "aws_efs_file_system")
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected not to trigger a validation error")
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsIamUserSshKey() *schema.Resource {
@@ -40,7 +41,7 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 			"encoding": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateIamUserSSHKeyEncoding,
+				ValidateFunc: validation.StringInSlice([]string{"PEM", "SSH"}, false),
 			},
 
 			"status": &schema.Schema{
@@ -138,16 +139,3 @@ func resourceAwsIamUserSshKeyDelete(d *schema.ResourceData, meta interface{}) er
 	}
 	return nil
 }
-
-func validateIamUserSSHKeyEncoding(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	encodingTypes := map[string]bool{
-		"PEM": true,
-		"SSH": true,
-	}
-
-	if !encodingTypes[value] {
-		errors = append(errors, fmt.Errorf("IAM User SSH Key Encoding can only be PEM or SSH"))
-	}
-	return
-}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"testing"
 
@@ -12,6 +13,47 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+		Name: "aws_key_pair",
+		F:    testSweepKeyPairs,
+	})
+}
+
+func testSweepKeyPairs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	ec2conn := client.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Destroying the tmp keys in (%s)", region)
+
+	resp, err := ec2conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("key-name"),
+				Values: []*string{aws.String("tf-acc*")},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error describing key pairs in Sweeper: %s", err)
+	}
+
+	for _, k := range resp.KeyPairs {
+		log.Printf("[INFO] Deleting key pair %q", *k.KeyName)
+		_, err := ec2conn.DeleteKeyPair(&ec2.DeleteKeyPairInput{
+			KeyName: k.KeyName,
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting key pair %q: %s", *k.KeyName, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAWSKeyPair_basic(t *testing.T) {
 	var conf ec2.KeyPairInfo
 
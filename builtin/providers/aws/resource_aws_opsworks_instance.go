@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -45,13 +46,13 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "x86_64",
-				ValidateFunc: validateArchitecture,
+				ValidateFunc: validation.StringInSlice([]string{"x86_64", "i386"}, false),
 			},
 
 			"auto_scaling_type": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateAutoScalingType,
+				ValidateFunc: validation.StringInSlice([]string{"load", "timer"}, false),
 			},
 
 			"availability_zone": &schema.Schema{
@@ -217,7 +218,7 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Computed:     true,
-				ValidateFunc: validateRootDeviceType,
+				ValidateFunc: validation.StringInSlice([]string{"ebs", "instance-store"}, false),
 			},
 
 			"root_device_volume_id": &schema.Schema{
@@ -260,7 +261,7 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 			"state": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateState,
+				ValidateFunc: validation.StringInSlice([]string{"running", "stopped"}, false),
 			},
 
 			"status": &schema.Schema{
@@ -281,7 +282,7 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateVirtualizationType,
+				ValidateFunc: validation.StringInSlice([]string{"paravirtual", "hvm"}, false),
 			},
 
 			"ebs_block_device": &schema.Schema{
@@ -420,51 +421,6 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 	}
 }
 
-func validateArchitecture(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	if value != "x86_64" && value != "i386" {
-		errors = append(errors, fmt.Errorf(
-			"%q must be one of \"x86_64\" or \"i386\"", k))
-	}
-	return
-}
-
-func validateAutoScalingType(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	if value != "load" && value != "timer" {
-		errors = append(errors, fmt.Errorf(
-			"%q must be one of \"load\" or \"timer\"", k))
-	}
-	return
-}
-
-func validateRootDeviceType(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	if value != "ebs" && value != "instance-store" {
-		errors = append(errors, fmt.Errorf(
-			"%q must be one of \"ebs\" or \"instance-store\"", k))
-	}
-	return
-}
-
-func validateState(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	if value != "running" && value != "stopped" {
-		errors = append(errors, fmt.Errorf(
-			"%q must be one of \"running\" or \"stopped\"", k))
-	}
-	return
-}
-
-func validateVirtualizationType(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	if value != "paravirtual" && value != "hvm" {
-		errors = append(errors, fmt.Errorf(
-			"%q must be one of \"paravirtual\" or \"hvm\"", k))
-	}
-	return
-}
-
 func resourceAwsOpsworksInstanceValidate(d *schema.ResourceData) error {
 	if d.HasChange("ami_id") {
 		if v, ok := d.GetOk("os"); ok {
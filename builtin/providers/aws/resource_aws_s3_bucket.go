@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -259,7 +260,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 									"storage_class": &schema.Schema{
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateS3BucketLifecycleStorageClass,
+										ValidateFunc: validation.StringInSlice([]string{s3.TransitionStorageClassStandardIa, s3.TransitionStorageClassGlacier}, false),
 									},
 								},
 							},
@@ -277,7 +278,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 									"storage_class": &schema.Schema{
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateS3BucketLifecycleStorageClass,
+										ValidateFunc: validation.StringInSlice([]string{s3.TransitionStorageClassStandardIa, s3.TransitionStorageClassGlacier}, false),
 									},
 								},
 							},
@@ -296,14 +297,14 @@ func resourceAwsS3Bucket() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validateS3BucketAccelerationStatus,
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Suspended"}, false),
 			},
 
 			"request_payer": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validateS3BucketRequestPayerType,
+				ValidateFunc: validation.StringInSlice([]string{s3.PayerRequester, s3.PayerBucketOwner}, false),
 			},
 
 			"tags": tagsSchema(),
@@ -1406,28 +1407,6 @@ func normalizeRegion(region string) string {
 	return region
 }
 
-func validateS3BucketAccelerationStatus(v interface{}, k string) (ws []string, errors []error) {
-	validTypes := map[string]struct{}{
-		"Enabled":   struct{}{},
-		"Suspended": struct{}{},
-	}
-
-	if _, ok := validTypes[v.(string)]; !ok {
-		errors = append(errors, fmt.Errorf("S3 Bucket Acceleration Status %q is invalid, must be %q or %q", v.(string), "Enabled", "Suspended"))
-	}
-	return
-}
-
-func validateS3BucketRequestPayerType(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	if value != s3.PayerRequester && value != s3.PayerBucketOwner {
-		errors = append(errors, fmt.Errorf(
-			"%q contains an invalid Request Payer type %q. Valid types are either %q or %q",
-			k, value, s3.PayerRequester, s3.PayerBucketOwner))
-	}
-	return
-}
-
 func expirationHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -8,8 +8,10 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -96,6 +98,26 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Computed: true,
 			},
 
+			// source_hash is never sent to S3, it's only used to trigger updates
+			// when the content of the source changes. Unlike etag it can be used
+			// together with multipart uploads and SSE-KMS encryption.
+			"source_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"part_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateS3BucketObjectPartSize,
+			},
+
+			"upload_concurrency": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
 			"version_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -111,6 +133,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 	key := d.Get("key").(string)
 	acl := d.Get("acl").(string)
 	var body io.ReadSeeker
+	var size int64
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -122,8 +145,15 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
 		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("Error reading S3 bucket object source (%s): %s", source, err)
+		}
 
 		body = file
+		size = info.Size()
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
@@ -137,6 +167,63 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	// Sources which are larger than the part size are uploaded in parts, so
+	// that a failed part can be retried without sending the whole file again.
+	if v, ok := d.GetOk("part_size"); ok && size > int64(v.(int)) {
+		if _, ok := d.GetOk("etag"); ok {
+			return fmt.Errorf("Unable to specify 'part_size' and 'etag' together for a source larger than 'part_size' because 'etag' wouldn't equal the ETag of a multipart upload, use 'source_hash' instead")
+		}
+
+		input := &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			ACL:    aws.String(acl),
+		}
+
+		if v, ok := d.GetOk("cache_control"); ok {
+			input.CacheControl = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("content_type"); ok {
+			input.ContentType = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("content_encoding"); ok {
+			input.ContentEncoding = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("content_language"); ok {
+			input.ContentLanguage = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("content_disposition"); ok {
+			input.ContentDisposition = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("kms_key_id"); ok {
+			input.SSEKMSKeyId = aws.String(v.(string))
+			input.ServerSideEncryption = aws.String("aws:kms")
+		}
+
+		concurrency := 1
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			concurrency = v.(int)
+		}
+
+		resp, err := putS3BucketObjectMultipart(s3conn, input, body.(io.ReaderAt), size, int64(d.Get("part_size").(int)), concurrency)
+		if err != nil {
+			return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+		}
+
+		// The ETag of an object uploaded in parts isn't the MD5 sum of its
+		// content, see http://docs.aws.amazon.com/AmazonS3/latest/API/mpUploadComplete.html
+		d.Set("etag", strings.Trim(*resp.ETag, `"`))
+
+		d.Set("version_id", resp.VersionId)
+		d.SetId(key)
+		return resourceAwsS3BucketObjectRead(d, meta)
+	}
+
 	putInput := &s3.PutObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
@@ -262,6 +349,114 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// S3 requires each part of a multipart upload, other than the last one, to be
+// at least 5MB, and supports at most 10,000 parts.
+const (
+	s3BucketObjectMinPartSize = 5 * 1024 * 1024
+	s3BucketObjectMaxParts    = 10000
+)
+
+// putS3BucketObjectMultipart uploads the given content in parts of partSize
+// bytes, with up to concurrency parts being uploaded at once. Each part is
+// read from the content as it's uploaded, so the content is never held in
+// memory in full. The upload is aborted if any of the parts fail.
+func putS3BucketObjectMultipart(s3conn *s3.S3, input *s3.CreateMultipartUploadInput, content io.ReaderAt, size, partSize int64, concurrency int) (*s3.CompleteMultipartUploadOutput, error) {
+	partCount := (size + partSize - 1) / partSize
+	if partCount > s3BucketObjectMaxParts {
+		return nil, fmt.Errorf("%d byte source would need %d parts of %d bytes, S3 supports at most %d parts",
+			size, partCount, partSize, s3BucketObjectMaxParts)
+	}
+
+	upload, err := s3conn.CreateMultipartUpload(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating multipart upload: %s", err)
+	}
+	log.Printf("[DEBUG] Uploading S3 Bucket Object %s in %d parts (upload ID %s)", *input.Key, partCount, *upload.UploadId)
+
+	parts := make([]*s3.CompletedPart, partCount)
+	partNumbers := make(chan int64)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var uploadErr error
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partNumber := range partNumbers {
+				offset := (partNumber - 1) * partSize
+				length := partSize
+				if offset+length > size {
+					length = size - offset
+				}
+
+				resp, err := s3conn.UploadPart(&s3.UploadPartInput{
+					Bucket:        input.Bucket,
+					Key:           input.Key,
+					UploadId:      upload.UploadId,
+					PartNumber:    aws.Int64(partNumber),
+					ContentLength: aws.Int64(length),
+					Body:          io.NewSectionReader(content, offset, length),
+				})
+
+				mu.Lock()
+				if err != nil {
+					if uploadErr == nil {
+						uploadErr = fmt.Errorf("Error uploading part %d: %s", partNumber, err)
+					}
+				} else {
+					parts[partNumber-1] = &s3.CompletedPart{
+						ETag:       resp.ETag,
+						PartNumber: aws.Int64(partNumber),
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for partNumber := int64(1); partNumber <= partCount; partNumber++ {
+		mu.Lock()
+		failed := uploadErr != nil
+		mu.Unlock()
+		if failed {
+			break
+		}
+		partNumbers <- partNumber
+	}
+	close(partNumbers)
+	wg.Wait()
+
+	if uploadErr == nil {
+		resp, err := s3conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+			Bucket:   input.Bucket,
+			Key:      input.Key,
+			UploadId: upload.UploadId,
+			MultipartUpload: &s3.CompletedMultipartUpload{
+				Parts: parts,
+			},
+		})
+		if err == nil {
+			return resp, nil
+		}
+		uploadErr = fmt.Errorf("Error completing multipart upload: %s", err)
+	}
+
+	// Parts of an upload which is never completed or aborted are kept, and
+	// billed for, until they're cleaned up by a bucket lifecycle rule.
+	_, err = s3conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   input.Bucket,
+		Key:      input.Key,
+		UploadId: upload.UploadId,
+	})
+	if err != nil {
+		log.Printf("[WARN] Error aborting multipart upload %s of S3 Bucket Object %s: %s", *upload.UploadId, *input.Key, err)
+	}
+
+	return nil, uploadErr
+}
+
 func validateS3BucketObjectAclType(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -297,3 +492,12 @@ func validateS3BucketObjectAclType(v interface{}, k string) (ws []string, errors
 	}
 	return
 }
+
+func validateS3BucketObjectPartSize(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < s3BucketObjectMinPartSize {
+		errors = append(errors, fmt.Errorf(
+			"%q must be at least %d bytes (5MB), got %d", k, s3BucketObjectMinPartSize, value))
+	}
+	return
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -249,6 +250,38 @@ func testAccCheckAWSS3BucketObjectExists(n string, obj *s3.GetObjectOutput) reso
 	}
 }
 
+func TestAccAWSS3BucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// 12MB is uploaded in three parts of 5MB, the last one being partial.
+	err = ioutil.WriteFile(tmpFile.Name(), make([]byte, 12*1024*1024), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_multipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestMatchResourceAttr(
+						"aws_s3_bucket_object.object", "etag", regexp.MustCompile("-3$")),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3BucketObject_kms(t *testing.T) {
 	rInt := acctest.RandInt()
 	var obj s3.GetObjectOutput
@@ -337,6 +370,106 @@ func TestResourceAWSS3BucketObjectAcl_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAWSS3BucketObjectPartSize_validation(t *testing.T) {
+	var testCases = []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    1024,
+			ErrCount: 1,
+		},
+		{
+			Value:    5*1024*1024 - 1,
+			ErrCount: 1,
+		},
+		{
+			Value:    5 * 1024 * 1024,
+			ErrCount: 0,
+		},
+		{
+			Value:    100 * 1024 * 1024,
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, errors := validateS3BucketObjectPartSize(tc.Value, "part_size")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for part size %d, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAWSS3BucketObjectUploadConcurrency_validation(t *testing.T) {
+	var testCases = []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    20,
+			ErrCount: 0,
+		},
+		{
+			Value:    21,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceAwsS3BucketObject().Schema["upload_concurrency"].ValidateFunc
+	for _, tc := range testCases {
+		_, errors := validateFunc(tc.Value, "upload_concurrency")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for upload concurrency %d, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAWSS3BucketObjectPut_multipartEtag(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-s3-obj-multipart-etag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if err := ioutil.WriteFile(tmpFile.Name(), []byte("{anything will do }"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The part size is smaller than the source, so that it would be
+	// uploaded in parts. The error is returned before anything is sent.
+	data := map[string]interface{}{
+		"bucket":    "tf-object-test-bucket",
+		"key":       "test-key",
+		"source":    tmpFile.Name(),
+		"etag":      "7b006ff4d70f68cc65061acf2f802e6f",
+		"part_size": 4,
+	}
+
+	d := resourceAwsS3BucketObject().TestResourceData()
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	err = resourceAwsS3BucketObjectPut(d, &AWSClient{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !regexp.MustCompile("'part_size' and 'etag'").MatchString(err.Error()) {
+		t.Fatalf("bad error: %s", err)
+	}
+}
+
 func testAccCheckAWSS3BucketObjectAcl(n string, expectedPerms []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, _ := s.RootModule().Resources[n]
@@ -441,6 +574,23 @@ resource "aws_s3_bucket_object" "object" {
 `, randInt, source, source)
 }
 
+func testAccAWSS3BucketObjectConfig_multipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket.bucket}"
+	key = "multipart-key"
+	source = "%s"
+	source_hash = "${base64sha256(file("%s"))}"
+	part_size = 5242880
+	upload_concurrency = 2
+}
+`, randInt, source, source)
+}
+
 func testAccAWSS3BucketObjectConfig_withKMSId(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "kms_key_1" {
@@ -116,7 +116,9 @@ func TestAccAWSS3Bucket_RequestPayer(t *testing.T) {
 }
 
 func TestResourceAWSS3BucketRequestPayer_validation(t *testing.T) {
-	_, errors := validateS3BucketRequestPayerType("incorrect", "request_payer")
+	validateFunc := resourceAwsS3Bucket().Schema["request_payer"].ValidateFunc
+
+	_, errors := validateFunc("incorrect", "request_payer")
 	if len(errors) == 0 {
 		t.Fatalf("Expected to trigger a validation error")
 	}
@@ -136,7 +138,7 @@ func TestResourceAWSS3BucketRequestPayer_validation(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		_, errors := validateS3BucketRequestPayerType(tc.Value, "request_payer")
+		_, errors := validateFunc(tc.Value, "request_payer")
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected not to trigger a validation error")
 		}
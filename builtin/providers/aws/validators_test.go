@@ -3,6 +3,8 @@ package aws
 import (
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestValidateEcrRepositoryName(t *testing.T) {
@@ -410,13 +412,15 @@ func TestValidateS3BucketLifecycleTimestamp(t *testing.T) {
 }
 
 func TestValidateS3BucketLifecycleStorageClass(t *testing.T) {
+	validateFunc := resourceAwsS3Bucket().Schema["lifecycle_rule"].Elem.(*schema.Resource).Schema["transition"].Elem.(*schema.Resource).Schema["storage_class"].ValidateFunc
+
 	validStorageClass := []string{
 		"STANDARD_IA",
 		"GLACIER",
 	}
 
 	for _, v := range validStorageClass {
-		_, errors := validateS3BucketLifecycleStorageClass(v, "storage_class")
+		_, errors := validateFunc(v, "storage_class")
 		if len(errors) != 0 {
 			t.Fatalf("%q should be valid storage class: %q", v, errors)
 		}
@@ -427,7 +431,7 @@ func TestValidateS3BucketLifecycleStorageClass(t *testing.T) {
 		"1234",
 	}
 	for _, v := range invalidStorageClass {
-		_, errors := validateS3BucketLifecycleStorageClass(v, "storage_class")
+		_, errors := validateFunc(v, "storage_class")
 		if len(errors) == 0 {
 			t.Fatalf("%q should be invalid storage class", v)
 		}
@@ -461,26 +465,6 @@ func TestValidateS3BucketLifecycleRuleId(t *testing.T) {
 	}
 }
 
-func TestValidateIntegerInRange(t *testing.T) {
-	validIntegers := []int{-259, 0, 1, 5, 999}
-	min := -259
-	max := 999
-	for _, v := range validIntegers {
-		_, errors := validateIntegerInRange(min, max)(v, "name")
-		if len(errors) != 0 {
-			t.Fatalf("%q should be an integer in range (%d, %d): %q", v, min, max, errors)
-		}
-	}
-
-	invalidIntegers := []int{-260, -99999, 1000, 25678}
-	for _, v := range invalidIntegers {
-		_, errors := validateIntegerInRange(min, max)(v, "name")
-		if len(errors) == 0 {
-			t.Fatalf("%q should be an integer outside range (%d, %d)", v, min, max)
-		}
-	}
-}
-
 func TestResourceAWSElastiCacheClusterIdValidation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -0,0 +1,422 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK does not include the Microsoft.Web client, so the
+// ARM requests used by the App Service resources are described here for use
+// with the Riviera client.
+
+const appServiceAPIVersion = "2016-08-01"
+const appServicePlanAPIVersion = "2016-09-01"
+
+func appServicePlanDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Web/serverfarms/%s", resourceGroupName, name)
+	}
+}
+
+// appServiceDefaultURLPath returns the path of an App Service, or of one of
+// its deployment slots when slot is not empty.
+func appServiceDefaultURLPath(resourceGroupName, name, slot string, suffix string) func() string {
+	return func() string {
+		path := fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Web/sites/%s", resourceGroupName, name)
+		if slot != "" {
+			path = fmt.Sprintf("%s/slots/%s", path, slot)
+		}
+		return path + suffix
+	}
+}
+
+type appServicePlanSku struct {
+	Name     *string `json:"name" mapstructure:"name"`
+	Tier     *string `json:"tier" mapstructure:"tier"`
+	Capacity *int32  `json:"capacity,omitempty" mapstructure:"capacity"`
+}
+
+type getAppServicePlanResponse struct {
+	ID                     *string             `mapstructure:"id"`
+	Name                   *string             `mapstructure:"name"`
+	Location               *string             `mapstructure:"location"`
+	Tags                   *map[string]*string `mapstructure:"tags"`
+	Kind                   *string             `mapstructure:"kind"`
+	Sku                    *appServicePlanSku  `mapstructure:"sku"`
+	Reserved               *bool               `mapstructure:"reserved"`
+	MaximumNumberOfWorkers *int32              `mapstructure:"maximumNumberOfWorkers"`
+}
+
+type createOrUpdateAppServicePlan struct {
+	Name              string             `json:"-"`
+	ResourceGroupName string             `json:"-"`
+	Location          string             `json:"-" riviera:"location"`
+	Tags              map[string]*string `json:"-" riviera:"tags"`
+	Kind              *string            `json:"-" riviera:"kind"`
+	Sku               *appServicePlanSku `json:"-" riviera:"sku"`
+	Reserved          *bool              `json:"reserved,omitempty"`
+}
+
+func (command createOrUpdateAppServicePlan) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServicePlanAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: appServicePlanDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getAppServicePlanResponse{}
+		},
+	}
+}
+
+type getAppServicePlan struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getAppServicePlan) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServicePlanAPIVersion,
+		Method:      "GET",
+		URLPathFunc: appServicePlanDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getAppServicePlanResponse{}
+		},
+	}
+}
+
+type deleteAppServicePlan struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteAppServicePlan) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServicePlanAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: appServicePlanDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getAppServiceResponse struct {
+	ID                    *string             `mapstructure:"id"`
+	Name                  *string             `mapstructure:"name"`
+	Location              *string             `mapstructure:"location"`
+	Tags                  *map[string]*string `mapstructure:"tags"`
+	ServerFarmID          *string             `mapstructure:"serverFarmId"`
+	Enabled               *bool               `mapstructure:"enabled"`
+	ClientAffinityEnabled *bool               `mapstructure:"clientAffinityEnabled"`
+	Kind                  *string             `mapstructure:"kind"`
+	DefaultHostName       *string             `mapstructure:"defaultHostName"`
+	OutboundIPAddresses   *string             `mapstructure:"outboundIpAddresses"`
+}
+
+type createOrUpdateAppService struct {
+	Name                  string             `json:"-"`
+	ResourceGroupName     string             `json:"-"`
+	Slot                  string             `json:"-"`
+	Location              string             `json:"-" riviera:"location"`
+	Tags                  map[string]*string `json:"-" riviera:"tags"`
+	ServerFarmID          *string            `json:"serverFarmId"`
+	Enabled               *bool              `json:"enabled,omitempty"`
+	ClientAffinityEnabled *bool              `json:"clientAffinityEnabled,omitempty"`
+}
+
+func (command createOrUpdateAppService) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getAppServiceResponse{}
+		},
+	}
+}
+
+type getAppService struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Slot              string `json:"-"`
+}
+
+func (command getAppService) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "GET",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getAppServiceResponse{}
+		},
+	}
+}
+
+type deleteAppService struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Slot              string `json:"-"`
+}
+
+func (command deleteAppService) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, ""),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type appServiceSiteConfig struct {
+	AlwaysOn              *bool     `json:"alwaysOn,omitempty" mapstructure:"alwaysOn"`
+	DefaultDocuments      *[]string `json:"defaultDocuments,omitempty" mapstructure:"defaultDocuments"`
+	NetFrameworkVersion   *string   `json:"netFrameworkVersion,omitempty" mapstructure:"netFrameworkVersion"`
+	PhpVersion            *string   `json:"phpVersion,omitempty" mapstructure:"phpVersion"`
+	JavaVersion           *string   `json:"javaVersion,omitempty" mapstructure:"javaVersion"`
+	JavaContainer         *string   `json:"javaContainer,omitempty" mapstructure:"javaContainer"`
+	JavaContainerVersion  *string   `json:"javaContainerVersion,omitempty" mapstructure:"javaContainerVersion"`
+	Use32BitWorkerProcess *bool     `json:"use32BitWorkerProcess,omitempty" mapstructure:"use32BitWorkerProcess"`
+	WebSocketsEnabled     *bool     `json:"webSocketsEnabled,omitempty" mapstructure:"webSocketsEnabled"`
+}
+
+type updateAppServiceSiteConfig struct {
+	Name              string               `json:"-"`
+	ResourceGroupName string               `json:"-"`
+	Slot              string               `json:"-"`
+	SiteConfig        appServiceSiteConfig `json:"-"`
+}
+
+func (command updateAppServiceSiteConfig) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/config/web"),
+		RequestPropertiesFunc: func() interface{} {
+			return command.SiteConfig
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &appServiceSiteConfig{}
+		},
+	}
+}
+
+type getAppServiceSiteConfig struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Slot              string `json:"-"`
+}
+
+func (command getAppServiceSiteConfig) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "GET",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/config/web"),
+		ResponseTypeFunc: func() interface{} {
+			return &appServiceSiteConfig{}
+		},
+	}
+}
+
+// appServiceProperties wraps a map of settings so that it can be sent as the
+// properties of a request. Riviera inspects the fields of request properties,
+// which it cannot do for a map, so the map is marshalled explicitly instead.
+type appServiceProperties struct {
+	values interface{}
+}
+
+func (p appServiceProperties) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.values)
+}
+
+type appServiceConnectionString struct {
+	Value *string `json:"value" mapstructure:"value"`
+	Type  *string `json:"type" mapstructure:"type"`
+}
+
+type listAppServiceAppSettingsResponse struct {
+	Properties map[string]string `mapstructure:"properties"`
+}
+
+type listAppServiceConnectionStringsResponse struct {
+	Properties map[string]appServiceConnectionString `mapstructure:"properties"`
+}
+
+type updateAppServiceAppSettings struct {
+	Name              string            `json:"-"`
+	ResourceGroupName string            `json:"-"`
+	Slot              string            `json:"-"`
+	AppSettings       map[string]string `json:"-"`
+}
+
+func (command updateAppServiceAppSettings) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/config/appsettings"),
+		RequestPropertiesFunc: func() interface{} {
+			return appServiceProperties{values: command.AppSettings}
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &listAppServiceAppSettingsResponse{}
+		},
+	}
+}
+
+type listAppServiceAppSettings struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Slot              string `json:"-"`
+}
+
+func (command listAppServiceAppSettings) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:      appServiceAPIVersion,
+		Method:          "POST",
+		URLPathFunc:     appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/config/appsettings/list"),
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &listAppServiceAppSettingsResponse{}
+		},
+	}
+}
+
+type updateAppServiceConnectionStrings struct {
+	Name              string                                `json:"-"`
+	ResourceGroupName string                                `json:"-"`
+	Slot              string                                `json:"-"`
+	ConnectionStrings map[string]appServiceConnectionString `json:"-"`
+}
+
+func (command updateAppServiceConnectionStrings) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/config/connectionstrings"),
+		RequestPropertiesFunc: func() interface{} {
+			return appServiceProperties{values: command.ConnectionStrings}
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &listAppServiceConnectionStringsResponse{}
+		},
+	}
+}
+
+type listAppServiceConnectionStrings struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Slot              string `json:"-"`
+}
+
+func (command listAppServiceConnectionStrings) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:      appServiceAPIVersion,
+		Method:          "POST",
+		URLPathFunc:     appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/config/connectionstrings/list"),
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &listAppServiceConnectionStringsResponse{}
+		},
+	}
+}
+
+// appServiceNameValuePair is a name/value pair as used for the app settings
+// embedded in a site configuration.
+type appServiceNameValuePair struct {
+	Name  *string `json:"name"`
+	Value *string `json:"value"`
+}
+
+type functionAppSiteConfig struct {
+	AppSettings           *[]appServiceNameValuePair `json:"appSettings,omitempty"`
+	AlwaysOn              *bool                      `json:"alwaysOn,omitempty"`
+	Use32BitWorkerProcess *bool                      `json:"use32BitWorkerProcess,omitempty"`
+	WebSocketsEnabled     *bool                      `json:"webSocketsEnabled,omitempty"`
+}
+
+type createOrUpdateFunctionApp struct {
+	Name                  string                 `json:"-"`
+	ResourceGroupName     string                 `json:"-"`
+	Location              string                 `json:"-" riviera:"location"`
+	Tags                  map[string]*string     `json:"-" riviera:"tags"`
+	Kind                  *string                `json:"-" riviera:"kind"`
+	ServerFarmID          *string                `json:"serverFarmId"`
+	Enabled               *bool                  `json:"enabled,omitempty"`
+	ClientAffinityEnabled *bool                  `json:"clientAffinityEnabled,omitempty"`
+	SiteConfig            *functionAppSiteConfig `json:"siteConfig,omitempty"`
+}
+
+func (command createOrUpdateFunctionApp) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, "", ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getAppServiceResponse{}
+		},
+	}
+}
+
+type appServiceSourceControl struct {
+	RepoURL             *string `json:"repoUrl,omitempty" mapstructure:"repoUrl"`
+	Branch              *string `json:"branch,omitempty" mapstructure:"branch"`
+	IsManualIntegration *bool   `json:"isManualIntegration,omitempty" mapstructure:"isManualIntegration"`
+}
+
+type updateAppServiceSourceControl struct {
+	Name              string                  `json:"-"`
+	ResourceGroupName string                  `json:"-"`
+	Slot              string                  `json:"-"`
+	SourceControl     appServiceSourceControl `json:"-"`
+}
+
+func (command updateAppServiceSourceControl) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/sourcecontrols/web"),
+		RequestPropertiesFunc: func() interface{} {
+			return command.SourceControl
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &appServiceSourceControl{}
+		},
+	}
+}
+
+type getAppServiceSourceControl struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Slot              string `json:"-"`
+}
+
+func (command getAppServiceSourceControl) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "GET",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/sourcecontrols/web"),
+		ResponseTypeFunc: func() interface{} {
+			return &appServiceSourceControl{}
+		},
+	}
+}
+
+type deleteAppServiceSourceControl struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Slot              string `json:"-"`
+}
+
+func (command deleteAppServiceSourceControl) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  appServiceAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: appServiceDefaultURLPath(command.ResourceGroupName, command.Name, command.Slot, "/sourcecontrols/web"),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
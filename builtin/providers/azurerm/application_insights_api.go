@@ -0,0 +1,83 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for Application Insights, so the ARM
+// requests used by the Application Insights resource are described here for
+// use with the Riviera client.
+
+const applicationInsightsAPIVersion = "2015-05-01"
+
+func applicationInsightsDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Insights/components/%s", resourceGroupName, name)
+	}
+}
+
+type getApplicationInsightsResponse struct {
+	ID                 *string             `mapstructure:"id"`
+	Name               *string             `mapstructure:"name"`
+	Location           *string             `mapstructure:"location"`
+	Tags               *map[string]*string `mapstructure:"tags"`
+	Kind               *string             `mapstructure:"kind"`
+	ApplicationType    *string             `mapstructure:"Application_Type"`
+	AppID              *string             `mapstructure:"AppId"`
+	InstrumentationKey *string             `mapstructure:"InstrumentationKey"`
+	ProvisioningState  *string             `mapstructure:"provisioningState"`
+}
+
+type createOrUpdateApplicationInsights struct {
+	Name              string             `json:"-"`
+	ResourceGroupName string             `json:"-"`
+	Location          string             `json:"-" riviera:"location"`
+	Tags              map[string]*string `json:"-" riviera:"tags"`
+	Kind              *string            `json:"-" riviera:"kind"`
+	ApplicationType   *string            `json:"Application_Type"`
+}
+
+func (command createOrUpdateApplicationInsights) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  applicationInsightsAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: applicationInsightsDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getApplicationInsightsResponse{}
+		},
+	}
+}
+
+type getApplicationInsights struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getApplicationInsights) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  applicationInsightsAPIVersion,
+		Method:      "GET",
+		URLPathFunc: applicationInsightsDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getApplicationInsightsResponse{}
+		},
+	}
+}
+
+type deleteApplicationInsights struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteApplicationInsights) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  applicationInsightsAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: applicationInsightsDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
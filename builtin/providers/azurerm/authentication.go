@@ -0,0 +1,330 @@
+package azurerm
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// The provider can authenticate using a Service Principal with a client
+// secret or certificate, using the Managed Service Identity of the machine
+// it runs on, or using the tokens of a user logged in with the Azure CLI.
+// The functions here return an autorest.Authorizer for each of these, given
+// the resource (audience) a token is required for.
+
+// defaultMsiEndpoint is the Instance Metadata Service endpoint which issues
+// tokens for the Managed Service Identity of an Azure virtual machine.
+const defaultMsiEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+func (c *Config) getAuthorizer(env azure.Environment, resource string) (autorest.Authorizer, error) {
+	if c.UseMsi {
+		return newMsiAuthorizer(c.MsiEndpoint, c.ClientID, resource), nil
+	}
+
+	oauthConfig, err := env.OAuthConfigForTenant(c.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// This is necessary because no-one thought about API usability. OAuthConfigForTenant
+	// returns a pointer, which can be nil. NewServicePrincipalToken does not take a pointer.
+	// Consequently we have to nil check this and do _something_ if it is nil, which should
+	// be either an invariant of OAuthConfigForTenant (guarantee the token is not nil if
+	// there is no error), or NewServicePrincipalToken should error out if the configuration
+	// is required and is nil. This is the worst of all worlds, however.
+	if oauthConfig == nil {
+		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
+	}
+
+	if c.UseCli {
+		spt, err := azure.NewServicePrincipalTokenFromManualToken(*oauthConfig, c.ClientID, resource, *c.cliToken)
+		if err != nil {
+			return nil, err
+		}
+
+		// The token cached by the Azure CLI is issued for a different
+		// audience, so it is exchanged for a token for this resource using
+		// its refresh token.
+		if err := spt.Refresh(); err != nil {
+			return nil, fmt.Errorf("Error refreshing the Azure CLI token for %q - try running `az login`: %s", resource, err)
+		}
+
+		return spt, nil
+	}
+
+	if c.ClientCertPath != "" {
+		certificate, privateKey, err := decodeClientCertificate(c.ClientCertPath, c.ClientCertPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		return azure.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.ClientID, certificate, privateKey, resource)
+	}
+
+	return azure.NewServicePrincipalToken(*oauthConfig, c.ClientID, c.ClientSecret, resource)
+}
+
+// decodeClientCertificate reads the certificate and RSA private key used to
+// authenticate a Service Principal from a PKCS#12 (PFX) file.
+func decodeClientCertificate(path, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading the client certificate %q: %s", path, err)
+	}
+
+	key, certificate, err := pkcs12.Decode(contents, password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error decoding the client certificate %q: %s", path, err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("The client certificate %q must contain an RSA private key", path)
+	}
+
+	return certificate, privateKey, nil
+}
+
+// msiAuthorizer authorizes requests using tokens obtained from the Managed
+// Service Identity endpoint, which are refreshed shortly before they expire.
+type msiAuthorizer struct {
+	endpoint string
+	clientID string
+	resource string
+
+	l     sync.Mutex
+	token *azure.Token
+}
+
+func newMsiAuthorizer(endpoint, clientID, resource string) *msiAuthorizer {
+	if endpoint == "" {
+		endpoint = defaultMsiEndpoint
+	}
+
+	return &msiAuthorizer{
+		endpoint: endpoint,
+		clientID: clientID,
+		resource: resource,
+	}
+}
+
+func (a *msiAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			token, err := a.getToken()
+			if err != nil {
+				return r, err
+			}
+
+			return autorest.Prepare(r, autorest.WithHeader("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken)))
+		})
+	}
+}
+
+func (a *msiAuthorizer) getToken() (*azure.Token, error) {
+	a.l.Lock()
+	defer a.l.Unlock()
+
+	if a.token != nil && !a.token.WillExpireIn(5*time.Minute) {
+		return a.token, nil
+	}
+
+	values := url.Values{}
+	values.Set("api-version", "2018-02-01")
+	values.Set("resource", a.resource)
+	if a.clientID != "" {
+		values.Set("client_id", a.clientID)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s?%s", a.endpoint, values.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata", "true")
+
+	log.Printf("[DEBUG] Requesting a Managed Service Identity token for %q", a.resource)
+	resp, err := cleanhttp.DefaultClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error requesting a Managed Service Identity token from %s: %s", a.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("Error requesting a Managed Service Identity token from %s: %s: %s", a.endpoint, resp.Status, body)
+	}
+
+	var token azure.Token
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("Error decoding the Managed Service Identity token: %s", err)
+	}
+
+	a.token = &token
+	return a.token, nil
+}
+
+// azureCliProfile is the subset of the Azure CLI's azureProfile.json which
+// describes the subscriptions available to the logged in user.
+type azureCliProfile struct {
+	Subscriptions []azureCliSubscription `json:"subscriptions"`
+}
+
+type azureCliSubscription struct {
+	ID        string `json:"id"`
+	TenantID  string `json:"tenantId"`
+	IsDefault bool   `json:"isDefault"`
+	User      struct {
+		Name string `json:"name"`
+	} `json:"user"`
+}
+
+// azureCliAccessToken is an entry in the Azure CLI's accessTokens.json.
+type azureCliAccessToken struct {
+	TokenType    string `json:"tokenType"`
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	ExpiresOn    string `json:"expiresOn"`
+	Resource     string `json:"resource"`
+	UserID       string `json:"userId"`
+	ClientID     string `json:"_clientId"`
+	Authority    string `json:"_authority"`
+}
+
+// azureCliConfigDir returns the directory the Azure CLI stores its
+// configuration in, which can be overridden using AZURE_CONFIG_DIR.
+func azureCliConfigDir() (string, error) {
+	if dir := os.Getenv("AZURE_CONFIG_DIR"); dir != "" {
+		return dir, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("Error finding the home directory: %s", err)
+	}
+
+	return filepath.Join(home, ".azure"), nil
+}
+
+// readAzureCliFile decodes a JSON file written by the Azure CLI, which may
+// begin with a byte order mark.
+func readAzureCliFile(path string, v interface{}) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Error reading %q - ensure you have logged in using `az login`: %s", path, err)
+	}
+
+	contents = bytes.TrimPrefix(contents, []byte("\xef\xbb\xbf"))
+	if err := json.Unmarshal(contents, v); err != nil {
+		return fmt.Errorf("Error parsing %q: %s", path, err)
+	}
+
+	return nil
+}
+
+// loadAzureCliCredentials populates the Config from the subscriptions and
+// tokens of the user logged in with the Azure CLI. The configured
+// subscription is used if there is one, otherwise the user's default.
+func (c *Config) loadAzureCliCredentials() error {
+	dir, err := azureCliConfigDir()
+	if err != nil {
+		return err
+	}
+
+	var profile azureCliProfile
+	if err := readAzureCliFile(filepath.Join(dir, "azureProfile.json"), &profile); err != nil {
+		return err
+	}
+
+	subscription, err := findAzureCliSubscription(profile, c.SubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	var tokens []azureCliAccessToken
+	if err := readAzureCliFile(filepath.Join(dir, "accessTokens.json"), &tokens); err != nil {
+		return err
+	}
+
+	cliToken, err := findAzureCliAccessToken(tokens, subscription)
+	if err != nil {
+		return err
+	}
+
+	token, err := cliToken.toToken()
+	if err != nil {
+		return err
+	}
+
+	c.SubscriptionID = subscription.ID
+	c.TenantID = subscription.TenantID
+	c.ClientID = cliToken.ClientID
+	c.cliToken = token
+
+	return nil
+}
+
+func findAzureCliSubscription(profile azureCliProfile, subscriptionID string) (*azureCliSubscription, error) {
+	for _, subscription := range profile.Subscriptions {
+		if subscriptionID == "" && subscription.IsDefault {
+			return &subscription, nil
+		}
+		if subscriptionID != "" && strings.EqualFold(subscription.ID, subscriptionID) {
+			return &subscription, nil
+		}
+	}
+
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("No default subscription was found in the Azure CLI profile - set one using `az account set`")
+	}
+	return nil, fmt.Errorf("Subscription %q was not found in the Azure CLI profile", subscriptionID)
+}
+
+func findAzureCliAccessToken(tokens []azureCliAccessToken, subscription *azureCliSubscription) (*azureCliAccessToken, error) {
+	for _, token := range tokens {
+		if token.RefreshToken == "" || !strings.HasSuffix(strings.ToLower(token.Authority), strings.ToLower(subscription.TenantID)) {
+			continue
+		}
+		if subscription.User.Name != "" && !strings.EqualFold(token.UserID, subscription.User.Name) {
+			continue
+		}
+
+		return &token, nil
+	}
+
+	return nil, fmt.Errorf("No Azure CLI access token was found for tenant %q - try running `az login`", subscription.TenantID)
+}
+
+// toToken converts a token cached by the Azure CLI, whose expiry is a local
+// time, into an azure.Token.
+func (t azureCliAccessToken) toToken() (*azure.Token, error) {
+	expiresOn, err := time.ParseInLocation("2006-01-02 15:04:05.999999", t.ExpiresOn, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the expiry of the Azure CLI access token %q: %s", t.ExpiresOn, err)
+	}
+
+	return &azure.Token{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		ExpiresOn:    strconv.FormatInt(expiresOn.Unix(), 10),
+		Resource:     t.Resource,
+		Type:         t.TokenType,
+	}, nil
+}
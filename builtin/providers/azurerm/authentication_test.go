@@ -0,0 +1,274 @@
+package azurerm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestProviderConfig_validate(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   *Config
+		ErrCount int
+	}{
+		{
+			Name: "Service Principal",
+			Config: &Config{
+				SubscriptionID: "00000000-0000-0000-0000-000000000000",
+				ClientID:       "11111111-1111-1111-1111-111111111111",
+				ClientSecret:   "secret",
+				TenantID:       "22222222-2222-2222-2222-222222222222",
+			},
+			ErrCount: 0,
+		},
+		{
+			Name: "Service Principal without a secret",
+			Config: &Config{
+				SubscriptionID: "00000000-0000-0000-0000-000000000000",
+				ClientID:       "11111111-1111-1111-1111-111111111111",
+				TenantID:       "22222222-2222-2222-2222-222222222222",
+			},
+			ErrCount: 1,
+		},
+		{
+			Name: "Service Principal with a certificate",
+			Config: &Config{
+				SubscriptionID: "00000000-0000-0000-0000-000000000000",
+				ClientID:       "11111111-1111-1111-1111-111111111111",
+				ClientCertPath: "test-fixtures/service_principal.pfx",
+				TenantID:       "22222222-2222-2222-2222-222222222222",
+			},
+			ErrCount: 0,
+		},
+		{
+			Name: "Service Principal with a secret and a certificate",
+			Config: &Config{
+				SubscriptionID: "00000000-0000-0000-0000-000000000000",
+				ClientID:       "11111111-1111-1111-1111-111111111111",
+				ClientSecret:   "secret",
+				ClientCertPath: "test-fixtures/service_principal.pfx",
+				TenantID:       "22222222-2222-2222-2222-222222222222",
+			},
+			ErrCount: 1,
+		},
+		{
+			Name: "Managed Service Identity",
+			Config: &Config{
+				SubscriptionID: "00000000-0000-0000-0000-000000000000",
+				TenantID:       "22222222-2222-2222-2222-222222222222",
+				UseMsi:         true,
+			},
+			ErrCount: 0,
+		},
+		{
+			Name: "Managed Service Identity without a tenant",
+			Config: &Config{
+				SubscriptionID: "00000000-0000-0000-0000-000000000000",
+				UseMsi:         true,
+			},
+			ErrCount: 1,
+		},
+		{
+			Name: "Managed Service Identity and Azure CLI",
+			Config: &Config{
+				SubscriptionID: "00000000-0000-0000-0000-000000000000",
+				TenantID:       "22222222-2222-2222-2222-222222222222",
+				UseMsi:         true,
+				UseCli:         true,
+			},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		err := tc.Config.validate()
+
+		errCount := 0
+		if err != nil {
+			errCount = len(err.(interface {
+				WrappedErrors() []error
+			}).WrappedErrors())
+		}
+
+		if errCount != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors, got %d: %v", tc.Name, tc.ErrCount, errCount, err)
+		}
+	}
+}
+
+const testAzureCliProfile = "\xef\xbb\xbf" + `{
+  "subscriptions": [
+    {
+      "id": "00000000-0000-0000-0000-000000000000",
+      "tenantId": "22222222-2222-2222-2222-222222222222",
+      "isDefault": false,
+      "user": {"name": "user@example.com", "type": "user"}
+    },
+    {
+      "id": "33333333-3333-3333-3333-333333333333",
+      "tenantId": "44444444-4444-4444-4444-444444444444",
+      "isDefault": true,
+      "user": {"name": "user@example.com", "type": "user"}
+    }
+  ]
+}`
+
+const testAzureCliAccessTokens = `[
+  {
+    "tokenType": "Bearer",
+    "expiresOn": "2017-06-14 17:30:52.190398",
+    "resource": "https://management.core.windows.net/",
+    "accessToken": "first-access-token",
+    "refreshToken": "first-refresh-token",
+    "userId": "user@example.com",
+    "_clientId": "04b07795-8ddb-461a-bbee-02f9e1bf7b46",
+    "_authority": "https://login.microsoftonline.com/22222222-2222-2222-2222-222222222222"
+  },
+  {
+    "tokenType": "Bearer",
+    "expiresOn": "2017-06-14 17:30:52.190398",
+    "resource": "https://management.core.windows.net/",
+    "accessToken": "second-access-token",
+    "refreshToken": "second-refresh-token",
+    "userId": "user@example.com",
+    "_clientId": "04b07795-8ddb-461a-bbee-02f9e1bf7b46",
+    "_authority": "https://login.microsoftonline.com/44444444-4444-4444-4444-444444444444"
+  }
+]`
+
+func TestProviderConfig_loadAzureCliCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-azurerm-cli")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "azureProfile.json"), []byte(testAzureCliProfile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "accessTokens.json"), []byte(testAzureCliAccessTokens), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	oldDir := os.Getenv("AZURE_CONFIG_DIR")
+	os.Setenv("AZURE_CONFIG_DIR", dir)
+	defer os.Setenv("AZURE_CONFIG_DIR", oldDir)
+
+	cases := []struct {
+		SubscriptionID string
+		TenantID       string
+		AccessToken    string
+		ShouldErr      bool
+	}{
+		{
+			SubscriptionID: "",
+			TenantID:       "44444444-4444-4444-4444-444444444444",
+			AccessToken:    "second-access-token",
+		},
+		{
+			SubscriptionID: "00000000-0000-0000-0000-000000000000",
+			TenantID:       "22222222-2222-2222-2222-222222222222",
+			AccessToken:    "first-access-token",
+		},
+		{
+			SubscriptionID: "55555555-5555-5555-5555-555555555555",
+			ShouldErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		config := &Config{
+			SubscriptionID: tc.SubscriptionID,
+			UseCli:         true,
+		}
+
+		err := config.loadAzureCliCredentials()
+		if tc.ShouldErr {
+			if err == nil {
+				t.Fatalf("Expected loading the Azure CLI credentials for subscription %q to fail", tc.SubscriptionID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error loading the Azure CLI credentials for subscription %q: %s", tc.SubscriptionID, err)
+		}
+
+		if config.TenantID != tc.TenantID {
+			t.Fatalf("Expected tenant %q, got %q", tc.TenantID, config.TenantID)
+		}
+		if config.ClientID != "04b07795-8ddb-461a-bbee-02f9e1bf7b46" {
+			t.Fatalf("Expected the Azure CLI client ID, got %q", config.ClientID)
+		}
+		if config.cliToken.AccessToken != tc.AccessToken {
+			t.Fatalf("Expected access token %q, got %q", tc.AccessToken, config.cliToken.AccessToken)
+		}
+
+		expected := time.Date(2017, 6, 14, 17, 30, 52, 0, time.Local).Unix()
+		if config.cliToken.ExpiresOn != fmt.Sprintf("%d", expected) {
+			t.Fatalf("Expected the token to expire at %d, got %s", expected, config.cliToken.ExpiresOn)
+		}
+	}
+}
+
+func TestProviderMsiAuthorizer(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Header.Get("Metadata") != "true" {
+			t.Fatalf("Expected the Metadata header to be set")
+		}
+		if resource := r.URL.Query().Get("resource"); resource != "https://management.azure.com/" {
+			t.Fatalf("Expected a token for Resource Manager, got %q", resource)
+		}
+
+		fmt.Fprintf(w, `{"access_token": "msi-token", "expires_on": "%d", "token_type": "Bearer"}`, time.Now().Add(time.Hour).Unix())
+	}))
+	defer server.Close()
+
+	authorizer := newMsiAuthorizer(server.URL, "", "https://management.azure.com/")
+
+	for i := 0; i < 2; i++ {
+		req, err := autorest.Prepare(&http.Request{Header: http.Header{}}, authorizer.WithAuthorization())
+		if err != nil {
+			t.Fatalf("Error authorizing request: %s", err)
+		}
+
+		if header := req.Header.Get("Authorization"); header != "Bearer msi-token" {
+			t.Fatalf("Expected the MSI token to be used, got %q", header)
+		}
+	}
+
+	if requests != 1 {
+		t.Fatalf("Expected the MSI token to be reused until it expires, but it was requested %d times", requests)
+	}
+}
+
+func TestProviderConfig_decodeClientCertificate(t *testing.T) {
+	certificate, privateKey, err := decodeClientCertificate("test-fixtures/service_principal.pfx", "terraform")
+	if err != nil {
+		t.Fatalf("Error decoding the client certificate: %s", err)
+	}
+	if certificate.Subject.CommonName != "terraform-acctest" {
+		t.Fatalf("Expected the certificate subject to be %q but got %q", "terraform-acctest", certificate.Subject.CommonName)
+	}
+	if privateKey.Validate() != nil {
+		t.Fatalf("Expected the private key to be valid")
+	}
+
+	if _, _, err := decodeClientCertificate("test-fixtures/service_principal.pfx", "incorrect"); err == nil {
+		t.Fatalf("Expected an error decoding the client certificate with an incorrect password")
+	}
+
+	if _, _, err := decodeClientCertificate("test-fixtures/missing.pfx", "terraform"); err == nil {
+		t.Fatalf("Expected an error decoding a client certificate which doesn't exist")
+	}
+}
@@ -0,0 +1,79 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/satori/go.uuid"
+)
+
+const (
+	roleAssignmentsURLSegment = "/providers/Microsoft.Authorization/roleAssignments/"
+	roleDefinitionsURLSegment = "/providers/Microsoft.Authorization/roleDefinitions/"
+
+	policyAssignmentsURLSegment = "/providers/Microsoft.Authorization/policyAssignments/"
+	policyDefinitionsURLSegment = "/providers/Microsoft.Authorization/policyDefinitions/"
+
+	managementLocksURLSegment = "/providers/Microsoft.Authorization/locks/"
+)
+
+var armUUIDRegexp = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// authorizationResourceURI returns the URI of an Authorization resource, such
+// as a role assignment or management lock, named name beneath scope.
+func authorizationResourceURI(scope, segment, name string) string {
+	return strings.TrimSuffix(scope, "/") + segment + name
+}
+
+// parseAuthorizationResourceID splits the ID of an Authorization resource
+// into its scope and name.
+func parseAuthorizationResourceID(id, segment string) (string, string, error) {
+	index := strings.LastIndex(strings.ToLower(id), strings.ToLower(segment))
+	if index == -1 {
+		return "", "", fmt.Errorf("Cannot parse Azure Authorization ID %q: %q not found", id, segment)
+	}
+
+	scope := id[:index]
+	name := id[index+len(segment):]
+	if name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("Cannot parse Azure Authorization ID %q: invalid name %q", id, name)
+	}
+
+	return scope, name, nil
+}
+
+// generateAuthorizationResourceName returns a name for a role assignment or
+// role definition which is derived from the given values, so that retrying a
+// failed apply with the same configuration updates the existing resource
+// rather than creating a duplicate.
+func generateAuthorizationResourceName(values ...string) string {
+	return uuid.NewV5(uuid.NamespaceURL, strings.ToLower(strings.Join(values, "|"))).String()
+}
+
+func validateArmUUID(v interface{}, k string) (ws []string, errors []error) {
+	if !armUUIDRegexp.MatchString(v.(string)) {
+		errors = append(errors, fmt.Errorf("%q must be a UUID, such as 00000000-0000-0000-0000-000000000000", k))
+	}
+	return
+}
+
+func validateArmRoleDefinitionID(v interface{}, k string) (ws []string, errors []error) {
+	_, name, err := parseAuthorizationResourceID(v.(string), roleDefinitionsURLSegment)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Role Definition: %s", k, err))
+		return
+	}
+
+	if !armUUIDRegexp.MatchString(name) {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Role Definition, ending in a UUID", k))
+	}
+	return
+}
+
+func validateArmPolicyDefinitionID(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := parseAuthorizationResourceID(v.(string), policyDefinitionsURLSegment); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Policy Definition: %s", k, err))
+	}
+	return
+}
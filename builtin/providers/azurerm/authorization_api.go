@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for the Authorization API, so the ARM
+// requests used by azurerm_role_assignment, azurerm_role_definition and
+// azurerm_management_lock are described here for use with the Riviera client.
+// These are created beneath an arbitrary scope rather than a resource group,
+// so these requests are always made with NewRequestForURI.
+
+const (
+	authorizationAPIVersion  = "2015-07-01"
+	managementLockAPIVersion = "2016-09-01"
+)
+
+type getRoleAssignmentResponse struct {
+	ID               *string `mapstructure:"id"`
+	Name             *string `mapstructure:"name"`
+	RoleDefinitionID *string `mapstructure:"roleDefinitionId"`
+	PrincipalID      *string `mapstructure:"principalId"`
+	Scope            *string `mapstructure:"scope"`
+}
+
+type createRoleAssignment struct {
+	RoleDefinitionID *string `json:"roleDefinitionId"`
+	PrincipalID      *string `json:"principalId"`
+}
+
+func (command createRoleAssignment) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: authorizationAPIVersion,
+		Method:     "PUT",
+		ResponseTypeFunc: func() interface{} {
+			return &getRoleAssignmentResponse{}
+		},
+	}
+}
+
+type getRoleAssignment struct{}
+
+func (command getRoleAssignment) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: authorizationAPIVersion,
+		Method:     "GET",
+		ResponseTypeFunc: func() interface{} {
+			return &getRoleAssignmentResponse{}
+		},
+	}
+}
+
+type deleteRoleAssignment struct{}
+
+func (command deleteRoleAssignment) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: authorizationAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type roleDefinitionPermission struct {
+	Actions    []string `json:"actions" mapstructure:"actions"`
+	NotActions []string `json:"notActions" mapstructure:"notActions"`
+}
+
+type getRoleDefinitionResponse struct {
+	ID               *string                    `mapstructure:"id"`
+	Name             *string                    `mapstructure:"name"`
+	RoleName         *string                    `mapstructure:"roleName"`
+	Description      *string                    `mapstructure:"description"`
+	Type             *string                    `mapstructure:"type"`
+	Permissions      []roleDefinitionPermission `mapstructure:"permissions"`
+	AssignableScopes []string                   `mapstructure:"assignableScopes"`
+}
+
+type createOrUpdateRoleDefinition struct {
+	RoleName         *string                    `json:"roleName"`
+	Description      *string                    `json:"description,omitempty"`
+	Type             *string                    `json:"type"`
+	Permissions      []roleDefinitionPermission `json:"permissions"`
+	AssignableScopes []string                   `json:"assignableScopes"`
+}
+
+func (command createOrUpdateRoleDefinition) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: authorizationAPIVersion,
+		Method:     "PUT",
+		ResponseTypeFunc: func() interface{} {
+			return &getRoleDefinitionResponse{}
+		},
+	}
+}
+
+type getRoleDefinition struct{}
+
+func (command getRoleDefinition) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: authorizationAPIVersion,
+		Method:     "GET",
+		ResponseTypeFunc: func() interface{} {
+			return &getRoleDefinitionResponse{}
+		},
+	}
+}
+
+type deleteRoleDefinition struct{}
+
+func (command deleteRoleDefinition) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: authorizationAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getManagementLockResponse struct {
+	ID    *string `mapstructure:"id"`
+	Name  *string `mapstructure:"name"`
+	Level *string `mapstructure:"level"`
+	Notes *string `mapstructure:"notes"`
+}
+
+type createOrUpdateManagementLock struct {
+	Level *string `json:"level"`
+	Notes *string `json:"notes,omitempty"`
+}
+
+func (command createOrUpdateManagementLock) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: managementLockAPIVersion,
+		Method:     "PUT",
+		ResponseTypeFunc: func() interface{} {
+			return &getManagementLockResponse{}
+		},
+	}
+}
+
+type getManagementLock struct{}
+
+func (command getManagementLock) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: managementLockAPIVersion,
+		Method:     "GET",
+		ResponseTypeFunc: func() interface{} {
+			return &getManagementLockResponse{}
+		},
+	}
+}
+
+type deleteManagementLock struct{}
+
+func (command deleteManagementLock) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: managementLockAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
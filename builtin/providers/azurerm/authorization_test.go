@@ -0,0 +1,157 @@
+package azurerm
+
+import (
+	"testing"
+)
+
+func TestParseAuthorizationResourceID(t *testing.T) {
+	cases := []struct {
+		ID            string
+		ExpectedScope string
+		ExpectedName  string
+		ExpectError   bool
+	}{
+		{
+			ID:            "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/11111111-1111-1111-1111-111111111111",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			ExpectedName:  "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			ID:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/roleAssignments/11111111-1111-1111-1111-111111111111",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			ExpectedName:  "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			ID:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			ExpectError: true,
+		},
+		{
+			ID:          "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		scope, name, err := parseAuthorizationResourceID(tc.ID, roleAssignmentsURLSegment)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %s", tc.ID, err)
+		}
+
+		if scope != tc.ExpectedScope {
+			t.Fatalf("Expected scope %q for %q, got %q", tc.ExpectedScope, tc.ID, scope)
+		}
+		if name != tc.ExpectedName {
+			t.Fatalf("Expected name %q for %q, got %q", tc.ExpectedName, tc.ID, name)
+		}
+	}
+}
+
+func TestGenerateAuthorizationResourceName(t *testing.T) {
+	first := generateAuthorizationResourceName("/subscriptions/00000000-0000-0000-0000-000000000000", "role1")
+	second := generateAuthorizationResourceName("/subscriptions/00000000-0000-0000-0000-000000000000", "role1")
+	other := generateAuthorizationResourceName("/subscriptions/00000000-0000-0000-0000-000000000000", "role2")
+
+	if first != second {
+		t.Fatalf("Expected the same values to generate the same name, got %q and %q", first, second)
+	}
+	if first == other {
+		t.Fatalf("Expected different values to generate different names, got %q for both", first)
+	}
+	if _, errors := validateArmUUID(first, "name"); len(errors) != 0 {
+		t.Fatalf("Expected the generated name %q to be a UUID", first)
+	}
+}
+
+func TestResourceAzureRMUUID_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "00000000-0000-0000-0000-000000000000",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ACDD72A7-3385-48EF-BD42-F606FBA81AE7",
+			ErrCount: 0,
+		},
+		{
+			Value:    "{00000000-0000-0000-0000-000000000000}",
+			ErrCount: 1,
+		},
+		{
+			Value:    "principal",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmUUID(tc.Value, "principal_id")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM UUID %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMRoleDefinitionID_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/Reader",
+			ErrCount: 1,
+		},
+		{
+			Value:    "acdd72a7-3385-48ef-bd42-f606fba81ae7",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmRoleDefinitionID(tc.Value, "role_definition_id")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Role Definition ID %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMPolicyDefinitionID_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/allowed-locations",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmPolicyDefinitionID(tc.Value, "policy_definition_id")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Policy Definition ID %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for Azure Monitor autoscale settings,
+// so the ARM requests used by azurerm_autoscale_setting are described here
+// for use with the Riviera client.
+
+const autoscaleSettingAPIVersion = "2015-04-01"
+
+// autoscaleSettingCapacity holds the instance counts of a profile, which the
+// API represents as strings.
+type autoscaleSettingCapacity struct {
+	Minimum *string `json:"minimum" mapstructure:"minimum"`
+	Maximum *string `json:"maximum" mapstructure:"maximum"`
+	Default *string `json:"default" mapstructure:"default"`
+}
+
+type autoscaleSettingMetricTrigger struct {
+	MetricName        *string  `json:"metricName" mapstructure:"metricName"`
+	MetricResourceURI *string  `json:"metricResourceUri" mapstructure:"metricResourceUri"`
+	TimeGrain         *string  `json:"timeGrain" mapstructure:"timeGrain"`
+	Statistic         *string  `json:"statistic" mapstructure:"statistic"`
+	TimeWindow        *string  `json:"timeWindow" mapstructure:"timeWindow"`
+	TimeAggregation   *string  `json:"timeAggregation" mapstructure:"timeAggregation"`
+	Operator          *string  `json:"operator" mapstructure:"operator"`
+	Threshold         *float64 `json:"threshold" mapstructure:"threshold"`
+}
+
+type autoscaleSettingScaleAction struct {
+	Direction *string `json:"direction" mapstructure:"direction"`
+	Type      *string `json:"type" mapstructure:"type"`
+	Value     *string `json:"value,omitempty" mapstructure:"value"`
+	Cooldown  *string `json:"cooldown" mapstructure:"cooldown"`
+}
+
+type autoscaleSettingRule struct {
+	MetricTrigger *autoscaleSettingMetricTrigger `json:"metricTrigger" mapstructure:"metricTrigger"`
+	ScaleAction   *autoscaleSettingScaleAction   `json:"scaleAction" mapstructure:"scaleAction"`
+}
+
+type autoscaleSettingFixedDate struct {
+	TimeZone *string `json:"timeZone,omitempty" mapstructure:"timeZone"`
+	Start    *string `json:"start" mapstructure:"start"`
+	End      *string `json:"end" mapstructure:"end"`
+}
+
+type autoscaleSettingRecurrentSchedule struct {
+	TimeZone *string  `json:"timeZone" mapstructure:"timeZone"`
+	Days     []string `json:"days" mapstructure:"days"`
+	Hours    []int    `json:"hours" mapstructure:"hours"`
+	Minutes  []int    `json:"minutes" mapstructure:"minutes"`
+}
+
+type autoscaleSettingRecurrence struct {
+	Frequency *string                            `json:"frequency" mapstructure:"frequency"`
+	Schedule  *autoscaleSettingRecurrentSchedule `json:"schedule" mapstructure:"schedule"`
+}
+
+type autoscaleSettingProfile struct {
+	Name       *string                     `json:"name" mapstructure:"name"`
+	Capacity   *autoscaleSettingCapacity   `json:"capacity" mapstructure:"capacity"`
+	Rules      []autoscaleSettingRule      `json:"rules" mapstructure:"rules"`
+	FixedDate  *autoscaleSettingFixedDate  `json:"fixedDate,omitempty" mapstructure:"fixedDate"`
+	Recurrence *autoscaleSettingRecurrence `json:"recurrence,omitempty" mapstructure:"recurrence"`
+}
+
+type autoscaleSettingEmailNotification struct {
+	SendToSubscriptionAdministrator    *bool    `json:"sendToSubscriptionAdministrator" mapstructure:"sendToSubscriptionAdministrator"`
+	SendToSubscriptionCoAdministrators *bool    `json:"sendToSubscriptionCoAdministrators" mapstructure:"sendToSubscriptionCoAdministrators"`
+	CustomEmails                       []string `json:"customEmails" mapstructure:"customEmails"`
+}
+
+type autoscaleSettingWebhookNotification struct {
+	ServiceURI *string            `json:"serviceUri" mapstructure:"serviceUri"`
+	Properties map[string]*string `json:"properties,omitempty" mapstructure:"properties"`
+}
+
+type autoscaleSettingNotification struct {
+	Operation *string                               `json:"operation" mapstructure:"operation"`
+	Email     *autoscaleSettingEmailNotification    `json:"email,omitempty" mapstructure:"email"`
+	Webhooks  []autoscaleSettingWebhookNotification `json:"webhooks" mapstructure:"webhooks"`
+}
+
+type getAutoscaleSettingResponse struct {
+	ID                *string                        `mapstructure:"id"`
+	Name              *string                        `mapstructure:"name"`
+	Location          *string                        `mapstructure:"location"`
+	Tags              *map[string]*string            `mapstructure:"tags"`
+	Enabled           *bool                          `mapstructure:"enabled"`
+	TargetResourceURI *string                        `mapstructure:"targetResourceUri"`
+	Profiles          []autoscaleSettingProfile      `mapstructure:"profiles"`
+	Notifications     []autoscaleSettingNotification `mapstructure:"notifications"`
+}
+
+type createOrUpdateAutoscaleSetting struct {
+	Name              string                         `json:"name"`
+	ResourceGroupName string                         `json:"-"`
+	Location          string                         `json:"-" riviera:"location"`
+	Tags              map[string]*string             `json:"-" riviera:"tags"`
+	Enabled           *bool                          `json:"enabled"`
+	TargetResourceURI *string                        `json:"targetResourceUri"`
+	Profiles          []autoscaleSettingProfile      `json:"profiles"`
+	Notifications     []autoscaleSettingNotification `json:"notifications"`
+}
+
+func (command createOrUpdateAutoscaleSetting) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: autoscaleSettingAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/microsoft.insights/autoscalesettings/%s",
+				command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getAutoscaleSettingResponse{}
+		},
+	}
+}
+
+type getAutoscaleSetting struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getAutoscaleSetting) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: autoscaleSettingAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/microsoft.insights/autoscalesettings/%s",
+				command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getAutoscaleSettingResponse{}
+		},
+	}
+}
+
+type deleteAutoscaleSetting struct{}
+
+func (command deleteAutoscaleSetting) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: autoscaleSettingAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
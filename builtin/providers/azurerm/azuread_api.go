@@ -0,0 +1,99 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Applications and service principals live in Azure Active Directory rather
+// than in Azure Resource Manager, and the vendored Azure SDK has no client
+// for the Graph API which manages them. azureADGraphClient issues these
+// requests directly using autorest, authorized with a token for the Graph
+// audience rather than for ARM.
+
+const azureADGraphAPIVersion = "1.6"
+
+type azureADGraphClient struct {
+	autorest.Client
+
+	BaseURI  string
+	TenantID string
+}
+
+// do sends a request to the given path beneath the tenant, marshalling body
+// (if any) into the request and unmarshalling the response into result (if
+// any). Status codes other than those given are returned as errors, apart
+// from 404 for which the response is returned without error so that callers
+// can decide how a missing object should be handled.
+func (client azureADGraphClient) do(method, path string, body interface{}, result interface{}, codes ...int) (*http.Response, error) {
+	decorators := []autorest.PrepareDecorator{
+		autorest.AsJSON(),
+		autorest.WithMethod(method),
+		autorest.WithBaseURL(fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(client.BaseURI, "/"), client.TenantID, path)),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": azureADGraphAPIVersion,
+		}),
+	}
+	if body != nil {
+		decorators = append(decorators, autorest.WithJSON(body))
+	}
+
+	req, err := autorest.Prepare(&http.Request{}, decorators...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return resp, nil
+	}
+
+	responders := []autorest.RespondDecorator{
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(codes...),
+	}
+	if result != nil {
+		responders = append(responders, autorest.ByUnmarshallingJSON(result))
+	}
+	responders = append(responders, autorest.ByClosing())
+
+	return resp, autorest.Respond(resp, responders...)
+}
+
+type azureADApplication struct {
+	ObjectID                *string   `json:"objectId,omitempty"`
+	AppID                   *string   `json:"appId,omitempty"`
+	DisplayName             *string   `json:"displayName,omitempty"`
+	Homepage                *string   `json:"homepage,omitempty"`
+	IdentifierURIs          *[]string `json:"identifierUris,omitempty"`
+	ReplyURLs               *[]string `json:"replyUrls,omitempty"`
+	AvailableToOtherTenants *bool     `json:"availableToOtherTenants,omitempty"`
+	Oauth2AllowImplicitFlow *bool     `json:"oauth2AllowImplicitFlow,omitempty"`
+}
+
+type azureADServicePrincipal struct {
+	ObjectID       *string `json:"objectId,omitempty"`
+	AppID          *string `json:"appId,omitempty"`
+	DisplayName    *string `json:"displayName,omitempty"`
+	AccountEnabled *bool   `json:"accountEnabled,omitempty"`
+}
+
+type azureADPasswordCredential struct {
+	KeyID     *string `json:"keyId,omitempty"`
+	Value     *string `json:"value,omitempty"`
+	StartDate *string `json:"startDate,omitempty"`
+	EndDate   *string `json:"endDate,omitempty"`
+}
+
+type azureADPasswordCredentialsList struct {
+	Value []azureADPasswordCredential `json:"value"`
+}
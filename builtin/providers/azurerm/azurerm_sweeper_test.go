@@ -0,0 +1,25 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sharedClient returns an ArmClient configured from the same environment
+// variables as the provider, for use by the sweepers.
+func sharedClient() (*ArmClient, error) {
+	p := Provider().(*schema.Provider)
+	if err := p.Configure(terraform.NewResourceConfig(nil)); err != nil {
+		return nil, fmt.Errorf("Error configuring the AzureRM provider: %s", err)
+	}
+
+	return p.Meta().(*ArmClient), nil
+}
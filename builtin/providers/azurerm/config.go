@@ -1,10 +1,15 @@
 package azurerm
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/cdn"
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
@@ -23,6 +28,11 @@ import (
 // ArmClient contains the handles to all the specific Azure Resource Manager
 // resource classes' respective clients.
 type ArmClient struct {
+	clientId       string
+	tenantId       string
+	subscriptionId string
+	environment    azure.Environment
+
 	rivieraClient *riviera.Client
 
 	availSetClient         compute.AvailabilitySetsClient
@@ -30,10 +40,14 @@ type ArmClient struct {
 	vmExtensionImageClient compute.VirtualMachineExtensionImagesClient
 	vmExtensionClient      compute.VirtualMachineExtensionsClient
 	vmScaleSetClient       compute.VirtualMachineScaleSetsClient
+	vmScaleSetVMsClient    compute.VirtualMachineScaleSetVMsClient
 	vmImageClient          compute.VirtualMachineImagesClient
 	vmClient               compute.VirtualMachinesClient
 
 	appGatewayClient             network.ApplicationGatewaysClient
+	expressRouteAuthsClient      network.ExpressRouteCircuitAuthorizationsClient
+	expressRouteCircuitClient    network.ExpressRouteCircuitsClient
+	expressRoutePeeringsClient   network.ExpressRouteCircuitPeeringsClient
 	ifaceClient                  network.InterfacesClient
 	loadBalancerClient           network.LoadBalancersClient
 	localNetConnClient           network.LocalNetworkGatewaysClient
@@ -48,8 +62,9 @@ type ArmClient struct {
 	routeTablesClient            network.RouteTablesClient
 	routesClient                 network.RoutesClient
 
-	cdnProfilesClient  cdn.ProfilesClient
-	cdnEndpointsClient cdn.EndpointsClient
+	cdnProfilesClient      cdn.ProfilesClient
+	cdnEndpointsClient     cdn.EndpointsClient
+	cdnCustomDomainsClient cdn.CustomDomainsClient
 
 	providers           resources.ProvidersClient
 	resourceGroupClient resources.GroupsClient
@@ -65,6 +80,10 @@ type ArmClient struct {
 
 	trafficManagerProfilesClient  trafficmanager.ProfilesClient
 	trafficManagerEndpointsClient trafficmanager.EndpointsClient
+
+	keyVaultClient keyVaultDataClient
+
+	azureADClient azureADGraphClient
 }
 
 func withRequestLogging() autorest.SendDecorator {
@@ -95,6 +114,104 @@ func withRequestLogging() autorest.SendDecorator {
 	}
 }
 
+// retryStatusCodes are the status codes of responses to requests which are
+// retried: Azure throttles requests with 429s, and server errors are
+// usually transient.
+var retryStatusCodes = []int{
+	http.StatusRequestTimeout,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// withRetries retries requests which fail with one of retryStatusCodes up to
+// maxRetries times, backing off exponentially from backoff between attempts
+// unless the response includes a Retry-After header.
+func withRetries(maxRetries int, backoff time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			var body []byte
+			if r.Body != nil {
+				var err error
+				if body, err = ioutil.ReadAll(r.Body); err != nil {
+					return nil, err
+				}
+			}
+
+			for attempt := 0; ; attempt++ {
+				if body != nil {
+					r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				}
+
+				resp, err := s.Do(r)
+				if err != nil || attempt >= maxRetries || !autorest.ResponseHasStatusCode(resp, retryStatusCodes...) {
+					return resp, err
+				}
+
+				delay := autorest.GetRetryAfter(resp, backoff*time.Duration(1<<uint(attempt)))
+				log.Printf("[DEBUG] AzureRM Response: %s for %s - retrying in %s (attempt %d of %d)", resp.Status, r.URL, delay, attempt+1, maxRetries)
+				autorest.Respond(resp, autorest.ByClosing())
+
+				select {
+				case <-time.After(delay):
+				case <-r.Cancel:
+					return nil, fmt.Errorf("Request to %s was cancelled", r.URL)
+				}
+			}
+		})
+	}
+}
+
+// configureClient sets up the sending of requests by an Azure SDK client,
+// which retries according to the provider's settings in place of the
+// SDK's own retries.
+func (c *Config) configureClient(client *autorest.Client) {
+	client.Sender = autorest.CreateSender(withRequestLogging(), withRetries(c.MaxRetries, 5*time.Second))
+	client.RetryAttempts = 0
+	client.PollingDuration = c.PollingTimeout
+}
+
+func validateArmPollingTimeout(v interface{}, k string) (ws []string, errors []error) {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as \"60m\": %s", k, err))
+		return
+	}
+	if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+	return
+}
+
+// environments maps the values of the provider's environment argument to the
+// Azure cloud they refer to.
+var environments = map[string]azure.Environment{
+	"public":       azure.PublicCloud,
+	"usgovernment": azure.USGovernmentCloud,
+	"german":       azure.GermanCloud,
+	"china":        azure.ChinaCloud,
+}
+
+func validateArmEnvironment(v interface{}, k string) (ws []string, errors []error) {
+	if _, ok := environments[v.(string)]; !ok {
+		errors = append(errors, fmt.Errorf("%q must be one of public, usgovernment, german or china", k))
+	}
+	return
+}
+
+// activeDirectoryHost returns the scheme and host of an Active Directory
+// endpoint, since some environments include a query string in theirs.
+func activeDirectoryHost(env azure.Environment) (string, error) {
+	u, err := url.Parse(env.ActiveDirectoryEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing the Active Directory endpoint %q: %s", env.ActiveDirectoryEndpoint, err)
+	}
+
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host), nil
+}
+
 func setUserAgent(client *autorest.Client) {
 	version := terraform.VersionString()
 	client.UserAgent = fmt.Sprintf("HashiCorp-Terraform-v%s", version)
@@ -103,244 +220,350 @@ func setUserAgent(client *autorest.Client) {
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
 func (c *Config) getArmClient() (*ArmClient, error) {
+	env, ok := environments[c.Environment]
+	if !ok {
+		return nil, fmt.Errorf("Unknown Azure environment %q", c.Environment)
+	}
+
 	// client declarations:
-	client := ArmClient{}
-
-	rivieraClient, err := riviera.NewClient(&riviera.AzureResourceManagerCredentials{
-		ClientID:       c.ClientID,
-		ClientSecret:   c.ClientSecret,
-		TenantID:       c.TenantID,
-		SubscriptionID: c.SubscriptionID,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("Error creating Riviera client: %s", err)
+	client := ArmClient{
+		clientId:       c.ClientID,
+		tenantId:       c.TenantID,
+		subscriptionId: c.SubscriptionID,
+		environment:    env,
 	}
 
-	// validate that the credentials are correct using Riviera. Note that this must be
-	// done _before_ using the Microsoft SDK, because Riviera handles errors. Using a
-	// namespace registration instead of a simple OAuth token refresh guarantees that
-	// service delegation is correct. This has the effect of registering Microsoft.Compute
-	// which is neccessary anyway.
-	if err := registerProviderWithSubscription("Microsoft.Compute", rivieraClient); err != nil {
+	// The clients of the Azure SDK default to the public cloud, so each has
+	// its BaseURI set to the Resource Manager endpoint of the environment.
+	endpoint := strings.TrimSuffix(env.ResourceManagerEndpoint, "/")
+
+	spt, err := c.getAuthorizer(env, env.ResourceManagerEndpoint)
+	if err != nil {
 		return nil, err
 	}
-	client.rivieraClient = rivieraClient
 
-	oauthConfig, err := azure.PublicCloud.OAuthConfigForTenant(c.TenantID)
+	adEndpoint, err := activeDirectoryHost(env)
 	if err != nil {
 		return nil, err
 	}
 
-	// This is necessary because no-one thought about API usability. OAuthConfigForTenant
-	// returns a pointer, which can be nil. NewServicePrincipalToken does not take a pointer.
-	// Consequently we have to nil check this and do _something_ if it is nil, which should
-	// be either an invariant of OAuthConfigForTenant (guarantee the token is not nil if
-	// there is no error), or NewServicePrincipalToken should error out if the configuration
-	// is required and is nil. This is the worst of all worlds, however.
-	if oauthConfig == nil {
-		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
+	rivieraCredentials := &riviera.AzureResourceManagerCredentials{
+		ClientID:                c.ClientID,
+		ClientSecret:            c.ClientSecret,
+		TenantID:                c.TenantID,
+		SubscriptionID:          c.SubscriptionID,
+		ResourceManagerEndpoint: env.ResourceManagerEndpoint,
+		ActiveDirectoryEndpoint: adEndpoint,
 	}
 
-	spt, err := azure.NewServicePrincipalToken(*oauthConfig, c.ClientID, c.ClientSecret,
-		azure.PublicCloud.ResourceManagerEndpoint)
+	// Riviera can only obtain tokens for a Service Principal with a client
+	// secret itself, so otherwise it shares the authorizer used by the
+	// Azure SDK.
+	if c.ClientSecret == "" {
+		rivieraCredentials.AuthorizeRequest = func(r *http.Request) error {
+			_, err := autorest.Prepare(r, spt.WithAuthorization())
+			return err
+		}
+	}
+
+	rivieraClient, err := riviera.NewClient(rivieraCredentials)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Error creating Riviera client: %s", err)
 	}
 
+	client.rivieraClient = rivieraClient
+
 	// NOTE: these declarations should be left separate for clarity should the
 	// clients be wished to be configured with custom Responders/PollingModess etc...
 	asc := compute.NewAvailabilitySetsClient(c.SubscriptionID)
 	setUserAgent(&asc.Client)
+	asc.BaseURI = endpoint
 	asc.Authorizer = spt
-	asc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&asc.Client)
 	client.availSetClient = asc
 
 	uoc := compute.NewUsageOperationsClient(c.SubscriptionID)
 	setUserAgent(&uoc.Client)
+	uoc.BaseURI = endpoint
 	uoc.Authorizer = spt
-	uoc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&uoc.Client)
 	client.usageOpsClient = uoc
 
 	vmeic := compute.NewVirtualMachineExtensionImagesClient(c.SubscriptionID)
 	setUserAgent(&vmeic.Client)
+	vmeic.BaseURI = endpoint
 	vmeic.Authorizer = spt
-	vmeic.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&vmeic.Client)
 	client.vmExtensionImageClient = vmeic
 
 	vmec := compute.NewVirtualMachineExtensionsClient(c.SubscriptionID)
 	setUserAgent(&vmec.Client)
+	vmec.BaseURI = endpoint
 	vmec.Authorizer = spt
-	vmec.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&vmec.Client)
 	client.vmExtensionClient = vmec
 
 	vmic := compute.NewVirtualMachineImagesClient(c.SubscriptionID)
 	setUserAgent(&vmic.Client)
+	vmic.BaseURI = endpoint
 	vmic.Authorizer = spt
-	vmic.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&vmic.Client)
 	client.vmImageClient = vmic
 
 	vmssc := compute.NewVirtualMachineScaleSetsClient(c.SubscriptionID)
 	setUserAgent(&vmssc.Client)
+	vmssc.BaseURI = endpoint
 	vmssc.Authorizer = spt
-	vmssc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&vmssc.Client)
 	client.vmScaleSetClient = vmssc
 
+	vmssvmc := compute.NewVirtualMachineScaleSetVMsClient(c.SubscriptionID)
+	setUserAgent(&vmssvmc.Client)
+	vmssvmc.BaseURI = endpoint
+	vmssvmc.Authorizer = spt
+	c.configureClient(&vmssvmc.Client)
+	client.vmScaleSetVMsClient = vmssvmc
+
 	vmc := compute.NewVirtualMachinesClient(c.SubscriptionID)
 	setUserAgent(&vmc.Client)
+	vmc.BaseURI = endpoint
 	vmc.Authorizer = spt
-	vmc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&vmc.Client)
 	client.vmClient = vmc
 
 	agc := network.NewApplicationGatewaysClient(c.SubscriptionID)
 	setUserAgent(&agc.Client)
+	agc.BaseURI = endpoint
 	agc.Authorizer = spt
-	agc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&agc.Client)
 	client.appGatewayClient = agc
 
 	ifc := network.NewInterfacesClient(c.SubscriptionID)
 	setUserAgent(&ifc.Client)
+	ifc.BaseURI = endpoint
 	ifc.Authorizer = spt
-	ifc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&ifc.Client)
 	client.ifaceClient = ifc
 
 	lbc := network.NewLoadBalancersClient(c.SubscriptionID)
 	setUserAgent(&lbc.Client)
+	lbc.BaseURI = endpoint
 	lbc.Authorizer = spt
-	lbc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&lbc.Client)
 	client.loadBalancerClient = lbc
 
 	lgc := network.NewLocalNetworkGatewaysClient(c.SubscriptionID)
 	setUserAgent(&lgc.Client)
+	lgc.BaseURI = endpoint
 	lgc.Authorizer = spt
-	lgc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&lgc.Client)
 	client.localNetConnClient = lgc
 
 	pipc := network.NewPublicIPAddressesClient(c.SubscriptionID)
 	setUserAgent(&pipc.Client)
+	pipc.BaseURI = endpoint
 	pipc.Authorizer = spt
-	pipc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&pipc.Client)
 	client.publicIPClient = pipc
 
 	sgc := network.NewSecurityGroupsClient(c.SubscriptionID)
 	setUserAgent(&sgc.Client)
+	sgc.BaseURI = endpoint
 	sgc.Authorizer = spt
-	sgc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&sgc.Client)
 	client.secGroupClient = sgc
 
 	src := network.NewSecurityRulesClient(c.SubscriptionID)
 	setUserAgent(&src.Client)
+	src.BaseURI = endpoint
 	src.Authorizer = spt
-	src.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&src.Client)
 	client.secRuleClient = src
 
 	snc := network.NewSubnetsClient(c.SubscriptionID)
 	setUserAgent(&snc.Client)
+	snc.BaseURI = endpoint
 	snc.Authorizer = spt
-	snc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&snc.Client)
 	client.subnetClient = snc
 
+	erac := network.NewExpressRouteCircuitAuthorizationsClient(c.SubscriptionID)
+	setUserAgent(&erac.Client)
+	erac.BaseURI = endpoint
+	erac.Authorizer = spt
+	c.configureClient(&erac.Client)
+	client.expressRouteAuthsClient = erac
+
+	ercc := network.NewExpressRouteCircuitsClient(c.SubscriptionID)
+	setUserAgent(&ercc.Client)
+	ercc.BaseURI = endpoint
+	ercc.Authorizer = spt
+	c.configureClient(&ercc.Client)
+	client.expressRouteCircuitClient = ercc
+
+	erpc := network.NewExpressRouteCircuitPeeringsClient(c.SubscriptionID)
+	setUserAgent(&erpc.Client)
+	erpc.BaseURI = endpoint
+	erpc.Authorizer = spt
+	c.configureClient(&erpc.Client)
+	client.expressRoutePeeringsClient = erpc
+
 	vgcc := network.NewVirtualNetworkGatewayConnectionsClient(c.SubscriptionID)
 	setUserAgent(&vgcc.Client)
+	vgcc.BaseURI = endpoint
 	vgcc.Authorizer = spt
-	vgcc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&vgcc.Client)
 	client.vnetGatewayConnectionsClient = vgcc
 
 	vgc := network.NewVirtualNetworkGatewaysClient(c.SubscriptionID)
 	setUserAgent(&vgc.Client)
+	vgc.BaseURI = endpoint
 	vgc.Authorizer = spt
-	vgc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&vgc.Client)
 	client.vnetGatewayClient = vgc
 
 	vnc := network.NewVirtualNetworksClient(c.SubscriptionID)
 	setUserAgent(&vnc.Client)
+	vnc.BaseURI = endpoint
 	vnc.Authorizer = spt
-	vnc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&vnc.Client)
 	client.vnetClient = vnc
 
 	rtc := network.NewRouteTablesClient(c.SubscriptionID)
 	setUserAgent(&rtc.Client)
+	rtc.BaseURI = endpoint
 	rtc.Authorizer = spt
-	rtc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&rtc.Client)
 	client.routeTablesClient = rtc
 
 	rc := network.NewRoutesClient(c.SubscriptionID)
 	setUserAgent(&rc.Client)
+	rc.BaseURI = endpoint
 	rc.Authorizer = spt
-	rc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&rc.Client)
 	client.routesClient = rc
 
 	rgc := resources.NewGroupsClient(c.SubscriptionID)
 	setUserAgent(&rgc.Client)
+	rgc.BaseURI = endpoint
 	rgc.Authorizer = spt
-	rgc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&rgc.Client)
 	client.resourceGroupClient = rgc
 
 	pc := resources.NewProvidersClient(c.SubscriptionID)
 	setUserAgent(&pc.Client)
+	pc.BaseURI = endpoint
 	pc.Authorizer = spt
-	pc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&pc.Client)
 	client.providers = pc
 
 	tc := resources.NewTagsClient(c.SubscriptionID)
 	setUserAgent(&tc.Client)
+	tc.BaseURI = endpoint
 	tc.Authorizer = spt
-	tc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&tc.Client)
 	client.tagsClient = tc
 
 	jc := scheduler.NewJobsClient(c.SubscriptionID)
 	setUserAgent(&jc.Client)
+	jc.BaseURI = endpoint
 	jc.Authorizer = spt
-	jc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&jc.Client)
 	client.jobsClient = jc
 
 	jcc := scheduler.NewJobCollectionsClient(c.SubscriptionID)
 	setUserAgent(&jcc.Client)
+	jcc.BaseURI = endpoint
 	jcc.Authorizer = spt
-	jcc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&jcc.Client)
 	client.jobsCollectionsClient = jcc
 
 	ssc := storage.NewAccountsClient(c.SubscriptionID)
 	setUserAgent(&ssc.Client)
+	ssc.BaseURI = endpoint
 	ssc.Authorizer = spt
-	ssc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&ssc.Client)
 	client.storageServiceClient = ssc
 
 	suc := storage.NewUsageOperationsClient(c.SubscriptionID)
 	setUserAgent(&suc.Client)
+	suc.BaseURI = endpoint
 	suc.Authorizer = spt
-	suc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&suc.Client)
 	client.storageUsageClient = suc
 
 	cpc := cdn.NewProfilesClient(c.SubscriptionID)
 	setUserAgent(&cpc.Client)
+	cpc.BaseURI = endpoint
 	cpc.Authorizer = spt
-	cpc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&cpc.Client)
 	client.cdnProfilesClient = cpc
 
 	cec := cdn.NewEndpointsClient(c.SubscriptionID)
 	setUserAgent(&cec.Client)
+	cec.BaseURI = endpoint
 	cec.Authorizer = spt
-	cec.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&cec.Client)
 	client.cdnEndpointsClient = cec
 
+	ccdc := cdn.NewCustomDomainsClient(c.SubscriptionID)
+	setUserAgent(&ccdc.Client)
+	ccdc.BaseURI = endpoint
+	ccdc.Authorizer = spt
+	c.configureClient(&ccdc.Client)
+	client.cdnCustomDomainsClient = ccdc
+
 	dc := resources.NewDeploymentsClient(c.SubscriptionID)
 	setUserAgent(&dc.Client)
+	dc.BaseURI = endpoint
 	dc.Authorizer = spt
-	dc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&dc.Client)
 	client.deploymentsClient = dc
 
 	tmpc := trafficmanager.NewProfilesClient(c.SubscriptionID)
 	setUserAgent(&tmpc.Client)
+	tmpc.BaseURI = endpoint
 	tmpc.Authorizer = spt
-	tmpc.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&tmpc.Client)
 	client.trafficManagerProfilesClient = tmpc
 
 	tmec := trafficmanager.NewEndpointsClient(c.SubscriptionID)
 	setUserAgent(&tmec.Client)
+	tmec.BaseURI = endpoint
 	tmec.Authorizer = spt
-	tmec.Sender = autorest.CreateSender(withRequestLogging())
+	c.configureClient(&tmec.Client)
 	client.trafficManagerEndpointsClient = tmec
 
+	// The Key Vault data plane requires a token issued for the Key Vault
+	// audience rather than for Azure Resource Manager.
+	kvspt, err := c.getAuthorizer(env, strings.TrimSuffix(env.KeyVaultEndpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	kvc := keyVaultDataClient{autorest.NewClientWithUserAgent("")}
+	setUserAgent(&kvc.Client)
+	kvc.Authorizer = kvspt
+	c.configureClient(&kvc.Client)
+	client.keyVaultClient = kvc
+
+	// Likewise the Graph API, which manages Azure Active Directory, requires
+	// a token issued for the Graph audience.
+	graphspt, err := c.getAuthorizer(env, env.GraphEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	adc := azureADGraphClient{
+		Client:   autorest.NewClientWithUserAgent(""),
+		BaseURI:  env.GraphEndpoint,
+		TenantID: c.TenantID,
+	}
+	setUserAgent(&adc.Client)
+	adc.Authorizer = graphspt
+	c.configureClient(&adc.Client)
+	client.azureADClient = adc
+
 	return &client, nil
 }
 
@@ -372,7 +595,7 @@ func (armClient *ArmClient) getBlobStorageClientForStorageAccount(resourceGroupN
 		return nil, false, nil
 	}
 
-	storageClient, err := mainStorage.NewBasicClient(storageAccountName, key)
+	storageClient, err := mainStorage.NewClient(storageAccountName, key, armClient.environment.StorageEndpointSuffix, mainStorage.DefaultAPIVersion, true)
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
 	}
@@ -389,7 +612,7 @@ func (armClient *ArmClient) getTableServiceClientForStorageAccount(resourceGroup
 		return nil, false, nil
 	}
 
-	storageClient, err := mainStorage.NewBasicClient(storageAccountName, key)
+	storageClient, err := mainStorage.NewClient(storageAccountName, key, armClient.environment.StorageEndpointSuffix, mainStorage.DefaultAPIVersion, true)
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
 	}
@@ -398,6 +621,24 @@ func (armClient *ArmClient) getTableServiceClientForStorageAccount(resourceGroup
 	return &tableClient, true, nil
 }
 
+func (armClient *ArmClient) getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName string) (*mainStorage.FileServiceClient, bool, error) {
+	key, accountExists, err := armClient.getKeyForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, accountExists, err
+	}
+	if accountExists == false {
+		return nil, false, nil
+	}
+
+	storageClient, err := mainStorage.NewClient(storageAccountName, key, armClient.environment.StorageEndpointSuffix, mainStorage.DefaultAPIVersion, true)
+	if err != nil {
+		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
+	}
+
+	fileClient := storageClient.GetFileService()
+	return &fileClient, true, nil
+}
+
 func (armClient *ArmClient) getQueueServiceClientForStorageAccount(resourceGroupName, storageAccountName string) (*mainStorage.QueueServiceClient, bool, error) {
 	key, accountExists, err := armClient.getKeyForStorageAccount(resourceGroupName, storageAccountName)
 	if err != nil {
@@ -407,7 +648,7 @@ func (armClient *ArmClient) getQueueServiceClientForStorageAccount(resourceGroup
 		return nil, false, nil
 	}
 
-	storageClient, err := mainStorage.NewBasicClient(storageAccountName, key)
+	storageClient, err := mainStorage.NewClient(storageAccountName, key, armClient.environment.StorageEndpointSuffix, mainStorage.DefaultAPIVersion, true)
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
 	}
@@ -0,0 +1,85 @@
+package azurerm
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithRetries(t *testing.T) {
+	cases := []struct {
+		Name       string
+		MaxRetries int
+		Failures   int
+		StatusCode int
+		Requests   int
+	}{
+		{
+			Name:       "Succeeds immediately",
+			MaxRetries: 3,
+			Failures:   0,
+			StatusCode: http.StatusOK,
+			Requests:   1,
+		},
+		{
+			Name:       "Succeeds after being throttled",
+			MaxRetries: 3,
+			Failures:   2,
+			StatusCode: http.StatusOK,
+			Requests:   3,
+		},
+		{
+			Name:       "Gives up after the maximum retries",
+			MaxRetries: 1,
+			Failures:   3,
+			StatusCode: http.StatusTooManyRequests,
+			Requests:   2,
+		},
+		{
+			Name:       "Doesn't retry",
+			MaxRetries: 0,
+			Failures:   1,
+			StatusCode: http.StatusTooManyRequests,
+			Requests:   1,
+		},
+	}
+
+	for _, tc := range cases {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != "request" {
+				t.Fatalf("%s: expected the request body to be resent, got %q", tc.Name, body)
+			}
+
+			if requests <= tc.Failures {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req, _ := http.NewRequest("PUT", server.URL, strings.NewReader("request"))
+		sender := autorest.DecorateSender(&http.Client{}, withRetries(tc.MaxRetries, time.Millisecond))
+		resp, err := sender.Do(req)
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+		if resp.StatusCode != tc.StatusCode {
+			t.Fatalf("%s: expected status code %d, got %d", tc.Name, tc.StatusCode, resp.StatusCode)
+		}
+		if requests != tc.Requests {
+			t.Fatalf("%s: expected %d requests, got %d", tc.Name, tc.Requests, requests)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK does not include the Microsoft.ContainerRegistry
+// client, so the ARM requests used by the Container Registry resources are
+// described here for use with the Riviera client.
+
+const containerRegistryAPIVersion = "2017-10-01"
+
+func containerRegistryDefaultURLPath(resourceGroupName, name, suffix string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s%s", resourceGroupName, name, suffix)
+	}
+}
+
+type containerRegistrySku struct {
+	Name *string `json:"name" mapstructure:"name"`
+}
+
+type getContainerRegistryResponse struct {
+	ID               *string               `mapstructure:"id"`
+	Name             *string               `mapstructure:"name"`
+	Location         *string               `mapstructure:"location"`
+	Tags             *map[string]*string   `mapstructure:"tags"`
+	Sku              *containerRegistrySku `mapstructure:"sku"`
+	LoginServer      *string               `mapstructure:"loginServer"`
+	AdminUserEnabled *bool                 `mapstructure:"adminUserEnabled"`
+}
+
+type createOrUpdateContainerRegistry struct {
+	Name              string                `json:"-"`
+	ResourceGroupName string                `json:"-"`
+	Location          string                `json:"-" riviera:"location"`
+	Tags              map[string]*string    `json:"-" riviera:"tags"`
+	Sku               *containerRegistrySku `json:"-" riviera:"sku"`
+	AdminUserEnabled  *bool                 `json:"adminUserEnabled"`
+}
+
+func (command createOrUpdateContainerRegistry) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getContainerRegistryResponse{}
+		},
+	}
+}
+
+type getContainerRegistry struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getContainerRegistry) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "GET",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getContainerRegistryResponse{}
+		},
+	}
+}
+
+type deleteContainerRegistry struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteContainerRegistry) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type containerRegistryPassword struct {
+	Name  *string `mapstructure:"name"`
+	Value *string `mapstructure:"value"`
+}
+
+type listContainerRegistryCredentialsResponse struct {
+	Username  *string                     `mapstructure:"username"`
+	Passwords []containerRegistryPassword `mapstructure:"passwords"`
+}
+
+type listContainerRegistryCredentials struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command listContainerRegistryCredentials) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:      containerRegistryAPIVersion,
+		Method:          "POST",
+		URLPathFunc:     containerRegistryDefaultURLPath(command.ResourceGroupName, command.Name, "/listCredentials"),
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &listContainerRegistryCredentialsResponse{}
+		},
+	}
+}
+
+// Geo-replications of a Premium Container Registry are child resources which
+// are named after the location they replicate the registry to.
+
+type containerRegistryReplication struct {
+	Name     *string `mapstructure:"name"`
+	Location *string `mapstructure:"location"`
+}
+
+type listContainerRegistryReplicationsResponse struct {
+	Value []containerRegistryReplication `mapstructure:"value"`
+}
+
+type listContainerRegistryReplications struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command listContainerRegistryReplications) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "GET",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.Name, "/replications"),
+		ResponseTypeFunc: func() interface{} {
+			return &listContainerRegistryReplicationsResponse{}
+		},
+	}
+}
+
+type createContainerRegistryReplication struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	RegistryName      string `json:"-"`
+	Location          string `json:"-" riviera:"location"`
+}
+
+func (command createContainerRegistryReplication) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.RegistryName, "/replications/"+command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &containerRegistryReplication{}
+		},
+	}
+}
+
+type deleteContainerRegistryReplication struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	RegistryName      string `json:"-"`
+}
+
+func (command deleteContainerRegistryReplication) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.RegistryName, "/replications/"+command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getContainerRegistryWebhookResponse struct {
+	ID       *string             `mapstructure:"id"`
+	Name     *string             `mapstructure:"name"`
+	Location *string             `mapstructure:"location"`
+	Tags     *map[string]*string `mapstructure:"tags"`
+	Status   *string             `mapstructure:"status"`
+	Scope    *string             `mapstructure:"scope"`
+	Actions  []string            `mapstructure:"actions"`
+}
+
+type createOrUpdateContainerRegistryWebhook struct {
+	Name              string             `json:"-"`
+	ResourceGroupName string             `json:"-"`
+	RegistryName      string             `json:"-"`
+	Location          string             `json:"-" riviera:"location"`
+	Tags              map[string]*string `json:"-" riviera:"tags"`
+	ServiceURI        *string            `json:"serviceUri"`
+	CustomHeaders     map[string]string  `json:"customHeaders,omitempty"`
+	Status            *string            `json:"status"`
+	Scope             *string            `json:"scope"`
+	Actions           []string           `json:"actions"`
+}
+
+func (command createOrUpdateContainerRegistryWebhook) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.RegistryName, "/webhooks/"+command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getContainerRegistryWebhookResponse{}
+		},
+	}
+}
+
+type getContainerRegistryWebhook struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	RegistryName      string `json:"-"`
+}
+
+func (command getContainerRegistryWebhook) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "GET",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.RegistryName, "/webhooks/"+command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getContainerRegistryWebhookResponse{}
+		},
+	}
+}
+
+type deleteContainerRegistryWebhook struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	RegistryName      string `json:"-"`
+}
+
+func (command deleteContainerRegistryWebhook) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerRegistryAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: containerRegistryDefaultURLPath(command.ResourceGroupName, command.RegistryName, "/webhooks/"+command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+// The service URI and custom headers of a webhook may contain secrets, so
+// they are only returned by a separate request.
+
+type getContainerRegistryWebhookCallbackConfigResponse struct {
+	ServiceURI    *string           `mapstructure:"serviceUri"`
+	CustomHeaders map[string]string `mapstructure:"customHeaders"`
+}
+
+type getContainerRegistryWebhookCallbackConfig struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	RegistryName      string `json:"-"`
+}
+
+func (command getContainerRegistryWebhookCallbackConfig) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:      containerRegistryAPIVersion,
+		Method:          "POST",
+		URLPathFunc:     containerRegistryDefaultURLPath(command.ResourceGroupName, command.RegistryName, "/webhooks/"+command.Name+"/getCallbackConfig"),
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &getContainerRegistryWebhookCallbackConfigResponse{}
+		},
+	}
+}
@@ -0,0 +1,238 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK does not include the Microsoft.ContainerService
+// client, so the ARM requests used by the Container Service and Kubernetes
+// Cluster resources are described here for use with the Riviera client.
+
+const containerServiceAPIVersion = "2016-09-30"
+const kubernetesClusterAPIVersion = "2017-08-31"
+
+func containerServiceDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.ContainerService/containerServices/%s", resourceGroupName, name)
+	}
+}
+
+func kubernetesClusterDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s", resourceGroupName, name)
+	}
+}
+
+type containerServiceOrchestratorProfile struct {
+	OrchestratorType *string `json:"orchestratorType" mapstructure:"orchestratorType"`
+}
+
+type containerServiceMasterProfile struct {
+	Count     *int32  `json:"count,omitempty" mapstructure:"count"`
+	DNSPrefix *string `json:"dnsPrefix" mapstructure:"dnsPrefix"`
+	FQDN      *string `json:"fqdn,omitempty" mapstructure:"fqdn"`
+}
+
+type containerServiceAgentPoolProfile struct {
+	Name         *string `json:"name" mapstructure:"name"`
+	Count        *int32  `json:"count,omitempty" mapstructure:"count"`
+	VMSize       *string `json:"vmSize" mapstructure:"vmSize"`
+	DNSPrefix    *string `json:"dnsPrefix,omitempty" mapstructure:"dnsPrefix"`
+	FQDN         *string `json:"fqdn,omitempty" mapstructure:"fqdn"`
+	OSDiskSizeGB *int32  `json:"osDiskSizeGB,omitempty" mapstructure:"osDiskSizeGB"`
+	OSType       *string `json:"osType,omitempty" mapstructure:"osType"`
+	VnetSubnetID *string `json:"vnetSubnetID,omitempty" mapstructure:"vnetSubnetID"`
+}
+
+type containerServiceSSHPublicKey struct {
+	KeyData *string `json:"keyData" mapstructure:"keyData"`
+}
+
+type containerServiceSSHConfiguration struct {
+	PublicKeys *[]containerServiceSSHPublicKey `json:"publicKeys" mapstructure:"publicKeys"`
+}
+
+type containerServiceLinuxProfile struct {
+	AdminUsername *string                           `json:"adminUsername" mapstructure:"adminUsername"`
+	SSH           *containerServiceSSHConfiguration `json:"ssh" mapstructure:"ssh"`
+}
+
+type containerServiceServicePrincipalProfile struct {
+	ClientID *string `json:"clientId" mapstructure:"clientId"`
+	Secret   *string `json:"secret,omitempty" mapstructure:"secret"`
+}
+
+type containerServiceVMDiagnostics struct {
+	Enabled    *bool   `json:"enabled" mapstructure:"enabled"`
+	StorageURI *string `json:"storageUri,omitempty" mapstructure:"storageUri"`
+}
+
+type containerServiceDiagnosticsProfile struct {
+	VMDiagnostics *containerServiceVMDiagnostics `json:"vmDiagnostics" mapstructure:"vmDiagnostics"`
+}
+
+type getContainerServiceResponse struct {
+	ID                      *string                                  `mapstructure:"id"`
+	Name                    *string                                  `mapstructure:"name"`
+	Location                *string                                  `mapstructure:"location"`
+	Tags                    *map[string]*string                      `mapstructure:"tags"`
+	ProvisioningState       *string                                  `mapstructure:"provisioningState"`
+	OrchestratorProfile     *containerServiceOrchestratorProfile     `mapstructure:"orchestratorProfile"`
+	MasterProfile           *containerServiceMasterProfile           `mapstructure:"masterProfile"`
+	AgentPoolProfiles       *[]containerServiceAgentPoolProfile      `mapstructure:"agentPoolProfiles"`
+	LinuxProfile            *containerServiceLinuxProfile            `mapstructure:"linuxProfile"`
+	ServicePrincipalProfile *containerServiceServicePrincipalProfile `mapstructure:"servicePrincipalProfile"`
+	DiagnosticsProfile      *containerServiceDiagnosticsProfile      `mapstructure:"diagnosticsProfile"`
+}
+
+type createOrUpdateContainerService struct {
+	Name                    string                                   `json:"-"`
+	ResourceGroupName       string                                   `json:"-"`
+	Location                string                                   `json:"-" riviera:"location"`
+	Tags                    map[string]*string                       `json:"-" riviera:"tags"`
+	OrchestratorProfile     *containerServiceOrchestratorProfile     `json:"orchestratorProfile"`
+	MasterProfile           *containerServiceMasterProfile           `json:"masterProfile"`
+	AgentPoolProfiles       []containerServiceAgentPoolProfile       `json:"agentPoolProfiles"`
+	LinuxProfile            *containerServiceLinuxProfile            `json:"linuxProfile"`
+	ServicePrincipalProfile *containerServiceServicePrincipalProfile `json:"servicePrincipalProfile,omitempty"`
+	DiagnosticsProfile      *containerServiceDiagnosticsProfile      `json:"diagnosticsProfile,omitempty"`
+}
+
+func (command createOrUpdateContainerService) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerServiceAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: containerServiceDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getContainerServiceResponse{}
+		},
+	}
+}
+
+type getContainerService struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getContainerService) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerServiceAPIVersion,
+		Method:      "GET",
+		URLPathFunc: containerServiceDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getContainerServiceResponse{}
+		},
+	}
+}
+
+type deleteContainerService struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteContainerService) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  containerServiceAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: containerServiceDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getKubernetesClusterResponse struct {
+	ID                      *string                                  `mapstructure:"id"`
+	Name                    *string                                  `mapstructure:"name"`
+	Location                *string                                  `mapstructure:"location"`
+	Tags                    *map[string]*string                      `mapstructure:"tags"`
+	ProvisioningState       *string                                  `mapstructure:"provisioningState"`
+	KubernetesVersion       *string                                  `mapstructure:"kubernetesVersion"`
+	DNSPrefix               *string                                  `mapstructure:"dnsPrefix"`
+	FQDN                    *string                                  `mapstructure:"fqdn"`
+	AgentPoolProfiles       *[]containerServiceAgentPoolProfile      `mapstructure:"agentPoolProfiles"`
+	LinuxProfile            *containerServiceLinuxProfile            `mapstructure:"linuxProfile"`
+	ServicePrincipalProfile *containerServiceServicePrincipalProfile `mapstructure:"servicePrincipalProfile"`
+}
+
+type createOrUpdateKubernetesCluster struct {
+	Name                    string                                   `json:"-"`
+	ResourceGroupName       string                                   `json:"-"`
+	Location                string                                   `json:"-" riviera:"location"`
+	Tags                    map[string]*string                       `json:"-" riviera:"tags"`
+	KubernetesVersion       *string                                  `json:"kubernetesVersion,omitempty"`
+	DNSPrefix               *string                                  `json:"dnsPrefix"`
+	AgentPoolProfiles       []containerServiceAgentPoolProfile       `json:"agentPoolProfiles"`
+	LinuxProfile            *containerServiceLinuxProfile            `json:"linuxProfile"`
+	ServicePrincipalProfile *containerServiceServicePrincipalProfile `json:"servicePrincipalProfile"`
+}
+
+func (command createOrUpdateKubernetesCluster) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  kubernetesClusterAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: kubernetesClusterDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getKubernetesClusterResponse{}
+		},
+	}
+}
+
+type getKubernetesCluster struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getKubernetesCluster) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  kubernetesClusterAPIVersion,
+		Method:      "GET",
+		URLPathFunc: kubernetesClusterDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getKubernetesClusterResponse{}
+		},
+	}
+}
+
+type deleteKubernetesCluster struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteKubernetesCluster) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  kubernetesClusterAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: kubernetesClusterDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getKubernetesClusterAccessProfileResponse struct {
+	KubeConfig *string `mapstructure:"kubeConfig"`
+}
+
+// getKubernetesClusterAccessProfile retrieves the base64 encoded kubeconfig
+// for the given role, such as clusterUser, of a managed cluster.
+type getKubernetesClusterAccessProfile struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	RoleName          string `json:"-"`
+}
+
+func (command getKubernetesClusterAccessProfile) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: kubernetesClusterAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/accessProfiles/%s", kubernetesClusterDefaultURLPath(command.ResourceGroupName, command.Name)(), command.RoleName)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getKubernetesClusterAccessProfileResponse{}
+		},
+	}
+}
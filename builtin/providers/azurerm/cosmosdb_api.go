@@ -0,0 +1,294 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for Cosmos DB, so the ARM requests used
+// by the Cosmos DB resources are described here for use with the Riviera
+// client.
+
+const cosmosDBAPIVersion = "2015-04-08"
+
+// cosmosDBAccountDefaultURLPath returns the path of a Cosmos DB account, or of
+// one of the databases, containers or collections within it when path is not
+// empty.
+func cosmosDBAccountDefaultURLPath(resourceGroupName, accountName, path string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s%s", resourceGroupName, accountName, path)
+	}
+}
+
+type cosmosDBConsistencyPolicy struct {
+	DefaultConsistencyLevel *string `json:"defaultConsistencyLevel" mapstructure:"defaultConsistencyLevel"`
+	MaxIntervalInSeconds    *int32  `json:"maxIntervalInSeconds,omitempty" mapstructure:"maxIntervalInSeconds"`
+	MaxStalenessPrefix      *int64  `json:"maxStalenessPrefix,omitempty" mapstructure:"maxStalenessPrefix"`
+}
+
+type cosmosDBLocation struct {
+	LocationName     *string `json:"locationName" mapstructure:"locationName"`
+	FailoverPriority *int32  `json:"failoverPriority" mapstructure:"failoverPriority"`
+}
+
+type cosmosDBFailoverPolicy struct {
+	LocationName     *string `json:"locationName" mapstructure:"locationName"`
+	FailoverPriority *int32  `json:"failoverPriority" mapstructure:"failoverPriority"`
+}
+
+type cosmosDBCapability struct {
+	Name *string `json:"name" mapstructure:"name"`
+}
+
+type getCosmosDBAccountResponse struct {
+	ID                       *string                    `mapstructure:"id"`
+	Name                     *string                    `mapstructure:"name"`
+	Location                 *string                    `mapstructure:"location"`
+	Tags                     *map[string]*string        `mapstructure:"tags"`
+	Kind                     *string                    `mapstructure:"kind"`
+	ProvisioningState        *string                    `mapstructure:"provisioningState"`
+	DocumentEndpoint         *string                    `mapstructure:"documentEndpoint"`
+	DatabaseAccountOfferType *string                    `mapstructure:"databaseAccountOfferType"`
+	ConsistencyPolicy        *cosmosDBConsistencyPolicy `mapstructure:"consistencyPolicy"`
+	FailoverPolicies         []cosmosDBFailoverPolicy   `mapstructure:"failoverPolicies"`
+	Capabilities             []cosmosDBCapability       `mapstructure:"capabilities"`
+	EnableAutomaticFailover  *bool                      `mapstructure:"enableAutomaticFailover"`
+	IPRangeFilter            *string                    `mapstructure:"ipRangeFilter"`
+}
+
+type createOrUpdateCosmosDBAccount struct {
+	Name                     string                     `json:"-"`
+	ResourceGroupName        string                     `json:"-"`
+	Location                 string                     `json:"-" riviera:"location"`
+	Tags                     map[string]*string         `json:"-" riviera:"tags"`
+	Kind                     *string                    `json:"-" riviera:"kind"`
+	DatabaseAccountOfferType *string                    `json:"databaseAccountOfferType"`
+	ConsistencyPolicy        *cosmosDBConsistencyPolicy `json:"consistencyPolicy,omitempty"`
+	Locations                []cosmosDBLocation         `json:"locations"`
+	Capabilities             []cosmosDBCapability       `json:"capabilities,omitempty"`
+	EnableAutomaticFailover  *bool                      `json:"enableAutomaticFailover,omitempty"`
+	IPRangeFilter            *string                    `json:"ipRangeFilter,omitempty"`
+}
+
+func (command createOrUpdateCosmosDBAccount) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getCosmosDBAccountResponse{}
+		},
+	}
+}
+
+type getCosmosDBAccount struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getCosmosDBAccount) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "GET",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getCosmosDBAccountResponse{}
+		},
+	}
+}
+
+type deleteCosmosDBAccount struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteCosmosDBAccount) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type changeCosmosDBAccountFailoverPriority struct {
+	Name              string                   `json:"-"`
+	ResourceGroupName string                   `json:"-"`
+	FailoverPolicies  []cosmosDBFailoverPolicy `json:"-"`
+}
+
+// cosmosDBFailoverPolicies is the body of a failover priority change. Unlike
+// most requests the policies aren't wrapped in a properties object, so they
+// are sent as an envelope field instead.
+type cosmosDBFailoverPolicies struct {
+	FailoverPolicies []cosmosDBFailoverPolicy `json:"-" riviera:"failoverPolicies"`
+}
+
+func (command changeCosmosDBAccountFailoverPriority) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "POST",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.Name, "/failoverPriorityChange"),
+		RequestPropertiesFunc: func() interface{} {
+			return cosmosDBFailoverPolicies{FailoverPolicies: command.FailoverPolicies}
+		},
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type listCosmosDBAccountKeysResponse struct {
+	PrimaryMasterKey           *string `mapstructure:"primaryMasterKey"`
+	SecondaryMasterKey         *string `mapstructure:"secondaryMasterKey"`
+	PrimaryReadonlyMasterKey   *string `mapstructure:"primaryReadonlyMasterKey"`
+	SecondaryReadonlyMasterKey *string `mapstructure:"secondaryReadonlyMasterKey"`
+}
+
+type listCosmosDBAccountKeys struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command listCosmosDBAccountKeys) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:      cosmosDBAPIVersion,
+		Method:          "POST",
+		URLPathFunc:     cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.Name, "/listKeys"),
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &listCosmosDBAccountKeysResponse{}
+		},
+	}
+}
+
+// The databases, containers and collections within an account share the same
+// request shape, differing only in their path and in the definition of the
+// resource itself.
+
+type cosmosDBPartitionKey struct {
+	Paths []string `json:"paths" mapstructure:"paths"`
+	Kind  *string  `json:"kind" mapstructure:"kind"`
+}
+
+type cosmosDBSQLContainerResource struct {
+	ID           *string               `json:"id"`
+	PartitionKey *cosmosDBPartitionKey `json:"partitionKey,omitempty"`
+}
+
+type cosmosDBMongoCollectionResource struct {
+	ID       *string           `json:"id"`
+	ShardKey map[string]string `json:"shardKey,omitempty"`
+}
+
+type cosmosDBDatabaseResource struct {
+	ID *string `json:"id"`
+}
+
+// getCosmosDBResourceResponse describes a database, container or collection.
+// The properties of the response are flattened by Riviera, so the `id` here
+// is the name of the resource within the account rather than its ARM ID.
+type getCosmosDBResourceResponse struct {
+	ID           *string               `mapstructure:"id"`
+	PartitionKey *cosmosDBPartitionKey `mapstructure:"partitionKey"`
+	ShardKey     map[string]string     `mapstructure:"shardKey"`
+}
+
+type createOrUpdateCosmosDBResource struct {
+	AccountName       string            `json:"-"`
+	ResourceGroupName string            `json:"-"`
+	Path              string            `json:"-"`
+	Resource          interface{}       `json:"resource"`
+	Options           map[string]string `json:"options"`
+}
+
+func (command createOrUpdateCosmosDBResource) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.AccountName, command.Path),
+		ResponseTypeFunc: func() interface{} {
+			return &getCosmosDBResourceResponse{}
+		},
+	}
+}
+
+type getCosmosDBResource struct {
+	AccountName       string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Path              string `json:"-"`
+}
+
+func (command getCosmosDBResource) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "GET",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.AccountName, command.Path),
+		ResponseTypeFunc: func() interface{} {
+			return &getCosmosDBResourceResponse{}
+		},
+	}
+}
+
+type deleteCosmosDBResource struct {
+	AccountName       string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Path              string `json:"-"`
+}
+
+func (command deleteCosmosDBResource) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.AccountName, command.Path),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type cosmosDBThroughputResource struct {
+	Throughput *int32 `json:"throughput"`
+}
+
+type getCosmosDBThroughputResponse struct {
+	Throughput *int32 `mapstructure:"throughput"`
+}
+
+type updateCosmosDBThroughput struct {
+	AccountName       string                     `json:"-"`
+	ResourceGroupName string                     `json:"-"`
+	Path              string                     `json:"-"`
+	Resource          cosmosDBThroughputResource `json:"resource"`
+}
+
+func (command updateCosmosDBThroughput) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.AccountName, command.Path+"/settings/throughput"),
+		ResponseTypeFunc: func() interface{} {
+			return &getCosmosDBThroughputResponse{}
+		},
+	}
+}
+
+type getCosmosDBThroughput struct {
+	AccountName       string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	Path              string `json:"-"`
+}
+
+func (command getCosmosDBThroughput) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  cosmosDBAPIVersion,
+		Method:      "GET",
+		URLPathFunc: cosmosDBAccountDefaultURLPath(command.ResourceGroupName, command.AccountName, command.Path+"/settings/throughput"),
+		ResponseTypeFunc: func() interface{} {
+			return &getCosmosDBThroughputResponse{}
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package azurerm
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMClientConfig_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMClientConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_client_config.current", "client_id", os.Getenv("ARM_CLIENT_ID")),
+					resource.TestCheckResourceAttr("data.azurerm_client_config.current", "tenant_id", os.Getenv("ARM_TENANT_ID")),
+					resource.TestCheckResourceAttr("data.azurerm_client_config.current", "subscription_id", os.Getenv("ARM_SUBSCRIPTION_ID")),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAzureRMClientConfig_basic = `
+data "azurerm_client_config" "current" {}
+`
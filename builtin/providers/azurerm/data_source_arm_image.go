@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmImage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmImageRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_disk": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"os_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"managed_disk_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"blob_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"caching": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"data_disk": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lun": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"managed_disk_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"blob_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"caching": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getImage{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Image %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error: Image %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure Image %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getImageResponse)
+
+	d.SetId(*resp.ID)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if resp.SourceVirtualMachine != nil {
+		d.Set("source_virtual_machine_id", resp.SourceVirtualMachine.ID)
+	}
+
+	if profile := resp.StorageProfile; profile != nil {
+		if profile.OSDisk != nil {
+			if err := d.Set("os_disk", flattenArmImageOSDisk(profile.OSDisk)); err != nil {
+				return fmt.Errorf("Error flattening `os_disk` for Image %q: %s", name, err)
+			}
+		}
+
+		if err := d.Set("data_disk", flattenArmImageDataDisks(profile.DataDisks)); err != nil {
+			return fmt.Errorf("Error flattening `data_disk` for Image %q: %s", name, err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
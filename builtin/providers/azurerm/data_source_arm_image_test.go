@@ -0,0 +1,62 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMImage_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccDataSourceAzureRMImage_basic, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_image.test", "os_disk.#", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_image.test", "os_disk.0.os_type", "Linux"),
+					resource.TestCheckResourceAttr("data.azurerm_image.test", "data_disk.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAzureRMImage_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_managed_disk" "test" {
+    name = "acctestmd-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_type = "Standard_LRS"
+    create_option = "Empty"
+    disk_size_gb = "30"
+}
+
+resource "azurerm_image" "test" {
+    name = "acctestimage-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    os_disk {
+        os_type = "Linux"
+        os_state = "Generalized"
+        managed_disk_id = "${azurerm_managed_disk.test.id}"
+    }
+}
+
+data "azurerm_image" "test" {
+    name = "${azurerm_image.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLoadBalancerRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address_allocation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_address_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"backend_address_pool": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	loadBalancerClient := meta.(*ArmClient).loadBalancerClient
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := loadBalancerClient.Get(resGroup, name, "")
+	if err != nil {
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error: Load Balancer %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure Load Balancer %q: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	frontendIPConfigurations := make([]interface{}, 0)
+	backendAddressPools := make([]interface{}, 0)
+	privateIPAddresses := make([]string, 0)
+
+	if props := resp.Properties; props != nil {
+		if props.FrontendIPConfigurations != nil {
+			for _, config := range *props.FrontendIPConfigurations {
+				frontendIPConfigurations = append(frontendIPConfigurations, flattenArmLoadBalancerFrontendIPConfiguration(config))
+
+				if config.Properties != nil && config.Properties.PrivateIPAddress != nil {
+					privateIPAddresses = append(privateIPAddresses, *config.Properties.PrivateIPAddress)
+				}
+			}
+		}
+
+		if props.BackendAddressPools != nil {
+			for _, pool := range *props.BackendAddressPools {
+				backendAddressPools = append(backendAddressPools, map[string]interface{}{
+					"id":   *pool.ID,
+					"name": *pool.Name,
+				})
+			}
+		}
+	}
+
+	if err := d.Set("frontend_ip_configuration", frontendIPConfigurations); err != nil {
+		return fmt.Errorf("Error flattening `frontend_ip_configuration` for Load Balancer %q: %s", name, err)
+	}
+
+	if err := d.Set("backend_address_pool", backendAddressPools); err != nil {
+		return fmt.Errorf("Error flattening `backend_address_pool` for Load Balancer %q: %s", name, err)
+	}
+
+	if len(privateIPAddresses) > 0 {
+		d.Set("private_ip_address", privateIPAddresses[0])
+	}
+	if err := d.Set("private_ip_addresses", privateIPAddresses); err != nil {
+		return err
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func flattenArmLoadBalancerFrontendIPConfiguration(config network.FrontendIPConfiguration) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":   *config.ID,
+		"name": *config.Name,
+	}
+
+	if props := config.Properties; props != nil {
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			result["subnet_id"] = *props.Subnet.ID
+		}
+		if props.PrivateIPAddress != nil {
+			result["private_ip_address"] = *props.PrivateIPAddress
+		}
+		if props.PrivateIPAllocationMethod != "" {
+			result["private_ip_address_allocation"] = strings.ToLower(string(props.PrivateIPAllocationMethod))
+		}
+		if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+			result["public_ip_address_id"] = *props.PublicIPAddress.ID
+		}
+	}
+
+	return result
+}
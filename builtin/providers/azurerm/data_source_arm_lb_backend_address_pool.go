@@ -0,0 +1,84 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmLoadBalancerBackendAddressPool() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLoadBalancerBackendAddressPoolRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"backend_ip_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"load_balancing_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerBackendAddressPoolRead(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	loadBalancerId := d.Get("loadbalancer_id").(string)
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerId, meta)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("Error: Load Balancer %q was not found", loadBalancerId)
+	}
+
+	pool, exists := findLoadBalancerBackEndAddressPoolByName(loadBalancer, name)
+	if !exists {
+		return fmt.Errorf("Error: Backend Address Pool %q was not found in Load Balancer %q", name, loadBalancerId)
+	}
+
+	d.SetId(*pool.ID)
+
+	backendIPConfigurations := make([]string, 0)
+	loadBalancingRules := make([]string, 0)
+
+	if props := pool.Properties; props != nil {
+		if props.BackendIPConfigurations != nil {
+			for _, config := range *props.BackendIPConfigurations {
+				backendIPConfigurations = append(backendIPConfigurations, *config.ID)
+			}
+		}
+
+		if props.LoadBalancingRules != nil {
+			for _, rule := range *props.LoadBalancingRules {
+				loadBalancingRules = append(loadBalancingRules, *rule.ID)
+			}
+		}
+	}
+
+	if err := d.Set("backend_ip_configurations", backendIPConfigurations); err != nil {
+		return err
+	}
+
+	if err := d.Set("load_balancing_rules", loadBalancingRules); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMLoadBalancerBackendAddressPool_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccDataSourceAzureRMLoadBalancerBackendAddressPool_basic, testAccAzureRMLoadBalancerTemplate(ri))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_lb_backend_address_pool.test", "name", "backend"),
+					resource.TestCheckResourceAttr("data.azurerm_lb_backend_address_pool.test", "backend_ip_configurations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAzureRMLoadBalancerBackendAddressPool_basic = `
+%s
+
+data "azurerm_lb_backend_address_pool" "test" {
+    name = "backend"
+    loadbalancer_id = "${azurerm_template_deployment.test.outputs.loadBalancerId}"
+}
+`
@@ -0,0 +1,134 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMLoadBalancer_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccDataSourceAzureRMLoadBalancer_basic, testAccAzureRMLoadBalancerTemplate(ri))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_lb.test", "location", "westeurope"),
+					resource.TestCheckResourceAttr("data.azurerm_lb.test", "frontend_ip_configuration.#", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_lb.test", "frontend_ip_configuration.0.name", "public"),
+					resource.TestCheckResourceAttr("data.azurerm_lb.test", "backend_address_pool.#", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_lb.test", "backend_address_pool.0.name", "backend"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAzureRMLoadBalancerTemplate returns a configuration which creates a
+// Load Balancer with a backend address pool named `backend` and an inbound
+// NAT rule named `ssh` using a template deployment, exposing its name and ID
+// as the `loadBalancerName` and `loadBalancerId` outputs of the deployment.
+func testAccAzureRMLoadBalancerTemplate(rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_public_ip" "test" {
+    name = "acctestpublicip-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    public_ip_address_allocation = "static"
+}
+
+resource "azurerm_template_deployment" "test" {
+    name = "acctesttemplate-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    deployment_mode = "Incremental"
+
+    parameters {
+        loadBalancerName = "acctestlb-%d"
+        publicIPAddressId = "${azurerm_public_ip.test.id}"
+    }
+
+    template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "loadBalancerName": {
+      "type": "string"
+    },
+    "publicIPAddressId": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Network/loadBalancers",
+      "apiVersion": "2016-09-01",
+      "name": "[parameters('loadBalancerName')]",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "frontendIPConfigurations": [
+          {
+            "name": "public",
+            "properties": {
+              "publicIPAddress": {
+                "id": "[parameters('publicIPAddressId')]"
+              }
+            }
+          }
+        ],
+        "backendAddressPools": [
+          {
+            "name": "backend"
+          }
+        ],
+        "inboundNatRules": [
+          {
+            "name": "ssh",
+            "properties": {
+              "frontendIPConfiguration": {
+                "id": "[concat(resourceId('Microsoft.Network/loadBalancers', parameters('loadBalancerName')), '/frontendIPConfigurations/public')]"
+              },
+              "protocol": "Tcp",
+              "frontendPort": 2222,
+              "backendPort": 22
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "outputs": {
+    "loadBalancerName": {
+      "type": "string",
+      "value": "[parameters('loadBalancerName')]"
+    },
+    "loadBalancerId": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.Network/loadBalancers', parameters('loadBalancerName'))]"
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, rInt, rInt, rInt)
+}
+
+var testAccDataSourceAzureRMLoadBalancer_basic = `
+%s
+
+data "azurerm_lb" "test" {
+    name = "${azurerm_template_deployment.test.outputs.loadBalancerName}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`
@@ -0,0 +1,96 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmPublicIP() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPublicIPRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_address_allocation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"idle_timeout_in_minutes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"domain_name_label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmPublicIPRead(d *schema.ResourceData, meta interface{}) error {
+	publicIPClient := meta.(*ArmClient).publicIPClient
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := publicIPClient.Get(resGroup, name, "")
+	if err != nil {
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error: Public IP %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure public ip %q: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.Properties; props != nil {
+		d.Set("public_ip_address_allocation", strings.ToLower(string(props.PublicIPAllocationMethod)))
+
+		if props.IdleTimeoutInMinutes != nil {
+			d.Set("idle_timeout_in_minutes", *props.IdleTimeoutInMinutes)
+		}
+
+		if dns := props.DNSSettings; dns != nil {
+			d.Set("domain_name_label", dns.DomainNameLabel)
+			d.Set("fqdn", dns.Fqdn)
+		}
+
+		d.Set("ip_address", props.IPAddress)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
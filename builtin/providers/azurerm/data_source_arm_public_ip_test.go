@@ -0,0 +1,50 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMPublicIP_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccDataSourceAzureRMPublicIP_basic, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_public_ip.test", "public_ip_address_allocation", "static"),
+					resource.TestCheckResourceAttr("data.azurerm_public_ip.test", "idle_timeout_in_minutes", "30"),
+					resource.TestCheckResourceAttr("data.azurerm_public_ip.test", "domain_name_label", fmt.Sprintf("acctest-%d", ri)),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAzureRMPublicIP_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_public_ip" "test" {
+    name = "acctestpublicip-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    public_ip_address_allocation = "static"
+    domain_name_label = "acctest-%d"
+    idle_timeout_in_minutes = 30
+}
+
+data "azurerm_public_ip" "test" {
+    name = "${azurerm_public_ip.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`
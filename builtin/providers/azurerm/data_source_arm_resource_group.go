@@ -0,0 +1,48 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourceGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	resourceGroupClient := meta.(*ArmClient).resourceGroupClient
+
+	name := d.Get("name").(string)
+
+	resp, err := resourceGroupClient.Get(name)
+	if err != nil {
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error: Resource Group %q was not found", name)
+		}
+		return fmt.Errorf("Error making Read request on Azure Resource Group %q: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
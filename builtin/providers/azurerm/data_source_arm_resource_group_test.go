@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMResourceGroup_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccDataSourceAzureRMResourceGroup_basic, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_resource_group.test", "location", "westeurope"),
+					resource.TestCheckResourceAttr("data.azurerm_resource_group.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_resource_group.test", "tags.environment", "acctest"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAzureRMResourceGroup_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+
+    tags {
+        environment = "acctest"
+    }
+}
+
+data "azurerm_resource_group" "test" {
+    name = "${azurerm_resource_group.test.name}"
+}
+`
@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmStorageAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"account_kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"account_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"access_tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_queue_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_queue_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_table_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_table_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.GetProperties(resGroup, name)
+	if err != nil {
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error: Storage Account %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error reading the state of AzureRM Storage Account %q: %s", name, err)
+	}
+
+	keys, err := client.ListKeys(resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error listing keys for AzureRM Storage Account %q: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("account_kind", string(resp.Kind))
+
+	if resp.Sku != nil {
+		d.Set("account_type", string(resp.Sku.Name))
+	}
+
+	if keys.Keys != nil {
+		accessKeys := *keys.Keys
+		if len(accessKeys) > 0 {
+			d.Set("primary_access_key", accessKeys[0].Value)
+		}
+		if len(accessKeys) > 1 {
+			d.Set("secondary_access_key", accessKeys[1].Value)
+		}
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("access_tier", string(props.AccessTier))
+		d.Set("primary_location", props.PrimaryLocation)
+		d.Set("secondary_location", props.SecondaryLocation)
+
+		if endpoints := props.PrimaryEndpoints; endpoints != nil {
+			d.Set("primary_blob_endpoint", endpoints.Blob)
+			d.Set("primary_queue_endpoint", endpoints.Queue)
+			d.Set("primary_table_endpoint", endpoints.Table)
+			d.Set("primary_file_endpoint", endpoints.File)
+		}
+
+		if endpoints := props.SecondaryEndpoints; endpoints != nil {
+			d.Set("secondary_blob_endpoint", endpoints.Blob)
+			d.Set("secondary_queue_endpoint", endpoints.Queue)
+			d.Set("secondary_table_endpoint", endpoints.Table)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMStorageAccount_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := fmt.Sprintf(testAccDataSourceAzureRMStorageAccount_basic, ri, rs)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_storage_account.test", "account_type", "Standard_LRS"),
+					resource.TestCheckResourceAttr("data.azurerm_storage_account.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_storage_account.test", "tags.environment", "production"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAzureRMStorageAccount_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_storage_account" "test" {
+    name = "acctestsa%s"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    account_type = "Standard_LRS"
+
+    tags {
+        environment = "production"
+    }
+}
+
+data "azurerm_storage_account" "test" {
+    name = "${azurerm_storage_account.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`
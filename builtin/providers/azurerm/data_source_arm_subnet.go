@@ -0,0 +1,91 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmSubnet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSubnetRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"virtual_network_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"address_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
+	subnetClient := meta.(*ArmClient).subnetClient
+
+	name := d.Get("name").(string)
+	vnetName := d.Get("virtual_network_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := subnetClient.Get(resGroup, vnetName, name, "")
+	if err != nil {
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error: Subnet %q (Virtual Network %q / Resource Group %q) was not found", name, vnetName, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure Subnet %q: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("address_prefix", resp.Properties.AddressPrefix)
+
+	if resp.Properties.NetworkSecurityGroup != nil {
+		d.Set("network_security_group_id", resp.Properties.NetworkSecurityGroup.ID)
+	}
+
+	if resp.Properties.RouteTable != nil {
+		d.Set("route_table_id", resp.Properties.RouteTable.ID)
+	}
+
+	ips := make([]string, 0)
+	if resp.Properties.IPConfigurations != nil {
+		for _, ip := range *resp.Properties.IPConfigurations {
+			ips = append(ips, *ip.ID)
+		}
+	}
+	if err := d.Set("ip_configurations", ips); err != nil {
+		return err
+	}
+
+	return nil
+}
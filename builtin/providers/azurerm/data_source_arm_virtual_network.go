@@ -0,0 +1,101 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmVirtualNetwork() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualNetworkRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"address_spaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"dns_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	vnetClient := meta.(*ArmClient).vnetClient
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := vnetClient.Get(resGroup, name, "")
+	if err != nil {
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error: Virtual Network %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure virtual network %q: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.Properties; props != nil {
+		addressSpaces := make([]string, 0)
+		if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+			addressSpaces = *props.AddressSpace.AddressPrefixes
+		}
+		if err := d.Set("address_spaces", addressSpaces); err != nil {
+			return err
+		}
+
+		dnsServers := make([]string, 0)
+		if props.DhcpOptions != nil && props.DhcpOptions.DNSServers != nil {
+			dnsServers = *props.DhcpOptions.DNSServers
+		}
+		if err := d.Set("dns_servers", dnsServers); err != nil {
+			return err
+		}
+
+		subnets := make([]string, 0)
+		if props.Subnets != nil {
+			for _, subnet := range *props.Subnets {
+				subnets = append(subnets, *subnet.Name)
+			}
+		}
+		if err := d.Set("subnets", subnets); err != nil {
+			return err
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
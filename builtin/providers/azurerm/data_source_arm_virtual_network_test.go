@@ -0,0 +1,54 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualNetwork_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccDataSourceAzureRMVirtualNetwork_basic, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_virtual_network.test", "address_spaces.0", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("data.azurerm_virtual_network.test", "dns_servers.0", "10.0.0.4"),
+					resource.TestCheckResourceAttr("data.azurerm_virtual_network.test", "subnets.0", "subnet1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAzureRMVirtualNetwork_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctestvirtnet%d"
+    address_space = ["10.0.0.0/16"]
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    dns_servers = ["10.0.0.4"]
+
+    subnet {
+        name = "subnet1"
+        address_prefix = "10.0.1.0/24"
+    }
+}
+
+data "azurerm_virtual_network" "test" {
+    name = "${azurerm_virtual_network.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`
@@ -0,0 +1,30 @@
+package azurerm
+
+import (
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Azure doesn't preserve the case of many values, such as the segments of
+// resource IDs, so the values it returns can differ from the configuration
+// without any actual change.
+func ignoreCaseDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return strings.ToLower(old) == strings.ToLower(new)
+}
+
+// suppressEquivalentCidrDiffs ignores differences between CIDR blocks which
+// address the same network, such as "10.0.0.1/24" and "10.0.0.0/24".
+func suppressEquivalentCidrDiffs(k, old, new string, d *schema.ResourceData) bool {
+	_, oldNet, err := net.ParseCIDR(old)
+	if err != nil {
+		return false
+	}
+	_, newNet, err := net.ParseCIDR(new)
+	if err != nil {
+		return false
+	}
+
+	return oldNet.String() == newNet.String()
+}
@@ -0,0 +1,39 @@
+package azurerm
+
+import "testing"
+
+func TestIgnoreCaseDiffSuppressFunc(t *testing.T) {
+	cases := []struct {
+		Old, New string
+		Suppress bool
+	}{
+		{"westeurope", "westeurope", true},
+		{"/subscriptions/00000000/resourcegroups/foo", "/subscriptions/00000000/resourceGroups/foo", true},
+		{"/subscriptions/00000000/resourceGroups/foo", "/subscriptions/00000000/resourceGroups/bar", false},
+	}
+
+	for _, tc := range cases {
+		if ignoreCaseDiffSuppressFunc("test", tc.Old, tc.New, nil) != tc.Suppress {
+			t.Fatalf("Expected suppressing %q => %q to be %t", tc.Old, tc.New, tc.Suppress)
+		}
+	}
+}
+
+func TestSuppressEquivalentCidrDiffs(t *testing.T) {
+	cases := []struct {
+		Old, New string
+		Suppress bool
+	}{
+		{"10.0.0.0/24", "10.0.0.0/24", true},
+		{"10.0.0.0/24", "10.0.0.1/24", true},
+		{"10.0.0.0/24", "10.0.0.0/16", false},
+		{"10.0.0.0/24", "10.0.1.0/24", false},
+		{"10.0.0.0/24", "", false},
+	}
+
+	for _, tc := range cases {
+		if suppressEquivalentCidrDiffs("test", tc.Old, tc.New, nil) != tc.Suppress {
+			t.Fatalf("Expected suppressing %q => %q to be %t", tc.Old, tc.New, tc.Suppress)
+		}
+	}
+}
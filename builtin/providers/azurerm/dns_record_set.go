@@ -0,0 +1,144 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+	"github.com/jen20/riviera/dns"
+)
+
+// The DNS record resources differ only in the records they hold, so the
+// requests which are common to every record set type are made here.
+
+const dnsRecordSetAPIVersion = "2015-05-04-preview"
+
+type getDnsRecordSetResponse struct {
+	ID *string `mapstructure:"id"`
+}
+
+// getDnsRecordSet reads the properties common to a record set of any type.
+type getDnsRecordSet struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	ZoneName          string `json:"-"`
+	RecordSetType     string `json:"-"`
+}
+
+func (command getDnsRecordSet) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: dnsRecordSetAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/%s/%s",
+				command.ResourceGroupName, command.ZoneName, command.RecordSetType, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getDnsRecordSetResponse{}
+		},
+	}
+}
+
+// createArmDnsRecordSet executes the create command for a record set of the
+// given type and sets the ID of the resource to that of the new record set.
+func createArmDnsRecordSet(d *schema.ResourceData, meta interface{}, recordSetType string, command azure.APICall) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating DNS %s Record: %s", recordSetType, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating DNS %s Record: %s", recordSetType, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getDnsRecordSet{
+		Name:              d.Get("name").(string),
+		ResourceGroupName: d.Get("resource_group_name").(string),
+		ZoneName:          d.Get("zone_name").(string),
+		RecordSetType:     recordSetType,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading DNS %s Record: %s", recordSetType, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading DNS %s Record: %s", recordSetType, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getDnsRecordSetResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read DNS %s Record %s ID", recordSetType, d.Get("name").(string))
+	}
+
+	d.SetId(*resp.ID)
+
+	return nil
+}
+
+// readArmDnsRecordSet executes the get command for the record set identified
+// by the resource ID and returns the parsed response. If the record set no
+// longer exists the resource is removed from state and nil is returned.
+func readArmDnsRecordSet(d *schema.ResourceData, meta interface{}, recordSetType string, command azure.APICall) (interface{}, error) {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = command
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading DNS %s Record: %s", recordSetType, err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] DNS %s Record %q not found - removing from state", recordSetType, d.Id())
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error reading DNS %s Record: %s", recordSetType, readResponse.Error)
+	}
+
+	// Record set IDs are returned with the zone segment in either case.
+	zoneName := id.Path["dnszones"]
+	if zoneName == "" {
+		zoneName = id.Path["dnsZones"]
+	}
+
+	d.Set("name", id.Path[recordSetType])
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("zone_name", zoneName)
+
+	return readResponse.Parsed, nil
+}
+
+// deleteArmDnsRecordSet deletes the record set identified by the resource ID.
+func deleteArmDnsRecordSet(d *schema.ResourceData, meta interface{}, recordSetType string) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &dns.DeleteRecordSet{
+		RecordSetType: recordSetType,
+	}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting DNS %s Record: %s", recordSetType, err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting DNS %s Record: %s", recordSetType, deleteResponse.Error)
+	}
+
+	return nil
+}
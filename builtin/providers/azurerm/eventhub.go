@@ -0,0 +1,139 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Event Hubs share the Shared Access Signature authorization model of
+// ServiceBus, so the authorization rule resources for namespaces and Event
+// Hubs use the ServiceBus schema and rights, with the requests which are
+// common to them made here.
+
+// deleteArmEventHubResource deletes the Event Hub resource identified by the
+// resource ID.
+func deleteArmEventHubResource(d *schema.ResourceData, meta interface{}, resourceType string) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteEventHubResource{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting %s %s: %s", resourceType, d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting %s %s: %s", resourceType, d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+// createArmEventHubAuthorizationRule creates the authorization rule on the
+// namespace or Event Hub at parentPath and sets the ID of the resource to
+// that of the new rule.
+func createArmEventHubAuthorizationRule(d *schema.ResourceData, meta interface{}, parentPath string) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	name := d.Get("name").(string)
+
+	rights, err := expandServiceBusAuthorizationRuleRights(d)
+	if err != nil {
+		return err
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateEventHubAuthorizationRule{
+		Name:       name,
+		ParentPath: parentPath,
+		Rights:     rights,
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating EventHub Authorization Rule %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating EventHub Authorization Rule %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getEventHubAuthorizationRule{
+		Name:       name,
+		ParentPath: parentPath,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading EventHub Authorization Rule %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading EventHub Authorization Rule %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getEventHubAuthorizationRuleResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read EventHub Authorization Rule %s ID", name)
+	}
+
+	d.SetId(*resp.ID)
+
+	return nil
+}
+
+// readArmEventHubAuthorizationRule reads the authorization rule identified by
+// the resource ID along with its keys. If the rule no longer exists the
+// resource is removed from state and nil is returned.
+func readArmEventHubAuthorizationRule(d *schema.ResourceData, meta interface{}) (*ResourceID, error) {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getEventHubAuthorizationRule{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Error making Read request on Azure EventHub Authorization Rule %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] EventHub Authorization Rule %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error making Read request on Azure EventHub Authorization Rule %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getEventHubAuthorizationRuleResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("namespace_name", id.Path["namespaces"])
+	flattenServiceBusAuthorizationRuleRights(d, resp.Rights)
+
+	keysRequest := rivieraClient.NewRequestForURI(fmt.Sprintf("%s/listKeys", d.Id()))
+	keysRequest.Command = &listEventHubAuthorizationRuleKeys{}
+
+	keysResponse, err := keysRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Error listing keys for EventHub Authorization Rule %s: %s", d.Id(), err)
+	}
+	if !keysResponse.IsSuccessful() {
+		return nil, fmt.Errorf("Error listing keys for EventHub Authorization Rule %s: %s", d.Id(), keysResponse.Error)
+	}
+
+	keys := keysResponse.Parsed.(*listEventHubAuthorizationRuleKeysResponse)
+	d.Set("primary_key", keys.PrimaryKey)
+	d.Set("primary_connection_string", keys.PrimaryConnectionString)
+	d.Set("secondary_key", keys.SecondaryKey)
+	d.Set("secondary_connection_string", keys.SecondaryConnectionString)
+
+	return id, nil
+}
@@ -0,0 +1,281 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for Event Hubs, so the ARM requests
+// used by the Event Hub resources are described here for use with the
+// Riviera client.
+
+const eventHubAPIVersion = "2017-04-01"
+
+func eventHubNamespaceURLPath(resourceGroupName, namespaceName string) string {
+	return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s", resourceGroupName, namespaceName)
+}
+
+type eventHubSku struct {
+	Name     *string `json:"name" mapstructure:"name"`
+	Tier     *string `json:"tier" mapstructure:"tier"`
+	Capacity *int32  `json:"capacity,omitempty" mapstructure:"capacity"`
+}
+
+type getEventHubNamespaceResponse struct {
+	ID                     *string             `mapstructure:"id"`
+	Name                   *string             `mapstructure:"name"`
+	Location               *string             `mapstructure:"location"`
+	Tags                   *map[string]*string `mapstructure:"tags"`
+	Sku                    *eventHubSku        `mapstructure:"sku"`
+	ProvisioningState      *string             `mapstructure:"provisioningState"`
+	IsAutoInflateEnabled   *bool               `mapstructure:"isAutoInflateEnabled"`
+	MaximumThroughputUnits *int32              `mapstructure:"maximumThroughputUnits"`
+}
+
+type createOrUpdateEventHubNamespace struct {
+	Name                   string             `json:"-"`
+	ResourceGroupName      string             `json:"-"`
+	Location               string             `json:"-" riviera:"location"`
+	Tags                   map[string]*string `json:"-" riviera:"tags"`
+	Sku                    *eventHubSku       `json:"-" riviera:"sku"`
+	IsAutoInflateEnabled   *bool              `json:"isAutoInflateEnabled,omitempty"`
+	MaximumThroughputUnits *int32             `json:"maximumThroughputUnits,omitempty"`
+}
+
+func (command createOrUpdateEventHubNamespace) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return eventHubNamespaceURLPath(command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getEventHubNamespaceResponse{}
+		},
+	}
+}
+
+type getEventHubNamespace struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getEventHubNamespace) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return eventHubNamespaceURLPath(command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getEventHubNamespaceResponse{}
+		},
+	}
+}
+
+type eventHubCaptureDestinationProperties struct {
+	StorageAccountResourceID *string `json:"storageAccountResourceId,omitempty" mapstructure:"storageAccountResourceId"`
+	BlobContainer            *string `json:"blobContainer,omitempty" mapstructure:"blobContainer"`
+	ArchiveNameFormat        *string `json:"archiveNameFormat,omitempty" mapstructure:"archiveNameFormat"`
+}
+
+type eventHubCaptureDestination struct {
+	Name       *string                               `json:"name,omitempty" mapstructure:"name"`
+	Properties *eventHubCaptureDestinationProperties `json:"properties,omitempty" mapstructure:"properties"`
+}
+
+type eventHubCaptureDescription struct {
+	Enabled           *bool                       `json:"enabled" mapstructure:"enabled"`
+	Encoding          *string                     `json:"encoding,omitempty" mapstructure:"encoding"`
+	IntervalInSeconds *int32                      `json:"intervalInSeconds,omitempty" mapstructure:"intervalInSeconds"`
+	SizeLimitInBytes  *int32                      `json:"sizeLimitInBytes,omitempty" mapstructure:"sizeLimitInBytes"`
+	Destination       *eventHubCaptureDestination `json:"destination,omitempty" mapstructure:"destination"`
+}
+
+type getEventHubResponse struct {
+	ID                     *string                     `mapstructure:"id"`
+	Name                   *string                     `mapstructure:"name"`
+	Location               *string                     `mapstructure:"location"`
+	Status                 *string                     `mapstructure:"status"`
+	PartitionCount         *int64                      `mapstructure:"partitionCount"`
+	MessageRetentionInDays *int64                      `mapstructure:"messageRetentionInDays"`
+	PartitionIds           []string                    `mapstructure:"partitionIds"`
+	CaptureDescription     *eventHubCaptureDescription `mapstructure:"captureDescription"`
+}
+
+type createOrUpdateEventHub struct {
+	Name                   string                      `json:"-"`
+	ResourceGroupName      string                      `json:"-"`
+	NamespaceName          string                      `json:"-"`
+	Location               string                      `json:"-" riviera:"location"`
+	PartitionCount         *int64                      `json:"partitionCount,omitempty"`
+	MessageRetentionInDays *int64                      `json:"messageRetentionInDays,omitempty"`
+	CaptureDescription     *eventHubCaptureDescription `json:"captureDescription,omitempty"`
+}
+
+func (command createOrUpdateEventHub) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/eventhubs/%s", eventHubNamespaceURLPath(command.ResourceGroupName, command.NamespaceName), command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getEventHubResponse{}
+		},
+	}
+}
+
+type getEventHub struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	NamespaceName     string `json:"-"`
+}
+
+func (command getEventHub) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/eventhubs/%s", eventHubNamespaceURLPath(command.ResourceGroupName, command.NamespaceName), command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getEventHubResponse{}
+		},
+	}
+}
+
+type getEventHubConsumerGroupResponse struct {
+	ID           *string `mapstructure:"id"`
+	Name         *string `mapstructure:"name"`
+	UserMetadata *string `mapstructure:"userMetadata"`
+}
+
+type createOrUpdateEventHubConsumerGroup struct {
+	Name              string  `json:"-"`
+	ResourceGroupName string  `json:"-"`
+	NamespaceName     string  `json:"-"`
+	EventHubName      string  `json:"-"`
+	UserMetadata      *string `json:"userMetadata,omitempty"`
+}
+
+func (command createOrUpdateEventHubConsumerGroup) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/eventhubs/%s/consumergroups/%s",
+				eventHubNamespaceURLPath(command.ResourceGroupName, command.NamespaceName), command.EventHubName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getEventHubConsumerGroupResponse{}
+		},
+	}
+}
+
+type getEventHubConsumerGroup struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+	NamespaceName     string `json:"-"`
+	EventHubName      string `json:"-"`
+}
+
+func (command getEventHubConsumerGroup) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/eventhubs/%s/consumergroups/%s",
+				eventHubNamespaceURLPath(command.ResourceGroupName, command.NamespaceName), command.EventHubName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getEventHubConsumerGroupResponse{}
+		},
+	}
+}
+
+// deleteEventHubResource deletes any Event Hub resource, and is used with a
+// request for the resource's URI.
+type deleteEventHubResource struct{}
+
+func (command deleteEventHubResource) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getEventHubAuthorizationRuleResponse struct {
+	ID     *string  `mapstructure:"id"`
+	Name   *string  `mapstructure:"name"`
+	Rights []string `mapstructure:"rights"`
+}
+
+// createOrUpdateEventHubAuthorizationRule creates or updates a Shared Access
+// Signature rule on the namespace or Event Hub at ParentPath.
+type createOrUpdateEventHubAuthorizationRule struct {
+	Name       string   `json:"-"`
+	ParentPath string   `json:"-"`
+	Rights     []string `json:"rights"`
+}
+
+func (command createOrUpdateEventHubAuthorizationRule) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/authorizationRules/%s", command.ParentPath, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getEventHubAuthorizationRuleResponse{}
+		},
+	}
+}
+
+type getEventHubAuthorizationRule struct {
+	Name       string `json:"-"`
+	ParentPath string `json:"-"`
+}
+
+func (command getEventHubAuthorizationRule) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/authorizationRules/%s", command.ParentPath, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getEventHubAuthorizationRuleResponse{}
+		},
+	}
+}
+
+type listEventHubAuthorizationRuleKeysResponse struct {
+	PrimaryConnectionString   *string `mapstructure:"primaryConnectionString"`
+	SecondaryConnectionString *string `mapstructure:"secondaryConnectionString"`
+	PrimaryKey                *string `mapstructure:"primaryKey"`
+	SecondaryKey              *string `mapstructure:"secondaryKey"`
+}
+
+type listEventHubAuthorizationRuleKeys struct {
+	Name       string `json:"-"`
+	ParentPath string `json:"-"`
+}
+
+func (command listEventHubAuthorizationRuleKeys) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: eventHubAPIVersion,
+		Method:     "POST",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/authorizationRules/%s/listKeys", command.ParentPath, command.Name)
+		},
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &listEventHubAuthorizationRuleKeysResponse{}
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMKeyVault_importBasic(t *testing.T) {
+	resourceName := "azurerm_key_vault.test"
+
+	ri := acctest.RandInt()
+	tenantID := os.Getenv("ARM_TENANT_ID")
+	config := fmt.Sprintf(testAccAzureRMKeyVault_basic, ri, ri, tenantID, tenantID, tenantID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: config,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
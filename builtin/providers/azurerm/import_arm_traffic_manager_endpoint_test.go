@@ -27,9 +27,6 @@ func TestAccAzureRMTrafficManagerEndpoint_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"resource_group_name",
-				},
 			},
 		},
 	})
@@ -0,0 +1,107 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK does not include a Key Vault management client,
+// so the ARM requests used by the Key Vault resources are described here
+// for use with the Riviera client.
+
+const keyVaultAPIVersion = "2015-06-01"
+
+func keyVaultDefaultURLPath(resourceGroupName, vaultName string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s", resourceGroupName, vaultName)
+	}
+}
+
+type keyVaultSku struct {
+	Family *string `json:"family" mapstructure:"family"`
+	Name   *string `json:"name" mapstructure:"name"`
+}
+
+type keyVaultPermissions struct {
+	Keys         []string `json:"keys" mapstructure:"keys"`
+	Secrets      []string `json:"secrets" mapstructure:"secrets"`
+	Certificates []string `json:"certificates,omitempty" mapstructure:"certificates"`
+}
+
+type keyVaultAccessPolicy struct {
+	TenantID      *string             `json:"tenantId" mapstructure:"tenantId"`
+	ObjectID      *string             `json:"objectId" mapstructure:"objectId"`
+	ApplicationID *string             `json:"applicationId,omitempty" mapstructure:"applicationId"`
+	Permissions   keyVaultPermissions `json:"permissions" mapstructure:"permissions"`
+}
+
+type getKeyVaultResponse struct {
+	ID                           *string                `mapstructure:"id"`
+	Name                         *string                `mapstructure:"name"`
+	Location                     *string                `mapstructure:"location"`
+	Tags                         *map[string]*string    `mapstructure:"tags"`
+	TenantID                     *string                `mapstructure:"tenantId"`
+	Sku                          *keyVaultSku           `mapstructure:"sku"`
+	AccessPolicies               []keyVaultAccessPolicy `mapstructure:"accessPolicies"`
+	VaultURI                     *string                `mapstructure:"vaultUri"`
+	EnabledForDeployment         *bool                  `mapstructure:"enabledForDeployment"`
+	EnabledForDiskEncryption     *bool                  `mapstructure:"enabledForDiskEncryption"`
+	EnabledForTemplateDeployment *bool                  `mapstructure:"enabledForTemplateDeployment"`
+}
+
+type createOrUpdateKeyVault struct {
+	Name                         string                 `json:"-"`
+	ResourceGroupName            string                 `json:"-"`
+	Location                     string                 `json:"-" riviera:"location"`
+	Tags                         map[string]*string     `json:"-" riviera:"tags"`
+	TenantID                     *string                `json:"tenantId"`
+	Sku                          *keyVaultSku           `json:"sku"`
+	AccessPolicies               []keyVaultAccessPolicy `json:"accessPolicies"`
+	EnabledForDeployment         *bool                  `json:"enabledForDeployment,omitempty"`
+	EnabledForDiskEncryption     *bool                  `json:"enabledForDiskEncryption,omitempty"`
+	EnabledForTemplateDeployment *bool                  `json:"enabledForTemplateDeployment,omitempty"`
+}
+
+func (command createOrUpdateKeyVault) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  keyVaultAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: keyVaultDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getKeyVaultResponse{}
+		},
+	}
+}
+
+type getKeyVault struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getKeyVault) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  keyVaultAPIVersion,
+		Method:      "GET",
+		URLPathFunc: keyVaultDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getKeyVaultResponse{}
+		},
+	}
+}
+
+type deleteKeyVault struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteKeyVault) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  keyVaultAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: keyVaultDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// The vendored Azure SDK does not include a client for the Key Vault data
+// plane, which is used to manage the secrets, keys and certificates held in
+// a vault. keyVaultDataClient issues these requests directly using autorest,
+// authorized with a token for the Key Vault audience rather than for ARM.
+
+const keyVaultDataAPIVersion = "2016-10-01"
+
+type keyVaultDataClient struct {
+	autorest.Client
+}
+
+// do sends a request to the given Key Vault URI, marshalling body (if any)
+// into the request and unmarshalling the response into result (if any).
+// Status codes other than 200 are returned as errors, apart from 404 for
+// which the response is returned without error so that callers can decide
+// how a missing object should be handled.
+func (client keyVaultDataClient) do(method, uri string, body interface{}, result interface{}) (*http.Response, error) {
+	decorators := []autorest.PrepareDecorator{
+		autorest.AsJSON(),
+		autorest.WithMethod(method),
+		autorest.WithBaseURL(uri),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": keyVaultDataAPIVersion,
+		}),
+	}
+	if body != nil {
+		decorators = append(decorators, autorest.WithJSON(body))
+	}
+
+	req, err := autorest.Prepare(&http.Request{}, decorators...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return resp, nil
+	}
+
+	responders := []autorest.RespondDecorator{
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+	}
+	if result != nil {
+		responders = append(responders, autorest.ByUnmarshallingJSON(result))
+	}
+	responders = append(responders, autorest.ByClosing())
+
+	return resp, autorest.Respond(resp, responders...)
+}
+
+// keyVaultChildID describes the versioned ID of a secret, key or certificate,
+// which takes the form https://{vault}.vault.azure.net/{type}/{name}/{version}.
+type keyVaultChildID struct {
+	KeyVaultBaseURL string
+	Name            string
+	Version         string
+}
+
+func parseKeyVaultChildID(id string) (*keyVaultChildID, error) {
+	idURL, err := url.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Key Vault ID %q: %s", id, err)
+	}
+
+	components := strings.Split(strings.Trim(idURL.Path, "/"), "/")
+	if len(components) != 3 {
+		return nil, fmt.Errorf("Key Vault ID %q should have 3 path segments, got %d", id, len(components))
+	}
+
+	return &keyVaultChildID{
+		KeyVaultBaseURL: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Name:            components[1],
+		Version:         components[2],
+	}, nil
+}
+
+// keyVaultChildURI builds the unversioned URI of a secret, key or certificate
+// within the vault at vaultURI.
+func keyVaultChildURI(vaultURI, childType, name string) string {
+	return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(vaultURI, "/"), childType, name)
+}
+
+type keyVaultObjectAttributes struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type keyVaultSecretBundle struct {
+	ID          *string                   `json:"id,omitempty"`
+	Value       *string                   `json:"value,omitempty"`
+	ContentType *string                   `json:"contentType,omitempty"`
+	Attributes  *keyVaultObjectAttributes `json:"attributes,omitempty"`
+	Tags        *map[string]*string       `json:"tags,omitempty"`
+}
+
+type keyVaultJSONWebKey struct {
+	Kid    *string   `json:"kid,omitempty"`
+	Kty    *string   `json:"kty,omitempty"`
+	KeyOps *[]string `json:"key_ops,omitempty"`
+	N      *string   `json:"n,omitempty"`
+	E      *string   `json:"e,omitempty"`
+}
+
+type keyVaultKeyCreateParameters struct {
+	Kty     *string             `json:"kty"`
+	KeySize *int32              `json:"key_size,omitempty"`
+	KeyOps  *[]string           `json:"key_ops,omitempty"`
+	Tags    *map[string]*string `json:"tags,omitempty"`
+}
+
+type keyVaultKeyUpdateParameters struct {
+	Tags *map[string]*string `json:"tags"`
+}
+
+type keyVaultKeyBundle struct {
+	Key        *keyVaultJSONWebKey       `json:"key,omitempty"`
+	Attributes *keyVaultObjectAttributes `json:"attributes,omitempty"`
+	Tags       *map[string]*string       `json:"tags,omitempty"`
+}
+
+type keyVaultCertificateSecretProperties struct {
+	ContentType *string `json:"contentType,omitempty"`
+}
+
+type keyVaultCertificatePolicy struct {
+	SecretProperties *keyVaultCertificateSecretProperties `json:"secret_props,omitempty"`
+}
+
+type keyVaultCertificateImportParameters struct {
+	Value    *string                    `json:"value"`
+	Password *string                    `json:"pwd,omitempty"`
+	Policy   *keyVaultCertificatePolicy `json:"policy,omitempty"`
+	Tags     *map[string]*string        `json:"tags,omitempty"`
+}
+
+type keyVaultCertificateUpdateParameters struct {
+	Tags *map[string]*string `json:"tags"`
+}
+
+type keyVaultCertificateBundle struct {
+	ID             *string                   `json:"id,omitempty"`
+	X509Thumbprint *string                   `json:"x5t,omitempty"`
+	Cer            *string                   `json:"cer,omitempty"`
+	Attributes     *keyVaultObjectAttributes `json:"attributes,omitempty"`
+	Tags           *map[string]*string       `json:"tags,omitempty"`
+}
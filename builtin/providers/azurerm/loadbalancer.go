@@ -0,0 +1,45 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+)
+
+// retrieveLoadBalancerById looks up a Load Balancer by its ID, returning
+// whether it exists alongside the Load Balancer itself.
+func retrieveLoadBalancerById(loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
+	loadBalancerClient := meta.(*ArmClient).loadBalancerClient
+
+	id, err := parseAzureResourceID(loadBalancerId)
+	if err != nil {
+		return nil, false, fmt.Errorf("Error parsing Load Balancer ID %q: %s", loadBalancerId, err)
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["loadBalancers"]
+
+	resp, err := loadBalancerClient.Get(resGroup, name, "")
+	if err != nil {
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("Error making Read request on Azure Load Balancer %q: %s", name, err)
+	}
+
+	return &resp, true, nil
+}
+
+func findLoadBalancerBackEndAddressPoolByName(lb *network.LoadBalancer, name string) (*network.BackendAddressPool, bool) {
+	if lb == nil || lb.Properties == nil || lb.Properties.BackendAddressPools == nil {
+		return nil, false
+	}
+
+	for _, pool := range *lb.Properties.BackendAddressPools {
+		if pool.Name != nil && *pool.Name == name {
+			return &pool, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no clients for Log Analytics, so the ARM
+// requests used by the Log Analytics workspace and solution resources are
+// described here for use with the Riviera client.
+
+const logAnalyticsWorkspaceAPIVersion = "2015-11-01-preview"
+const logAnalyticsSolutionAPIVersion = "2015-11-01-preview"
+
+func logAnalyticsWorkspaceDefaultURLPath(resourceGroupName, name, suffix string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s%s", resourceGroupName, name, suffix)
+	}
+}
+
+func logAnalyticsSolutionDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.OperationsManagement/solutions/%s", resourceGroupName, name)
+	}
+}
+
+type logAnalyticsWorkspaceSku struct {
+	Name *string `json:"name" mapstructure:"name"`
+}
+
+type getLogAnalyticsWorkspaceResponse struct {
+	ID                *string                   `mapstructure:"id"`
+	Name              *string                   `mapstructure:"name"`
+	Location          *string                   `mapstructure:"location"`
+	Tags              *map[string]*string       `mapstructure:"tags"`
+	ProvisioningState *string                   `mapstructure:"provisioningState"`
+	Sku               *logAnalyticsWorkspaceSku `mapstructure:"sku"`
+	RetentionInDays   *int32                    `mapstructure:"retentionInDays"`
+	CustomerID        *string                   `mapstructure:"customerId"`
+	PortalURL         *string                   `mapstructure:"portalUrl"`
+}
+
+type createOrUpdateLogAnalyticsWorkspace struct {
+	Name              string                    `json:"-"`
+	ResourceGroupName string                    `json:"-"`
+	Location          string                    `json:"-" riviera:"location"`
+	Tags              map[string]*string        `json:"-" riviera:"tags"`
+	Sku               *logAnalyticsWorkspaceSku `json:"sku"`
+	RetentionInDays   *int32                    `json:"retentionInDays,omitempty"`
+}
+
+func (command createOrUpdateLogAnalyticsWorkspace) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  logAnalyticsWorkspaceAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: logAnalyticsWorkspaceDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getLogAnalyticsWorkspaceResponse{}
+		},
+	}
+}
+
+type getLogAnalyticsWorkspace struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getLogAnalyticsWorkspace) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  logAnalyticsWorkspaceAPIVersion,
+		Method:      "GET",
+		URLPathFunc: logAnalyticsWorkspaceDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getLogAnalyticsWorkspaceResponse{}
+		},
+	}
+}
+
+type deleteLogAnalyticsWorkspace struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteLogAnalyticsWorkspace) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  logAnalyticsWorkspaceAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: logAnalyticsWorkspaceDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getLogAnalyticsWorkspaceSharedKeysResponse struct {
+	PrimarySharedKey   *string `mapstructure:"primarySharedKey"`
+	SecondarySharedKey *string `mapstructure:"secondarySharedKey"`
+}
+
+type getLogAnalyticsWorkspaceSharedKeys struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getLogAnalyticsWorkspaceSharedKeys) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:      logAnalyticsWorkspaceAPIVersion,
+		Method:          "POST",
+		URLPathFunc:     logAnalyticsWorkspaceDefaultURLPath(command.ResourceGroupName, command.Name, "/sharedKeys"),
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &getLogAnalyticsWorkspaceSharedKeysResponse{}
+		},
+	}
+}
+
+type logAnalyticsSolutionPlan struct {
+	Name          *string `json:"name" mapstructure:"name"`
+	Publisher     *string `json:"publisher" mapstructure:"publisher"`
+	Product       *string `json:"product" mapstructure:"product"`
+	PromotionCode *string `json:"promotionCode,omitempty" mapstructure:"promotionCode"`
+}
+
+type getLogAnalyticsSolutionResponse struct {
+	ID                  *string                   `mapstructure:"id"`
+	Name                *string                   `mapstructure:"name"`
+	Location            *string                   `mapstructure:"location"`
+	Plan                *logAnalyticsSolutionPlan `mapstructure:"plan"`
+	WorkspaceResourceID *string                   `mapstructure:"workspaceResourceId"`
+	ProvisioningState   *string                   `mapstructure:"provisioningState"`
+}
+
+type createOrUpdateLogAnalyticsSolution struct {
+	Name                string                    `json:"-"`
+	ResourceGroupName   string                    `json:"-"`
+	Location            string                    `json:"-" riviera:"location"`
+	Plan                *logAnalyticsSolutionPlan `json:"-" riviera:"plan"`
+	WorkspaceResourceID *string                   `json:"workspaceResourceId"`
+}
+
+func (command createOrUpdateLogAnalyticsSolution) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  logAnalyticsSolutionAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: logAnalyticsSolutionDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getLogAnalyticsSolutionResponse{}
+		},
+	}
+}
+
+type getLogAnalyticsSolution struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getLogAnalyticsSolution) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  logAnalyticsSolutionAPIVersion,
+		Method:      "GET",
+		URLPathFunc: logAnalyticsSolutionDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getLogAnalyticsSolutionResponse{}
+		},
+	}
+}
+
+type deleteLogAnalyticsSolution struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteLogAnalyticsSolution) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  logAnalyticsSolutionAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: logAnalyticsSolutionDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
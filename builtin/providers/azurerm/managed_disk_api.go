@@ -0,0 +1,380 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK predates Managed Disks, so the ARM requests used by
+// the Managed Disk, Snapshot and Image resources are described here for use
+// with the Riviera client. Virtual Machines are also read and updated through these requests
+// when attaching Managed Disks, since the SDK's models cannot refer to them, as are
+// Availability Sets, which are "Aligned" with Managed Disks through their SKU.
+
+const managedDiskAPIVersion = "2016-04-30-preview"
+
+func managedDiskDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Compute/disks/%s", resourceGroupName, name)
+	}
+}
+
+func snapshotDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Compute/snapshots/%s", resourceGroupName, name)
+	}
+}
+
+func imageDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Compute/images/%s", resourceGroupName, name)
+	}
+}
+
+func availabilitySetDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s", resourceGroupName, name)
+	}
+}
+
+type managedDiskImageReference struct {
+	ID  *string `json:"id" mapstructure:"id"`
+	Lun *int32  `json:"lun,omitempty" mapstructure:"lun"`
+}
+
+type managedDiskCreationData struct {
+	CreateOption     *string                    `json:"createOption" mapstructure:"createOption"`
+	StorageAccountID *string                    `json:"storageAccountId,omitempty" mapstructure:"storageAccountId"`
+	ImageReference   *managedDiskImageReference `json:"imageReference,omitempty" mapstructure:"imageReference"`
+	SourceURI        *string                    `json:"sourceUri,omitempty" mapstructure:"sourceUri"`
+	SourceResourceID *string                    `json:"sourceResourceId,omitempty" mapstructure:"sourceResourceId"`
+}
+
+type getManagedDiskResponse struct {
+	ID                *string                  `mapstructure:"id"`
+	Name              *string                  `mapstructure:"name"`
+	Location          *string                  `mapstructure:"location"`
+	Tags              *map[string]*string      `mapstructure:"tags"`
+	ProvisioningState *string                  `mapstructure:"provisioningState"`
+	AccountType       *string                  `mapstructure:"accountType"`
+	CreationData      *managedDiskCreationData `mapstructure:"creationData"`
+	DiskSizeGB        *int32                   `mapstructure:"diskSizeGB"`
+	OSType            *string                  `mapstructure:"osType"`
+	OwnerID           *string                  `mapstructure:"ownerId"`
+}
+
+type createOrUpdateManagedDisk struct {
+	Name              string                   `json:"-"`
+	ResourceGroupName string                   `json:"-"`
+	Location          string                   `json:"-" riviera:"location"`
+	Tags              map[string]*string       `json:"-" riviera:"tags"`
+	AccountType       *string                  `json:"accountType"`
+	CreationData      *managedDiskCreationData `json:"creationData"`
+	DiskSizeGB        *int32                   `json:"diskSizeGB,omitempty"`
+	OSType            *string                  `json:"osType,omitempty"`
+}
+
+func (command createOrUpdateManagedDisk) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: managedDiskDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getManagedDiskResponse{}
+		},
+	}
+}
+
+type getManagedDisk struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getManagedDisk) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "GET",
+		URLPathFunc: managedDiskDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getManagedDiskResponse{}
+		},
+	}
+}
+
+type deleteManagedDisk struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteManagedDisk) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: managedDiskDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getSnapshotResponse struct {
+	ID                *string                  `mapstructure:"id"`
+	Name              *string                  `mapstructure:"name"`
+	Location          *string                  `mapstructure:"location"`
+	Tags              *map[string]*string      `mapstructure:"tags"`
+	ProvisioningState *string                  `mapstructure:"provisioningState"`
+	AccountType       *string                  `mapstructure:"accountType"`
+	CreationData      *managedDiskCreationData `mapstructure:"creationData"`
+	DiskSizeGB        *int32                   `mapstructure:"diskSizeGB"`
+	OSType            *string                  `mapstructure:"osType"`
+}
+
+type createOrUpdateSnapshot struct {
+	Name              string                   `json:"-"`
+	ResourceGroupName string                   `json:"-"`
+	Location          string                   `json:"-" riviera:"location"`
+	Tags              map[string]*string       `json:"-" riviera:"tags"`
+	AccountType       *string                  `json:"accountType,omitempty"`
+	CreationData      *managedDiskCreationData `json:"creationData"`
+	DiskSizeGB        *int32                   `json:"diskSizeGB,omitempty"`
+}
+
+func (command createOrUpdateSnapshot) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: snapshotDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getSnapshotResponse{}
+		},
+	}
+}
+
+type getSnapshot struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getSnapshot) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "GET",
+		URLPathFunc: snapshotDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getSnapshotResponse{}
+		},
+	}
+}
+
+type deleteSnapshot struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteSnapshot) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: snapshotDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type imageSubResource struct {
+	ID *string `json:"id" mapstructure:"id"`
+}
+
+type imageOSDisk struct {
+	OSType      *string           `json:"osType" mapstructure:"osType"`
+	OSState     *string           `json:"osState" mapstructure:"osState"`
+	Snapshot    *imageSubResource `json:"snapshot,omitempty" mapstructure:"snapshot"`
+	ManagedDisk *imageSubResource `json:"managedDisk,omitempty" mapstructure:"managedDisk"`
+	BlobURI     *string           `json:"blobUri,omitempty" mapstructure:"blobUri"`
+	Caching     *string           `json:"caching,omitempty" mapstructure:"caching"`
+	DiskSizeGB  *int32            `json:"diskSizeGB,omitempty" mapstructure:"diskSizeGB"`
+}
+
+type imageDataDisk struct {
+	Lun         *int32            `json:"lun" mapstructure:"lun"`
+	Snapshot    *imageSubResource `json:"snapshot,omitempty" mapstructure:"snapshot"`
+	ManagedDisk *imageSubResource `json:"managedDisk,omitempty" mapstructure:"managedDisk"`
+	BlobURI     *string           `json:"blobUri,omitempty" mapstructure:"blobUri"`
+	Caching     *string           `json:"caching,omitempty" mapstructure:"caching"`
+	DiskSizeGB  *int32            `json:"diskSizeGB,omitempty" mapstructure:"diskSizeGB"`
+}
+
+type imageStorageProfile struct {
+	OSDisk    *imageOSDisk    `json:"osDisk,omitempty" mapstructure:"osDisk"`
+	DataDisks []imageDataDisk `json:"dataDisks,omitempty" mapstructure:"dataDisks"`
+}
+
+type getImageResponse struct {
+	ID                   *string              `mapstructure:"id"`
+	Name                 *string              `mapstructure:"name"`
+	Location             *string              `mapstructure:"location"`
+	Tags                 *map[string]*string  `mapstructure:"tags"`
+	ProvisioningState    *string              `mapstructure:"provisioningState"`
+	SourceVirtualMachine *imageSubResource    `mapstructure:"sourceVirtualMachine"`
+	StorageProfile       *imageStorageProfile `mapstructure:"storageProfile"`
+}
+
+type createOrUpdateImage struct {
+	Name                 string               `json:"-"`
+	ResourceGroupName    string               `json:"-"`
+	Location             string               `json:"-" riviera:"location"`
+	Tags                 map[string]*string   `json:"-" riviera:"tags"`
+	SourceVirtualMachine *imageSubResource    `json:"sourceVirtualMachine,omitempty"`
+	StorageProfile       *imageStorageProfile `json:"storageProfile,omitempty"`
+}
+
+func (command createOrUpdateImage) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: imageDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getImageResponse{}
+		},
+	}
+}
+
+type getImage struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getImage) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "GET",
+		URLPathFunc: imageDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getImageResponse{}
+		},
+	}
+}
+
+type deleteImage struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteImage) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: imageDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getVirtualMachineDisksResponse struct {
+	ID         *string                `mapstructure:"id"`
+	Name       *string                `mapstructure:"name"`
+	Location   *string                `mapstructure:"location"`
+	Properties map[string]interface{} `mapstructure:"properties"`
+}
+
+// getVirtualMachineDisks reads a Virtual Machine with its properties left
+// as returned by the API, so that they can be sent back unchanged apart from
+// the data disks. It is used with a request for the Virtual Machine's URI.
+type getVirtualMachineDisks struct{}
+
+func (command getVirtualMachineDisks) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: managedDiskAPIVersion,
+		Method:     "GET",
+		ResponseTypeFunc: func() interface{} {
+			return &getVirtualMachineDisksResponse{}
+		},
+	}
+}
+
+// virtualMachineDisksProperties marshals the properties of a Virtual Machine
+// directly, since Riviera cannot inspect the fields of a map.
+type virtualMachineDisksProperties struct {
+	Location   string `riviera:"location"`
+	Properties map[string]interface{}
+}
+
+func (p virtualMachineDisksProperties) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Properties)
+}
+
+type updateVirtualMachineDisks struct {
+	Location   string                 `json:"-"`
+	Properties map[string]interface{} `json:"-"`
+}
+
+func (command updateVirtualMachineDisks) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: managedDiskAPIVersion,
+		Method:     "PUT",
+		RequestPropertiesFunc: func() interface{} {
+			return virtualMachineDisksProperties{
+				Location:   command.Location,
+				Properties: command.Properties,
+			}
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getVirtualMachineDisksResponse{}
+		},
+	}
+}
+
+type availabilitySetSku struct {
+	Name *string `json:"name" mapstructure:"name"`
+}
+
+type getAvailabilitySetResponse struct {
+	ID                        *string             `mapstructure:"id"`
+	Name                      *string             `mapstructure:"name"`
+	Location                  *string             `mapstructure:"location"`
+	Tags                      *map[string]*string `mapstructure:"tags"`
+	Sku                       *availabilitySetSku `mapstructure:"sku"`
+	PlatformFaultDomainCount  *int32              `mapstructure:"platformFaultDomainCount"`
+	PlatformUpdateDomainCount *int32              `mapstructure:"platformUpdateDomainCount"`
+}
+
+type createOrUpdateAvailabilitySet struct {
+	Name                      string              `json:"-"`
+	ResourceGroupName         string              `json:"-"`
+	Location                  string              `json:"-" riviera:"location"`
+	Tags                      map[string]*string  `json:"-" riviera:"tags"`
+	Sku                       *availabilitySetSku `json:"-" riviera:"sku"`
+	PlatformFaultDomainCount  *int32              `json:"platformFaultDomainCount"`
+	PlatformUpdateDomainCount *int32              `json:"platformUpdateDomainCount"`
+}
+
+func (command createOrUpdateAvailabilitySet) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: availabilitySetDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getAvailabilitySetResponse{}
+		},
+	}
+}
+
+type getAvailabilitySet struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getAvailabilitySet) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  managedDiskAPIVersion,
+		Method:      "GET",
+		URLPathFunc: availabilitySetDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getAvailabilitySetResponse{}
+		},
+	}
+}
@@ -0,0 +1,199 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for Azure Monitor alerts, so the ARM
+// requests used by azurerm_metric_alertrule and
+// azurerm_monitor_activity_log_alert are described here for use with the
+// Riviera client.
+
+const (
+	metricAlertRuleAPIVersion     = "2016-03-01"
+	activityLogAlertAPIVersion    = "2017-04-01"
+	metricAlertRuleConditionType  = "Microsoft.Azure.Management.Insights.Models.ThresholdRuleCondition"
+	metricAlertRuleDataSourceType = "Microsoft.Azure.Management.Insights.Models.RuleMetricDataSource"
+	metricAlertRuleEmailType      = "Microsoft.Azure.Management.Insights.Models.RuleEmailAction"
+	metricAlertRuleWebhookType    = "Microsoft.Azure.Management.Insights.Models.RuleWebhookAction"
+)
+
+type metricAlertRuleDataSource struct {
+	ODataType   string  `json:"odata.type" mapstructure:"odata.type"`
+	ResourceURI *string `json:"resourceUri" mapstructure:"resourceUri"`
+	MetricName  *string `json:"metricName" mapstructure:"metricName"`
+}
+
+type metricAlertRuleCondition struct {
+	ODataType       string                     `json:"odata.type" mapstructure:"odata.type"`
+	DataSource      *metricAlertRuleDataSource `json:"dataSource" mapstructure:"dataSource"`
+	Operator        *string                    `json:"operator" mapstructure:"operator"`
+	Threshold       *float64                   `json:"threshold" mapstructure:"threshold"`
+	WindowSize      *string                    `json:"windowSize" mapstructure:"windowSize"`
+	TimeAggregation *string                    `json:"timeAggregation" mapstructure:"timeAggregation"`
+}
+
+// metricAlertRuleAction holds either an email or a webhook action, which are
+// told apart by their OData type.
+type metricAlertRuleAction struct {
+	ODataType           string             `json:"odata.type" mapstructure:"odata.type"`
+	SendToServiceOwners *bool              `json:"sendToServiceOwners,omitempty" mapstructure:"sendToServiceOwners"`
+	CustomEmails        []string           `json:"customEmails,omitempty" mapstructure:"customEmails"`
+	ServiceURI          *string            `json:"serviceUri,omitempty" mapstructure:"serviceUri"`
+	Properties          map[string]*string `json:"properties,omitempty" mapstructure:"properties"`
+}
+
+type getMetricAlertRuleResponse struct {
+	ID          *string                   `mapstructure:"id"`
+	Name        *string                   `mapstructure:"name"`
+	Location    *string                   `mapstructure:"location"`
+	Tags        *map[string]*string       `mapstructure:"tags"`
+	Description *string                   `mapstructure:"description"`
+	IsEnabled   *bool                     `mapstructure:"isEnabled"`
+	Condition   *metricAlertRuleCondition `mapstructure:"condition"`
+	Actions     []metricAlertRuleAction   `mapstructure:"actions"`
+}
+
+type createOrUpdateMetricAlertRule struct {
+	Name              string                    `json:"name"`
+	ResourceGroupName string                    `json:"-"`
+	Location          string                    `json:"-" riviera:"location"`
+	Tags              map[string]*string        `json:"-" riviera:"tags"`
+	Description       *string                   `json:"description,omitempty"`
+	IsEnabled         *bool                     `json:"isEnabled"`
+	Condition         *metricAlertRuleCondition `json:"condition"`
+	Actions           []metricAlertRuleAction   `json:"actions"`
+}
+
+func (command createOrUpdateMetricAlertRule) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: metricAlertRuleAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/microsoft.insights/alertrules/%s",
+				command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getMetricAlertRuleResponse{}
+		},
+	}
+}
+
+type getMetricAlertRule struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getMetricAlertRule) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: metricAlertRuleAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/microsoft.insights/alertrules/%s",
+				command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getMetricAlertRuleResponse{}
+		},
+	}
+}
+
+type deleteMetricAlertRule struct{}
+
+func (command deleteMetricAlertRule) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: metricAlertRuleAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type activityLogAlertLeafCondition struct {
+	Field  *string `json:"field" mapstructure:"field"`
+	Equals *string `json:"equals" mapstructure:"equals"`
+}
+
+type activityLogAlertCondition struct {
+	AllOf []activityLogAlertLeafCondition `json:"allOf" mapstructure:"allOf"`
+}
+
+type activityLogAlertActionGroup struct {
+	ActionGroupID     *string            `json:"actionGroupId" mapstructure:"actionGroupId"`
+	WebhookProperties map[string]*string `json:"webhookProperties,omitempty" mapstructure:"webhookProperties"`
+}
+
+type activityLogAlertActions struct {
+	ActionGroups []activityLogAlertActionGroup `json:"actionGroups" mapstructure:"actionGroups"`
+}
+
+type getActivityLogAlertResponse struct {
+	ID          *string                    `mapstructure:"id"`
+	Name        *string                    `mapstructure:"name"`
+	Tags        *map[string]*string        `mapstructure:"tags"`
+	Scopes      []string                   `mapstructure:"scopes"`
+	Enabled     *bool                      `mapstructure:"enabled"`
+	Description *string                    `mapstructure:"description"`
+	Condition   *activityLogAlertCondition `mapstructure:"condition"`
+	Actions     *activityLogAlertActions   `mapstructure:"actions"`
+}
+
+type createOrUpdateActivityLogAlert struct {
+	Name              string                     `json:"-"`
+	ResourceGroupName string                     `json:"-"`
+	Location          string                     `json:"-" riviera:"location"`
+	Tags              map[string]*string         `json:"-" riviera:"tags"`
+	Scopes            []string                   `json:"scopes"`
+	Enabled           *bool                      `json:"enabled"`
+	Description       *string                    `json:"description,omitempty"`
+	Condition         *activityLogAlertCondition `json:"condition"`
+	Actions           *activityLogAlertActions   `json:"actions"`
+}
+
+func (command createOrUpdateActivityLogAlert) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: activityLogAlertAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/microsoft.insights/activityLogAlerts/%s",
+				command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getActivityLogAlertResponse{}
+		},
+	}
+}
+
+type getActivityLogAlert struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getActivityLogAlert) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: activityLogAlertAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/microsoft.insights/activityLogAlerts/%s",
+				command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getActivityLogAlertResponse{}
+		},
+	}
+}
+
+type deleteActivityLogAlert struct{}
+
+func (command deleteActivityLogAlert) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: activityLogAlertAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
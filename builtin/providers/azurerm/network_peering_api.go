@@ -0,0 +1,86 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK predates Virtual Network Peering, so the ARM
+// requests used by azurerm_virtual_network_peering are described here for use
+// with the Riviera client.
+
+const virtualNetworkPeeringAPIVersion = "2016-12-01"
+
+type virtualNetworkPeeringRemoteNetwork struct {
+	ID *string `json:"id" mapstructure:"id"`
+}
+
+type getVirtualNetworkPeeringResponse struct {
+	ID                        *string                             `mapstructure:"id"`
+	Name                      *string                             `mapstructure:"name"`
+	RemoteVirtualNetwork      *virtualNetworkPeeringRemoteNetwork `mapstructure:"remoteVirtualNetwork"`
+	AllowVirtualNetworkAccess *bool                               `mapstructure:"allowVirtualNetworkAccess"`
+	AllowForwardedTraffic     *bool                               `mapstructure:"allowForwardedTraffic"`
+	AllowGatewayTransit       *bool                               `mapstructure:"allowGatewayTransit"`
+	UseRemoteGateways         *bool                               `mapstructure:"useRemoteGateways"`
+	PeeringState              *string                             `mapstructure:"peeringState"`
+	ProvisioningState         *string                             `mapstructure:"provisioningState"`
+}
+
+type createOrUpdateVirtualNetworkPeering struct {
+	Name                      string                              `json:"-"`
+	ResourceGroupName         string                              `json:"-"`
+	VirtualNetworkName        string                              `json:"-"`
+	RemoteVirtualNetwork      *virtualNetworkPeeringRemoteNetwork `json:"remoteVirtualNetwork"`
+	AllowVirtualNetworkAccess *bool                               `json:"allowVirtualNetworkAccess"`
+	AllowForwardedTraffic     *bool                               `json:"allowForwardedTraffic"`
+	AllowGatewayTransit       *bool                               `json:"allowGatewayTransit"`
+	UseRemoteGateways         *bool                               `json:"useRemoteGateways"`
+}
+
+func (command createOrUpdateVirtualNetworkPeering) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: virtualNetworkPeeringAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/virtualNetworkPeerings/%s",
+				command.ResourceGroupName, command.VirtualNetworkName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getVirtualNetworkPeeringResponse{}
+		},
+	}
+}
+
+type getVirtualNetworkPeering struct {
+	Name               string `json:"-"`
+	ResourceGroupName  string `json:"-"`
+	VirtualNetworkName string `json:"-"`
+}
+
+func (command getVirtualNetworkPeering) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: virtualNetworkPeeringAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/virtualNetworkPeerings/%s",
+				command.ResourceGroupName, command.VirtualNetworkName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getVirtualNetworkPeeringResponse{}
+		},
+	}
+}
+
+type deleteVirtualNetworkPeering struct{}
+
+func (command deleteVirtualNetworkPeering) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: virtualNetworkPeeringAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package azurerm
+
+import (
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for the Policy API, so the ARM
+// requests used by azurerm_policy_definition and azurerm_policy_assignment
+// are described here for use with the Riviera client. Like role assignments,
+// policy assignments are created beneath an arbitrary scope, so these
+// requests are always made with NewRequestForURI.
+
+const policyAPIVersion = "2019-06-01"
+
+type getPolicyDefinitionResponse struct {
+	ID          *string                `mapstructure:"id"`
+	Name        *string                `mapstructure:"name"`
+	PolicyType  *string                `mapstructure:"policyType"`
+	Mode        *string                `mapstructure:"mode"`
+	DisplayName *string                `mapstructure:"displayName"`
+	Description *string                `mapstructure:"description"`
+	PolicyRule  map[string]interface{} `mapstructure:"policyRule"`
+	Parameters  map[string]interface{} `mapstructure:"parameters"`
+	Metadata    map[string]interface{} `mapstructure:"metadata"`
+}
+
+type createOrUpdatePolicyDefinition struct {
+	PolicyType  *string                `json:"policyType"`
+	Mode        *string                `json:"mode"`
+	DisplayName *string                `json:"displayName"`
+	Description *string                `json:"description,omitempty"`
+	PolicyRule  map[string]interface{} `json:"policyRule,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (command createOrUpdatePolicyDefinition) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: policyAPIVersion,
+		Method:     "PUT",
+		ResponseTypeFunc: func() interface{} {
+			return &getPolicyDefinitionResponse{}
+		},
+	}
+}
+
+type getPolicyDefinition struct{}
+
+func (command getPolicyDefinition) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: policyAPIVersion,
+		Method:     "GET",
+		ResponseTypeFunc: func() interface{} {
+			return &getPolicyDefinitionResponse{}
+		},
+	}
+}
+
+type deletePolicyDefinition struct{}
+
+func (command deletePolicyDefinition) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: policyAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getPolicyAssignmentResponse struct {
+	ID                 *string                `mapstructure:"id"`
+	Name               *string                `mapstructure:"name"`
+	Scope              *string                `mapstructure:"scope"`
+	PolicyDefinitionID *string                `mapstructure:"policyDefinitionId"`
+	DisplayName        *string                `mapstructure:"displayName"`
+	Description        *string                `mapstructure:"description"`
+	Parameters         map[string]interface{} `mapstructure:"parameters"`
+	NotScopes          []string               `mapstructure:"notScopes"`
+	EnforcementMode    *string                `mapstructure:"enforcementMode"`
+}
+
+type createOrUpdatePolicyAssignment struct {
+	Scope              *string                `json:"scope"`
+	PolicyDefinitionID *string                `json:"policyDefinitionId"`
+	DisplayName        *string                `json:"displayName,omitempty"`
+	Description        *string                `json:"description,omitempty"`
+	Parameters         map[string]interface{} `json:"parameters,omitempty"`
+	NotScopes          []string               `json:"notScopes"`
+	EnforcementMode    *string                `json:"enforcementMode"`
+}
+
+func (command createOrUpdatePolicyAssignment) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: policyAPIVersion,
+		Method:     "PUT",
+		ResponseTypeFunc: func() interface{} {
+			return &getPolicyAssignmentResponse{}
+		},
+	}
+}
+
+type getPolicyAssignment struct{}
+
+func (command getPolicyAssignment) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: policyAPIVersion,
+		Method:     "GET",
+		ResponseTypeFunc: func() interface{} {
+			return &getPolicyAssignmentResponse{}
+		},
+	}
+}
+
+type deletePolicyAssignment struct{}
+
+func (command deletePolicyAssignment) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: policyAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
@@ -2,15 +2,20 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"reflect"
 	"strings"
 
 	"sync"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	riviera "github.com/jen20/riviera/azure"
 )
@@ -21,68 +26,200 @@ func Provider() terraform.ResourceProvider {
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SUBSCRIPTION_ID", ""),
 			},
 
 			"client_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_ID", ""),
 			},
 
 			"client_secret": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
+			"client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
+			},
+
+			"client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
+
+			"environment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
+				ValidateFunc: validateArmEnvironment,
+			},
+
+			"use_msi": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_MSI", false),
+			},
+
+			"msi_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
+			},
+
+			"use_cli": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_CLI", false),
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", 5),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"polling_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_POLLING_TIMEOUT", "60m"),
+				ValidateFunc: validateArmPollingTimeout,
+			},
+
+			"skip_provider_registration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
+			},
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"azurerm_client_config":           dataSourceArmClientConfig(),
+			"azurerm_image":                   dataSourceArmImage(),
+			"azurerm_lb":                      dataSourceArmLoadBalancer(),
+			"azurerm_lb_backend_address_pool": dataSourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_public_ip":               dataSourceArmPublicIP(),
+			"azurerm_resource_group":          dataSourceArmResourceGroup(),
+			"azurerm_storage_account":         dataSourceArmStorageAccount(),
+			"azurerm_subnet":                  dataSourceArmSubnet(),
+			"azurerm_virtual_network":         dataSourceArmVirtualNetwork(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			// These resources use the Azure ARM SDK
-			"azurerm_availability_set":          resourceArmAvailabilitySet(),
-			"azurerm_cdn_endpoint":              resourceArmCdnEndpoint(),
-			"azurerm_cdn_profile":               resourceArmCdnProfile(),
-			"azurerm_local_network_gateway":     resourceArmLocalNetworkGateway(),
-			"azurerm_network_interface":         resourceArmNetworkInterface(),
-			"azurerm_network_security_group":    resourceArmNetworkSecurityGroup(),
-			"azurerm_network_security_rule":     resourceArmNetworkSecurityRule(),
-			"azurerm_public_ip":                 resourceArmPublicIp(),
-			"azurerm_route":                     resourceArmRoute(),
-			"azurerm_route_table":               resourceArmRouteTable(),
-			"azurerm_storage_account":           resourceArmStorageAccount(),
-			"azurerm_storage_blob":              resourceArmStorageBlob(),
-			"azurerm_storage_container":         resourceArmStorageContainer(),
-			"azurerm_storage_queue":             resourceArmStorageQueue(),
-			"azurerm_storage_table":             resourceArmStorageTable(),
-			"azurerm_subnet":                    resourceArmSubnet(),
-			"azurerm_template_deployment":       resourceArmTemplateDeployment(),
-			"azurerm_traffic_manager_endpoint":  resourceArmTrafficManagerEndpoint(),
-			"azurerm_traffic_manager_profile":   resourceArmTrafficManagerProfile(),
-			"azurerm_virtual_machine":           resourceArmVirtualMachine(),
-			"azurerm_virtual_machine_scale_set": resourceArmVirtualMachineScaleSet(),
-			"azurerm_virtual_network":           resourceArmVirtualNetwork(),
+			"azurerm_application_gateway":                                resourceArmApplicationGateway(),
+			"azurerm_availability_set":                                   resourceArmAvailabilitySet(),
+			"azurerm_azuread_application":                                resourceArmAzureADApplication(),
+			"azurerm_azuread_service_principal":                          resourceArmAzureADServicePrincipal(),
+			"azurerm_azuread_service_principal_password":                 resourceArmAzureADServicePrincipalPassword(),
+			"azurerm_cdn_custom_domain":                                  resourceArmCdnCustomDomain(),
+			"azurerm_cdn_endpoint":                                       resourceArmCdnEndpoint(),
+			"azurerm_cdn_profile":                                        resourceArmCdnProfile(),
+			"azurerm_express_route_circuit":                              resourceArmExpressRouteCircuit(),
+			"azurerm_express_route_circuit_authorization":                resourceArmExpressRouteCircuitAuthorization(),
+			"azurerm_express_route_circuit_peering":                      resourceArmExpressRouteCircuitPeering(),
+			"azurerm_key_vault_certificate":                              resourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_key":                                      resourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                                   resourceArmKeyVaultSecret(),
+			"azurerm_local_network_gateway":                              resourceArmLocalNetworkGateway(),
+			"azurerm_network_interface":                                  resourceArmNetworkInterface(),
+			"azurerm_network_interface_backend_address_pool_association": resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
+			"azurerm_network_interface_nat_rule_association":             resourceArmNetworkInterfaceNatRuleAssociation(),
+			"azurerm_network_security_group":                             resourceArmNetworkSecurityGroup(),
+			"azurerm_network_security_rule":                              resourceArmNetworkSecurityRule(),
+			"azurerm_public_ip":                                          resourceArmPublicIp(),
+			"azurerm_route":                                              resourceArmRoute(),
+			"azurerm_route_table":                                        resourceArmRouteTable(),
+			"azurerm_storage_account":                                    resourceArmStorageAccount(),
+			"azurerm_storage_blob":                                       resourceArmStorageBlob(),
+			"azurerm_storage_container":                                  resourceArmStorageContainer(),
+			"azurerm_storage_queue":                                      resourceArmStorageQueue(),
+			"azurerm_storage_share":                                      resourceArmStorageShare(),
+			"azurerm_storage_table":                                      resourceArmStorageTable(),
+			"azurerm_subnet":                                             resourceArmSubnet(),
+			"azurerm_subnet_route_table_association":                     resourceArmSubnetRouteTableAssociation(),
+			"azurerm_template_deployment":                                resourceArmTemplateDeployment(),
+			"azurerm_traffic_manager_endpoint":                           resourceArmTrafficManagerEndpoint(),
+			"azurerm_traffic_manager_profile":                            resourceArmTrafficManagerProfile(),
+			"azurerm_virtual_machine":                                    resourceArmVirtualMachine(),
+			"azurerm_virtual_machine_extension":                          resourceArmVirtualMachineExtension(),
+			"azurerm_virtual_machine_scale_set":                          resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_network":                                    resourceArmVirtualNetwork(),
+			"azurerm_virtual_network_gateway":                            resourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network_gateway_connection":                 resourceArmVirtualNetworkGatewayConnection(),
 
 			// These resources use the Riviera SDK
-			"azurerm_dns_a_record":      resourceArmDnsARecord(),
-			"azurerm_dns_aaaa_record":   resourceArmDnsAAAARecord(),
-			"azurerm_dns_cname_record":  resourceArmDnsCNameRecord(),
-			"azurerm_dns_mx_record":     resourceArmDnsMxRecord(),
-			"azurerm_dns_ns_record":     resourceArmDnsNsRecord(),
-			"azurerm_dns_srv_record":    resourceArmDnsSrvRecord(),
-			"azurerm_dns_txt_record":    resourceArmDnsTxtRecord(),
-			"azurerm_dns_zone":          resourceArmDnsZone(),
-			"azurerm_resource_group":    resourceArmResourceGroup(),
-			"azurerm_search_service":    resourceArmSearchService(),
-			"azurerm_sql_database":      resourceArmSqlDatabase(),
-			"azurerm_sql_firewall_rule": resourceArmSqlFirewallRule(),
-			"azurerm_sql_server":        resourceArmSqlServer(),
+			"azurerm_app_service":                             resourceArmAppService(),
+			"azurerm_app_service_plan":                        resourceArmAppServicePlan(),
+			"azurerm_app_service_slot":                        resourceArmAppServiceSlot(),
+			"azurerm_application_insights":                    resourceArmApplicationInsights(),
+			"azurerm_autoscale_setting":                       resourceArmAutoscaleSetting(),
+			"azurerm_container_registry":                      resourceArmContainerRegistry(),
+			"azurerm_container_registry_webhook":              resourceArmContainerRegistryWebhook(),
+			"azurerm_container_service":                       resourceArmContainerService(),
+			"azurerm_cosmosdb_account":                        resourceArmCosmosDBAccount(),
+			"azurerm_cosmosdb_mongo_collection":               resourceArmCosmosDBMongoCollection(),
+			"azurerm_cosmosdb_mongo_database":                 resourceArmCosmosDBMongoDatabase(),
+			"azurerm_cosmosdb_sql_container":                  resourceArmCosmosDBSQLContainer(),
+			"azurerm_cosmosdb_sql_database":                   resourceArmCosmosDBSQLDatabase(),
+			"azurerm_dns_a_record":                            resourceArmDnsARecord(),
+			"azurerm_dns_aaaa_record":                         resourceArmDnsAAAARecord(),
+			"azurerm_dns_cname_record":                        resourceArmDnsCNameRecord(),
+			"azurerm_dns_mx_record":                           resourceArmDnsMxRecord(),
+			"azurerm_dns_ns_record":                           resourceArmDnsNsRecord(),
+			"azurerm_dns_srv_record":                          resourceArmDnsSrvRecord(),
+			"azurerm_dns_txt_record":                          resourceArmDnsTxtRecord(),
+			"azurerm_dns_zone":                                resourceArmDnsZone(),
+			"azurerm_eventhub":                                resourceArmEventHub(),
+			"azurerm_eventhub_authorization_rule":             resourceArmEventHubAuthorizationRule(),
+			"azurerm_eventhub_consumer_group":                 resourceArmEventHubConsumerGroup(),
+			"azurerm_eventhub_namespace":                      resourceArmEventHubNamespace(),
+			"azurerm_eventhub_namespace_authorization_rule":   resourceArmEventHubNamespaceAuthorizationRule(),
+			"azurerm_function_app":                            resourceArmFunctionApp(),
+			"azurerm_image":                                   resourceArmImage(),
+			"azurerm_key_vault":                               resourceArmKeyVault(),
+			"azurerm_key_vault_access_policy":                 resourceArmKeyVaultAccessPolicy(),
+			"azurerm_kubernetes_cluster":                      resourceArmKubernetesCluster(),
+			"azurerm_log_analytics_solution":                  resourceArmLogAnalyticsSolution(),
+			"azurerm_log_analytics_workspace":                 resourceArmLogAnalyticsWorkspace(),
+			"azurerm_managed_disk":                            resourceArmManagedDisk(),
+			"azurerm_management_lock":                         resourceArmManagementLock(),
+			"azurerm_metric_alertrule":                        resourceArmMetricAlertRule(),
+			"azurerm_monitor_activity_log_alert":              resourceArmMonitorActivityLogAlert(),
+			"azurerm_policy_assignment":                       resourceArmPolicyAssignment(),
+			"azurerm_policy_definition":                       resourceArmPolicyDefinition(),
+			"azurerm_redis_cache":                             resourceArmRedisCache(),
+			"azurerm_resource_group":                          resourceArmResourceGroup(),
+			"azurerm_role_assignment":                         resourceArmRoleAssignment(),
+			"azurerm_role_definition":                         resourceArmRoleDefinition(),
+			"azurerm_search_service":                          resourceArmSearchService(),
+			"azurerm_servicebus_namespace":                    resourceArmServiceBusNamespace(),
+			"azurerm_servicebus_namespace_authorization_rule": resourceArmServiceBusNamespaceAuthorizationRule(),
+			"azurerm_servicebus_queue":                        resourceArmServiceBusQueue(),
+			"azurerm_servicebus_queue_authorization_rule":     resourceArmServiceBusQueueAuthorizationRule(),
+			"azurerm_servicebus_subscription":                 resourceArmServiceBusSubscription(),
+			"azurerm_servicebus_topic":                        resourceArmServiceBusTopic(),
+			"azurerm_servicebus_topic_authorization_rule":     resourceArmServiceBusTopicAuthorizationRule(),
+			"azurerm_snapshot":                                resourceArmSnapshot(),
+			"azurerm_sql_database":                            resourceArmSqlDatabase(),
+			"azurerm_sql_firewall_rule":                       resourceArmSqlFirewallRule(),
+			"azurerm_sql_server":                              resourceArmSqlServer(),
+			"azurerm_virtual_machine_data_disk_attachment":    resourceArmVirtualMachineDataDiskAttachment(),
+			"azurerm_virtual_network_peering":                 resourceArmVirtualNetworkPeering(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -98,34 +235,102 @@ type Config struct {
 	ClientSecret   string
 	TenantID       string
 
+	// Environment is the name of the Azure cloud to use, one of the keys of
+	// environments.
+	Environment string
+
+	// ClientCertPath is the path of a PKCS#12 certificate which can be used
+	// to authenticate the Service Principal in place of ClientSecret.
+	ClientCertPath     string
+	ClientCertPassword string
+
+	// UseMsi authenticates using the Managed Service Identity of the
+	// machine, obtaining tokens from MsiEndpoint.
+	UseMsi      bool
+	MsiEndpoint string
+
+	// UseCli authenticates using the tokens of the user logged in with the
+	// Azure CLI, which are loaded into cliToken.
+	UseCli   bool
+	cliToken *azure.Token
+
+	// MaxRetries is the number of times a request which was throttled or
+	// failed with a server error is retried, and PollingTimeout is how long
+	// a long-running operation is polled for before giving up.
+	MaxRetries     int
+	PollingTimeout time.Duration
+
+	// SkipProviderRegistration disables the registration of the resource
+	// providers used by the provider with the subscription.
+	SkipProviderRegistration bool
+
 	validateCredentialsOnce sync.Once
 }
 
 func (c *Config) validate() error {
 	var err *multierror.Error
 
+	if c.UseMsi && c.UseCli {
+		err = multierror.Append(err, fmt.Errorf("Only one of use_msi and use_cli can be set for the AzureRM provider"))
+	}
+
 	if c.SubscriptionID == "" {
 		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
 	}
-	if c.ClientID == "" {
-		err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
-	}
-	if c.ClientSecret == "" {
-		err = multierror.Append(err, fmt.Errorf("Client Secret must be configured for the AzureRM provider"))
-	}
 	if c.TenantID == "" {
 		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
 	}
 
+	// A Managed Service Identity or the Azure CLI supply the credentials
+	// themselves, so the Service Principal is only required otherwise.
+	if !c.UseMsi && !c.UseCli {
+		if c.ClientID == "" {
+			err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
+		}
+		if c.ClientSecret == "" && c.ClientCertPath == "" {
+			err = multierror.Append(err, fmt.Errorf("Either a Client Secret or a Client Certificate must be configured for the AzureRM provider"))
+		}
+		if c.ClientSecret != "" && c.ClientCertPath != "" {
+			err = multierror.Append(err, fmt.Errorf("Only one of a Client Secret and a Client Certificate can be configured for the AzureRM provider"))
+		}
+	}
+
 	return err.ErrorOrNil()
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := &Config{
-		SubscriptionID: d.Get("subscription_id").(string),
-		ClientID:       d.Get("client_id").(string),
-		ClientSecret:   d.Get("client_secret").(string),
-		TenantID:       d.Get("tenant_id").(string),
+		SubscriptionID:     d.Get("subscription_id").(string),
+		ClientID:           d.Get("client_id").(string),
+		ClientSecret:       d.Get("client_secret").(string),
+		TenantID:           d.Get("tenant_id").(string),
+		Environment:        d.Get("environment").(string),
+		ClientCertPath:     d.Get("client_certificate_path").(string),
+		ClientCertPassword: d.Get("client_certificate_password").(string),
+		UseMsi:             d.Get("use_msi").(bool),
+		MsiEndpoint:        d.Get("msi_endpoint").(string),
+		UseCli:             d.Get("use_cli").(bool),
+		MaxRetries:         d.Get("max_retries").(int),
+
+		SkipProviderRegistration: d.Get("skip_provider_registration").(bool),
+	}
+
+	pollingTimeout, err := time.ParseDuration(d.Get("polling_timeout").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing polling_timeout: %s", err)
+	}
+	config.PollingTimeout = pollingTimeout
+
+	// Without a Service Principal or a Managed Service Identity, fall back
+	// to the credentials of the user logged in with the Azure CLI.
+	if !config.UseMsi && config.ClientID == "" && config.ClientSecret == "" && config.ClientCertPath == "" {
+		config.UseCli = true
+	}
+
+	if config.UseCli && !config.UseMsi {
+		if err := config.loadAzureCliCredentials(); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := config.validate(); err != nil {
@@ -137,60 +342,112 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
-	err = registerAzureResourceProvidersWithSubscription(client.rivieraClient)
-	if err != nil {
-		return nil, err
+	// Registering the resource providers requires permissions which not
+	// every service principal has, so it can be skipped when they have been
+	// registered by other means.
+	if !config.SkipProviderRegistration {
+		if err := registerAzureResourceProvidersWithSubscription(client.providers); err != nil {
+			return nil, err
+		}
 	}
 
 	return client, nil
 }
 
-func registerProviderWithSubscription(providerName string, client *riviera.Client) error {
-	request := client.NewRequest()
-	request.Command = riviera.RegisterResourceProvider{
-		Namespace: providerName,
-	}
+// requiredResourceProviders are the Azure resource providers which must be
+// registered with the subscription for the resources of this provider to be
+// used (regardless of whether they are actually used by the configuration or
+// not). It was confirmed by Microsoft that this is the approach their own
+// internal tools also take.
+var requiredResourceProviders = []string{
+	"Microsoft.Cache",
+	"Microsoft.Cdn",
+	"Microsoft.Compute",
+	"Microsoft.ContainerRegistry",
+	"Microsoft.ContainerService",
+	"Microsoft.DocumentDB",
+	"Microsoft.EventHub",
+	"microsoft.insights",
+	"Microsoft.KeyVault",
+	"Microsoft.Network",
+	"Microsoft.OperationalInsights",
+	"Microsoft.OperationsManagement",
+	"Microsoft.Resources",
+	"Microsoft.Search",
+	"Microsoft.ServiceBus",
+	"Microsoft.Sql",
+	"Microsoft.Storage",
+	"Microsoft.Web",
+}
 
-	response, err := request.Execute()
-	if err != nil {
-		return fmt.Errorf("Cannot request provider registration for Azure Resource Manager: %s.", err)
+// determineResourceProvidersToRegister returns those of the required resource
+// providers which aren't registered with the subscription. Namespaces are
+// compared case-insensitively.
+func determineResourceProvidersToRegister(available []resources.Provider, required []string) []string {
+	registered := make(map[string]bool)
+	for _, provider := range available {
+		if provider.Namespace == nil || provider.RegistrationState == nil {
+			continue
+		}
+		if strings.EqualFold(*provider.RegistrationState, "Registered") {
+			registered[strings.ToLower(*provider.Namespace)] = true
+		}
 	}
 
-	if !response.IsSuccessful() {
-		return fmt.Errorf("Credentials for acessing the Azure Resource Manager API are likely " +
-			"to be incorrect, or\n  the service principal does not have permission to use " +
-			"the Azure Service Management\n  API.")
+	var toRegister []string
+	for _, namespace := range required {
+		if !registered[strings.ToLower(namespace)] {
+			toRegister = append(toRegister, namespace)
+		}
 	}
 
-	return nil
+	return toRegister
 }
 
-var providerRegistrationOnce sync.Once
-
-// registerAzureResourceProvidersWithSubscription uses the providers client to register
-// all Azure resource providers which the Terraform provider may require (regardless of
-// whether they are actually used by the configuration or not). It was confirmed by Microsoft
-// that this is the approach their own internal tools also take.
-func registerAzureResourceProvidersWithSubscription(client *riviera.Client) error {
-	var err error
-	providerRegistrationOnce.Do(func() {
-		// We register Microsoft.Compute during client initialization
-		providers := []string{"Microsoft.Network", "Microsoft.Cdn", "Microsoft.Storage", "Microsoft.Sql", "Microsoft.Search", "Microsoft.Resources"}
-
-		var wg sync.WaitGroup
-		wg.Add(len(providers))
-		for _, providerName := range providers {
-			go func(p string) {
-				defer wg.Done()
-				if innerErr := registerProviderWithSubscription(p, client); err != nil {
-					err = innerErr
-				}
-			}(providerName)
+// registerAzureResourceProvidersWithSubscription registers those of the
+// required resource providers which aren't already registered with the
+// subscription, in parallel. Listing the resource providers also has the
+// effect of validating the credentials.
+func registerAzureResourceProvidersWithSubscription(client resources.ProvidersClient) error {
+	var available []resources.Provider
+
+	result, err := client.List(nil)
+	for {
+		if err != nil {
+			return fmt.Errorf("Error listing the Resource Providers of the subscription - the credentials for "+
+				"accessing the Azure Resource Manager API are likely to be incorrect, or the service principal "+
+				"does not have access to the subscription: %s", err)
+		}
+		if result.Value != nil {
+			available = append(available, *result.Value...)
 		}
-		wg.Wait()
-	})
+		if result.NextLink == nil || *result.NextLink == "" {
+			break
+		}
+		result, err = client.ListNextResults(result)
+	}
+
+	providers := determineResourceProvidersToRegister(available, requiredResourceProviders)
+
+	var wg sync.WaitGroup
+	var l sync.Mutex
+	var errors *multierror.Error
+	for _, namespace := range providers {
+		wg.Add(1)
+		go func(namespace string) {
+			defer wg.Done()
+
+			log.Printf("[DEBUG] Registering Resource Provider %q with the subscription", namespace)
+			if _, err := client.Register(namespace); err != nil {
+				l.Lock()
+				errors = multierror.Append(errors, fmt.Errorf("Error registering Resource Provider %q: %s", namespace, err))
+				l.Unlock()
+			}
+		}(namespace)
+	}
+	wg.Wait()
 
-	return err
+	return errors.ErrorOrNil()
 }
 
 // azureRMNormalizeLocation is a function which normalises human-readable region/location
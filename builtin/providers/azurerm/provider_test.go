@@ -2,8 +2,12 @@ package azurerm
 
 import (
 	"os"
+	"reflect"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -38,3 +42,40 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("ARM_SUBSCRIPTION_ID, ARM_CLIENT_ID, ARM_CLIENT_SECRET and ARM_TENANT_ID must be set for acceptance tests")
 	}
 }
+
+func TestProvider_validateArmEnvironment(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "public", ErrCount: 0},
+		{Value: "usgovernment", ErrCount: 0},
+		{Value: "german", ErrCount: 0},
+		{Value: "china", ErrCount: 0},
+		{Value: "", ErrCount: 1},
+		{Value: "AzurePublicCloud", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmEnvironment(tc.Value, "environment")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors validating the environment %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestProvider_determineResourceProvidersToRegister(t *testing.T) {
+	available := []resources.Provider{
+		{Namespace: to.StringPtr("Microsoft.Compute"), RegistrationState: to.StringPtr("Registered")},
+		{Namespace: to.StringPtr("Microsoft.Insights"), RegistrationState: to.StringPtr("Registered")},
+		{Namespace: to.StringPtr("Microsoft.Network"), RegistrationState: to.StringPtr("NotRegistered")},
+		{Namespace: to.StringPtr("Microsoft.Storage"), RegistrationState: to.StringPtr("Registering")},
+	}
+	required := []string{"Microsoft.Cdn", "Microsoft.Compute", "microsoft.insights", "Microsoft.Network", "Microsoft.Storage"}
+
+	expected := []string{"Microsoft.Cdn", "Microsoft.Network", "Microsoft.Storage"}
+	if actual := determineResourceProvidersToRegister(available, required); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected the Resource Providers to register to be %v, got %v", expected, actual)
+	}
+}
@@ -0,0 +1,81 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK predates Public IP Address SKUs and Availability
+// Zones, so the ARM requests used to create and read Public IP Addresses are
+// described here for use with the Riviera client.
+
+const publicIPAddressAPIVersion = "2017-09-01"
+
+func publicIPAddressDefaultURLPath(resourceGroupName, name string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s", resourceGroupName, name)
+	}
+}
+
+type publicIPAddressSku struct {
+	Name *string `json:"name" mapstructure:"name"`
+}
+
+type publicIPAddressDNSSettings struct {
+	DomainNameLabel *string `json:"domainNameLabel,omitempty" mapstructure:"domainNameLabel"`
+	Fqdn            *string `json:"fqdn,omitempty" mapstructure:"fqdn"`
+	ReverseFqdn     *string `json:"reverseFqdn,omitempty" mapstructure:"reverseFqdn"`
+}
+
+type getPublicIPAddressResponse struct {
+	ID                       *string                     `mapstructure:"id"`
+	Name                     *string                     `mapstructure:"name"`
+	Location                 *string                     `mapstructure:"location"`
+	Tags                     *map[string]*string         `mapstructure:"tags"`
+	Sku                      *publicIPAddressSku         `mapstructure:"sku"`
+	Zones                    []string                    `mapstructure:"zones"`
+	PublicIPAllocationMethod *string                     `mapstructure:"publicIPAllocationMethod"`
+	IdleTimeoutInMinutes     *int32                      `mapstructure:"idleTimeoutInMinutes"`
+	DNSSettings              *publicIPAddressDNSSettings `mapstructure:"dnsSettings"`
+	IPAddress                *string                     `mapstructure:"ipAddress"`
+}
+
+type createOrUpdatePublicIPAddress struct {
+	Name                     string                      `json:"-"`
+	ResourceGroupName        string                      `json:"-"`
+	Location                 string                      `json:"-" riviera:"location"`
+	Tags                     map[string]*string          `json:"-" riviera:"tags"`
+	Sku                      *publicIPAddressSku         `json:"-" riviera:"sku"`
+	Zones                    []string                    `json:"-" riviera:"zones"`
+	PublicIPAllocationMethod *string                     `json:"publicIPAllocationMethod"`
+	IdleTimeoutInMinutes     *int32                      `json:"idleTimeoutInMinutes,omitempty"`
+	DNSSettings              *publicIPAddressDNSSettings `json:"dnsSettings,omitempty"`
+}
+
+func (command createOrUpdatePublicIPAddress) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  publicIPAddressAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: publicIPAddressDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getPublicIPAddressResponse{}
+		},
+	}
+}
+
+type getPublicIPAddress struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getPublicIPAddress) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  publicIPAddressAPIVersion,
+		Method:      "GET",
+		URLPathFunc: publicIPAddressDefaultURLPath(command.ResourceGroupName, command.Name),
+		ResponseTypeFunc: func() interface{} {
+			return &getPublicIPAddressResponse{}
+		},
+	}
+}
@@ -0,0 +1,178 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for Redis Cache, so the ARM requests
+// used by the Redis Cache resource are described here for use with the
+// Riviera client.
+
+const redisCacheAPIVersion = "2016-04-01"
+
+func redisCacheDefaultURLPath(resourceGroupName, name, suffix string) func() string {
+	return func() string {
+		return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.Cache/Redis/%s%s", resourceGroupName, name, suffix)
+	}
+}
+
+type redisCacheSku struct {
+	Name     *string `json:"name" mapstructure:"name"`
+	Family   *string `json:"family" mapstructure:"family"`
+	Capacity *int32  `json:"capacity" mapstructure:"capacity"`
+}
+
+type getRedisCacheResponse struct {
+	ID                 *string             `mapstructure:"id"`
+	Name               *string             `mapstructure:"name"`
+	Location           *string             `mapstructure:"location"`
+	Tags               *map[string]*string `mapstructure:"tags"`
+	ProvisioningState  *string             `mapstructure:"provisioningState"`
+	Sku                *redisCacheSku      `mapstructure:"sku"`
+	RedisConfiguration map[string]string   `mapstructure:"redisConfiguration"`
+	EnableNonSslPort   *bool               `mapstructure:"enableNonSslPort"`
+	ShardCount         *int32              `mapstructure:"shardCount"`
+	SubnetID           *string             `mapstructure:"subnetId"`
+	StaticIP           *string             `mapstructure:"staticIP"`
+	HostName           *string             `mapstructure:"hostName"`
+	Port               *int32              `mapstructure:"port"`
+	SslPort            *int32              `mapstructure:"sslPort"`
+}
+
+type createOrUpdateRedisCache struct {
+	Name               string             `json:"-"`
+	ResourceGroupName  string             `json:"-"`
+	Location           string             `json:"-" riviera:"location"`
+	Tags               map[string]*string `json:"-" riviera:"tags"`
+	Sku                *redisCacheSku     `json:"sku"`
+	RedisConfiguration map[string]string  `json:"redisConfiguration,omitempty"`
+	EnableNonSslPort   *bool              `json:"enableNonSslPort,omitempty"`
+	ShardCount         *int32             `json:"shardCount,omitempty"`
+	SubnetID           *string            `json:"subnetId,omitempty"`
+	StaticIP           *string            `json:"staticIP,omitempty"`
+}
+
+func (command createOrUpdateRedisCache) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  redisCacheAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: redisCacheDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getRedisCacheResponse{}
+		},
+	}
+}
+
+type getRedisCache struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getRedisCache) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  redisCacheAPIVersion,
+		Method:      "GET",
+		URLPathFunc: redisCacheDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return &getRedisCacheResponse{}
+		},
+	}
+}
+
+type deleteRedisCache struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteRedisCache) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  redisCacheAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: redisCacheDefaultURLPath(command.ResourceGroupName, command.Name, ""),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type listRedisCacheKeysResponse struct {
+	PrimaryKey   *string `mapstructure:"primaryKey"`
+	SecondaryKey *string `mapstructure:"secondaryKey"`
+}
+
+type listRedisCacheKeys struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command listRedisCacheKeys) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:      redisCacheAPIVersion,
+		Method:          "POST",
+		URLPathFunc:     redisCacheDefaultURLPath(command.ResourceGroupName, command.Name, "/listKeys"),
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &listRedisCacheKeysResponse{}
+		},
+	}
+}
+
+type redisCacheScheduleEntry struct {
+	DayOfWeek    *string `json:"dayOfWeek" mapstructure:"dayOfWeek"`
+	StartHourUtc *int32  `json:"startHourUtc" mapstructure:"startHourUtc"`
+}
+
+type getRedisCachePatchSchedulesResponse struct {
+	ScheduleEntries []redisCacheScheduleEntry `mapstructure:"scheduleEntries"`
+}
+
+type createOrUpdateRedisCachePatchSchedules struct {
+	Name              string                    `json:"-"`
+	ResourceGroupName string                    `json:"-"`
+	ScheduleEntries   []redisCacheScheduleEntry `json:"scheduleEntries"`
+}
+
+func (command createOrUpdateRedisCachePatchSchedules) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  redisCacheAPIVersion,
+		Method:      "PUT",
+		URLPathFunc: redisCacheDefaultURLPath(command.ResourceGroupName, command.Name, "/patchSchedules/default"),
+		ResponseTypeFunc: func() interface{} {
+			return &getRedisCachePatchSchedulesResponse{}
+		},
+	}
+}
+
+type getRedisCachePatchSchedules struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getRedisCachePatchSchedules) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  redisCacheAPIVersion,
+		Method:      "GET",
+		URLPathFunc: redisCacheDefaultURLPath(command.ResourceGroupName, command.Name, "/patchSchedules/default"),
+		ResponseTypeFunc: func() interface{} {
+			return &getRedisCachePatchSchedulesResponse{}
+		},
+	}
+}
+
+type deleteRedisCachePatchSchedules struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command deleteRedisCachePatchSchedules) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion:  redisCacheAPIVersion,
+		Method:      "DELETE",
+		URLPathFunc: redisCacheDefaultURLPath(command.ResourceGroupName, command.Name, "/patchSchedules/default"),
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
@@ -0,0 +1,538 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmAppService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceCreate,
+		Read:   resourceArmAppServiceRead,
+		Update: resourceArmAppServiceCreate,
+		Delete: resourceArmAppServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: appServiceSchema(),
+	}
+}
+
+// appServiceSchema returns the schema shared by App Services and their
+// deployment slots.
+func appServiceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"location": {
+			Type:      schema.TypeString,
+			Required:  true,
+			ForceNew:  true,
+			StateFunc: azureRMNormalizeLocation,
+		},
+
+		"resource_group_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"app_service_plan_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"client_affinity_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+
+		"site_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"always_on": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"default_documents": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+
+					"dotnet_framework_version": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "v4.0",
+						ValidateFunc: validation.StringInSlice([]string{"v2.0", "v4.0"}, false),
+					},
+
+					"java_version": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"1.7", "1.8"}, false),
+					},
+
+					"java_container": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"JETTY", "TOMCAT"}, false),
+					},
+
+					"java_container_version": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					"php_version": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"5.5", "5.6", "7.0"}, false),
+					},
+
+					"use_32_bit_worker_process": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					"websockets_enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"app_settings": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+
+		"connection_string": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					"value": {
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
+					},
+
+					"type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"MySql", "SQLServer", "SQLAzure", "Custom"}, false),
+					},
+				},
+			},
+		},
+
+		"default_site_hostname": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"tags": tagsSchema(),
+	}
+}
+
+func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	return createOrUpdateAppServiceSite(d, meta, d.Get("name").(string), "")
+}
+
+func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
+	return readAppServiceSite(d, meta)
+}
+
+func resourceArmAppServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteAppServiceSite(d, meta)
+}
+
+// createOrUpdateAppServiceSite creates or updates the App Service called name,
+// or its deployment slot when slot is not empty, followed by its site
+// configuration, app settings and connection strings.
+func createOrUpdateAppServiceSite(d *schema.ResourceData, meta interface{}, name, slot string) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM App Service creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateAppService{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Slot:              slot,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		ServerFarmID:      azure.String(d.Get("app_service_plan_id").(string)),
+		Enabled:           azure.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("client_affinity_enabled"); ok {
+		command.ClientAffinityEnabled = azure.Bool(v.(bool))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating App Service %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating App Service %q: %s", name, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getAppServiceResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read App Service %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	if _, ok := d.GetOk("site_config"); ok {
+		configRequest := rivieraClient.NewRequest()
+		configRequest.Command = &updateAppServiceSiteConfig{
+			Name:              name,
+			ResourceGroupName: resGroup,
+			Slot:              slot,
+			SiteConfig:        expandAppServiceSiteConfig(d),
+		}
+
+		configResponse, err := configRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Error updating site configuration of App Service %q: %s", name, err)
+		}
+		if !configResponse.IsSuccessful() {
+			return fmt.Errorf("Error updating site configuration of App Service %q: %s", name, configResponse.Error)
+		}
+	}
+
+	if d.HasChange("app_settings") {
+		settings := make(map[string]string)
+		for k, v := range d.Get("app_settings").(map[string]interface{}) {
+			settings[k] = v.(string)
+		}
+
+		settingsRequest := rivieraClient.NewRequest()
+		settingsRequest.Command = &updateAppServiceAppSettings{
+			Name:              name,
+			ResourceGroupName: resGroup,
+			Slot:              slot,
+			AppSettings:       settings,
+		}
+
+		settingsResponse, err := settingsRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Error updating app settings of App Service %q: %s", name, err)
+		}
+		if !settingsResponse.IsSuccessful() {
+			return fmt.Errorf("Error updating app settings of App Service %q: %s", name, settingsResponse.Error)
+		}
+	}
+
+	if d.HasChange("connection_string") {
+		connectionStrings := make(map[string]appServiceConnectionString)
+		for _, raw := range d.Get("connection_string").(*schema.Set).List() {
+			cs := raw.(map[string]interface{})
+			connectionStrings[cs["name"].(string)] = appServiceConnectionString{
+				Value: azure.String(cs["value"].(string)),
+				Type:  azure.String(cs["type"].(string)),
+			}
+		}
+
+		connectionStringsRequest := rivieraClient.NewRequest()
+		connectionStringsRequest.Command = &updateAppServiceConnectionStrings{
+			Name:              name,
+			ResourceGroupName: resGroup,
+			Slot:              slot,
+			ConnectionStrings: connectionStrings,
+		}
+
+		connectionStringsResponse, err := connectionStringsRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Error updating connection strings of App Service %q: %s", name, err)
+		}
+		if !connectionStringsResponse.IsSuccessful() {
+			return fmt.Errorf("Error updating connection strings of App Service %q: %s", name, connectionStringsResponse.Error)
+		}
+	}
+
+	return readAppServiceSite(d, meta)
+}
+
+// readAppServiceSite refreshes the state of an App Service, or of a deployment
+// slot when the ID refers to one.
+func readAppServiceSite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getAppService{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure App Service %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] App Service %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure App Service %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getAppServiceResponse)
+
+	if slot != "" {
+		d.Set("name", slot)
+		d.Set("app_service_name", name)
+	} else {
+		d.Set("name", name)
+	}
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("app_service_plan_id", resp.ServerFarmID)
+	d.Set("enabled", resp.Enabled)
+	d.Set("client_affinity_enabled", resp.ClientAffinityEnabled)
+	d.Set("default_site_hostname", resp.DefaultHostName)
+	d.Set("outbound_ip_addresses", resp.OutboundIPAddresses)
+
+	configRequest := rivieraClient.NewRequest()
+	configRequest.Command = &getAppServiceSiteConfig{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Slot:              slot,
+	}
+
+	configResponse, err := configRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading site configuration of App Service %q: %s", name, err)
+	}
+	if !configResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading site configuration of App Service %q: %s", name, configResponse.Error)
+	}
+
+	siteConfig := flattenAppServiceSiteConfig(configResponse.Parsed.(*appServiceSiteConfig))
+	if err := d.Set("site_config", siteConfig); err != nil {
+		return fmt.Errorf("Error flattening `site_config` for App Service %q: %s", name, err)
+	}
+
+	settingsRequest := rivieraClient.NewRequest()
+	settingsRequest.Command = &listAppServiceAppSettings{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Slot:              slot,
+	}
+
+	settingsResponse, err := settingsRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading app settings of App Service %q: %s", name, err)
+	}
+	if !settingsResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading app settings of App Service %q: %s", name, settingsResponse.Error)
+	}
+
+	if err := d.Set("app_settings", settingsResponse.Parsed.(*listAppServiceAppSettingsResponse).Properties); err != nil {
+		return fmt.Errorf("Error flattening `app_settings` for App Service %q: %s", name, err)
+	}
+
+	connectionStringsRequest := rivieraClient.NewRequest()
+	connectionStringsRequest.Command = &listAppServiceConnectionStrings{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Slot:              slot,
+	}
+
+	connectionStringsResponse, err := connectionStringsRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading connection strings of App Service %q: %s", name, err)
+	}
+	if !connectionStringsResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading connection strings of App Service %q: %s", name, connectionStringsResponse.Error)
+	}
+
+	connectionStrings := flattenAppServiceConnectionStrings(connectionStringsResponse.Parsed.(*listAppServiceConnectionStringsResponse).Properties)
+	if err := d.Set("connection_string", connectionStrings); err != nil {
+		return fmt.Errorf("Error flattening `connection_string` for App Service %q: %s", name, err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func deleteAppServiceSite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteAppService{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting App Service %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting App Service %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandAppServiceSiteConfig(d *schema.ResourceData) appServiceSiteConfig {
+	configs := d.Get("site_config").([]interface{})
+	siteConfig := appServiceSiteConfig{}
+	if len(configs) == 0 || configs[0] == nil {
+		return siteConfig
+	}
+
+	config := configs[0].(map[string]interface{})
+
+	siteConfig.AlwaysOn = azure.Bool(config["always_on"].(bool))
+
+	if v, ok := config["default_documents"]; ok {
+		documents := make([]string, 0)
+		for _, document := range v.([]interface{}) {
+			documents = append(documents, document.(string))
+		}
+		if len(documents) > 0 {
+			siteConfig.DefaultDocuments = &documents
+		}
+	}
+
+	if v, ok := config["dotnet_framework_version"]; ok && v.(string) != "" {
+		siteConfig.NetFrameworkVersion = azure.String(v.(string))
+	}
+
+	if v, ok := config["java_version"]; ok && v.(string) != "" {
+		siteConfig.JavaVersion = azure.String(v.(string))
+	}
+
+	if v, ok := config["java_container"]; ok && v.(string) != "" {
+		siteConfig.JavaContainer = azure.String(v.(string))
+	}
+
+	if v, ok := config["java_container_version"]; ok && v.(string) != "" {
+		siteConfig.JavaContainerVersion = azure.String(v.(string))
+	}
+
+	if v, ok := config["php_version"]; ok && v.(string) != "" {
+		siteConfig.PhpVersion = azure.String(v.(string))
+	}
+
+	if v, ok := config["use_32_bit_worker_process"]; ok {
+		siteConfig.Use32BitWorkerProcess = azure.Bool(v.(bool))
+	}
+
+	if v, ok := config["websockets_enabled"]; ok {
+		siteConfig.WebSocketsEnabled = azure.Bool(v.(bool))
+	}
+
+	return siteConfig
+}
+
+func flattenAppServiceSiteConfig(config *appServiceSiteConfig) []interface{} {
+	result := make(map[string]interface{})
+
+	if config.AlwaysOn != nil {
+		result["always_on"] = *config.AlwaysOn
+	}
+	if config.DefaultDocuments != nil {
+		result["default_documents"] = *config.DefaultDocuments
+	}
+	if config.NetFrameworkVersion != nil {
+		result["dotnet_framework_version"] = *config.NetFrameworkVersion
+	}
+	if config.JavaVersion != nil {
+		result["java_version"] = *config.JavaVersion
+	}
+	if config.JavaContainer != nil {
+		result["java_container"] = *config.JavaContainer
+	}
+	if config.JavaContainerVersion != nil {
+		result["java_container_version"] = *config.JavaContainerVersion
+	}
+	if config.PhpVersion != nil {
+		result["php_version"] = *config.PhpVersion
+	}
+	if config.Use32BitWorkerProcess != nil {
+		result["use_32_bit_worker_process"] = *config.Use32BitWorkerProcess
+	}
+	if config.WebSocketsEnabled != nil {
+		result["websockets_enabled"] = *config.WebSocketsEnabled
+	}
+
+	return []interface{}{result}
+}
+
+func flattenAppServiceConnectionStrings(connectionStrings map[string]appServiceConnectionString) []interface{} {
+	result := make([]interface{}, 0, len(connectionStrings))
+
+	for name, cs := range connectionStrings {
+		flattened := map[string]interface{}{
+			"name": name,
+		}
+		if cs.Value != nil {
+			flattened["value"] = *cs.Value
+		}
+		if cs.Type != nil {
+			flattened["type"] = *cs.Type
+		}
+		result = append(result, flattened)
+	}
+
+	return result
+}
@@ -0,0 +1,239 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmAppServicePlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServicePlanCreate,
+		Read:   resourceArmAppServicePlanRead,
+		Update: resourceArmAppServicePlanCreate,
+		Delete: resourceArmAppServicePlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"kind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Windows",
+				ValidateFunc: validation.StringInSlice([]string{"Windows", "Linux", "FunctionApp"}, false),
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Free", "Shared", "Basic", "Standard", "Premium", "Dynamic"}, false),
+						},
+
+						"size": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"maximum_number_of_workers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAppServicePlanCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM App Service Plan creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	skus := d.Get("sku").([]interface{})
+	sku := skus[0].(map[string]interface{})
+
+	command := &createOrUpdateAppServicePlan{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		Kind:              azure.String("app"),
+		Sku: &appServicePlanSku{
+			Name: azure.String(sku["size"].(string)),
+			Tier: azure.String(sku["tier"].(string)),
+		},
+	}
+
+	if v, ok := sku["capacity"]; ok && v.(int) > 0 {
+		command.Sku.Capacity = azure.Int32(int32(v.(int)))
+	}
+
+	// Linux plans are identified by their kind, and must also be reserved.
+	// Consumption plans for Function Apps use the Dynamic tier and are
+	// likewise identified by their kind.
+	switch d.Get("kind").(string) {
+	case "Linux":
+		command.Kind = azure.String("linux")
+		command.Reserved = azure.Bool(true)
+	case "FunctionApp":
+		command.Kind = azure.String("functionapp")
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating App Service Plan %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating App Service Plan %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getAppServicePlan{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading App Service Plan %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading App Service Plan %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getAppServicePlanResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read App Service Plan %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmAppServicePlanRead(d, meta)
+}
+
+func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getAppServicePlan{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure App Service Plan %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] App Service Plan %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure App Service Plan %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getAppServicePlanResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("maximum_number_of_workers", resp.MaximumNumberOfWorkers)
+
+	switch {
+	case resp.Kind != nil && strings.EqualFold(*resp.Kind, "linux"):
+		d.Set("kind", "Linux")
+	case resp.Kind != nil && strings.EqualFold(*resp.Kind, "functionapp"):
+		d.Set("kind", "FunctionApp")
+	default:
+		d.Set("kind", "Windows")
+	}
+
+	if sku := resp.Sku; sku != nil {
+		flattened := map[string]interface{}{
+			"tier": *sku.Tier,
+			"size": *sku.Name,
+		}
+		if sku.Capacity != nil {
+			flattened["capacity"] = int(*sku.Capacity)
+		}
+		if err := d.Set("sku", []interface{}{flattened}); err != nil {
+			return fmt.Errorf("Error flattening `sku` for App Service Plan %q: %s", *resp.Name, err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppServicePlanDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteAppServicePlan{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting App Service Plan %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting App Service Plan %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMAppServicePlanSkuTier_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Free",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Basic",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Standard",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Premium",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Dynamic",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmAppServicePlan().Schema["sku"].Elem.(*schema.Resource).Schema["tier"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "tier")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM App Service Plan SKU tier %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMAppServicePlan_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMAppServicePlan_basic, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServicePlanExists("azurerm_app_service_plan.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service_plan.test", "sku.0.tier", "Basic"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service_plan.test", "sku.0.size", "B1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServicePlan_standard(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMAppServicePlan_standard, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServicePlanExists("azurerm_app_service_plan.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service_plan.test", "sku.0.capacity", "2"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service_plan.test", "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServicePlanExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getAppServicePlan{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get App Service Plan: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get App Service Plan: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServicePlanDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_plan" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getAppServicePlan{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get App Service Plan: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: App Service Plan still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMAppServicePlan_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Basic"
+        size = "B1"
+    }
+}
+`
+
+var testAccAzureRMAppServicePlan_standard = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Standard"
+        size = "S1"
+        capacity = 2
+    }
+
+    tags {
+        environment = "Production"
+    }
+}
+`
@@ -0,0 +1,38 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmAppServiceSlot() *schema.Resource {
+	slotSchema := appServiceSchema()
+	slotSchema["app_service_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmAppServiceSlotCreate,
+		Read:   resourceArmAppServiceSlotRead,
+		Update: resourceArmAppServiceSlotCreate,
+		Delete: resourceArmAppServiceSlotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: slotSchema,
+	}
+}
+
+func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) error {
+	return createOrUpdateAppServiceSite(d, meta, d.Get("app_service_name").(string), d.Get("name").(string))
+}
+
+func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) error {
+	return readAppServiceSite(d, meta)
+}
+
+func resourceArmAppServiceSlotDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteAppServiceSite(d, meta)
+}
@@ -0,0 +1,67 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMAppServiceSlot_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMAppServiceSlot_basic, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists("azurerm_app_service_slot.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service_slot.test", "app_settings.SLOT", "staging"),
+				),
+			},
+		},
+	})
+}
+
+var testAccAzureRMAppServiceSlot_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Standard"
+        size = "S1"
+    }
+}
+
+resource "azurerm_app_service" "test" {
+    name = "acctestas-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_app_service_slot" "test" {
+    name = "acctestslot-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+    app_service_name = "${azurerm_app_service.test.name}"
+
+    app_settings {
+        "SLOT" = "staging"
+    }
+}
+`
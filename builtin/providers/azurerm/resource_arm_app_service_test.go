@@ -0,0 +1,243 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMAppServicePhpVersion_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "4.4",
+			ErrCount: 1,
+		},
+		{
+			Value:    "5.5",
+			ErrCount: 0,
+		},
+		{
+			Value:    "5.6",
+			ErrCount: 0,
+		},
+		{
+			Value:    "7.0",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := appServiceSchema()["site_config"].Elem.(*schema.Resource).Schema["php_version"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "php_version")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM App Service PHP version %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMAppServiceConnectionStringType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "MySql",
+			ErrCount: 0,
+		},
+		{
+			Value:    "SQLAzure",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Custom",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := appServiceSchema()["connection_string"].Elem.(*schema.Resource).Schema["type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM App Service connection string type %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMAppService_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMAppService_basic, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists("azurerm_app_service.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_complete(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMAppService_complete, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists("azurerm_app_service.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service.test", "site_config.0.always_on", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service.test", "site_config.0.php_version", "7.0"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service.test", "app_settings.SOME_KEY", "some-value"),
+					resource.TestCheckResourceAttr(
+						"azurerm_app_service.test", "connection_string.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getAppService{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get App Service: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get App Service: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service" && rs.Type != "azurerm_app_service_slot" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getAppService{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get App Service: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: App Service still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMAppService_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Standard"
+        size = "S1"
+    }
+}
+
+resource "azurerm_app_service" "test" {
+    name = "acctestas-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+`
+
+var testAccAzureRMAppService_complete = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Standard"
+        size = "S1"
+    }
+}
+
+resource "azurerm_app_service" "test" {
+    name = "acctestas-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+    site_config {
+        always_on = true
+        php_version = "7.0"
+        websockets_enabled = true
+    }
+
+    app_settings {
+        "SOME_KEY" = "some-value"
+    }
+
+    connection_string {
+        name = "Database"
+        type = "SQLServer"
+        value = "Server=some-server.mydomain.com;Integrated Security=SSPI"
+    }
+
+    tags {
+        environment = "Production"
+    }
+}
+`
@@ -0,0 +1,1263 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmApplicationGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayCreate,
+		Read:   resourceArmApplicationGatewayRead,
+		Update: resourceArmApplicationGatewayCreate,
+		Delete: resourceArmApplicationGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(network.StandardSmall), string(network.StandardMedium), string(network.StandardLarge)}, false),
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.Standard),
+						},
+
+						"capacity": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"gateway_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"frontend_port": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"frontend_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"private_ip_address_allocation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Static", "Dynamic"}, true),
+						},
+
+						"public_ip_address_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"backend_address_pool": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"ip_address_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"fqdn_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"backend_http_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(network.HTTP), string(network.HTTPS)}, false),
+						},
+
+						"cookie_based_affinity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(network.Disabled),
+							ValidateFunc: validation.StringInSlice([]string{string(network.Enabled), string(network.Disabled)}, false),
+						},
+
+						"request_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  30,
+						},
+
+						"probe_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"http_listener": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"frontend_ip_configuration_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"frontend_port_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(network.HTTP), string(network.HTTPS)}, false),
+						},
+
+						"host_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"ssl_certificate_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"require_sni": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"probe": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(network.HTTP), string(network.HTTPS)}, false),
+						},
+
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"interval": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  30,
+						},
+
+						"timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  30,
+						},
+
+						"unhealthy_threshold": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  3,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"request_routing_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"rule_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(network.Basic), string(network.PathBasedRouting)}, false),
+						},
+
+						"http_listener_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"backend_address_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"backend_http_settings_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"url_path_map_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"url_path_map": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"default_backend_address_pool_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"default_backend_http_settings_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"path_rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"paths": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"backend_address_pool_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"backend_http_settings_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"ssl_certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"data": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+
+						"public_cert_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"operational_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmApplicationGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	appGatewayClient := client.appGatewayClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Application Gateway creation.")
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	// Sub-resources of an Application Gateway reference one another by ID,
+	// so we build them here from the names given in the configuration.
+	gatewayID := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s",
+		client.subscriptionId, resGroup, name)
+
+	properties := network.ApplicationGatewayPropertiesFormat{
+		Sku:                           expandApplicationGatewaySku(d),
+		GatewayIPConfigurations:       expandApplicationGatewayIPConfigurations(d),
+		FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
+		FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d),
+		BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
+		BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, gatewayID),
+		HTTPListeners:                 expandApplicationGatewayHTTPListeners(d, gatewayID),
+		Probes:                        expandApplicationGatewayProbes(d),
+		RequestRoutingRules:           expandApplicationGatewayRequestRoutingRules(d, gatewayID),
+		URLPathMaps:                   expandApplicationGatewayURLPathMaps(d, gatewayID),
+		SslCertificates:               expandApplicationGatewaySslCertificates(d),
+	}
+
+	gateway := network.ApplicationGateway{
+		Name:       &name,
+		Location:   &location,
+		Tags:       expandTags(tags),
+		Properties: &properties,
+	}
+
+	_, err := appGatewayClient.CreateOrUpdate(resGroup, name, gateway, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Application Gateway %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
+	read, err := appGatewayClient.Get(resGroup, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Application Gateway %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApplicationGatewayRead(d, meta)
+}
+
+func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	appGatewayClient := meta.(*ArmClient).appGatewayClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["applicationGateways"]
+
+	resp, err := appGatewayClient.Get(resGroup, name)
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] AzureRM Application Gateway (%s) Not Found. Removing from State", name)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Application Gateway %s: %s", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("location", resp.Location)
+	d.Set("resource_group_name", resGroup)
+
+	props := resp.Properties
+	d.Set("operational_state", string(props.OperationalState))
+
+	if err := d.Set("sku", flattenApplicationGatewaySku(props.Sku)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting Application Gateway Sku error: %#v", err)
+	}
+
+	if props.GatewayIPConfigurations != nil {
+		if err := d.Set("gateway_ip_configuration", flattenApplicationGatewayIPConfigurations(props.GatewayIPConfigurations)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway IP Configurations error: %#v", err)
+		}
+	}
+
+	if props.FrontendPorts != nil {
+		if err := d.Set("frontend_port", flattenApplicationGatewayFrontendPorts(props.FrontendPorts)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway Frontend Ports error: %#v", err)
+		}
+	}
+
+	if props.FrontendIPConfigurations != nil {
+		if err := d.Set("frontend_ip_configuration", flattenApplicationGatewayFrontendIPConfigurations(props.FrontendIPConfigurations)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway Frontend IP Configurations error: %#v", err)
+		}
+	}
+
+	if props.BackendAddressPools != nil {
+		if err := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway Backend Address Pools error: %#v", err)
+		}
+	}
+
+	if props.BackendHTTPSettingsCollection != nil {
+		if err := d.Set("backend_http_settings", flattenApplicationGatewayBackendHTTPSettings(props.BackendHTTPSettingsCollection)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway Backend HTTP Settings error: %#v", err)
+		}
+	}
+
+	if props.HTTPListeners != nil {
+		if err := d.Set("http_listener", flattenApplicationGatewayHTTPListeners(props.HTTPListeners)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway HTTP Listeners error: %#v", err)
+		}
+	}
+
+	if props.Probes != nil {
+		if err := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway Probes error: %#v", err)
+		}
+	}
+
+	if props.RequestRoutingRules != nil {
+		if err := d.Set("request_routing_rule", flattenApplicationGatewayRequestRoutingRules(props.RequestRoutingRules)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway Request Routing Rules error: %#v", err)
+		}
+	}
+
+	if props.URLPathMaps != nil {
+		if err := d.Set("url_path_map", flattenApplicationGatewayURLPathMaps(props.URLPathMaps)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway URL Path Maps error: %#v", err)
+		}
+	}
+
+	if props.SslCertificates != nil {
+		if err := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(d, props.SslCertificates)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Application Gateway SSL Certificates error: %#v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	appGatewayClient := meta.(*ArmClient).appGatewayClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["applicationGateways"]
+
+	_, err = appGatewayClient.Delete(resGroup, name, make(chan struct{}))
+
+	return err
+}
+
+// applicationGatewaySubResource returns a reference to a named child of the
+// Application Gateway, e.g. "frontendPorts/port-80".
+func applicationGatewaySubResource(gatewayID, childType, name string) *network.SubResource {
+	id := fmt.Sprintf("%s/%s/%s", gatewayID, childType, name)
+	return &network.SubResource{
+		ID: &id,
+	}
+}
+
+// applicationGatewaySubResourceName returns the name of the child resource
+// referenced by the given SubResource, which is the final segment of its ID.
+func applicationGatewaySubResourceName(ref *network.SubResource) string {
+	if ref == nil || ref.ID == nil {
+		return ""
+	}
+
+	segments := strings.Split(*ref.ID, "/")
+	return segments[len(segments)-1]
+}
+
+func expandApplicationGatewaySku(d *schema.ResourceData) *network.ApplicationGatewaySku {
+	config := d.Get("sku").([]interface{})[0].(map[string]interface{})
+
+	capacity := int32(config["capacity"].(int))
+	return &network.ApplicationGatewaySku{
+		Name:     network.ApplicationGatewaySkuName(config["name"].(string)),
+		Tier:     network.ApplicationGatewayTier(config["tier"].(string)),
+		Capacity: &capacity,
+	}
+}
+
+func expandApplicationGatewayIPConfigurations(d *schema.ResourceData) *[]network.ApplicationGatewayIPConfiguration {
+	configs := d.Get("gateway_ip_configuration").([]interface{})
+	ipConfigs := make([]network.ApplicationGatewayIPConfiguration, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		name := data["name"].(string)
+		subnetID := data["subnet_id"].(string)
+
+		ipConfigs = append(ipConfigs, network.ApplicationGatewayIPConfiguration{
+			Name: &name,
+			Properties: &network.ApplicationGatewayIPConfigurationPropertiesFormat{
+				Subnet: &network.SubResource{
+					ID: &subnetID,
+				},
+			},
+		})
+	}
+
+	return &ipConfigs
+}
+
+func expandApplicationGatewayFrontendPorts(d *schema.ResourceData) *[]network.ApplicationGatewayFrontendPort {
+	configs := d.Get("frontend_port").([]interface{})
+	ports := make([]network.ApplicationGatewayFrontendPort, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		name := data["name"].(string)
+		port := int32(data["port"].(int))
+
+		ports = append(ports, network.ApplicationGatewayFrontendPort{
+			Name: &name,
+			Properties: &network.ApplicationGatewayFrontendPortPropertiesFormat{
+				Port: &port,
+			},
+		})
+	}
+
+	return &ports
+}
+
+func expandApplicationGatewayFrontendIPConfigurations(d *schema.ResourceData) *[]network.ApplicationGatewayFrontendIPConfiguration {
+	configs := d.Get("frontend_ip_configuration").([]interface{})
+	ipConfigs := make([]network.ApplicationGatewayFrontendIPConfiguration, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		properties := network.ApplicationGatewayFrontendIPConfigurationPropertiesFormat{}
+
+		if v := data["subnet_id"].(string); v != "" {
+			properties.Subnet = &network.SubResource{
+				ID: &v,
+			}
+		}
+
+		if v := data["private_ip_address_allocation"].(string); v != "" {
+			properties.PrivateIPAllocationMethod = network.IPAllocationMethod(v)
+		}
+
+		if v := data["private_ip_address"].(string); v != "" {
+			properties.PrivateIPAddress = &v
+		}
+
+		if v := data["public_ip_address_id"].(string); v != "" {
+			properties.PublicIPAddress = &network.SubResource{
+				ID: &v,
+			}
+		}
+
+		name := data["name"].(string)
+		ipConfigs = append(ipConfigs, network.ApplicationGatewayFrontendIPConfiguration{
+			Name:       &name,
+			Properties: &properties,
+		})
+	}
+
+	return &ipConfigs
+}
+
+func expandApplicationGatewayBackendAddressPools(d *schema.ResourceData) *[]network.ApplicationGatewayBackendAddressPool {
+	configs := d.Get("backend_address_pool").([]interface{})
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
+
+		for _, rawIP := range data["ip_address_list"].([]interface{}) {
+			ip := rawIP.(string)
+			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+				IPAddress: &ip,
+			})
+		}
+
+		for _, rawFqdn := range data["fqdn_list"].([]interface{}) {
+			fqdn := rawFqdn.(string)
+			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+				Fqdn: &fqdn,
+			})
+		}
+
+		name := data["name"].(string)
+		pools = append(pools, network.ApplicationGatewayBackendAddressPool{
+			Name: &name,
+			Properties: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+				BackendAddresses: &backendAddresses,
+			},
+		})
+	}
+
+	return &pools
+}
+
+func expandApplicationGatewayBackendHTTPSettings(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
+	configs := d.Get("backend_http_settings").([]interface{})
+	settings := make([]network.ApplicationGatewayBackendHTTPSettings, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		port := int32(data["port"].(int))
+		requestTimeout := int32(data["request_timeout"].(int))
+
+		properties := network.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+			Port:                &port,
+			Protocol:            network.ApplicationGatewayProtocol(data["protocol"].(string)),
+			CookieBasedAffinity: network.ApplicationGatewayCookieBasedAffinity(data["cookie_based_affinity"].(string)),
+			RequestTimeout:      &requestTimeout,
+		}
+
+		if v := data["probe_name"].(string); v != "" {
+			properties.Probe = applicationGatewaySubResource(gatewayID, "probes", v)
+		}
+
+		name := data["name"].(string)
+		settings = append(settings, network.ApplicationGatewayBackendHTTPSettings{
+			Name:       &name,
+			Properties: &properties,
+		})
+	}
+
+	return &settings
+}
+
+func expandApplicationGatewayHTTPListeners(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayHTTPListener {
+	configs := d.Get("http_listener").([]interface{})
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		requireSNI := data["require_sni"].(bool)
+		properties := network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration:     applicationGatewaySubResource(gatewayID, "frontendIPConfigurations", data["frontend_ip_configuration_name"].(string)),
+			FrontendPort:                applicationGatewaySubResource(gatewayID, "frontendPorts", data["frontend_port_name"].(string)),
+			Protocol:                    network.ApplicationGatewayProtocol(data["protocol"].(string)),
+			RequireServerNameIndication: &requireSNI,
+		}
+
+		if v := data["host_name"].(string); v != "" {
+			properties.HostName = &v
+		}
+
+		if v := data["ssl_certificate_name"].(string); v != "" {
+			properties.SslCertificate = applicationGatewaySubResource(gatewayID, "sslCertificates", v)
+		}
+
+		name := data["name"].(string)
+		listeners = append(listeners, network.ApplicationGatewayHTTPListener{
+			Name:       &name,
+			Properties: &properties,
+		})
+	}
+
+	return &listeners
+}
+
+func expandApplicationGatewayProbes(d *schema.ResourceData) *[]network.ApplicationGatewayProbe {
+	configs := d.Get("probe").([]interface{})
+	probes := make([]network.ApplicationGatewayProbe, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		name := data["name"].(string)
+		path := data["path"].(string)
+		host := data["host"].(string)
+		interval := int32(data["interval"].(int))
+		timeout := int32(data["timeout"].(int))
+		unhealthyThreshold := int32(data["unhealthy_threshold"].(int))
+
+		probes = append(probes, network.ApplicationGatewayProbe{
+			Name: &name,
+			Properties: &network.ApplicationGatewayProbePropertiesFormat{
+				Protocol:           network.ApplicationGatewayProtocol(data["protocol"].(string)),
+				Path:               &path,
+				Host:               &host,
+				Interval:           &interval,
+				Timeout:            &timeout,
+				UnhealthyThreshold: &unhealthyThreshold,
+			},
+		})
+	}
+
+	return &probes
+}
+
+func expandApplicationGatewayRequestRoutingRules(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayRequestRoutingRule {
+	configs := d.Get("request_routing_rule").([]interface{})
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		properties := network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType:     network.ApplicationGatewayRequestRoutingRuleType(data["rule_type"].(string)),
+			HTTPListener: applicationGatewaySubResource(gatewayID, "httpListeners", data["http_listener_name"].(string)),
+		}
+
+		if v := data["backend_address_pool_name"].(string); v != "" {
+			properties.BackendAddressPool = applicationGatewaySubResource(gatewayID, "backendAddressPools", v)
+		}
+
+		if v := data["backend_http_settings_name"].(string); v != "" {
+			properties.BackendHTTPSettings = applicationGatewaySubResource(gatewayID, "backendHttpSettingsCollection", v)
+		}
+
+		if v := data["url_path_map_name"].(string); v != "" {
+			properties.URLPathMap = applicationGatewaySubResource(gatewayID, "urlPathMaps", v)
+		}
+
+		name := data["name"].(string)
+		rules = append(rules, network.ApplicationGatewayRequestRoutingRule{
+			Name:       &name,
+			Properties: &properties,
+		})
+	}
+
+	return &rules
+}
+
+func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayURLPathMap {
+	configs := d.Get("url_path_map").([]interface{})
+	pathMaps := make([]network.ApplicationGatewayURLPathMap, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		pathRuleConfigs := data["path_rule"].([]interface{})
+		pathRules := make([]network.ApplicationGatewayPathRule, 0, len(pathRuleConfigs))
+		for _, ruleConfigRaw := range pathRuleConfigs {
+			ruleConfig := ruleConfigRaw.(map[string]interface{})
+
+			ruleName := ruleConfig["name"].(string)
+			paths := make([]string, 0)
+			for _, path := range ruleConfig["paths"].([]interface{}) {
+				paths = append(paths, path.(string))
+			}
+
+			pathRules = append(pathRules, network.ApplicationGatewayPathRule{
+				Name: &ruleName,
+				Properties: &network.ApplicationGatewayPathRulePropertiesFormat{
+					Paths:               &paths,
+					BackendAddressPool:  applicationGatewaySubResource(gatewayID, "backendAddressPools", ruleConfig["backend_address_pool_name"].(string)),
+					BackendHTTPSettings: applicationGatewaySubResource(gatewayID, "backendHttpSettingsCollection", ruleConfig["backend_http_settings_name"].(string)),
+				},
+			})
+		}
+
+		name := data["name"].(string)
+		pathMaps = append(pathMaps, network.ApplicationGatewayURLPathMap{
+			Name: &name,
+			Properties: &network.ApplicationGatewayURLPathMapPropertiesFormat{
+				DefaultBackendAddressPool:  applicationGatewaySubResource(gatewayID, "backendAddressPools", data["default_backend_address_pool_name"].(string)),
+				DefaultBackendHTTPSettings: applicationGatewaySubResource(gatewayID, "backendHttpSettingsCollection", data["default_backend_http_settings_name"].(string)),
+				PathRules:                  &pathRules,
+			},
+		})
+	}
+
+	return &pathMaps
+}
+
+func expandApplicationGatewaySslCertificates(d *schema.ResourceData) *[]network.ApplicationGatewaySslCertificate {
+	configs := d.Get("ssl_certificate").([]interface{})
+	certs := make([]network.ApplicationGatewaySslCertificate, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		name := data["name"].(string)
+		certData := data["data"].(string)
+		password := data["password"].(string)
+
+		certs = append(certs, network.ApplicationGatewaySslCertificate{
+			Name: &name,
+			Properties: &network.ApplicationGatewaySslCertificatePropertiesFormat{
+				Data:     &certData,
+				Password: &password,
+			},
+		})
+	}
+
+	return &certs
+}
+
+func flattenApplicationGatewaySku(sku *network.ApplicationGatewaySku) []interface{} {
+	result := make(map[string]interface{})
+	result["name"] = string(sku.Name)
+	result["tier"] = string(sku.Tier)
+	result["capacity"] = int(*sku.Capacity)
+
+	return []interface{}{result}
+}
+
+func flattenApplicationGatewayIPConfigurations(ipConfigs *[]network.ApplicationGatewayIPConfiguration) []interface{} {
+	result := make([]interface{}, 0, len(*ipConfigs))
+
+	for _, config := range *ipConfigs {
+		c := map[string]interface{}{
+			"id":        *config.ID,
+			"name":      *config.Name,
+			"subnet_id": *config.Properties.Subnet.ID,
+		}
+		result = append(result, c)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewayFrontendPorts(ports *[]network.ApplicationGatewayFrontendPort) []interface{} {
+	result := make([]interface{}, 0, len(*ports))
+
+	for _, port := range *ports {
+		p := map[string]interface{}{
+			"id":   *port.ID,
+			"name": *port.Name,
+			"port": int(*port.Properties.Port),
+		}
+		result = append(result, p)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewayFrontendIPConfigurations(ipConfigs *[]network.ApplicationGatewayFrontendIPConfiguration) []interface{} {
+	result := make([]interface{}, 0, len(*ipConfigs))
+
+	for _, config := range *ipConfigs {
+		c := map[string]interface{}{
+			"id":                            *config.ID,
+			"name":                          *config.Name,
+			"private_ip_address_allocation": string(config.Properties.PrivateIPAllocationMethod),
+		}
+
+		if config.Properties.Subnet != nil {
+			c["subnet_id"] = *config.Properties.Subnet.ID
+		}
+
+		if config.Properties.PrivateIPAddress != nil {
+			c["private_ip_address"] = *config.Properties.PrivateIPAddress
+		}
+
+		if config.Properties.PublicIPAddress != nil {
+			c["public_ip_address_id"] = *config.Properties.PublicIPAddress.ID
+		}
+
+		result = append(result, c)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewayBackendAddressPools(pools *[]network.ApplicationGatewayBackendAddressPool) []interface{} {
+	result := make([]interface{}, 0, len(*pools))
+
+	for _, pool := range *pools {
+		ipAddressList := make([]interface{}, 0)
+		fqdnList := make([]interface{}, 0)
+
+		if pool.Properties.BackendAddresses != nil {
+			for _, address := range *pool.Properties.BackendAddresses {
+				if address.IPAddress != nil {
+					ipAddressList = append(ipAddressList, *address.IPAddress)
+				}
+				if address.Fqdn != nil {
+					fqdnList = append(fqdnList, *address.Fqdn)
+				}
+			}
+		}
+
+		p := map[string]interface{}{
+			"id":              *pool.ID,
+			"name":            *pool.Name,
+			"ip_address_list": ipAddressList,
+			"fqdn_list":       fqdnList,
+		}
+		result = append(result, p)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewayBackendHTTPSettings(settings *[]network.ApplicationGatewayBackendHTTPSettings) []interface{} {
+	result := make([]interface{}, 0, len(*settings))
+
+	for _, setting := range *settings {
+		s := map[string]interface{}{
+			"id":                    *setting.ID,
+			"name":                  *setting.Name,
+			"port":                  int(*setting.Properties.Port),
+			"protocol":              string(setting.Properties.Protocol),
+			"cookie_based_affinity": string(setting.Properties.CookieBasedAffinity),
+		}
+
+		if setting.Properties.RequestTimeout != nil {
+			s["request_timeout"] = int(*setting.Properties.RequestTimeout)
+		}
+
+		if setting.Properties.Probe != nil {
+			s["probe_name"] = applicationGatewaySubResourceName(setting.Properties.Probe)
+		}
+
+		result = append(result, s)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewayHTTPListeners(listeners *[]network.ApplicationGatewayHTTPListener) []interface{} {
+	result := make([]interface{}, 0, len(*listeners))
+
+	for _, listener := range *listeners {
+		l := map[string]interface{}{
+			"id":                             *listener.ID,
+			"name":                           *listener.Name,
+			"frontend_ip_configuration_name": applicationGatewaySubResourceName(listener.Properties.FrontendIPConfiguration),
+			"frontend_port_name":             applicationGatewaySubResourceName(listener.Properties.FrontendPort),
+			"protocol":                       string(listener.Properties.Protocol),
+		}
+
+		if listener.Properties.HostName != nil {
+			l["host_name"] = *listener.Properties.HostName
+		}
+
+		if listener.Properties.SslCertificate != nil {
+			l["ssl_certificate_name"] = applicationGatewaySubResourceName(listener.Properties.SslCertificate)
+		}
+
+		if listener.Properties.RequireServerNameIndication != nil {
+			l["require_sni"] = *listener.Properties.RequireServerNameIndication
+		}
+
+		result = append(result, l)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewayProbes(probes *[]network.ApplicationGatewayProbe) []interface{} {
+	result := make([]interface{}, 0, len(*probes))
+
+	for _, probe := range *probes {
+		p := map[string]interface{}{
+			"id":                  *probe.ID,
+			"name":                *probe.Name,
+			"protocol":            string(probe.Properties.Protocol),
+			"path":                *probe.Properties.Path,
+			"host":                *probe.Properties.Host,
+			"interval":            int(*probe.Properties.Interval),
+			"timeout":             int(*probe.Properties.Timeout),
+			"unhealthy_threshold": int(*probe.Properties.UnhealthyThreshold),
+		}
+		result = append(result, p)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewayRequestRoutingRules(rules *[]network.ApplicationGatewayRequestRoutingRule) []interface{} {
+	result := make([]interface{}, 0, len(*rules))
+
+	for _, rule := range *rules {
+		r := map[string]interface{}{
+			"id":                 *rule.ID,
+			"name":               *rule.Name,
+			"rule_type":          string(rule.Properties.RuleType),
+			"http_listener_name": applicationGatewaySubResourceName(rule.Properties.HTTPListener),
+		}
+
+		if rule.Properties.BackendAddressPool != nil {
+			r["backend_address_pool_name"] = applicationGatewaySubResourceName(rule.Properties.BackendAddressPool)
+		}
+
+		if rule.Properties.BackendHTTPSettings != nil {
+			r["backend_http_settings_name"] = applicationGatewaySubResourceName(rule.Properties.BackendHTTPSettings)
+		}
+
+		if rule.Properties.URLPathMap != nil {
+			r["url_path_map_name"] = applicationGatewaySubResourceName(rule.Properties.URLPathMap)
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewayURLPathMaps(pathMaps *[]network.ApplicationGatewayURLPathMap) []interface{} {
+	result := make([]interface{}, 0, len(*pathMaps))
+
+	for _, pathMap := range *pathMaps {
+		pathRules := make([]interface{}, 0)
+		if pathMap.Properties.PathRules != nil {
+			for _, rule := range *pathMap.Properties.PathRules {
+				paths := make([]interface{}, 0)
+				if rule.Properties.Paths != nil {
+					for _, path := range *rule.Properties.Paths {
+						paths = append(paths, path)
+					}
+				}
+
+				pathRules = append(pathRules, map[string]interface{}{
+					"id":                         *rule.ID,
+					"name":                       *rule.Name,
+					"paths":                      paths,
+					"backend_address_pool_name":  applicationGatewaySubResourceName(rule.Properties.BackendAddressPool),
+					"backend_http_settings_name": applicationGatewaySubResourceName(rule.Properties.BackendHTTPSettings),
+				})
+			}
+		}
+
+		m := map[string]interface{}{
+			"id":                                 *pathMap.ID,
+			"name":                               *pathMap.Name,
+			"default_backend_address_pool_name":  applicationGatewaySubResourceName(pathMap.Properties.DefaultBackendAddressPool),
+			"default_backend_http_settings_name": applicationGatewaySubResourceName(pathMap.Properties.DefaultBackendHTTPSettings),
+			"path_rule":                          pathRules,
+		}
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func flattenApplicationGatewaySslCertificates(d *schema.ResourceData, certs *[]network.ApplicationGatewaySslCertificate) []interface{} {
+	result := make([]interface{}, 0, len(*certs))
+
+	// The API never returns the certificate data or password, so we retain
+	// the values from the configuration for the certificates we know about.
+	existing := make(map[string]map[string]interface{})
+	for _, configRaw := range d.Get("ssl_certificate").([]interface{}) {
+		config := configRaw.(map[string]interface{})
+		existing[config["name"].(string)] = config
+	}
+
+	for _, cert := range *certs {
+		c := map[string]interface{}{
+			"id":   *cert.ID,
+			"name": *cert.Name,
+		}
+
+		if cert.Properties.PublicCertData != nil {
+			c["public_cert_data"] = *cert.Properties.PublicCertData
+		}
+
+		if config, ok := existing[*cert.Name]; ok {
+			c["data"] = config["data"]
+			c["password"] = config["password"]
+		}
+
+		result = append(result, c)
+	}
+
+	return result
+}
@@ -0,0 +1,351 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMApplicationGatewaySkuName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Standard_Small",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Standard_Medium",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Standard_Large",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmApplicationGateway().Schema["sku"].Elem.(*schema.Resource).Schema["name"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Application Gateway SKU name %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMApplicationGatewayRoutingRuleType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Basic",
+			ErrCount: 0,
+		},
+		{
+			Value:    "PathBasedRouting",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmApplicationGateway().Schema["request_routing_rule"].Elem.(*schema.Resource).Schema["rule_type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "rule_type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Application Gateway rule type %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMApplicationGateway_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMApplicationGateway_basic, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists("azurerm_application_gateway.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_application_gateway.test", "sku.0.name", "Standard_Small"),
+					resource.TestCheckResourceAttr(
+						"azurerm_application_gateway.test", "request_routing_rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGateway_pathBasedRouting(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMApplicationGateway_pathBasedRouting, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists("azurerm_application_gateway.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_application_gateway.test", "url_path_map.0.path_rule.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_application_gateway.test", "probe.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationGatewayExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		gatewayName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for application gateway: %s", gatewayName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).appGatewayClient
+
+		resp, err := conn.Get(resourceGroup, gatewayName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on appGatewayClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Application Gateway %q (resource group: %q) does not exist", gatewayName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApplicationGatewayDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).appGatewayClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_application_gateway" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Application Gateway still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMApplicationGateway_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctestvn-%d"
+    address_space = ["10.0.0.0/16"]
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+    name = "acctestsub-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.1.0/24"
+}
+
+resource "azurerm_application_gateway" "test" {
+    name = "acctestag-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        name = "Standard_Small"
+        capacity = 1
+    }
+
+    gateway_ip_configuration {
+        name = "gateway-ip-config"
+        subnet_id = "${azurerm_subnet.test.id}"
+    }
+
+    frontend_port {
+        name = "http"
+        port = 80
+    }
+
+    frontend_ip_configuration {
+        name = "frontend"
+        subnet_id = "${azurerm_subnet.test.id}"
+        private_ip_address_allocation = "Dynamic"
+    }
+
+    backend_address_pool {
+        name = "backend"
+        ip_address_list = ["10.0.1.10", "10.0.1.11"]
+    }
+
+    backend_http_settings {
+        name = "http"
+        port = 80
+        protocol = "Http"
+    }
+
+    http_listener {
+        name = "http"
+        frontend_ip_configuration_name = "frontend"
+        frontend_port_name = "http"
+        protocol = "Http"
+    }
+
+    request_routing_rule {
+        name = "http"
+        rule_type = "Basic"
+        http_listener_name = "http"
+        backend_address_pool_name = "backend"
+        backend_http_settings_name = "http"
+    }
+
+    tags {
+        environment = "Production"
+    }
+}
+`
+
+var testAccAzureRMApplicationGateway_pathBasedRouting = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctestvn-%d"
+    address_space = ["10.0.0.0/16"]
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+    name = "acctestsub-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.1.0/24"
+}
+
+resource "azurerm_application_gateway" "test" {
+    name = "acctestag-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        name = "Standard_Small"
+        capacity = 1
+    }
+
+    gateway_ip_configuration {
+        name = "gateway-ip-config"
+        subnet_id = "${azurerm_subnet.test.id}"
+    }
+
+    frontend_port {
+        name = "http"
+        port = 80
+    }
+
+    frontend_ip_configuration {
+        name = "frontend"
+        subnet_id = "${azurerm_subnet.test.id}"
+        private_ip_address_allocation = "Dynamic"
+    }
+
+    backend_address_pool {
+        name = "web"
+        ip_address_list = ["10.0.1.10"]
+    }
+
+    backend_address_pool {
+        name = "images"
+        ip_address_list = ["10.0.1.20"]
+    }
+
+    backend_http_settings {
+        name = "http"
+        port = 80
+        protocol = "Http"
+        probe_name = "health"
+    }
+
+    probe {
+        name = "health"
+        protocol = "Http"
+        path = "/health"
+        host = "127.0.0.1"
+    }
+
+    http_listener {
+        name = "http"
+        frontend_ip_configuration_name = "frontend"
+        frontend_port_name = "http"
+        protocol = "Http"
+    }
+
+    url_path_map {
+        name = "paths"
+        default_backend_address_pool_name = "web"
+        default_backend_http_settings_name = "http"
+
+        path_rule {
+            name = "images"
+            paths = ["/images/*"]
+            backend_address_pool_name = "images"
+            backend_http_settings_name = "http"
+        }
+    }
+
+    request_routing_rule {
+        name = "paths"
+        rule_type = "PathBasedRouting"
+        http_listener_name = "http"
+        url_path_map_name = "paths"
+    }
+}
+`
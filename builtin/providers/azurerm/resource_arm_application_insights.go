@@ -0,0 +1,182 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmApplicationInsights() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsCreateOrUpdate,
+		Read:   resourceArmApplicationInsightsRead,
+		Update: resourceArmApplicationInsightsCreateOrUpdate,
+		Delete: resourceArmApplicationInsightsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"application_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Web", "Other"}, true),
+				StateFunc: func(val interface{}) string {
+					return strings.ToLower(val.(string))
+				},
+			},
+
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"instrumentation_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Application Insights creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	applicationType := strings.ToLower(d.Get("application_type").(string))
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateApplicationInsights{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		Kind:              azure.String(applicationType),
+		ApplicationType:   azure.String(applicationType),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Application Insights %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Application Insights %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getApplicationInsights{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Application Insights %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Application Insights %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getApplicationInsightsResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Application Insights %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmApplicationInsightsRead(d, meta)
+}
+
+func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getApplicationInsights{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Application Insights %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Application Insights %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Application Insights %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getApplicationInsightsResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("app_id", resp.AppID)
+	d.Set("instrumentation_key", resp.InstrumentationKey)
+
+	if resp.ApplicationType != nil {
+		d.Set("application_type", strings.ToLower(*resp.ApplicationType))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmApplicationInsightsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteApplicationInsights{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Application Insights %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Application Insights %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
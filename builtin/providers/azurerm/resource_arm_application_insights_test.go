@@ -0,0 +1,135 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMApplicationInsightsApplicationType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "web", ErrCount: 0},
+		{Value: "Web", ErrCount: 0},
+		{Value: "other", ErrCount: 0},
+		{Value: "Other", ErrCount: 0},
+		{Value: "Random", ErrCount: 1},
+	}
+
+	validateFunc := resourceArmApplicationInsights().Schema["application_type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "application_type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Application Insights Application Type %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAccAzureRMApplicationInsights_basicWeb(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMApplicationInsights_basic, ri, ri, "web")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsExists("azurerm_application_insights.test"),
+					resource.TestCheckResourceAttr("azurerm_application_insights.test", "application_type", "web"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsights_basicOther(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMApplicationInsights_basic, ri, ri, "Other")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsExists("azurerm_application_insights.test"),
+					resource.TestCheckResourceAttr("azurerm_application_insights.test", "application_type", "other"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationInsightsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getApplicationInsights{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Application Insights: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Application Insights: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApplicationInsightsDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_application_insights" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getApplicationInsights{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Application Insights: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Application Insights still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMApplicationInsights_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_application_insights" "test" {
+    name = "acctestappinsights-%d"
+    location = "West Europe"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    application_type = "%s"
+}
+`
@@ -0,0 +1,729 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmAutoscaleSetting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutoscaleSettingCreate,
+		Read:   resourceArmAutoscaleSettingRead,
+		Update: resourceArmAutoscaleSettingCreate,
+		Delete: resourceArmAutoscaleSettingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"profile": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"capacity": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 1000),
+									},
+
+									"maximum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 1000),
+									},
+
+									"default": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 1000),
+									},
+								},
+							},
+						},
+
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_trigger": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"metric_resource_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"time_grain": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"statistic": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"Average", "Min", "Max", "Sum"}, false),
+												},
+
+												"time_window": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"time_aggregation": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"Average", "Minimum", "Maximum", "Total", "Count", "Last"}, false),
+												},
+
+												"operator": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"Equals", "NotEquals", "GreaterThan", "GreaterThanOrEqual", "LessThan", "LessThanOrEqual"}, false),
+												},
+
+												"threshold": {
+													Type:     schema.TypeFloat,
+													Required: true,
+												},
+											},
+										},
+									},
+
+									"scale_action": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"direction": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"Increase", "Decrease"}, false),
+												},
+
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"ChangeCount", "PercentChangeCount", "ExactCount"}, false),
+												},
+
+												"value": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+
+												"cooldown": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"fixed_date": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timezone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "UTC",
+									},
+
+									"start": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"end": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"recurrence": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timezone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "UTC",
+									},
+
+									"days": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}, false),
+										},
+									},
+
+									"hours": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeInt,
+										},
+									},
+
+									"minutes": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeInt,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"send_to_subscription_administrator": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"send_to_subscription_co_administrator": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"custom_emails": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"webhook": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAutoscaleSettingCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Autoscale Setting creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	profiles, err := expandArmAutoscaleSettingProfiles(d)
+	if err != nil {
+		return err
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateAutoscaleSetting{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		Enabled:           azure.Bool(d.Get("enabled").(bool)),
+		TargetResourceURI: azure.String(d.Get("target_resource_id").(string)),
+		Profiles:          profiles,
+		Notifications:     expandArmAutoscaleSettingNotifications(d),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Autoscale Setting %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Autoscale Setting %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getAutoscaleSetting{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Autoscale Setting %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Autoscale Setting %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getAutoscaleSettingResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Autoscale Setting %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmAutoscaleSettingRead(d, meta)
+}
+
+func resourceArmAutoscaleSettingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getAutoscaleSetting{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Autoscale Setting %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Autoscale Setting %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Autoscale Setting %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getAutoscaleSettingResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("enabled", resp.Enabled)
+	d.Set("target_resource_id", resp.TargetResourceURI)
+
+	profiles, err := flattenArmAutoscaleSettingProfiles(resp.Profiles)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("profile", profiles); err != nil {
+		return fmt.Errorf("Error flattening `profile` for Autoscale Setting %q: %s", *resp.Name, err)
+	}
+
+	if err := d.Set("notification", flattenArmAutoscaleSettingNotifications(resp.Notifications)); err != nil {
+		return fmt.Errorf("Error flattening `notification` for Autoscale Setting %q: %s", *resp.Name, err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAutoscaleSettingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteAutoscaleSetting{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Autoscale Setting %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Autoscale Setting %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmAutoscaleSettingProfiles(d *schema.ResourceData) ([]autoscaleSettingProfile, error) {
+	configs := d.Get("profile").([]interface{})
+	profiles := make([]autoscaleSettingProfile, 0, len(configs))
+
+	for _, raw := range configs {
+		config := raw.(map[string]interface{})
+
+		capacity := config["capacity"].([]interface{})[0].(map[string]interface{})
+		minimum := capacity["minimum"].(int)
+		maximum := capacity["maximum"].(int)
+		defaultCapacity := capacity["default"].(int)
+		if minimum > maximum || defaultCapacity < minimum || defaultCapacity > maximum {
+			return nil, fmt.Errorf("[ERROR] the default capacity of profile %q must be between its minimum and maximum capacity", config["name"].(string))
+		}
+
+		profile := autoscaleSettingProfile{
+			Name: azure.String(config["name"].(string)),
+			Capacity: &autoscaleSettingCapacity{
+				Minimum: azure.String(strconv.Itoa(minimum)),
+				Maximum: azure.String(strconv.Itoa(maximum)),
+				Default: azure.String(strconv.Itoa(defaultCapacity)),
+			},
+			Rules: expandArmAutoscaleSettingRules(config["rule"].([]interface{})),
+		}
+
+		if fixedDates := config["fixed_date"].([]interface{}); len(fixedDates) > 0 {
+			fixedDate := fixedDates[0].(map[string]interface{})
+			profile.FixedDate = &autoscaleSettingFixedDate{
+				TimeZone: azure.String(fixedDate["timezone"].(string)),
+				Start:    azure.String(fixedDate["start"].(string)),
+				End:      azure.String(fixedDate["end"].(string)),
+			}
+		}
+
+		if recurrences := config["recurrence"].([]interface{}); len(recurrences) > 0 {
+			if profile.FixedDate != nil {
+				return nil, fmt.Errorf("[ERROR] profile %q can only have one of fixed_date or recurrence", config["name"].(string))
+			}
+
+			recurrence := recurrences[0].(map[string]interface{})
+
+			days := make([]string, 0)
+			for _, day := range recurrence["days"].([]interface{}) {
+				days = append(days, day.(string))
+			}
+			hours := make([]int, 0)
+			for _, hour := range recurrence["hours"].([]interface{}) {
+				hours = append(hours, hour.(int))
+			}
+			minutes := make([]int, 0)
+			for _, minute := range recurrence["minutes"].([]interface{}) {
+				minutes = append(minutes, minute.(int))
+			}
+
+			profile.Recurrence = &autoscaleSettingRecurrence{
+				Frequency: azure.String("Week"),
+				Schedule: &autoscaleSettingRecurrentSchedule{
+					TimeZone: azure.String(recurrence["timezone"].(string)),
+					Days:     days,
+					Hours:    hours,
+					Minutes:  minutes,
+				},
+			}
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+func expandArmAutoscaleSettingRules(configs []interface{}) []autoscaleSettingRule {
+	rules := make([]autoscaleSettingRule, 0, len(configs))
+
+	for _, raw := range configs {
+		config := raw.(map[string]interface{})
+		trigger := config["metric_trigger"].([]interface{})[0].(map[string]interface{})
+		action := config["scale_action"].([]interface{})[0].(map[string]interface{})
+		threshold := trigger["threshold"].(float64)
+
+		rules = append(rules, autoscaleSettingRule{
+			MetricTrigger: &autoscaleSettingMetricTrigger{
+				MetricName:        azure.String(trigger["metric_name"].(string)),
+				MetricResourceURI: azure.String(trigger["metric_resource_id"].(string)),
+				TimeGrain:         azure.String(trigger["time_grain"].(string)),
+				Statistic:         azure.String(trigger["statistic"].(string)),
+				TimeWindow:        azure.String(trigger["time_window"].(string)),
+				TimeAggregation:   azure.String(trigger["time_aggregation"].(string)),
+				Operator:          azure.String(trigger["operator"].(string)),
+				Threshold:         &threshold,
+			},
+			ScaleAction: &autoscaleSettingScaleAction{
+				Direction: azure.String(action["direction"].(string)),
+				Type:      azure.String(action["type"].(string)),
+				Value:     azure.String(strconv.Itoa(action["value"].(int))),
+				Cooldown:  azure.String(action["cooldown"].(string)),
+			},
+		})
+	}
+
+	return rules
+}
+
+func expandArmAutoscaleSettingNotifications(d *schema.ResourceData) []autoscaleSettingNotification {
+	notifications := make([]autoscaleSettingNotification, 0)
+
+	configs := d.Get("notification").([]interface{})
+	if len(configs) == 0 {
+		return notifications
+	}
+	config := configs[0].(map[string]interface{})
+
+	notification := autoscaleSettingNotification{
+		Operation: azure.String("Scale"),
+		Webhooks:  make([]autoscaleSettingWebhookNotification, 0),
+	}
+
+	if emails := config["email"].([]interface{}); len(emails) > 0 {
+		email := emails[0].(map[string]interface{})
+
+		customEmails := make([]string, 0)
+		for _, v := range email["custom_emails"].([]interface{}) {
+			customEmails = append(customEmails, v.(string))
+		}
+
+		notification.Email = &autoscaleSettingEmailNotification{
+			SendToSubscriptionAdministrator:    azure.Bool(email["send_to_subscription_administrator"].(bool)),
+			SendToSubscriptionCoAdministrators: azure.Bool(email["send_to_subscription_co_administrator"].(bool)),
+			CustomEmails:                       customEmails,
+		}
+	}
+
+	for _, raw := range config["webhook"].([]interface{}) {
+		webhook := raw.(map[string]interface{})
+
+		properties := make(map[string]*string)
+		for k, v := range webhook["properties"].(map[string]interface{}) {
+			properties[k] = azure.String(v.(string))
+		}
+
+		notification.Webhooks = append(notification.Webhooks, autoscaleSettingWebhookNotification{
+			ServiceURI: azure.String(webhook["service_uri"].(string)),
+			Properties: properties,
+		})
+	}
+
+	return append(notifications, notification)
+}
+
+func flattenArmAutoscaleSettingProfiles(profiles []autoscaleSettingProfile) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(profiles))
+
+	for _, profile := range profiles {
+		flattened := map[string]interface{}{
+			"name": *profile.Name,
+		}
+
+		if capacity := profile.Capacity; capacity != nil {
+			flattenedCapacity := make(map[string]interface{})
+			for key, value := range map[string]*string{
+				"minimum": capacity.Minimum,
+				"maximum": capacity.Maximum,
+				"default": capacity.Default,
+			} {
+				if value == nil {
+					continue
+				}
+				count, err := strconv.Atoi(*value)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing %s capacity %q of profile %q: %s", key, *value, *profile.Name, err)
+				}
+				flattenedCapacity[key] = count
+			}
+			flattened["capacity"] = []interface{}{flattenedCapacity}
+		}
+
+		rules := make([]interface{}, 0, len(profile.Rules))
+		for _, rule := range profile.Rules {
+			flattenedRule := make(map[string]interface{})
+
+			if trigger := rule.MetricTrigger; trigger != nil {
+				flattenedTrigger := map[string]interface{}{
+					"metric_name":        *trigger.MetricName,
+					"metric_resource_id": *trigger.MetricResourceURI,
+					"time_grain":         *trigger.TimeGrain,
+					"statistic":          *trigger.Statistic,
+					"time_window":        *trigger.TimeWindow,
+					"time_aggregation":   *trigger.TimeAggregation,
+					"operator":           *trigger.Operator,
+				}
+				if trigger.Threshold != nil {
+					flattenedTrigger["threshold"] = *trigger.Threshold
+				}
+				flattenedRule["metric_trigger"] = []interface{}{flattenedTrigger}
+			}
+
+			if action := rule.ScaleAction; action != nil {
+				flattenedAction := map[string]interface{}{
+					"direction": *action.Direction,
+					"type":      *action.Type,
+					"cooldown":  *action.Cooldown,
+				}
+				if action.Value != nil {
+					value, err := strconv.Atoi(*action.Value)
+					if err != nil {
+						return nil, fmt.Errorf("Error parsing scale action value %q of profile %q: %s", *action.Value, *profile.Name, err)
+					}
+					flattenedAction["value"] = value
+				}
+				flattenedRule["scale_action"] = []interface{}{flattenedAction}
+			}
+
+			rules = append(rules, flattenedRule)
+		}
+		flattened["rule"] = rules
+
+		if fixedDate := profile.FixedDate; fixedDate != nil {
+			flattenedFixedDate := map[string]interface{}{
+				"start": *fixedDate.Start,
+				"end":   *fixedDate.End,
+			}
+			if fixedDate.TimeZone != nil {
+				flattenedFixedDate["timezone"] = *fixedDate.TimeZone
+			}
+			flattened["fixed_date"] = []interface{}{flattenedFixedDate}
+		}
+
+		if recurrence := profile.Recurrence; recurrence != nil && recurrence.Schedule != nil {
+			schedule := recurrence.Schedule
+			flattenedRecurrence := map[string]interface{}{
+				"days":    schedule.Days,
+				"hours":   schedule.Hours,
+				"minutes": schedule.Minutes,
+			}
+			if schedule.TimeZone != nil {
+				flattenedRecurrence["timezone"] = *schedule.TimeZone
+			}
+			flattened["recurrence"] = []interface{}{flattenedRecurrence}
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result, nil
+}
+
+func flattenArmAutoscaleSettingNotifications(notifications []autoscaleSettingNotification) []interface{} {
+	result := make([]interface{}, 0, len(notifications))
+
+	for _, notification := range notifications {
+		flattened := make(map[string]interface{})
+
+		if email := notification.Email; email != nil {
+			flattenedEmail := map[string]interface{}{
+				"custom_emails": email.CustomEmails,
+			}
+			if email.SendToSubscriptionAdministrator != nil {
+				flattenedEmail["send_to_subscription_administrator"] = *email.SendToSubscriptionAdministrator
+			}
+			if email.SendToSubscriptionCoAdministrators != nil {
+				flattenedEmail["send_to_subscription_co_administrator"] = *email.SendToSubscriptionCoAdministrators
+			}
+			flattened["email"] = []interface{}{flattenedEmail}
+		}
+
+		webhooks := make([]interface{}, 0, len(notification.Webhooks))
+		for _, webhook := range notification.Webhooks {
+			properties := make(map[string]interface{})
+			for k, v := range webhook.Properties {
+				if v != nil {
+					properties[k] = *v
+				}
+			}
+
+			webhooks = append(webhooks, map[string]interface{}{
+				"service_uri": *webhook.ServiceURI,
+				"properties":  properties,
+			})
+		}
+		flattened["webhook"] = webhooks
+
+		result = append(result, flattened)
+	}
+
+	return result
+}
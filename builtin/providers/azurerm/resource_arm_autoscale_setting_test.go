@@ -0,0 +1,421 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMAutoscaleSettingCapacity_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    -1,
+			ErrCount: 1,
+		},
+		{
+			Value:    0,
+			ErrCount: 0,
+		},
+		{
+			Value:    1000,
+			ErrCount: 0,
+		},
+		{
+			Value:    1001,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmAutoscaleSetting().Schema["profile"].Elem.(*schema.Resource).Schema["capacity"].Elem.(*schema.Resource).Schema["minimum"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "minimum")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Autoscale Setting capacity %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMAutoscaleSettingMetricOperator_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "GreaterThan",
+			ErrCount: 0,
+		},
+		{
+			Value:    "LessThanOrEqual",
+			ErrCount: 0,
+		},
+		{
+			Value:    "greaterthan",
+			ErrCount: 1,
+		},
+		{
+			Value:    ">",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmAutoscaleSetting().Schema["profile"].Elem.(*schema.Resource).Schema["rule"].Elem.(*schema.Resource).Schema["metric_trigger"].Elem.(*schema.Resource).Schema["operator"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "operator")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Autoscale Setting metric operator %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMAutoscaleSettingScaleType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ChangeCount",
+			ErrCount: 0,
+		},
+		{
+			Value:    "PercentChangeCount",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ExactCount",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Exact",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmAutoscaleSetting().Schema["profile"].Elem.(*schema.Resource).Schema["rule"].Elem.(*schema.Resource).Schema["scale_action"].Elem.(*schema.Resource).Schema["type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Autoscale Setting scale type %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMAutoscaleSettingRecurrenceDay_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Monday",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Sunday",
+			ErrCount: 0,
+		},
+		{
+			Value:    "monday",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Mon",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmAutoscaleSetting().Schema["profile"].Elem.(*schema.Resource).Schema["recurrence"].Elem.(*schema.Resource).Schema["days"].Elem.(*schema.Schema).ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "days")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Autoscale Setting recurrence day %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMAutoscaleSetting_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMAutoscaleSetting_basic, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutoscaleSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutoscaleSettingExists("azurerm_autoscale_setting.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "profile.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "profile.0.capacity.0.default", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "profile.0.rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutoscaleSetting_notifications(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMAutoscaleSetting_basic, ri, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMAutoscaleSetting_notifications, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutoscaleSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutoscaleSettingExists("azurerm_autoscale_setting.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "notification.#", "0"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutoscaleSettingExists("azurerm_autoscale_setting.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "notification.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "notification.0.email.0.send_to_subscription_administrator", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "notification.0.email.0.custom_emails.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "notification.0.webhook.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_autoscale_setting.test", "profile.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutoscaleSettingExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getAutoscaleSetting{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Autoscale Setting: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Autoscale Setting: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAutoscaleSettingDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_autoscale_setting" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getAutoscaleSetting{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Autoscale Setting: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Autoscale Setting still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMAutoscaleSetting_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Standard"
+        size = "S1"
+    }
+}
+
+resource "azurerm_autoscale_setting" "test" {
+    name = "acctestautoscale-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    target_resource_id = "${azurerm_app_service_plan.test.id}"
+
+    profile {
+        name = "default"
+
+        capacity {
+            default = 1
+            minimum = 1
+            maximum = 3
+        }
+
+        rule {
+            metric_trigger {
+                metric_name = "CpuPercentage"
+                metric_resource_id = "${azurerm_app_service_plan.test.id}"
+                time_grain = "PT1M"
+                statistic = "Average"
+                time_window = "PT5M"
+                time_aggregation = "Average"
+                operator = "GreaterThan"
+                threshold = 75
+            }
+
+            scale_action {
+                direction = "Increase"
+                type = "ChangeCount"
+                value = 1
+                cooldown = "PT5M"
+            }
+        }
+
+        rule {
+            metric_trigger {
+                metric_name = "CpuPercentage"
+                metric_resource_id = "${azurerm_app_service_plan.test.id}"
+                time_grain = "PT1M"
+                statistic = "Average"
+                time_window = "PT5M"
+                time_aggregation = "Average"
+                operator = "LessThan"
+                threshold = 25
+            }
+
+            scale_action {
+                direction = "Decrease"
+                type = "ChangeCount"
+                value = 1
+                cooldown = "PT5M"
+            }
+        }
+    }
+}
+`
+
+var testAccAzureRMAutoscaleSetting_notifications = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Standard"
+        size = "S1"
+    }
+}
+
+resource "azurerm_autoscale_setting" "test" {
+    name = "acctestautoscale-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    target_resource_id = "${azurerm_app_service_plan.test.id}"
+
+    profile {
+        name = "default"
+
+        capacity {
+            default = 1
+            minimum = 1
+            maximum = 3
+        }
+
+        rule {
+            metric_trigger {
+                metric_name = "CpuPercentage"
+                metric_resource_id = "${azurerm_app_service_plan.test.id}"
+                time_grain = "PT1M"
+                statistic = "Average"
+                time_window = "PT5M"
+                time_aggregation = "Average"
+                operator = "GreaterThan"
+                threshold = 75
+            }
+
+            scale_action {
+                direction = "Increase"
+                type = "ChangeCount"
+                value = 1
+                cooldown = "PT5M"
+            }
+        }
+    }
+
+    profile {
+        name = "weekends"
+
+        capacity {
+            default = 1
+            minimum = 1
+            maximum = 1
+        }
+
+        recurrence {
+            timezone = "Pacific Standard Time"
+            days = ["Saturday", "Sunday"]
+            hours = [0]
+            minutes = [0]
+        }
+    }
+
+    notification {
+        email {
+            send_to_subscription_administrator = true
+            custom_emails = ["admin@example.com"]
+        }
+
+        webhook {
+            service_uri = "https://example.com/autoscale"
+
+            properties {
+                source = "terraform"
+            }
+        }
+    }
+}
+`
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/riviera/azure"
 )
@@ -68,6 +68,13 @@ func resourceArmAvailabilitySet() *schema.Resource {
 				},
 			},
 
+			"managed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -75,7 +82,7 @@ func resourceArmAvailabilitySet() *schema.Resource {
 
 func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	availSetClient := client.availSetClient
+	rivieraClient := client.rivieraClient
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Availability Set creation.")
 
@@ -86,19 +93,35 @@ func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{})
 	faultDomainCount := d.Get("platform_fault_domain_count").(int)
 	tags := d.Get("tags").(map[string]interface{})
 
-	availSet := compute.AvailabilitySet{
-		Name:     &name,
-		Location: &location,
-		Properties: &compute.AvailabilitySetProperties{
-			PlatformFaultDomainCount:  azure.Int32(int32(faultDomainCount)),
-			PlatformUpdateDomainCount: azure.Int32(int32(updateDomainCount)),
-		},
-		Tags: expandTags(tags),
+	// Availability Sets containing Virtual Machines with Managed Disks must be
+	// "Aligned", so that the disks are placed in the same fault domains.
+	sku := "Classic"
+	if d.Get("managed").(bool) {
+		sku = "Aligned"
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateAvailabilitySet{
+		Name:                      name,
+		ResourceGroupName:         resGroup,
+		Location:                  location,
+		Tags:                      *expandTags(tags),
+		Sku:                       &availabilitySetSku{Name: azure.String(sku)},
+		PlatformFaultDomainCount:  azure.Int32(int32(faultDomainCount)),
+		PlatformUpdateDomainCount: azure.Int32(int32(updateDomainCount)),
 	}
 
-	resp, err := availSetClient.CreateOrUpdate(resGroup, name, availSet)
+	createResponse, err := createRequest.Execute()
 	if err != nil {
-		return err
+		return fmt.Errorf("Error creating Availability Set %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Availability Set %q: %s", name, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getAvailabilitySetResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Availability Set %s (resource group %s) ID", name, resGroup)
 	}
 
 	d.SetId(*resp.ID)
@@ -107,7 +130,7 @@ func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error {
-	availSetClient := meta.(*ArmClient).availSetClient
+	rivieraClient := meta.(*ArmClient).rivieraClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -116,20 +139,32 @@ func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) er
 	resGroup := id.ResourceGroup
 	name := id.Path["availabilitySets"]
 
-	resp, err := availSetClient.Get(resGroup, name)
-	if resp.StatusCode == http.StatusNotFound {
-		d.SetId("")
-		return nil
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getAvailabilitySet{
+		Name:              name,
+		ResourceGroupName: resGroup,
 	}
+
+	readResponse, err := readRequest.Execute()
 	if err != nil {
 		return fmt.Errorf("Error making Read request on Azure Availability Set %s: %s", name, err)
 	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Availability Set %s: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getAvailabilitySetResponse)
 
-	availSet := *resp.Properties
-	d.Set("platform_update_domain_count", availSet.PlatformUpdateDomainCount)
-	d.Set("platform_fault_domain_count", availSet.PlatformFaultDomainCount)
+	d.Set("platform_update_domain_count", resp.PlatformUpdateDomainCount)
+	d.Set("platform_fault_domain_count", resp.PlatformFaultDomainCount)
 	d.Set("name", resp.Name)
-	d.Set("location", resp.Location)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("managed", resp.Sku != nil && resp.Sku.Name != nil && strings.EqualFold(*resp.Sku.Name, "Aligned"))
 
 	flattenAndSetTags(d, resp.Tags)
 
@@ -0,0 +1,194 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmAzureADApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAzureADApplicationCreate,
+		Read:   resourceArmAzureADApplicationRead,
+		Update: resourceArmAzureADApplicationUpdate,
+		Delete: resourceArmAzureADApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"homepage": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"identifier_uris": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"reply_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"available_to_other_tenants": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"oauth2_allow_implicit_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAzureADApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	log.Printf("[INFO] preparing arguments for Azure Active Directory Application creation.")
+
+	name := d.Get("name").(string)
+	properties := expandArmAzureADApplication(d)
+
+	var application azureADApplication
+	_, err := client.do("POST", "applications", properties, &application, http.StatusCreated)
+	if err != nil {
+		return fmt.Errorf("Error creating Azure Active Directory Application %q: %s", name, err)
+	}
+	if application.ObjectID == nil {
+		return fmt.Errorf("Cannot read Azure Active Directory Application %q Object ID", name)
+	}
+
+	d.SetId(*application.ObjectID)
+
+	if err := waitForAzureADObject(client, "applications/"+d.Id()); err != nil {
+		return fmt.Errorf("Error waiting for Azure Active Directory Application %q to become available: %s", name, err)
+	}
+
+	return resourceArmAzureADApplicationRead(d, meta)
+}
+
+func resourceArmAzureADApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	var application azureADApplication
+	resp, err := client.do("GET", "applications/"+d.Id(), nil, &application, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Active Directory Application %s: %s", d.Id(), err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Azure Active Directory Application %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", application.DisplayName)
+	d.Set("application_id", application.AppID)
+	d.Set("homepage", application.Homepage)
+	d.Set("available_to_other_tenants", application.AvailableToOtherTenants)
+	d.Set("oauth2_allow_implicit_flow", application.Oauth2AllowImplicitFlow)
+	if application.IdentifierURIs != nil {
+		d.Set("identifier_uris", *application.IdentifierURIs)
+	}
+	if application.ReplyURLs != nil {
+		d.Set("reply_urls", *application.ReplyURLs)
+	}
+
+	return nil
+}
+
+func resourceArmAzureADApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	name := d.Get("name").(string)
+	properties := expandArmAzureADApplication(d)
+
+	_, err := client.do("PATCH", "applications/"+d.Id(), properties, nil, http.StatusNoContent)
+	if err != nil {
+		return fmt.Errorf("Error updating Azure Active Directory Application %q: %s", name, err)
+	}
+
+	return resourceArmAzureADApplicationRead(d, meta)
+}
+
+func resourceArmAzureADApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	_, err := client.do("DELETE", "applications/"+d.Id(), nil, nil, http.StatusNoContent)
+	if err != nil {
+		return fmt.Errorf("Error deleting Azure Active Directory Application %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandArmAzureADApplication(d *schema.ResourceData) *azureADApplication {
+	properties := &azureADApplication{
+		DisplayName:             azure.String(d.Get("name").(string)),
+		AvailableToOtherTenants: azure.Bool(d.Get("available_to_other_tenants").(bool)),
+		Oauth2AllowImplicitFlow: azure.Bool(d.Get("oauth2_allow_implicit_flow").(bool)),
+	}
+
+	if v, ok := d.GetOk("homepage"); ok {
+		properties.Homepage = azure.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("identifier_uris"); ok {
+		uris := make([]string, 0)
+		for _, uri := range v.([]interface{}) {
+			uris = append(uris, uri.(string))
+		}
+		properties.IdentifierURIs = &uris
+	}
+
+	if v, ok := d.GetOk("reply_urls"); ok {
+		urls := make([]string, 0)
+		for _, url := range v.([]interface{}) {
+			urls = append(urls, url.(string))
+		}
+		properties.ReplyURLs = &urls
+	}
+
+	return properties
+}
+
+// waitForAzureADObject waits for a newly created object to be readable, since
+// Azure Active Directory replicates new objects to its replicas in the
+// background and reads can briefly return 404.
+func waitForAzureADObject(client azureADGraphClient, path string) error {
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, err := client.do("GET", path, nil, nil, http.StatusOK)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return resource.RetryableError(fmt.Errorf("%s has not replicated yet", path))
+		}
+		return nil
+	})
+}
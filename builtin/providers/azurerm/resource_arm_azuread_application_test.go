@@ -0,0 +1,106 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMAzureADApplication_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMAzureADApplication_basic, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMAzureADApplication_updated, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADApplicationExists("azurerm_azuread_application.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_azuread_application.test", "name", fmt.Sprintf("acctestapp-%d", ri)),
+					resource.TestCheckResourceAttr(
+						"azurerm_azuread_application.test", "available_to_other_tenants", "false"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADApplicationExists("azurerm_azuread_application.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_azuread_application.test", "homepage", fmt.Sprintf("https://acctestapp-%d.example.com", ri)),
+					resource.TestCheckResourceAttr(
+						"azurerm_azuread_application.test", "reply_urls.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_azuread_application.test", "oauth2_allow_implicit_flow", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAzureADApplicationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).azureADClient
+
+		resp, err := client.do("GET", "applications/"+rs.Primary.ID, nil, nil, http.StatusOK)
+		if err != nil {
+			return fmt.Errorf("Bad: Get Azure Active Directory Application: %s", err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Azure Active Directory Application %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAzureADApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).azureADClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_azuread_application" {
+			continue
+		}
+
+		resp, err := client.do("GET", "applications/"+rs.Primary.ID, nil, nil, http.StatusOK)
+		if err != nil {
+			return fmt.Errorf("Bad: Get Azure Active Directory Application: %s", err)
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Azure Active Directory Application still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMAzureADApplication_basic = `
+resource "azurerm_azuread_application" "test" {
+    name = "acctestapp-%d"
+}
+`
+
+var testAccAzureRMAzureADApplication_updated = `
+resource "azurerm_azuread_application" "test" {
+    name = "acctestapp-%d"
+    homepage = "https://acctestapp-%d.example.com"
+    reply_urls = ["https://acctestapp-%d.example.com/callback"]
+    oauth2_allow_implicit_flow = true
+}
+`
@@ -0,0 +1,95 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmAzureADServicePrincipal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAzureADServicePrincipalCreate,
+		Read:   resourceArmAzureADServicePrincipalRead,
+		Delete: resourceArmAzureADServicePrincipalDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmUUID,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAzureADServicePrincipalCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	log.Printf("[INFO] preparing arguments for Azure Active Directory Service Principal creation.")
+
+	applicationID := d.Get("application_id").(string)
+	properties := &azureADServicePrincipal{
+		AppID:          azure.String(applicationID),
+		AccountEnabled: azure.Bool(true),
+	}
+
+	var servicePrincipal azureADServicePrincipal
+	_, err := client.do("POST", "servicePrincipals", properties, &servicePrincipal, http.StatusCreated)
+	if err != nil {
+		return fmt.Errorf("Error creating Azure Active Directory Service Principal for Application %q: %s", applicationID, err)
+	}
+	if servicePrincipal.ObjectID == nil {
+		return fmt.Errorf("Cannot read Azure Active Directory Service Principal for Application %q Object ID", applicationID)
+	}
+
+	d.SetId(*servicePrincipal.ObjectID)
+
+	if err := waitForAzureADObject(client, "servicePrincipals/"+d.Id()); err != nil {
+		return fmt.Errorf("Error waiting for Azure Active Directory Service Principal for Application %q to become available: %s", applicationID, err)
+	}
+
+	return resourceArmAzureADServicePrincipalRead(d, meta)
+}
+
+func resourceArmAzureADServicePrincipalRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	var servicePrincipal azureADServicePrincipal
+	resp, err := client.do("GET", "servicePrincipals/"+d.Id(), nil, &servicePrincipal, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Active Directory Service Principal %s: %s", d.Id(), err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Azure Active Directory Service Principal %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_id", servicePrincipal.AppID)
+	d.Set("display_name", servicePrincipal.DisplayName)
+
+	return nil
+}
+
+func resourceArmAzureADServicePrincipalDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	_, err := client.do("DELETE", "servicePrincipals/"+d.Id(), nil, nil, http.StatusNoContent)
+	if err != nil {
+		return fmt.Errorf("Error deleting Azure Active Directory Service Principal %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+	"github.com/satori/go.uuid"
+)
+
+func resourceArmAzureADServicePrincipalPassword() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAzureADServicePrincipalPasswordCreate,
+		Read:   resourceArmAzureADServicePrincipalPasswordRead,
+		Delete: resourceArmAzureADServicePrincipalPasswordDelete,
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmUUID,
+			},
+
+			"key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmUUID,
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmAzureADCredentialDate,
+			},
+
+			"end_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmAzureADCredentialDate,
+			},
+		},
+	}
+}
+
+func resourceArmAzureADServicePrincipalPasswordCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	log.Printf("[INFO] preparing arguments for Azure Active Directory Service Principal Password creation.")
+
+	servicePrincipalID := d.Get("service_principal_id").(string)
+
+	keyID := d.Get("key_id").(string)
+	if keyID == "" {
+		keyID = uuid.NewV4().String()
+	}
+
+	startDate := d.Get("start_date").(string)
+	if startDate == "" {
+		startDate = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	// The passwords of a service principal are replaced as a whole, so
+	// concurrent changes to the same service principal must not interleave.
+	armMutexKV.Lock(servicePrincipalID)
+	defer armMutexKV.Unlock(servicePrincipalID)
+
+	credentials, err := listArmAzureADServicePrincipalPasswords(client, servicePrincipalID)
+	if err != nil {
+		return err
+	}
+
+	credentials = append(credentials, azureADPasswordCredential{
+		KeyID:     azure.String(keyID),
+		Value:     azure.String(d.Get("value").(string)),
+		StartDate: azure.String(startDate),
+		EndDate:   azure.String(d.Get("end_date").(string)),
+	})
+
+	if err := updateArmAzureADServicePrincipalPasswords(client, servicePrincipalID, credentials); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", servicePrincipalID, keyID))
+
+	return resourceArmAzureADServicePrincipalPasswordRead(d, meta)
+}
+
+func resourceArmAzureADServicePrincipalPasswordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	servicePrincipalID, keyID, err := parseArmAzureADServicePrincipalPasswordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var list azureADPasswordCredentialsList
+	resp, err := client.do("GET", fmt.Sprintf("servicePrincipals/%s/passwordCredentials", servicePrincipalID), nil, &list, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("Error listing Passwords for Azure Active Directory Service Principal %q: %s", servicePrincipalID, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Azure Active Directory Service Principal %q not found - removing Password from state", servicePrincipalID)
+		d.SetId("")
+		return nil
+	}
+
+	var credential *azureADPasswordCredential
+	for i, c := range list.Value {
+		if c.KeyID != nil && strings.EqualFold(*c.KeyID, keyID) {
+			credential = &list.Value[i]
+			break
+		}
+	}
+	if credential == nil {
+		log.Printf("[INFO] Azure Active Directory Service Principal Password %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The value of a password can't be read back, so it's left as configured.
+	d.Set("service_principal_id", servicePrincipalID)
+	d.Set("key_id", keyID)
+	d.Set("start_date", credential.StartDate)
+	d.Set("end_date", credential.EndDate)
+
+	return nil
+}
+
+func resourceArmAzureADServicePrincipalPasswordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureADClient
+
+	servicePrincipalID, keyID, err := parseArmAzureADServicePrincipalPasswordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	armMutexKV.Lock(servicePrincipalID)
+	defer armMutexKV.Unlock(servicePrincipalID)
+
+	credentials, err := listArmAzureADServicePrincipalPasswords(client, servicePrincipalID)
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]azureADPasswordCredential, 0, len(credentials))
+	for _, credential := range credentials {
+		if credential.KeyID != nil && strings.EqualFold(*credential.KeyID, keyID) {
+			continue
+		}
+		remaining = append(remaining, credential)
+	}
+
+	return updateArmAzureADServicePrincipalPasswords(client, servicePrincipalID, remaining)
+}
+
+func listArmAzureADServicePrincipalPasswords(client azureADGraphClient, servicePrincipalID string) ([]azureADPasswordCredential, error) {
+	var list azureADPasswordCredentialsList
+	resp, err := client.do("GET", fmt.Sprintf("servicePrincipals/%s/passwordCredentials", servicePrincipalID), nil, &list, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Passwords for Azure Active Directory Service Principal %q: %s", servicePrincipalID, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("Azure Active Directory Service Principal %q was not found", servicePrincipalID)
+	}
+
+	return list.Value, nil
+}
+
+func updateArmAzureADServicePrincipalPasswords(client azureADGraphClient, servicePrincipalID string, credentials []azureADPasswordCredential) error {
+	list := &azureADPasswordCredentialsList{
+		Value: credentials,
+	}
+
+	_, err := client.do("PATCH", fmt.Sprintf("servicePrincipals/%s/passwordCredentials", servicePrincipalID), list, nil, http.StatusNoContent)
+	if err != nil {
+		return fmt.Errorf("Error updating Passwords for Azure Active Directory Service Principal %q: %s", servicePrincipalID, err)
+	}
+
+	return nil
+}
+
+// parseArmAzureADServicePrincipalPasswordID returns the service principal
+// object ID and key ID from an ID of the form {servicePrincipalId}/{keyId}.
+func parseArmAzureADServicePrincipalPasswordID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Service Principal Password ID %q should be in the format {servicePrincipalId}/{keyId}", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func validateArmAzureADCredentialDate(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an RFC3339 date, such as 2018-01-01T01:02:03Z: %s", k, err))
+	}
+	return
+}
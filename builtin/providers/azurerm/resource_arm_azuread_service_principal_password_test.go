@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseArmAzureADServicePrincipalPasswordID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		ExpectError bool
+	}{
+		{
+			ID: "00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111",
+		},
+		{
+			ID:          "00000000-0000-0000-0000-000000000000",
+			ExpectError: true,
+		},
+		{
+			ID:          "00000000-0000-0000-0000-000000000000/",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		servicePrincipalID, keyID, err := parseArmAzureADServicePrincipalPasswordID(tc.ID)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %s", tc.ID, err)
+		}
+		if servicePrincipalID != "00000000-0000-0000-0000-000000000000" || keyID != "11111111-1111-1111-1111-111111111111" {
+			t.Fatalf("Unexpected result parsing %q: %q, %q", tc.ID, servicePrincipalID, keyID)
+		}
+	}
+}
+
+func TestResourceAzureRMAzureADCredentialDate_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "2020-01-01T01:02:03Z",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2020-01-01T01:02:03+01:00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2020-01-01",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmAzureADCredentialDate(tc.Value, "end_date")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure AD credential date %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMAzureADServicePrincipalPassword_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := acctest.RandString(16)
+	config := fmt.Sprintf(testAccAzureRMAzureADServicePrincipalPassword_basic, ri, rs)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADServicePrincipalPasswordExists("azurerm_azuread_service_principal_password.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_azuread_service_principal_password.test", "end_date", "2099-01-01T01:02:03Z"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAzureADServicePrincipalPasswordExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		servicePrincipalID, keyID, err := parseArmAzureADServicePrincipalPasswordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).azureADClient
+
+		credentials, err := listArmAzureADServicePrincipalPasswords(client, servicePrincipalID)
+		if err != nil {
+			return fmt.Errorf("Bad: %s", err)
+		}
+
+		for _, credential := range credentials {
+			if credential.KeyID != nil && strings.EqualFold(*credential.KeyID, keyID) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bad: Azure Active Directory Service Principal Password %q does not exist", rs.Primary.ID)
+	}
+}
+
+var testAccAzureRMAzureADServicePrincipalPassword_basic = `
+resource "azurerm_azuread_application" "test" {
+    name = "acctestapp-%d"
+}
+
+resource "azurerm_azuread_service_principal" "test" {
+    application_id = "${azurerm_azuread_application.test.application_id}"
+}
+
+resource "azurerm_azuread_service_principal_password" "test" {
+    service_principal_id = "${azurerm_azuread_service_principal.test.id}"
+    value = "%s"
+    end_date = "2099-01-01T01:02:03Z"
+}
+`
@@ -0,0 +1,85 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMAzureADServicePrincipal_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMAzureADServicePrincipal_basic, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAzureADServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAzureADServicePrincipalExists("azurerm_azuread_service_principal.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_azuread_service_principal.test", "display_name", fmt.Sprintf("acctestapp-%d", ri)),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAzureADServicePrincipalExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).azureADClient
+
+		resp, err := client.do("GET", "servicePrincipals/"+rs.Primary.ID, nil, nil, http.StatusOK)
+		if err != nil {
+			return fmt.Errorf("Bad: Get Azure Active Directory Service Principal: %s", err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Azure Active Directory Service Principal %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAzureADServicePrincipalDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).azureADClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_azuread_service_principal" {
+			continue
+		}
+
+		resp, err := client.do("GET", "servicePrincipals/"+rs.Primary.ID, nil, nil, http.StatusOK)
+		if err != nil {
+			return fmt.Errorf("Bad: Get Azure Active Directory Service Principal: %s", err)
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Azure Active Directory Service Principal still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMAzureADServicePrincipal_basic = `
+resource "azurerm_azuread_application" "test" {
+    name = "acctestapp-%d"
+}
+
+resource "azurerm_azuread_service_principal" "test" {
+    application_id = "${azurerm_azuread_application.test.application_id}"
+}
+`
@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/arm/cdn"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmCdnCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCdnCustomDomainCreate,
+		Read:   resourceArmCdnCustomDomainRead,
+		Delete: resourceArmCdnCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"profile_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"endpoint_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"host_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmCdnCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	cdnCustomDomainsClient := meta.(*ArmClient).cdnCustomDomainsClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM CDN Custom Domain creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	profileName := d.Get("profile_name").(string)
+	endpointName := d.Get("endpoint_name").(string)
+	hostName := d.Get("host_name").(string)
+
+	parameters := cdn.CustomDomainParameters{
+		Properties: &cdn.CustomDomainPropertiesParameters{
+			HostName: &hostName,
+		},
+	}
+
+	_, err := cdnCustomDomainsClient.Create(name, parameters, endpointName, profileName, resGroup, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error creating CDN Custom Domain %q: %s", name, err)
+	}
+
+	read, err := cdnCustomDomainsClient.Get(name, endpointName, profileName, resGroup)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read CDN Custom Domain %s/%s/%s (resource group %s) ID", profileName, endpointName, name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmCdnCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	cdnCustomDomainsClient := meta.(*ArmClient).cdnCustomDomainsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup, profileName, endpointName, name := parseArmCdnCustomDomainID(id)
+
+	resp, err := cdnCustomDomainsClient.Get(name, endpointName, profileName, resGroup)
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] CDN Custom Domain %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure CDN Custom Domain %s: %s", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("profile_name", profileName)
+	d.Set("endpoint_name", endpointName)
+	if resp.Properties != nil {
+		d.Set("host_name", resp.Properties.HostName)
+	}
+
+	return nil
+}
+
+func resourceArmCdnCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	cdnCustomDomainsClient := meta.(*ArmClient).cdnCustomDomainsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup, profileName, endpointName, name := parseArmCdnCustomDomainID(id)
+
+	resp, err := cdnCustomDomainsClient.DeleteIfExists(name, endpointName, profileName, resGroup, make(chan struct{}))
+	if err != nil {
+		if resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error issuing AzureRM delete request for CDN Custom Domain %q: %s", name, err)
+	}
+
+	return nil
+}
+
+// parseArmCdnCustomDomainID returns the resource group, profile, endpoint and
+// custom domain names from the ID of a custom domain. The CDN API returns the
+// profiles and customDomains segments of IDs in either case.
+func parseArmCdnCustomDomainID(id *ResourceID) (string, string, string, string) {
+	profileName := id.Path["profiles"]
+	if profileName == "" {
+		profileName = id.Path["Profiles"]
+	}
+	name := id.Path["customDomains"]
+	if name == "" {
+		name = id.Path["customdomains"]
+	}
+
+	return id.ResourceGroup, profileName, id.Path["endpoints"], name
+}
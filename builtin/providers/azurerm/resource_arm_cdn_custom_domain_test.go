@@ -0,0 +1,144 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Azure validates that the host name of a custom domain has a CNAME record
+// pointing at the endpoint, so this test needs a DNS zone which has been
+// delegated to Azure DNS.
+func TestAccAzureRMCdnCustomDomain_basic(t *testing.T) {
+	zoneName := os.Getenv("ARM_TEST_DNS_ZONE_NAME")
+	zoneResourceGroup := os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP")
+	if zoneName == "" || zoneResourceGroup == "" {
+		t.Skip("ARM_TEST_DNS_ZONE_NAME and ARM_TEST_DNS_ZONE_RESOURCE_GROUP must be set to a delegated DNS zone")
+	}
+
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMCdnCustomDomain_basic, ri, ri, ri, ri, zoneName, zoneResourceGroup, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnCustomDomainExists("azurerm_cdn_custom_domain.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_cdn_custom_domain.test", "host_name", fmt.Sprintf("acctestcdn%d.%s", ri, zoneName)),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCdnCustomDomainExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for cdn custom domain: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).cdnCustomDomainsClient
+
+		resp, err := conn.Get(name, endpointName, profileName, resourceGroup)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on cdnCustomDomainsClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: CDN Custom Domain %q (resource group: %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCdnCustomDomainDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).cdnCustomDomainsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cdn_custom_domain" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(name, endpointName, profileName, resourceGroup)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("CDN Custom Domain still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMCdnCustomDomain_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_cdn_profile" "test" {
+    name = "acctestcdnprof%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard_Verizon"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+    name = "acctestcdnend%d"
+    profile_name = "${azurerm_cdn_profile.test.name}"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    origin {
+        name = "acceptanceTestCdnOrigin1"
+        host_name = "www.example.com"
+        https_port = 443
+        http_port = 80
+    }
+}
+
+resource "azurerm_dns_cname_record" "test" {
+    name = "acctestcdn%d"
+    zone_name = "%s"
+    resource_group_name = "%s"
+    ttl = 300
+    record = "${azurerm_cdn_endpoint.test.host_name}"
+}
+
+resource "azurerm_cdn_custom_domain" "test" {
+    name = "acctestcdndomain%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    profile_name = "${azurerm_cdn_profile.test.name}"
+    endpoint_name = "${azurerm_cdn_endpoint.test.name}"
+    host_name = "${azurerm_dns_cname_record.test.name}.${azurerm_dns_cname_record.test.zone_name}"
+}
+`
@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/arm/cdn"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmCdnEndpoint() *schema.Resource {
@@ -105,7 +105,7 @@ func resourceArmCdnEndpoint() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "IgnoreQueryString",
-				ValidateFunc: validateCdnEndpointQuerystringCachingBehaviour,
+				ValidateFunc: validation.StringInSlice([]string{"IgnoreQueryString", "BypassCaching", "UseQueryString"}, true),
 			},
 
 			"content_types_to_compress": {
@@ -258,10 +258,6 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 	cdnEndpointsClient := meta.(*ArmClient).cdnEndpointsClient
 
-	if !d.HasChange("tags") {
-		return nil
-	}
-
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	profileName := d.Get("profile_name").(string)
@@ -337,20 +333,6 @@ func resourceArmCdnEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 	return err
 }
 
-func validateCdnEndpointQuerystringCachingBehaviour(v interface{}, k string) (ws []string, errors []error) {
-	value := strings.ToLower(v.(string))
-	cachingTypes := map[string]bool{
-		"ignorequerystring": true,
-		"bypasscaching":     true,
-		"usequerystring":    true,
-	}
-
-	if !cachingTypes[value] {
-		errors = append(errors, fmt.Errorf("CDN Endpoint querystringCachingBehaviours can only be IgnoreQueryString, BypassCaching or UseQueryString"))
-	}
-	return
-}
-
 func resourceArmCdnEndpointOriginHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -66,6 +66,43 @@ func TestAccAzureRMCdnEndpoint_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMCdnEndpoint_updateCompression(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMCdnEndpoint_basic, ri, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMCdnEndpoint_compression, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists("azurerm_cdn_endpoint.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_cdn_endpoint.test", "is_compression_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"azurerm_cdn_endpoint.test", "querystring_caching_behaviour", "IgnoreQueryString"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists("azurerm_cdn_endpoint.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_cdn_endpoint.test", "is_compression_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_cdn_endpoint.test", "content_types_to_compress.#", "2"),
+					resource.TestCheckResourceAttr(
+						"azurerm_cdn_endpoint.test", "querystring_caching_behaviour", "UseQueryString"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMCdnEndpointExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -211,3 +248,33 @@ resource "azurerm_cdn_endpoint" "test" {
     }
 }
 `
+
+var testAccAzureRMCdnEndpoint_compression = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+resource "azurerm_cdn_profile" "test" {
+    name = "acctestcdnprof%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard_Verizon"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+    name = "acctestcdnend%d"
+    profile_name = "${azurerm_cdn_profile.test.name}"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    is_compression_enabled = true
+    content_types_to_compress = ["text/html", "application/json"]
+    querystring_caching_behaviour = "UseQueryString"
+
+    origin {
+	name = "acceptanceTestCdnOrigin1"
+	host_name = "www.example.com"
+	https_port = 443
+	http_port = 80
+    }
+}
+`
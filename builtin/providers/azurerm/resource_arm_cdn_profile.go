@@ -5,10 +5,9 @@ import (
 	"log"
 	"net/http"
 
-	"strings"
-
 	"github.com/Azure/azure-sdk-for-go/arm/cdn"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmCdnProfile() *schema.Resource {
@@ -42,7 +41,7 @@ func resourceArmCdnProfile() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateCdnProfileSku,
+				ValidateFunc: validation.StringInSlice([]string{"Standard_Akamai", "Premium_Verizon", "Standard_Verizon"}, true),
 			},
 
 			"tags": tagsSchema(),
@@ -153,17 +152,3 @@ func resourceArmCdnProfileDelete(d *schema.ResourceData, meta interface{}) error
 
 	return err
 }
-
-func validateCdnProfileSku(v interface{}, k string) (ws []string, errors []error) {
-	value := strings.ToLower(v.(string))
-	skus := map[string]bool{
-		"standard_akamai":  true,
-		"premium_verizon":  true,
-		"standard_verizon": true,
-	}
-
-	if !skus[value] {
-		errors = append(errors, fmt.Errorf("CDN Profile SKU can only be Premium_Verizon, Standard_Verizon or Standard_Akamai"))
-	}
-	return
-}
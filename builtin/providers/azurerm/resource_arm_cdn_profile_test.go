@@ -41,8 +41,9 @@ func TestResourceAzureRMCdnProfileSKU_validation(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceArmCdnProfile().Schema["sku"].ValidateFunc
 	for _, tc := range cases {
-		_, errors := validateCdnProfileSku(tc.Value, "azurerm_cdn_profile")
+		_, errors := validateFunc(tc.Value, "azurerm_cdn_profile")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Azure RM CDN Profile SKU to trigger a validation error")
@@ -0,0 +1,366 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmContainerRegistry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmContainerRegistryCreate,
+		Read:   resourceArmContainerRegistryRead,
+		Update: resourceArmContainerRegistryCreate,
+		Delete: resourceArmContainerRegistryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmContainerRegistryName,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sku": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Basic",
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium"}, false),
+			},
+
+			"admin_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"georeplication_locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      resourceArmContainerRegistryLocationHash,
+			},
+
+			"login_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Container Registry creation.")
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	sku := d.Get("sku").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	georeplicationLocations := d.Get("georeplication_locations").(*schema.Set)
+	if georeplicationLocations.Len() > 0 && sku != "Premium" {
+		return fmt.Errorf("Container Registry %q: `georeplication_locations` can only be specified for a Premium registry", name)
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateContainerRegistry{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          location,
+		Tags:              *expandTags(tags),
+		Sku:               &containerRegistrySku{Name: azure.String(sku)},
+		AdminUserEnabled:  azure.Bool(d.Get("admin_enabled").(bool)),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Container Registry %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Container Registry %q: %s", name, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getContainerRegistryResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Container Registry %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	if err := applyArmContainerRegistryGeoreplications(client, resGroup, name, location, sku, georeplicationLocations); err != nil {
+		return err
+	}
+
+	return resourceArmContainerRegistryRead(d, meta)
+}
+
+func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["registries"]
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getContainerRegistry{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Container Registry %s: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Container Registry %q not found - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Container Registry %s: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getContainerRegistryResponse)
+
+	location := azureRMNormalizeLocation(*resp.Location)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", location)
+	d.Set("login_server", resp.LoginServer)
+	d.Set("admin_enabled", resp.AdminUserEnabled)
+
+	if resp.Sku != nil {
+		d.Set("sku", resp.Sku.Name)
+	}
+
+	if resp.AdminUserEnabled != nil && *resp.AdminUserEnabled {
+		credentialsRequest := rivieraClient.NewRequest()
+		credentialsRequest.Command = &listContainerRegistryCredentials{
+			Name:              name,
+			ResourceGroupName: resGroup,
+		}
+
+		credentialsResponse, err := credentialsRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Error listing the admin credentials of Container Registry %s: %s", name, err)
+		}
+		if !credentialsResponse.IsSuccessful() {
+			return fmt.Errorf("Error listing the admin credentials of Container Registry %s: %s", name, credentialsResponse.Error)
+		}
+
+		credentials := credentialsResponse.Parsed.(*listContainerRegistryCredentialsResponse)
+		d.Set("admin_username", credentials.Username)
+		if len(credentials.Passwords) > 0 {
+			d.Set("admin_password", credentials.Passwords[0].Value)
+		}
+	} else {
+		d.Set("admin_username", "")
+		d.Set("admin_password", "")
+	}
+
+	// Only Premium registries can be replicated, and listing the replications
+	// of other registries fails.
+	if resp.Sku != nil && resp.Sku.Name != nil && *resp.Sku.Name == "Premium" {
+		replications, err := listArmContainerRegistryReplications(client, resGroup, name)
+		if err != nil {
+			return err
+		}
+
+		locations := make([]interface{}, 0)
+		for _, replication := range replications {
+			// The replication in the registry's own location is managed by Azure.
+			if replication != location {
+				locations = append(locations, replication)
+			}
+		}
+		d.Set("georeplication_locations", schema.NewSet(resourceArmContainerRegistryLocationHash, locations))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteRequest := rivieraClient.NewRequest()
+	deleteRequest.Command = &deleteContainerRegistry{
+		Name:              id.Path["registries"],
+		ResourceGroupName: id.ResourceGroup,
+	}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Container Registry %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting Container Registry %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+// listArmContainerRegistryReplications returns the normalized locations a
+// Container Registry is replicated to.
+func listArmContainerRegistryReplications(client *ArmClient, resGroup, name string) ([]string, error) {
+	listRequest := client.rivieraClient.NewRequest()
+	listRequest.Command = &listContainerRegistryReplications{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	listResponse, err := listRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the replications of Container Registry %s: %s", name, err)
+	}
+	if !listResponse.IsSuccessful() {
+		return nil, fmt.Errorf("Error listing the replications of Container Registry %s: %s", name, listResponse.Error)
+	}
+
+	locations := make([]string, 0)
+	for _, replication := range listResponse.Parsed.(*listContainerRegistryReplicationsResponse).Value {
+		if replication.Location != nil {
+			locations = append(locations, azureRMNormalizeLocation(*replication.Location))
+		}
+	}
+
+	return locations, nil
+}
+
+// applyArmContainerRegistryGeoreplications creates and deletes the
+// replications of a Container Registry so that it's replicated to exactly the
+// given locations, in addition to its own location.
+func applyArmContainerRegistryGeoreplications(client *ArmClient, resGroup, name, location, sku string, locations *schema.Set) error {
+	rivieraClient := client.rivieraClient
+	location = azureRMNormalizeLocation(location)
+
+	// Only Premium registries can be replicated.
+	if sku != "Premium" {
+		return nil
+	}
+
+	replications, err := listArmContainerRegistryReplications(client, resGroup, name)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool)
+	for _, replication := range replications {
+		existing[replication] = true
+	}
+
+	desired := make(map[string]bool)
+	for _, raw := range locations.List() {
+		replicationLocation := azureRMNormalizeLocation(raw.(string))
+		desired[replicationLocation] = true
+
+		if existing[replicationLocation] || replicationLocation == location {
+			continue
+		}
+
+		log.Printf("[INFO] Replicating Container Registry %q to %q", name, replicationLocation)
+		createRequest := rivieraClient.NewRequest()
+		createRequest.Command = &createContainerRegistryReplication{
+			Name:              replicationLocation,
+			ResourceGroupName: resGroup,
+			RegistryName:      name,
+			Location:          replicationLocation,
+		}
+
+		createResponse, err := createRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Error replicating Container Registry %q to %q: %s", name, replicationLocation, err)
+		}
+		if !createResponse.IsSuccessful() {
+			return fmt.Errorf("Error replicating Container Registry %q to %q: %s", name, replicationLocation, createResponse.Error)
+		}
+	}
+
+	for replicationLocation := range existing {
+		if desired[replicationLocation] || replicationLocation == location {
+			continue
+		}
+
+		log.Printf("[INFO] Removing the replication of Container Registry %q to %q", name, replicationLocation)
+		deleteRequest := rivieraClient.NewRequest()
+		deleteRequest.Command = &deleteContainerRegistryReplication{
+			Name:              replicationLocation,
+			ResourceGroupName: resGroup,
+			RegistryName:      name,
+		}
+
+		deleteResponse, err := deleteRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Error removing the replication of Container Registry %q to %q: %s", name, replicationLocation, err)
+		}
+		if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Error removing the replication of Container Registry %q to %q: %s", name, replicationLocation, deleteResponse.Error)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmContainerRegistryLocationHash(v interface{}) int {
+	return hashcode.String(azureRMNormalizeLocation(v))
+}
+
+func validateArmContainerRegistryName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9]{5,50}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 5 and 50 alphanumeric characters: %q", k, value))
+	}
+	return
+}
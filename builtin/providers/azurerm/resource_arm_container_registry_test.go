@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMContainerRegistryName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "four",
+			ErrCount: 1,
+		},
+		{
+			Value:    "five5",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "helloWorld",
+			ErrCount: 0,
+		},
+		{
+			Value:    acctest.RandString(50),
+			ErrCount: 0,
+		},
+		{
+			Value:    acctest.RandString(51),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmContainerRegistryName(tc.Value, "azurerm_container_registry")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Container Registry Name %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMContainerRegistrySku_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Basic",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Premium",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Classic",
+			ErrCount: 1,
+		},
+		{
+			Value:    "standard",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmContainerRegistry().Schema["sku"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "sku")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Container Registry SKU %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMContainerRegistry_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMContainerRegistry_basic, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists("azurerm_container_registry.test"),
+					resource.TestCheckResourceAttr("azurerm_container_registry.test", "sku", "Basic"),
+					resource.TestCheckResourceAttr("azurerm_container_registry.test", "admin_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMContainerRegistry_georeplication(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMContainerRegistry_georeplication, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists("azurerm_container_registry.test"),
+					resource.TestCheckResourceAttr("azurerm_container_registry.test", "georeplication_locations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMContainerRegistryExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getContainerRegistry{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Container Registry: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Container Registry: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMContainerRegistryDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_container_registry" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getContainerRegistry{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Container Registry: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Container Registry still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMContainerRegistry_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_container_registry" "test" {
+    name = "acctestacr%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Basic"
+    admin_enabled = true
+
+    tags {
+        environment = "Production"
+    }
+}
+`
+
+var testAccAzureRMContainerRegistry_georeplication = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_container_registry" "test" {
+    name = "acctestacr%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Premium"
+    georeplication_locations = ["North Europe"]
+}
+`
@@ -0,0 +1,233 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmContainerRegistryWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmContainerRegistryWebhookCreate,
+		Read:   resourceArmContainerRegistryWebhookRead,
+		Update: resourceArmContainerRegistryWebhookCreate,
+		Delete: resourceArmContainerRegistryWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmContainerRegistryName,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmContainerRegistryName,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"service_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"custom_headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"push", "delete", "quarantine", "chart_push", "chart_delete"}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmContainerRegistryWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Container Registry Webhook creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	registryName := d.Get("registry_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	actions := make([]string, 0)
+	for _, action := range d.Get("actions").(*schema.Set).List() {
+		actions = append(actions, action.(string))
+	}
+
+	customHeaders := make(map[string]string)
+	for k, v := range d.Get("custom_headers").(map[string]interface{}) {
+		customHeaders[k] = v.(string)
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateContainerRegistryWebhook{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		RegistryName:      registryName,
+		Location:          d.Get("location").(string),
+		Tags:              *expandTags(tags),
+		ServiceURI:        azure.String(d.Get("service_uri").(string)),
+		CustomHeaders:     customHeaders,
+		Status:            azure.String(d.Get("status").(string)),
+		Scope:             azure.String(d.Get("scope").(string)),
+		Actions:           actions,
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Container Registry Webhook %q (Registry %q): %s", name, registryName, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Container Registry Webhook %q (Registry %q): %s", name, registryName, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getContainerRegistryWebhookResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Container Registry Webhook %s (Registry %s / resource group %s) ID", name, registryName, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmContainerRegistryWebhookRead(d, meta)
+}
+
+func resourceArmContainerRegistryWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	registryName := id.Path["registries"]
+	name := id.Path["webhooks"]
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getContainerRegistryWebhook{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		RegistryName:      registryName,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Container Registry Webhook %s: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Container Registry Webhook %q (Registry %q) not found - removing from state", name, registryName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Container Registry Webhook %s: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getContainerRegistryWebhookResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("registry_name", registryName)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("status", resp.Status)
+	d.Set("scope", resp.Scope)
+	d.Set("actions", resp.Actions)
+
+	configRequest := rivieraClient.NewRequest()
+	configRequest.Command = &getContainerRegistryWebhookCallbackConfig{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		RegistryName:      registryName,
+	}
+
+	configResponse, err := configRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading the callback configuration of Container Registry Webhook %s: %s", name, err)
+	}
+	if !configResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading the callback configuration of Container Registry Webhook %s: %s", name, configResponse.Error)
+	}
+
+	config := configResponse.Parsed.(*getContainerRegistryWebhookCallbackConfigResponse)
+	d.Set("service_uri", config.ServiceURI)
+	d.Set("custom_headers", config.CustomHeaders)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmContainerRegistryWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteRequest := rivieraClient.NewRequest()
+	deleteRequest.Command = &deleteContainerRegistryWebhook{
+		Name:              id.Path["webhooks"],
+		ResourceGroupName: id.ResourceGroup,
+		RegistryName:      id.Path["registries"],
+	}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Container Registry Webhook %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting Container Registry Webhook %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
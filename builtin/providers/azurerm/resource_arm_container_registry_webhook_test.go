@@ -0,0 +1,155 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMContainerRegistryWebhookAction_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "push",
+			ErrCount: 0,
+		},
+		{
+			Value:    "chart_delete",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Push",
+			ErrCount: 1,
+		},
+		{
+			Value:    "pull",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmContainerRegistryWebhook().Schema["actions"].Elem.(*schema.Schema).ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "actions")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Container Registry Webhook action %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMContainerRegistryWebhook_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := testAccAzureRMContainerRegistryWebhook_basic(ri, "enabled")
+	postConfig := testAccAzureRMContainerRegistryWebhook_basic(ri, "disabled")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryWebhookExists("azurerm_container_registry_webhook.test"),
+					resource.TestCheckResourceAttr("azurerm_container_registry_webhook.test", "actions.#", "2"),
+					resource.TestCheckResourceAttr("azurerm_container_registry_webhook.test", "custom_headers.%", "1"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryWebhookExists("azurerm_container_registry_webhook.test"),
+					resource.TestCheckResourceAttr("azurerm_container_registry_webhook.test", "status", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMContainerRegistryWebhookExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getContainerRegistryWebhook{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Container Registry Webhook: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Container Registry Webhook: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMContainerRegistryWebhookDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_container_registry_webhook" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getContainerRegistryWebhook{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Container Registry Webhook: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Container Registry Webhook still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMContainerRegistryWebhook_basic(rInt int, status string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_container_registry" "test" {
+    name = "acctestacr%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+}
+
+resource "azurerm_container_registry_webhook" "test" {
+    name = "acctestwh%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    registry_name = "${azurerm_container_registry.test.name}"
+    service_uri = "https://mywebhookreceiver.example/mytag"
+    actions = ["push", "delete"]
+    status = "%s"
+    scope = "mytag:*"
+
+    custom_headers {
+        "Content-Type" = "application/json"
+    }
+}
+`, rInt, rInt, rInt, status)
+}
@@ -0,0 +1,492 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmContainerService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmContainerServiceCreate,
+		Read:   resourceArmContainerServiceRead,
+		Update: resourceArmContainerServiceCreate,
+		Delete: resourceArmContainerServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"orchestration_platform": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DCOS", "Kubernetes", "Swarm"}, false),
+			},
+
+			"master_profile": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      1,
+							ValidateFunc: validateArmContainerServiceMasterProfileCount,
+						},
+
+						"dns_prefix": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"agent_pool_profile": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"dns_prefix": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vm_size": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"linux_profile": containerServiceLinuxProfileSchema(),
+
+			"service_principal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"diagnostics_profile": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"storage_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// containerServiceLinuxProfileSchema is shared by the Container Service and
+// Kubernetes Cluster resources, which accept the same Linux profile.
+func containerServiceLinuxProfileSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"admin_username": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+
+				"ssh_key": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key_data": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Container Service creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	masterProfiles := d.Get("master_profile").([]interface{})
+	masterProfile := masterProfiles[0].(map[string]interface{})
+
+	diagnosticsProfiles := d.Get("diagnostics_profile").([]interface{})
+	diagnosticsProfile := diagnosticsProfiles[0].(map[string]interface{})
+
+	command := &createOrUpdateContainerService{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		OrchestratorProfile: &containerServiceOrchestratorProfile{
+			OrchestratorType: azure.String(d.Get("orchestration_platform").(string)),
+		},
+		MasterProfile: &containerServiceMasterProfile{
+			Count:     azure.Int32(int32(masterProfile["count"].(int))),
+			DNSPrefix: azure.String(masterProfile["dns_prefix"].(string)),
+		},
+		AgentPoolProfiles: expandArmContainerServiceAgentPoolProfiles(d),
+		LinuxProfile:      expandArmContainerServiceLinuxProfile(d),
+		DiagnosticsProfile: &containerServiceDiagnosticsProfile{
+			VMDiagnostics: &containerServiceVMDiagnostics{
+				Enabled: azure.Bool(diagnosticsProfile["enabled"].(bool)),
+			},
+		},
+	}
+
+	if _, ok := d.GetOk("service_principal"); ok {
+		command.ServicePrincipalProfile = expandArmContainerServiceServicePrincipal(d)
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Container Service %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Container Service %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getContainerService{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Container Service %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Container Service %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getContainerServiceResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Container Service %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmContainerServiceRead(d, meta)
+}
+
+func resourceArmContainerServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getContainerService{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Container Service %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Container Service %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Container Service %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getContainerServiceResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if resp.OrchestratorProfile != nil {
+		d.Set("orchestration_platform", resp.OrchestratorProfile.OrchestratorType)
+	}
+
+	if profile := resp.MasterProfile; profile != nil {
+		master := map[string]interface{}{
+			"dns_prefix": *profile.DNSPrefix,
+		}
+		if profile.Count != nil {
+			master["count"] = int(*profile.Count)
+		}
+		if profile.FQDN != nil {
+			master["fqdn"] = *profile.FQDN
+		}
+		if err := d.Set("master_profile", []interface{}{master}); err != nil {
+			return fmt.Errorf("Error flattening `master_profile` for Container Service %q: %s", *resp.Name, err)
+		}
+	}
+
+	if resp.AgentPoolProfiles != nil {
+		if err := d.Set("agent_pool_profile", flattenArmContainerServiceAgentPoolProfiles(*resp.AgentPoolProfiles)); err != nil {
+			return fmt.Errorf("Error flattening `agent_pool_profile` for Container Service %q: %s", *resp.Name, err)
+		}
+	}
+
+	if resp.LinuxProfile != nil {
+		if err := d.Set("linux_profile", flattenArmContainerServiceLinuxProfile(resp.LinuxProfile)); err != nil {
+			return fmt.Errorf("Error flattening `linux_profile` for Container Service %q: %s", *resp.Name, err)
+		}
+	}
+
+	if resp.ServicePrincipalProfile != nil {
+		if err := d.Set("service_principal", flattenArmContainerServiceServicePrincipal(d, resp.ServicePrincipalProfile)); err != nil {
+			return fmt.Errorf("Error flattening `service_principal` for Container Service %q: %s", *resp.Name, err)
+		}
+	}
+
+	if profile := resp.DiagnosticsProfile; profile != nil && profile.VMDiagnostics != nil {
+		diagnostics := map[string]interface{}{
+			"enabled": *profile.VMDiagnostics.Enabled,
+		}
+		if profile.VMDiagnostics.StorageURI != nil {
+			diagnostics["storage_uri"] = *profile.VMDiagnostics.StorageURI
+		}
+		if err := d.Set("diagnostics_profile", []interface{}{diagnostics}); err != nil {
+			return fmt.Errorf("Error flattening `diagnostics_profile` for Container Service %q: %s", *resp.Name, err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmContainerServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteContainerService{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Container Service %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Container Service %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmContainerServiceAgentPoolProfiles(d *schema.ResourceData) []containerServiceAgentPoolProfile {
+	configs := d.Get("agent_pool_profile").([]interface{})
+	profiles := make([]containerServiceAgentPoolProfile, 0, len(configs))
+
+	for _, raw := range configs {
+		config := raw.(map[string]interface{})
+
+		profile := containerServiceAgentPoolProfile{
+			Name:      azure.String(config["name"].(string)),
+			Count:     azure.Int32(int32(config["count"].(int))),
+			VMSize:    azure.String(config["vm_size"].(string)),
+			DNSPrefix: azure.String(config["dns_prefix"].(string)),
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles
+}
+
+func flattenArmContainerServiceAgentPoolProfiles(profiles []containerServiceAgentPoolProfile) []interface{} {
+	result := make([]interface{}, 0, len(profiles))
+
+	for _, profile := range profiles {
+		flattened := map[string]interface{}{
+			"name":    *profile.Name,
+			"vm_size": *profile.VMSize,
+		}
+		if profile.Count != nil {
+			flattened["count"] = int(*profile.Count)
+		}
+		if profile.DNSPrefix != nil {
+			flattened["dns_prefix"] = *profile.DNSPrefix
+		}
+		if profile.FQDN != nil {
+			flattened["fqdn"] = *profile.FQDN
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result
+}
+
+func expandArmContainerServiceLinuxProfile(d *schema.ResourceData) *containerServiceLinuxProfile {
+	profiles := d.Get("linux_profile").([]interface{})
+	config := profiles[0].(map[string]interface{})
+
+	keyConfigs := config["ssh_key"].([]interface{})
+	keys := make([]containerServiceSSHPublicKey, 0, len(keyConfigs))
+	for _, raw := range keyConfigs {
+		key := raw.(map[string]interface{})
+		keys = append(keys, containerServiceSSHPublicKey{
+			KeyData: azure.String(key["key_data"].(string)),
+		})
+	}
+
+	return &containerServiceLinuxProfile{
+		AdminUsername: azure.String(config["admin_username"].(string)),
+		SSH: &containerServiceSSHConfiguration{
+			PublicKeys: &keys,
+		},
+	}
+}
+
+func flattenArmContainerServiceLinuxProfile(profile *containerServiceLinuxProfile) []interface{} {
+	keys := make([]interface{}, 0)
+	if profile.SSH != nil && profile.SSH.PublicKeys != nil {
+		for _, key := range *profile.SSH.PublicKeys {
+			keys = append(keys, map[string]interface{}{
+				"key_data": *key.KeyData,
+			})
+		}
+	}
+
+	flattened := map[string]interface{}{
+		"admin_username": *profile.AdminUsername,
+		"ssh_key":        keys,
+	}
+
+	return []interface{}{flattened}
+}
+
+func expandArmContainerServiceServicePrincipal(d *schema.ResourceData) *containerServiceServicePrincipalProfile {
+	principals := d.Get("service_principal").([]interface{})
+	config := principals[0].(map[string]interface{})
+
+	return &containerServiceServicePrincipalProfile{
+		ClientID: azure.String(config["client_id"].(string)),
+		Secret:   azure.String(config["client_secret"].(string)),
+	}
+}
+
+// flattenArmContainerServiceServicePrincipal keeps the client secret from the
+// configuration, since the API never returns it.
+func flattenArmContainerServiceServicePrincipal(d *schema.ResourceData, profile *containerServiceServicePrincipalProfile) []interface{} {
+	flattened := map[string]interface{}{
+		"client_id": *profile.ClientID,
+	}
+
+	if v, ok := d.GetOk("service_principal.0.client_secret"); ok {
+		flattened["client_secret"] = v.(string)
+	}
+
+	return []interface{}{flattened}
+}
+
+func validateArmContainerServiceMasterProfileCount(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value != 1 && value != 3 && value != 5 {
+		errors = append(errors, fmt.Errorf("The number of master nodes must be 1, 3 or 5."))
+	}
+	return
+}
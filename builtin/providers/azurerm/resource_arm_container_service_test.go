@@ -0,0 +1,289 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMContainerServiceOrchestrationPlatform_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "DCOS",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Kubernetes",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Swarm",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmContainerService().Schema["orchestration_platform"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "orchestration_platform")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Container Service Orchestration Platform %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMContainerServiceMasterProfileCount_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    2,
+			ErrCount: 1,
+		},
+		{
+			Value:    3,
+			ErrCount: 0,
+		},
+		{
+			Value:    5,
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmContainerServiceMasterProfileCount(tc.Value, "count")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Container Service Master Profile Count %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMContainerServiceAgentPoolProfileCount_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    100,
+			ErrCount: 0,
+		},
+		{
+			Value:    101,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmContainerService().Schema["agent_pool_profile"].Elem.(*schema.Resource).Schema["count"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "count")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Container Service Agent Pool Profile Count %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMContainerService_dcosBasic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMContainerService_dcosBasic, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerServiceExists("azurerm_container_service.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_container_service.test", "orchestration_platform", "DCOS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMContainerService_kubernetesBasic(t *testing.T) {
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	config := fmt.Sprintf(testAccAzureRMContainerService_kubernetesBasic, ri, ri, ri, ri, clientId, clientSecret)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerServiceExists("azurerm_container_service.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_container_service.test", "orchestration_platform", "Kubernetes"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMContainerServiceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getContainerService{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Container Service: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Container Service: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMContainerServiceDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_container_service" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getContainerService{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Container Service: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Container Service still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMContainerService_dcosBasic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "East US"
+}
+
+resource "azurerm_container_service" "test" {
+    name = "acctestcontservice%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    orchestration_platform = "DCOS"
+
+    master_profile {
+        count = 1
+        dns_prefix = "acctestmaster%d"
+    }
+
+    linux_profile {
+        admin_username = "acctestuser"
+
+        ssh_key {
+            key_data = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCqaZoyiz1qbdOQ8xEf6uEu1cCwYowo5FHtsBhqLoDnnp7KUTEBN+L2NxRIfQ781rxV6Iq5jSav6b2Q8z5KiseOlvKA/RF2wqU0UPYqQviQhLmW6THTpmrv/YkUCuzxDpsH7DUDhZcwySLKVVe0Qm3+5N2Ta6UYH3lsDf9R9wTP2K/+vAnflKebuypNlmocIvakFWoZda18FOmsOoIVXQ8HWFNCuw9ZCunMSN62QGamCe3dL5cXlkgHYv7ekJE15IA9aOJcM7e90oeTqo+7HTcWfdu0qQqPWY5ujyMw/llas8tsXY85LFqRnr3gJ02bAscjc477+X+j/gkpFoN1QEmt terraform@demo.tld"
+        }
+    }
+
+    agent_pool_profile {
+        name = "default"
+        count = 1
+        dns_prefix = "acctestagent%d"
+        vm_size = "Standard_A0"
+    }
+
+    diagnostics_profile {
+        enabled = false
+    }
+}
+`
+
+var testAccAzureRMContainerService_kubernetesBasic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "East US"
+}
+
+resource "azurerm_container_service" "test" {
+    name = "acctestcontservice%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    orchestration_platform = "Kubernetes"
+
+    master_profile {
+        count = 1
+        dns_prefix = "acctestmaster%d"
+    }
+
+    linux_profile {
+        admin_username = "acctestuser"
+
+        ssh_key {
+            key_data = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCqaZoyiz1qbdOQ8xEf6uEu1cCwYowo5FHtsBhqLoDnnp7KUTEBN+L2NxRIfQ781rxV6Iq5jSav6b2Q8z5KiseOlvKA/RF2wqU0UPYqQviQhLmW6THTpmrv/YkUCuzxDpsH7DUDhZcwySLKVVe0Qm3+5N2Ta6UYH3lsDf9R9wTP2K/+vAnflKebuypNlmocIvakFWoZda18FOmsOoIVXQ8HWFNCuw9ZCunMSN62QGamCe3dL5cXlkgHYv7ekJE15IA9aOJcM7e90oeTqo+7HTcWfdu0qQqPWY5ujyMw/llas8tsXY85LFqRnr3gJ02bAscjc477+X+j/gkpFoN1QEmt terraform@demo.tld"
+        }
+    }
+
+    agent_pool_profile {
+        name = "default"
+        count = 1
+        dns_prefix = "acctestagent%d"
+        vm_size = "Standard_A0"
+    }
+
+    service_principal {
+        client_id = "%s"
+        client_secret = "%s"
+    }
+
+    diagnostics_profile {
+        enabled = false
+    }
+}
+`
@@ -0,0 +1,626 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmCosmosDBAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDBAccountCreateOrUpdate,
+		Read:   resourceArmCosmosDBAccountRead,
+		Update: resourceArmCosmosDBAccountCreateOrUpdate,
+		Delete: resourceArmCosmosDBAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmCosmosDBAccountName,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"offer_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Standard"}, false),
+			},
+
+			"kind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "GlobalDocumentDB",
+				ValidateFunc: validation.StringInSlice([]string{"GlobalDocumentDB", "MongoDB"}, false),
+			},
+
+			"ip_range_filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enable_automatic_failover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"consistency_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"consistency_level": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"BoundedStaleness", "ConsistentPrefix", "Eventual", "Session", "Strong"}, false),
+						},
+
+						"max_interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"max_staleness_prefix": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(1, 2147483647),
+						},
+					},
+				},
+			},
+
+			"geo_location": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:      schema.TypeString,
+							Required:  true,
+							StateFunc: azureRMNormalizeLocation,
+						},
+
+						"failover_priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+				Set: resourceArmCosmosDBAccountGeoLocationHash,
+			},
+
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"EnableAggregationPipeline", "EnableCassandra", "EnableGremlin", "EnableTable", "MongoDBv3.4"}, false),
+						},
+					},
+				},
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_master_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_master_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_readonly_master_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_readonly_master_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDBAccountCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Cosmos DB Account creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	locations := expandArmCosmosDBAccountGeoLocations(d)
+	if err := validateArmCosmosDBAccountFailoverPriorities(locations); err != nil {
+		return err
+	}
+
+	// The priorities of existing locations can't be changed by updating the
+	// account, so when only the priorities have changed they are changed
+	// through a failover first.
+	if !d.IsNewResource() && d.HasChange("geo_location") {
+		old, new := d.GetChange("geo_location")
+		if haveSameArmCosmosDBAccountLocationNames(old.(*schema.Set), new.(*schema.Set)) {
+			policies := make([]cosmosDBFailoverPolicy, 0, len(locations))
+			for _, location := range locations {
+				policies = append(policies, cosmosDBFailoverPolicy{
+					LocationName:     location.LocationName,
+					FailoverPriority: location.FailoverPriority,
+				})
+			}
+
+			failoverRequest := rivieraClient.NewRequest()
+			failoverRequest.Command = &changeCosmosDBAccountFailoverPriority{
+				Name:              name,
+				ResourceGroupName: resGroup,
+				FailoverPolicies:  policies,
+			}
+
+			failoverResponse, err := failoverRequest.Execute()
+			if err != nil {
+				return fmt.Errorf("Error changing failover priorities of Cosmos DB Account %q: %s", name, err)
+			}
+			if !failoverResponse.IsSuccessful() {
+				return fmt.Errorf("Error changing failover priorities of Cosmos DB Account %q: %s", name, failoverResponse.Error)
+			}
+		}
+	}
+
+	command := &createOrUpdateCosmosDBAccount{
+		Name:                     name,
+		ResourceGroupName:        resGroup,
+		Location:                 d.Get("location").(string),
+		Tags:                     *expandedTags,
+		Kind:                     azure.String(d.Get("kind").(string)),
+		DatabaseAccountOfferType: azure.String(d.Get("offer_type").(string)),
+		ConsistencyPolicy:        expandArmCosmosDBAccountConsistencyPolicy(d),
+		Locations:                locations,
+		Capabilities:             expandArmCosmosDBAccountCapabilities(d),
+		EnableAutomaticFailover:  azure.Bool(d.Get("enable_automatic_failover").(bool)),
+	}
+
+	if v, ok := d.GetOk("ip_range_filter"); ok {
+		command.IPRangeFilter = azure.String(v.(string))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Cosmos DB Account %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Cosmos DB Account %q: %s", name, createResponse.Error)
+	}
+
+	getCosmosDBAccountCommand := &getCosmosDBAccount{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = getCosmosDBAccountCommand
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Cosmos DB Account %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Cosmos DB Account %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getCosmosDBAccountResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Cosmos DB Account %s (resource group %s) ID", name, resGroup)
+	}
+
+	log.Printf("[DEBUG] Waiting for Cosmos DB Account (%s) to become available", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Creating", "Updating", "Initializing"},
+		Target:     []string{"Succeeded"},
+		Refresh:    azureStateRefreshFunc(*resp.ID, client, getCosmosDBAccountCommand),
+		Timeout:    60 * time.Minute,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Cosmos DB Account (%s) to become available: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmCosmosDBAccountRead(d, meta)
+}
+
+func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["databaseAccounts"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getCosmosDBAccount{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Cosmos DB Account %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Cosmos DB Account %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Cosmos DB Account %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getCosmosDBAccountResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("kind", resp.Kind)
+	d.Set("offer_type", resp.DatabaseAccountOfferType)
+	d.Set("ip_range_filter", resp.IPRangeFilter)
+	d.Set("enable_automatic_failover", resp.EnableAutomaticFailover)
+	d.Set("endpoint", resp.DocumentEndpoint)
+
+	if policy := resp.ConsistencyPolicy; policy != nil {
+		if err := d.Set("consistency_policy", flattenArmCosmosDBAccountConsistencyPolicy(policy)); err != nil {
+			return fmt.Errorf("Error flattening `consistency_policy` for Cosmos DB Account %q: %s", name, err)
+		}
+	}
+
+	if err := d.Set("geo_location", flattenArmCosmosDBAccountGeoLocations(resp.FailoverPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `geo_location` for Cosmos DB Account %q: %s", name, err)
+	}
+
+	if err := d.Set("capabilities", flattenArmCosmosDBAccountCapabilities(resp.Capabilities)); err != nil {
+		return fmt.Errorf("Error flattening `capabilities` for Cosmos DB Account %q: %s", name, err)
+	}
+
+	keysRequest := rivieraClient.NewRequest()
+	keysRequest.Command = &listCosmosDBAccountKeys{
+		Name:              name,
+		ResourceGroupName: id.ResourceGroup,
+	}
+
+	keysResponse, err := keysRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error listing keys for Cosmos DB Account %q: %s", name, err)
+	}
+	if !keysResponse.IsSuccessful() {
+		return fmt.Errorf("Error listing keys for Cosmos DB Account %q: %s", name, keysResponse.Error)
+	}
+
+	keys := keysResponse.Parsed.(*listCosmosDBAccountKeysResponse)
+	d.Set("primary_master_key", keys.PrimaryMasterKey)
+	d.Set("secondary_master_key", keys.SecondaryMasterKey)
+	d.Set("primary_readonly_master_key", keys.PrimaryReadonlyMasterKey)
+	d.Set("secondary_readonly_master_key", keys.SecondaryReadonlyMasterKey)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteCosmosDBAccount{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Cosmos DB Account %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Cosmos DB Account %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmCosmosDBAccountConsistencyPolicy(d *schema.ResourceData) *cosmosDBConsistencyPolicy {
+	policies := d.Get("consistency_policy").([]interface{})
+	policy := policies[0].(map[string]interface{})
+
+	consistencyLevel := policy["consistency_level"].(string)
+	result := &cosmosDBConsistencyPolicy{
+		DefaultConsistencyLevel: azure.String(consistencyLevel),
+	}
+
+	// The staleness bounds are only accepted for bounded staleness.
+	if consistencyLevel == "BoundedStaleness" {
+		result.MaxIntervalInSeconds = azure.Int32(int32(policy["max_interval_in_seconds"].(int)))
+		result.MaxStalenessPrefix = azure.Int64(int64(policy["max_staleness_prefix"].(int)))
+	}
+
+	return result
+}
+
+func flattenArmCosmosDBAccountConsistencyPolicy(policy *cosmosDBConsistencyPolicy) []interface{} {
+	result := make(map[string]interface{})
+
+	if policy.DefaultConsistencyLevel != nil {
+		result["consistency_level"] = *policy.DefaultConsistencyLevel
+	}
+	if policy.MaxIntervalInSeconds != nil {
+		result["max_interval_in_seconds"] = int(*policy.MaxIntervalInSeconds)
+	}
+	if policy.MaxStalenessPrefix != nil {
+		result["max_staleness_prefix"] = int(*policy.MaxStalenessPrefix)
+	}
+
+	return []interface{}{result}
+}
+
+func expandArmCosmosDBAccountGeoLocations(d *schema.ResourceData) []cosmosDBLocation {
+	locations := make([]cosmosDBLocation, 0)
+
+	for _, raw := range d.Get("geo_location").(*schema.Set).List() {
+		location := raw.(map[string]interface{})
+		locations = append(locations, cosmosDBLocation{
+			LocationName:     azure.String(azureRMNormalizeLocation(location["location"])),
+			FailoverPriority: azure.Int32(int32(location["failover_priority"].(int))),
+		})
+	}
+
+	return locations
+}
+
+func flattenArmCosmosDBAccountGeoLocations(policies []cosmosDBFailoverPolicy) *schema.Set {
+	result := &schema.Set{
+		F: resourceArmCosmosDBAccountGeoLocationHash,
+	}
+
+	for _, policy := range policies {
+		location := map[string]interface{}{}
+		if policy.LocationName != nil {
+			location["location"] = azureRMNormalizeLocation(*policy.LocationName)
+		}
+		if policy.FailoverPriority != nil {
+			location["failover_priority"] = int(*policy.FailoverPriority)
+		}
+		result.Add(location)
+	}
+
+	return result
+}
+
+func expandArmCosmosDBAccountCapabilities(d *schema.ResourceData) []cosmosDBCapability {
+	capabilities := make([]cosmosDBCapability, 0)
+
+	for _, raw := range d.Get("capabilities").(*schema.Set).List() {
+		capability := raw.(map[string]interface{})
+		capabilities = append(capabilities, cosmosDBCapability{
+			Name: azure.String(capability["name"].(string)),
+		})
+	}
+
+	return capabilities
+}
+
+func flattenArmCosmosDBAccountCapabilities(capabilities []cosmosDBCapability) []interface{} {
+	result := make([]interface{}, 0, len(capabilities))
+
+	for _, capability := range capabilities {
+		if capability.Name != nil {
+			result = append(result, map[string]interface{}{
+				"name": *capability.Name,
+			})
+		}
+	}
+
+	return result
+}
+
+func resourceArmCosmosDBAccountGeoLocationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", azureRMNormalizeLocation(m["location"])))
+	buf.WriteString(fmt.Sprintf("%d-", m["failover_priority"].(int)))
+
+	return hashcode.String(buf.String())
+}
+
+// haveSameArmCosmosDBAccountLocationNames returns whether two sets of
+// `geo_location` blocks refer to the same locations, regardless of their
+// failover priorities.
+func haveSameArmCosmosDBAccountLocationNames(old, new *schema.Set) bool {
+	names := func(s *schema.Set) map[string]bool {
+		result := make(map[string]bool)
+		for _, raw := range s.List() {
+			location := raw.(map[string]interface{})
+			result[azureRMNormalizeLocation(location["location"])] = true
+		}
+		return result
+	}
+
+	oldNames := names(old)
+	newNames := names(new)
+	if len(oldNames) != len(newNames) {
+		return false
+	}
+	for name := range oldNames {
+		if !newNames[name] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateArmCosmosDBAccountFailoverPriorities checks that the failover
+// priorities of an account's locations are unique and include the write
+// region, which has a priority of zero.
+func validateArmCosmosDBAccountFailoverPriorities(locations []cosmosDBLocation) error {
+	priorities := make(map[int32]bool)
+
+	for _, location := range locations {
+		priority := *location.FailoverPriority
+		if priorities[priority] {
+			return fmt.Errorf("Each `geo_location` of a Cosmos DB Account must have a unique `failover_priority`, but %d is used more than once", priority)
+		}
+		priorities[priority] = true
+	}
+
+	if !priorities[0] {
+		return fmt.Errorf("A Cosmos DB Account must have a `geo_location` with a `failover_priority` of 0")
+	}
+
+	return nil
+}
+
+func validateArmCosmosDBAccountName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-z0-9-]{3,50}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain lowercase letters, numbers and dashes and must be between 3 and 50 characters", k))
+	}
+	return
+}
+
+// The databases, containers and collections within an account share the
+// helpers below for building their IDs and managing their throughput.
+
+func cosmosDBResourceID(subscriptionId, resourceGroupName, accountName, path string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s%s", subscriptionId, resourceGroupName, accountName, path)
+}
+
+func cosmosDBThroughputSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validateArmCosmosDBThroughput,
+	}
+}
+
+// expandArmCosmosDBThroughputOptions returns the options used to provision
+// throughput when a database, container or collection is created.
+func expandArmCosmosDBThroughputOptions(d *schema.ResourceData) map[string]string {
+	options := make(map[string]string)
+	if v, ok := d.GetOk("throughput"); ok {
+		options["throughput"] = fmt.Sprintf("%d", v.(int))
+	}
+	return options
+}
+
+func updateArmCosmosDBThroughput(d *schema.ResourceData, meta interface{}, resGroup, accountName, path string) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	updateRequest := rivieraClient.NewRequest()
+	updateRequest.Command = &updateCosmosDBThroughput{
+		AccountName:       accountName,
+		ResourceGroupName: resGroup,
+		Path:              path,
+		Resource: cosmosDBThroughputResource{
+			Throughput: azure.Int32(int32(d.Get("throughput").(int))),
+		},
+	}
+
+	updateResponse, err := updateRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error updating throughput of %s in Cosmos DB Account %q: %s", path, accountName, err)
+	}
+	if !updateResponse.IsSuccessful() {
+		return fmt.Errorf("Error updating throughput of %s in Cosmos DB Account %q: %s", path, accountName, updateResponse.Error)
+	}
+
+	return nil
+}
+
+func readArmCosmosDBThroughput(d *schema.ResourceData, meta interface{}, resGroup, accountName, path string) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getCosmosDBThroughput{
+		AccountName:       accountName,
+		ResourceGroupName: resGroup,
+		Path:              path,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading throughput of %s in Cosmos DB Account %q: %s", path, accountName, err)
+	}
+	if !readResponse.IsSuccessful() {
+		// Throughput is only returned when it was provisioned on this
+		// resource rather than shared from its database.
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error reading throughput of %s in Cosmos DB Account %q: %s", path, accountName, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getCosmosDBThroughputResponse)
+	if resp.Throughput != nil {
+		d.Set("throughput", int(*resp.Throughput))
+	}
+
+	return nil
+}
+
+func validateArmCosmosDBThroughput(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 400 || value%100 != 0 {
+		errors = append(errors, fmt.Errorf("%q must be at least 400 and a multiple of 100", k))
+	}
+	return
+}
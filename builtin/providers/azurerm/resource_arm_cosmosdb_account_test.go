@@ -0,0 +1,380 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/jen20/riviera/azure"
+)
+
+func TestResourceAzureRMCosmosDBAccountName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "ab", ErrCount: 1},
+		{Value: "abc", ErrCount: 0},
+		{Value: "acctest-cosmos-123", ErrCount: 0},
+		{Value: "Acctest", ErrCount: 1},
+		{Value: "acctest_cosmos", ErrCount: 1},
+		{Value: acctest.RandString(50), ErrCount: 0},
+		{Value: acctest.RandString(51), ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmCosmosDBAccountName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Cosmos DB Account Name %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMCosmosDBAccountConsistencyLevel_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "BoundedStaleness", ErrCount: 0},
+		{Value: "ConsistentPrefix", ErrCount: 0},
+		{Value: "Eventual", ErrCount: 0},
+		{Value: "Session", ErrCount: 0},
+		{Value: "Strong", ErrCount: 0},
+		{Value: "strong", ErrCount: 1},
+		{Value: "Random", ErrCount: 1},
+	}
+
+	validateFunc := resourceArmCosmosDBAccount().Schema["consistency_policy"].Elem.(*schema.Resource).Schema["consistency_level"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "consistency_level")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Cosmos DB Account Consistency Level %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMCosmosDBThroughput_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{Value: 300, ErrCount: 1},
+		{Value: 400, ErrCount: 0},
+		{Value: 450, ErrCount: 1},
+		{Value: 10000, ErrCount: 0},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmCosmosDBThroughput(tc.Value, "throughput")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Cosmos DB Throughput %d to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMCosmosDBAccountFailoverPriorities_validation(t *testing.T) {
+	cases := []struct {
+		Priorities []int32
+		ShouldErr  bool
+	}{
+		{Priorities: []int32{0}, ShouldErr: false},
+		{Priorities: []int32{0, 1, 2}, ShouldErr: false},
+		{Priorities: []int32{1}, ShouldErr: true},
+		{Priorities: []int32{0, 1, 1}, ShouldErr: true},
+	}
+
+	for _, tc := range cases {
+		locations := make([]cosmosDBLocation, 0, len(tc.Priorities))
+		for i, priority := range tc.Priorities {
+			locations = append(locations, cosmosDBLocation{
+				LocationName:     azure.String(fmt.Sprintf("location%d", i)),
+				FailoverPriority: azure.Int32(priority),
+			})
+		}
+
+		err := validateArmCosmosDBAccountFailoverPriorities(locations)
+		if tc.ShouldErr && err == nil {
+			t.Fatalf("Expected the failover priorities %v to be invalid", tc.Priorities)
+		}
+		if !tc.ShouldErr && err != nil {
+			t.Fatalf("Expected the failover priorities %v to be valid, got: %s", tc.Priorities, err)
+		}
+	}
+}
+
+func TestAccAzureRMCosmosDBAccount_boundedStaleness(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMCosmosDBAccount_boundedStaleness, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists("azurerm_cosmosdb_account.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "kind", "GlobalDocumentDB"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "consistency_policy.0.consistency_level", "BoundedStaleness"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "consistency_policy.0.max_interval_in_seconds", "10"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "consistency_policy.0.max_staleness_prefix", "200"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "geo_location.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBAccount_mongoDB(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMCosmosDBAccount_mongoDB, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists("azurerm_cosmosdb_account.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "kind", "MongoDB"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "capabilities.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBAccount_geoReplicated(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMCosmosDBAccount_geoReplicated, ri, ri, 0, 1)
+	postConfig := fmt.Sprintf(testAccAzureRMCosmosDBAccount_geoReplicated, ri, ri, 1, 0)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists("azurerm_cosmosdb_account.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "geo_location.#", "2"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists("azurerm_cosmosdb_account.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_account.test", "geo_location.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCosmosDBAccountExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getCosmosDBAccount{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Cosmos DB Account: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Cosmos DB Account: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDBAccountDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cosmosdb_account" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getCosmosDBAccount{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Cosmos DB Account: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Cosmos DB Account still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testCheckAzureRMCosmosDBResourceExists checks that a database, container or
+// collection within a Cosmos DB Account exists.
+func testCheckAzureRMCosmosDBResourceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		readResponse, err := testAccAzureRMCosmosDBReadResource(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get %s: %s", name, readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDBResourceDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			readResponse, err := testAccAzureRMCosmosDBReadResource(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			if readResponse.HTTP.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("Bad: %s still exists: %s", resourceType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMCosmosDBReadResource(resourceID string) (*azure.Response, error) {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	id, err := parseAzureResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	accountID := cosmosDBResourceID(id.SubscriptionID, id.ResourceGroup, id.Path["databaseAccounts"], "")
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getCosmosDBResource{
+		AccountName:       id.Path["databaseAccounts"],
+		ResourceGroupName: id.ResourceGroup,
+		Path:              resourceID[len(accountID):],
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Bad: Get Cosmos DB resource %s: %s", resourceID, err)
+	}
+
+	return readResponse, nil
+}
+
+var testAccAzureRMCosmosDBAccount_boundedStaleness = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+    name = "acctest-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    offer_type = "Standard"
+
+    consistency_policy {
+        consistency_level = "BoundedStaleness"
+        max_interval_in_seconds = 10
+        max_staleness_prefix = 200
+    }
+
+    geo_location {
+        location = "${azurerm_resource_group.test.location}"
+        failover_priority = 0
+    }
+}
+`
+
+var testAccAzureRMCosmosDBAccount_mongoDB = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+    name = "acctest-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    offer_type = "Standard"
+    kind = "MongoDB"
+
+    consistency_policy {
+        consistency_level = "Session"
+    }
+
+    geo_location {
+        location = "${azurerm_resource_group.test.location}"
+        failover_priority = 0
+    }
+
+    capabilities {
+        name = "EnableAggregationPipeline"
+    }
+}
+`
+
+var testAccAzureRMCosmosDBAccount_geoReplicated = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+    name = "acctest-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    offer_type = "Standard"
+    enable_automatic_failover = true
+
+    consistency_policy {
+        consistency_level = "Eventual"
+    }
+
+    geo_location {
+        location = "West Europe"
+        failover_priority = %d
+    }
+
+    geo_location {
+        location = "North Europe"
+        failover_priority = %d
+    }
+}
+`
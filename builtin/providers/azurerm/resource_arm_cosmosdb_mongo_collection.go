@@ -0,0 +1,191 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmCosmosDBMongoCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDBMongoCollectionCreate,
+		Read:   resourceArmCosmosDBMongoCollectionRead,
+		Update: resourceArmCosmosDBMongoCollectionUpdate,
+		Delete: resourceArmCosmosDBMongoCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmCosmosDBAccountName,
+			},
+
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"shard_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"throughput": cosmosDBThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDBMongoCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Cosmos DB Mongo Collection creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("account_name").(string)
+	databaseName := d.Get("database_name").(string)
+	path := fmt.Sprintf("/apis/mongodb/databases/%s/collections/%s", databaseName, name)
+
+	collection := cosmosDBMongoCollectionResource{
+		ID: azure.String(name),
+	}
+	if v, ok := d.GetOk("shard_key"); ok {
+		collection.ShardKey = map[string]string{
+			v.(string): "Hash",
+		}
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateCosmosDBResource{
+		AccountName:       accountName,
+		ResourceGroupName: resGroup,
+		Path:              path,
+		Resource:          collection,
+		Options:           expandArmCosmosDBThroughputOptions(d),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Cosmos DB Mongo Collection %q (Account %q / Database %q): %s", name, accountName, databaseName, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Cosmos DB Mongo Collection %q (Account %q / Database %q): %s", name, accountName, databaseName, createResponse.Error)
+	}
+
+	d.SetId(cosmosDBResourceID(client.subscriptionId, resGroup, accountName, path))
+
+	return resourceArmCosmosDBMongoCollectionRead(d, meta)
+}
+
+func resourceArmCosmosDBMongoCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.Path["databaseAccounts"]
+	path := fmt.Sprintf("/apis/mongodb/databases/%s/collections/%s", id.Path["databases"], id.Path["collections"])
+
+	if d.HasChange("throughput") {
+		if err := updateArmCosmosDBThroughput(d, meta, id.ResourceGroup, accountName, path); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmCosmosDBMongoCollectionRead(d, meta)
+}
+
+func resourceArmCosmosDBMongoCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.Path["databaseAccounts"]
+	databaseName := id.Path["databases"]
+	name := id.Path["collections"]
+	path := fmt.Sprintf("/apis/mongodb/databases/%s/collections/%s", databaseName, name)
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getCosmosDBResource{
+		AccountName:       accountName,
+		ResourceGroupName: id.ResourceGroup,
+		Path:              path,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Cosmos DB Mongo Collection %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Cosmos DB Mongo Collection %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Cosmos DB Mongo Collection %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getCosmosDBResourceResponse)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", accountName)
+	d.Set("database_name", databaseName)
+
+	for key := range resp.ShardKey {
+		d.Set("shard_key", key)
+	}
+
+	return readArmCosmosDBThroughput(d, meta, id.ResourceGroup, accountName, path)
+}
+
+func resourceArmCosmosDBMongoCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteRequest := rivieraClient.NewRequest()
+	deleteRequest.Command = &deleteCosmosDBResource{
+		AccountName:       id.Path["databaseAccounts"],
+		ResourceGroupName: id.ResourceGroup,
+		Path:              fmt.Sprintf("/apis/mongodb/databases/%s/collections/%s", id.Path["databases"], id.Path["collections"]),
+	}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Cosmos DB Mongo Collection %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Cosmos DB Mongo Collection %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
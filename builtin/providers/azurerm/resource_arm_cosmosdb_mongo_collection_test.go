@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMCosmosDBMongoCollection_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMCosmosDBMongoCollection_basic, ri, ri, ri, ri, 400)
+	postConfig := fmt.Sprintf(testAccAzureRMCosmosDBMongoCollection_basic, ri, ri, ri, ri, 700)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_mongo_collection"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists("azurerm_cosmosdb_mongo_collection.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_mongo_collection.test", "shard_key", "seven"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_mongo_collection.test", "throughput", "400"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists("azurerm_cosmosdb_mongo_collection.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_mongo_collection.test", "throughput", "700"),
+				),
+			},
+		},
+	})
+}
+
+var testAccAzureRMCosmosDBMongoCollection_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+    name = "acctest-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    offer_type = "Standard"
+    kind = "MongoDB"
+
+    consistency_policy {
+        consistency_level = "Session"
+    }
+
+    geo_location {
+        location = "${azurerm_resource_group.test.location}"
+        failover_priority = 0
+    }
+}
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+    name = "acctest-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    account_name = "${azurerm_cosmosdb_account.test.name}"
+}
+
+resource "azurerm_cosmosdb_mongo_collection" "test" {
+    name = "acctest-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    account_name = "${azurerm_cosmosdb_account.test.name}"
+    database_name = "${azurerm_cosmosdb_mongo_database.test.name}"
+    shard_key = "seven"
+    throughput = %d
+}
+`
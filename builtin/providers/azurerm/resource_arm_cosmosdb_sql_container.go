@@ -0,0 +1,192 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmCosmosDBSQLContainer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDBSQLContainerCreate,
+		Read:   resourceArmCosmosDBSQLContainerRead,
+		Update: resourceArmCosmosDBSQLContainerUpdate,
+		Delete: resourceArmCosmosDBSQLContainerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmCosmosDBAccountName,
+			},
+
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"partition_key_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"throughput": cosmosDBThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDBSQLContainerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Cosmos DB SQL Container creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("account_name").(string)
+	databaseName := d.Get("database_name").(string)
+	path := fmt.Sprintf("/apis/sql/databases/%s/containers/%s", databaseName, name)
+
+	container := cosmosDBSQLContainerResource{
+		ID: azure.String(name),
+	}
+	if v, ok := d.GetOk("partition_key_path"); ok {
+		container.PartitionKey = &cosmosDBPartitionKey{
+			Paths: []string{v.(string)},
+			Kind:  azure.String("Hash"),
+		}
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateCosmosDBResource{
+		AccountName:       accountName,
+		ResourceGroupName: resGroup,
+		Path:              path,
+		Resource:          container,
+		Options:           expandArmCosmosDBThroughputOptions(d),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Cosmos DB SQL Container %q (Account %q / Database %q): %s", name, accountName, databaseName, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Cosmos DB SQL Container %q (Account %q / Database %q): %s", name, accountName, databaseName, createResponse.Error)
+	}
+
+	d.SetId(cosmosDBResourceID(client.subscriptionId, resGroup, accountName, path))
+
+	return resourceArmCosmosDBSQLContainerRead(d, meta)
+}
+
+func resourceArmCosmosDBSQLContainerUpdate(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.Path["databaseAccounts"]
+	path := fmt.Sprintf("/apis/sql/databases/%s/containers/%s", id.Path["databases"], id.Path["containers"])
+
+	if d.HasChange("throughput") {
+		if err := updateArmCosmosDBThroughput(d, meta, id.ResourceGroup, accountName, path); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmCosmosDBSQLContainerRead(d, meta)
+}
+
+func resourceArmCosmosDBSQLContainerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.Path["databaseAccounts"]
+	databaseName := id.Path["databases"]
+	name := id.Path["containers"]
+	path := fmt.Sprintf("/apis/sql/databases/%s/containers/%s", databaseName, name)
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getCosmosDBResource{
+		AccountName:       accountName,
+		ResourceGroupName: id.ResourceGroup,
+		Path:              path,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Cosmos DB SQL Container %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Cosmos DB SQL Container %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Cosmos DB SQL Container %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getCosmosDBResourceResponse)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", accountName)
+	d.Set("database_name", databaseName)
+
+	if key := resp.PartitionKey; key != nil && len(key.Paths) > 0 {
+		d.Set("partition_key_path", key.Paths[0])
+	}
+
+	return readArmCosmosDBThroughput(d, meta, id.ResourceGroup, accountName, path)
+}
+
+func resourceArmCosmosDBSQLContainerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteRequest := rivieraClient.NewRequest()
+	deleteRequest.Command = &deleteCosmosDBResource{
+		AccountName:       id.Path["databaseAccounts"],
+		ResourceGroupName: id.ResourceGroup,
+		Path:              fmt.Sprintf("/apis/sql/databases/%s/containers/%s", id.Path["databases"], id.Path["containers"]),
+	}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Cosmos DB SQL Container %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Cosmos DB SQL Container %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
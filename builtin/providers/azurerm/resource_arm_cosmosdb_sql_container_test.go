@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMCosmosDBSQLContainer_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMCosmosDBSQLContainer_basic, ri, ri, ri, ri, 400)
+	postConfig := fmt.Sprintf(testAccAzureRMCosmosDBSQLContainer_basic, ri, ri, ri, ri, 700)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_sql_container"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists("azurerm_cosmosdb_sql_container.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_sql_container.test", "partition_key_path", "/definition/id"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_sql_container.test", "throughput", "400"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists("azurerm_cosmosdb_sql_container.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_sql_container.test", "throughput", "700"),
+				),
+			},
+		},
+	})
+}
+
+var testAccAzureRMCosmosDBSQLContainer_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+    name = "acctest-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    offer_type = "Standard"
+    kind = "GlobalDocumentDB"
+
+    consistency_policy {
+        consistency_level = "Session"
+    }
+
+    geo_location {
+        location = "${azurerm_resource_group.test.location}"
+        failover_priority = 0
+    }
+}
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+    name = "acctest-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    account_name = "${azurerm_cosmosdb_account.test.name}"
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+    name = "acctest-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    account_name = "${azurerm_cosmosdb_account.test.name}"
+    database_name = "${azurerm_cosmosdb_sql_database.test.name}"
+    partition_key_path = "/definition/id"
+    throughput = %d
+}
+`
@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmCosmosDBSQLDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDBSQLDatabaseCreate,
+		Read:   resourceArmCosmosDBSQLDatabaseRead,
+		Update: resourceArmCosmosDBSQLDatabaseUpdate,
+		Delete: resourceArmCosmosDBSQLDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmCosmosDBAccountName,
+			},
+
+			"throughput": cosmosDBThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDBSQLDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Cosmos DB SQL Database creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("account_name").(string)
+	path := fmt.Sprintf("/apis/sql/databases/%s", name)
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateCosmosDBResource{
+		AccountName:       accountName,
+		ResourceGroupName: resGroup,
+		Path:              path,
+		Resource: cosmosDBDatabaseResource{
+			ID: azure.String(name),
+		},
+		Options: expandArmCosmosDBThroughputOptions(d),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Cosmos DB SQL Database %q (Account %q): %s", name, accountName, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Cosmos DB SQL Database %q (Account %q): %s", name, accountName, createResponse.Error)
+	}
+
+	d.SetId(cosmosDBResourceID(client.subscriptionId, resGroup, accountName, path))
+
+	return resourceArmCosmosDBSQLDatabaseRead(d, meta)
+}
+
+func resourceArmCosmosDBSQLDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.Path["databaseAccounts"]
+	path := fmt.Sprintf("/apis/sql/databases/%s", id.Path["databases"])
+
+	if d.HasChange("throughput") {
+		if err := updateArmCosmosDBThroughput(d, meta, id.ResourceGroup, accountName, path); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmCosmosDBSQLDatabaseRead(d, meta)
+}
+
+func resourceArmCosmosDBSQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.Path["databaseAccounts"]
+	name := id.Path["databases"]
+	path := fmt.Sprintf("/apis/sql/databases/%s", name)
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getCosmosDBResource{
+		AccountName:       accountName,
+		ResourceGroupName: id.ResourceGroup,
+		Path:              path,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Cosmos DB SQL Database %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Cosmos DB SQL Database %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Cosmos DB SQL Database %s: %s", d.Id(), readResponse.Error)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", accountName)
+
+	return readArmCosmosDBThroughput(d, meta, id.ResourceGroup, accountName, path)
+}
+
+func resourceArmCosmosDBSQLDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteRequest := rivieraClient.NewRequest()
+	deleteRequest.Command = &deleteCosmosDBResource{
+		AccountName:       id.Path["databaseAccounts"],
+		ResourceGroupName: id.ResourceGroup,
+		Path:              fmt.Sprintf("/apis/sql/databases/%s", id.Path["databases"]),
+	}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Cosmos DB SQL Database %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Cosmos DB SQL Database %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
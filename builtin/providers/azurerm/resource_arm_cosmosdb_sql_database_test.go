@@ -0,0 +1,69 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMCosmosDBSQLDatabase_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMCosmosDBSQLDatabase_basic, ri, ri, ri, 400)
+	postConfig := fmt.Sprintf(testAccAzureRMCosmosDBSQLDatabase_basic, ri, ri, ri, 700)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_sql_database"),
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists("azurerm_cosmosdb_sql_database.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_sql_database.test", "throughput", "400"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists("azurerm_cosmosdb_sql_database.test"),
+					resource.TestCheckResourceAttr("azurerm_cosmosdb_sql_database.test", "throughput", "700"),
+				),
+			},
+		},
+	})
+}
+
+var testAccAzureRMCosmosDBSQLDatabase_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+    name = "acctest-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    offer_type = "Standard"
+    kind = "GlobalDocumentDB"
+
+    consistency_policy {
+        consistency_level = "Session"
+    }
+
+    geo_location {
+        location = "${azurerm_resource_group.test.location}"
+        failover_priority = 0
+    }
+}
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+    name = "acctest-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    account_name = "${azurerm_cosmosdb_account.test.name}"
+    throughput = %d
+}
+`
@@ -1,9 +1,6 @@
 package azurerm
 
 import (
-	"fmt"
-	"log"
-
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/riviera/dns"
 )
@@ -14,6 +11,9 @@ func resourceArmDnsARecord() *schema.Resource {
 		Read:   resourceArmDnsARecordRead,
 		Update: resourceArmDnsARecordCreate,
 		Delete: resourceArmDnsARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -51,9 +51,6 @@ func resourceArmDnsARecord() *schema.Resource {
 }
 
 func resourceArmDnsARecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
 
@@ -75,56 +72,23 @@ func resourceArmDnsARecordCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	createCommand.ARecords = records
 
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = createCommand
-
-	createResponse, err := createRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error creating DNS A Record: %s", err)
-	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating DNS A Record: %s", createResponse.Error)
-	}
-
-	readRequest := rivieraClient.NewRequest()
-	readRequest.Command = &dns.GetARecordSet{
-		Name:              d.Get("name").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
-		ZoneName:          d.Get("zone_name").(string),
-	}
-
-	readResponse, err := readRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error reading DNS A Record: %s", err)
-	}
-	if !readResponse.IsSuccessful() {
-		return fmt.Errorf("Error reading DNS A Record: %s", readResponse.Error)
+	if err := createArmDnsRecordSet(d, meta, "A", createCommand); err != nil {
+		return err
 	}
 
-	resp := readResponse.Parsed.(*dns.GetARecordSetResponse)
-	d.SetId(resp.ID)
-
 	return resourceArmDnsARecordRead(d, meta)
 }
 
 func resourceArmDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &dns.GetARecordSet{}
-
-	readResponse, err := readRequest.Execute()
+	parsed, err := readArmDnsRecordSet(d, meta, "A", &dns.GetARecordSet{})
 	if err != nil {
-		return fmt.Errorf("Error reading DNS A Record: %s", err)
+		return err
 	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading DNS A Record %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading DNS A Record: %s", readResponse.Error)
+	if parsed == nil {
+		return nil
 	}
 
-	resp := readResponse.Parsed.(*dns.GetARecordSetResponse)
+	resp := parsed.(*dns.GetARecordSetResponse)
 
 	d.Set("ttl", resp.TTL)
 
@@ -145,21 +109,5 @@ func resourceArmDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &dns.DeleteRecordSet{
-		RecordSetType: "A",
-	}
-
-	deleteResponse, err := deleteRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error deleting DNS A Record: %s", err)
-	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting DNS A Record: %s", deleteResponse.Error)
-	}
-
-	return nil
+	return deleteArmDnsRecordSet(d, meta, "A")
 }
@@ -1,9 +1,6 @@
 package azurerm
 
 import (
-	"fmt"
-	"log"
-
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/riviera/dns"
 )
@@ -14,6 +11,9 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 		Read:   resourceArmDnsAAAARecordRead,
 		Update: resourceArmDnsAAAARecordCreate,
 		Delete: resourceArmDnsAAAARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -51,9 +51,6 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 }
 
 func resourceArmDnsAAAARecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
 
@@ -75,56 +72,23 @@ func resourceArmDnsAAAARecordCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	createCommand.AAAARecords = records
 
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = createCommand
-
-	createResponse, err := createRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error creating DNS AAAA Record: %s", err)
-	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating DNS AAAA Record: %s", createResponse.Error)
-	}
-
-	readRequest := rivieraClient.NewRequest()
-	readRequest.Command = &dns.GetAAAARecordSet{
-		Name:              d.Get("name").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
-		ZoneName:          d.Get("zone_name").(string),
-	}
-
-	readResponse, err := readRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error reading DNS AAAA Record: %s", err)
-	}
-	if !readResponse.IsSuccessful() {
-		return fmt.Errorf("Error reading DNS AAAA Record: %s", readResponse.Error)
+	if err := createArmDnsRecordSet(d, meta, "AAAA", createCommand); err != nil {
+		return err
 	}
 
-	resp := readResponse.Parsed.(*dns.GetAAAARecordSetResponse)
-	d.SetId(resp.ID)
-
 	return resourceArmDnsAAAARecordRead(d, meta)
 }
 
 func resourceArmDnsAAAARecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &dns.GetAAAARecordSet{}
-
-	readResponse, err := readRequest.Execute()
+	parsed, err := readArmDnsRecordSet(d, meta, "AAAA", &dns.GetAAAARecordSet{})
 	if err != nil {
-		return fmt.Errorf("Error reading DNS AAAA Record: %s", err)
+		return err
 	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading DNS AAAA Record %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading DNS AAAA Record: %s", readResponse.Error)
+	if parsed == nil {
+		return nil
 	}
 
-	resp := readResponse.Parsed.(*dns.GetAAAARecordSetResponse)
+	resp := parsed.(*dns.GetAAAARecordSetResponse)
 
 	d.Set("ttl", resp.TTL)
 
@@ -145,21 +109,5 @@ func resourceArmDnsAAAARecordRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmDnsAAAARecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &dns.DeleteRecordSet{
-		RecordSetType: "AAAA",
-	}
-
-	deleteResponse, err := deleteRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error deleting DNS AAAA Record: %s", err)
-	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting DNS AAAA Record: %s", deleteResponse.Error)
-	}
-
-	return nil
+	return deleteArmDnsRecordSet(d, meta, "AAAA")
 }
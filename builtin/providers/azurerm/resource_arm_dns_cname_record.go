@@ -1,9 +1,6 @@
 package azurerm
 
 import (
-	"fmt"
-	"log"
-
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/riviera/dns"
 )
@@ -14,6 +11,9 @@ func resourceArmDnsCNameRecord() *schema.Resource {
 		Read:   resourceArmDnsCNameRecordRead,
 		Update: resourceArmDnsCNameRecordCreate,
 		Delete: resourceArmDnsCNameRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -57,9 +57,6 @@ func resourceArmDnsCNameRecord() *schema.Resource {
 }
 
 func resourceArmDnsCNameRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
 
@@ -75,56 +72,23 @@ func resourceArmDnsCNameRecordCreate(d *schema.ResourceData, meta interface{}) e
 		},
 	}
 
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = createCommand
-
-	createResponse, err := createRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error creating DNS CName Record: %s", err)
-	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating DNS CName Record: %s", createResponse.Error)
-	}
-
-	readRequest := rivieraClient.NewRequest()
-	readRequest.Command = &dns.GetCNAMERecordSet{
-		Name:              d.Get("name").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
-		ZoneName:          d.Get("zone_name").(string),
-	}
-
-	readResponse, err := readRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error reading DNS CName Record: %s", err)
-	}
-	if !readResponse.IsSuccessful() {
-		return fmt.Errorf("Error reading DNS CName Record: %s", readResponse.Error)
+	if err := createArmDnsRecordSet(d, meta, "CNAME", createCommand); err != nil {
+		return err
 	}
 
-	resp := readResponse.Parsed.(*dns.GetCNAMERecordSetResponse)
-	d.SetId(resp.ID)
-
 	return resourceArmDnsCNameRecordRead(d, meta)
 }
 
 func resourceArmDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &dns.GetCNAMERecordSet{}
-
-	readResponse, err := readRequest.Execute()
+	parsed, err := readArmDnsRecordSet(d, meta, "CNAME", &dns.GetCNAMERecordSet{})
 	if err != nil {
-		return fmt.Errorf("Error reading DNS A Record: %s", err)
+		return err
 	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading DNS A Record %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading DNS A Record: %s", readResponse.Error)
+	if parsed == nil {
+		return nil
 	}
 
-	resp := readResponse.Parsed.(*dns.GetCNAMERecordSetResponse)
+	resp := parsed.(*dns.GetCNAMERecordSetResponse)
 
 	d.Set("ttl", resp.TTL)
 	d.Set("record", resp.CNAMERecord.CNAME)
@@ -135,21 +99,5 @@ func resourceArmDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &dns.DeleteRecordSet{
-		RecordSetType: "CNAME",
-	}
-
-	deleteResponse, err := deleteRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error deleting DNS CName Record: %s", err)
-	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting DNS CName Record: %s", deleteResponse.Error)
-	}
-
-	return nil
+	return deleteArmDnsRecordSet(d, meta, "CNAME")
 }
@@ -16,6 +16,9 @@ func resourceArmDnsMxRecord() *schema.Resource {
 		Read:   resourceArmDnsMxRecordRead,
 		Update: resourceArmDnsMxRecordCreate,
 		Delete: resourceArmDnsMxRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -65,9 +68,6 @@ func resourceArmDnsMxRecord() *schema.Resource {
 }
 
 func resourceArmDnsMxRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
 
@@ -86,56 +86,23 @@ func resourceArmDnsMxRecordCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	createCommand.MXRecords = mxRecords
 
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = createCommand
-
-	createResponse, err := createRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error creating DNS MX Record: %s", err)
-	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating DNS MX Record: %s", createResponse.Error)
-	}
-
-	readRequest := rivieraClient.NewRequest()
-	readRequest.Command = &dns.GetMXRecordSet{
-		Name:              d.Get("name").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
-		ZoneName:          d.Get("zone_name").(string),
-	}
-
-	readResponse, err := readRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error reading DNS MX Record: %s", err)
-	}
-	if !readResponse.IsSuccessful() {
-		return fmt.Errorf("Error reading DNS MX Record: %s", readResponse.Error)
+	if err := createArmDnsRecordSet(d, meta, "MX", createCommand); err != nil {
+		return err
 	}
 
-	resp := readResponse.Parsed.(*dns.GetMXRecordSetResponse)
-	d.SetId(resp.ID)
-
 	return resourceArmDnsMxRecordRead(d, meta)
 }
 
 func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &dns.GetMXRecordSet{}
-
-	readResponse, err := readRequest.Execute()
+	parsed, err := readArmDnsRecordSet(d, meta, "MX", &dns.GetMXRecordSet{})
 	if err != nil {
-		return fmt.Errorf("Error reading DNS MX Record: %s", err)
+		return err
 	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading DNS MX Record %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading DNS MX Record: %s", readResponse.Error)
+	if parsed == nil {
+		return nil
 	}
 
-	resp := readResponse.Parsed.(*dns.GetMXRecordSetResponse)
+	resp := parsed.(*dns.GetMXRecordSetResponse)
 
 	d.Set("ttl", resp.TTL)
 
@@ -150,23 +117,7 @@ func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &dns.DeleteRecordSet{
-		RecordSetType: "MX",
-	}
-
-	deleteResponse, err := deleteRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error deleting DNS MX Record: %s", err)
-	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting DNS MX Record: %s", deleteResponse.Error)
-	}
-
-	return nil
+	return deleteArmDnsRecordSet(d, meta, "MX")
 }
 
 func expandAzureRmDnsMxRecord(d *schema.ResourceData) ([]dns.MXRecord, error) {
@@ -14,6 +14,9 @@ func resourceArmDnsNsRecord() *schema.Resource {
 		Read:   resourceArmDnsNsRecordRead,
 		Update: resourceArmDnsNsRecordCreate,
 		Delete: resourceArmDnsNsRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -57,9 +60,6 @@ func resourceArmDnsNsRecord() *schema.Resource {
 }
 
 func resourceArmDnsNsRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
 
@@ -78,56 +78,23 @@ func resourceArmDnsNsRecordCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	createCommand.NSRecords = nsRecords
 
-	createRequest := rivieraClient.NewRequest()
-	createRequest.Command = createCommand
-
-	createResponse, err := createRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error creating DNS NS Record: %s", err)
-	}
-	if !createResponse.IsSuccessful() {
-		return fmt.Errorf("Error creating DNS NS Record: %s", createResponse.Error)
+	if err := createArmDnsRecordSet(d, meta, "NS", createCommand); err != nil {
+		return err
 	}
 
-	readRequest := rivieraClient.NewRequest()
-	readRequest.Command = &dns.GetNSRecordSet{
-		Name:              d.Get("name").(string),
-		ResourceGroupName: d.Get("resource_group_name").(string),
-		ZoneName:          d.Get("zone_name").(string),
-	}
-
-	readResponse, err := readRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error reading DNS NS Record: %s", err)
-	}
-	if !readResponse.IsSuccessful() {
-		return fmt.Errorf("Error reading DNS NS Record: %s", readResponse.Error)
-	}
-
-	resp := readResponse.Parsed.(*dns.GetNSRecordSetResponse)
-	d.SetId(resp.ID)
-
 	return resourceArmDnsNsRecordRead(d, meta)
 }
 
 func resourceArmDnsNsRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	readRequest := rivieraClient.NewRequestForURI(d.Id())
-	readRequest.Command = &dns.GetNSRecordSet{}
-
-	readResponse, err := readRequest.Execute()
+	parsed, err := readArmDnsRecordSet(d, meta, "NS", &dns.GetNSRecordSet{})
 	if err != nil {
-		return fmt.Errorf("Error reading DNS Ns Record: %s", err)
+		return err
 	}
-	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading DNS NS Record %q - removing from state", d.Id())
-		d.SetId("")
-		return fmt.Errorf("Error reading DNS NS Record: %s", readResponse.Error)
+	if parsed == nil {
+		return nil
 	}
 
-	resp := readResponse.Parsed.(*dns.GetNSRecordSetResponse)
+	resp := parsed.(*dns.GetNSRecordSetResponse)
 
 	d.Set("ttl", resp.TTL)
 
@@ -144,23 +111,7 @@ func resourceArmDnsNsRecordRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmDnsNsRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	rivieraClient := client.rivieraClient
-
-	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
-	deleteRequest.Command = &dns.DeleteRecordSet{
-		RecordSetType: "NS",
-	}
-
-	deleteResponse, err := deleteRequest.Execute()
-	if err != nil {
-		return fmt.Errorf("Error deleting DNS TXT Record: %s", err)
-	}
-	if !deleteResponse.IsSuccessful() {
-		return fmt.Errorf("Error deleting DNS TXT Record: %s", deleteResponse.Error)
-	}
-
-	return nil
+	return deleteArmDnsRecordSet(d, meta, "NS")
 }
 
 func expandAzureRmDnsNsRecords(d *schema.ResourceData) ([]dns.NSRecord, error) {
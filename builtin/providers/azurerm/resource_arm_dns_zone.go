@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/riviera/dns"
@@ -96,6 +97,11 @@ func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	rivieraClient := client.rivieraClient
 
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	readRequest := rivieraClient.NewRequestForURI(d.Id())
 	readRequest.Command = &dns.GetDNSZone{}
 
@@ -104,13 +110,17 @@ func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading DNS Zone: %s", err)
 	}
 	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading DNS Zone %q - removing from state", d.Id())
-		d.SetId("")
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] DNS Zone %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error reading DNS Zone: %s", readResponse.Error)
 	}
 
 	resp := readResponse.Parsed.(*dns.GetDNSZoneResponse)
 
+	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("number_of_record_sets", resp.NumberOfRecordSets)
 	d.Set("max_number_of_record_sets", resp.MaxNumberOfRecordSets)
 	d.Set("name", resp.Name)
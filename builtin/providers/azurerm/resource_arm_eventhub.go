@@ -0,0 +1,315 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+// eventHubCaptureDestinationName is the only destination which Event Hubs
+// Capture supports.
+const eventHubCaptureDestinationName = "EventHubArchive.AzureBlockBlob"
+
+func resourceArmEventHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmEventHubCreate,
+		Read:   resourceArmEventHubRead,
+		Update: resourceArmEventHubCreate,
+		Delete: resourceArmEventHubDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"partition_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(2, 32),
+			},
+
+			"message_retention": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
+
+			"capture_description": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"encoding": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Avro", "AvroDeflate"}, false),
+						},
+
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(60, 900),
+						},
+
+						"size_limit_in_bytes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      314572800,
+							ValidateFunc: validation.IntBetween(10485760, 524288000),
+						},
+
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{eventHubCaptureDestinationName}, false),
+									},
+
+									"archive_name_format": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"blob_container_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"storage_account_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"partition_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceArmEventHubCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM EventHub creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	partitionCount := int64(d.Get("partition_count").(int))
+	messageRetention := int64(d.Get("message_retention").(int))
+
+	command := &createOrUpdateEventHub{
+		Name:                   name,
+		ResourceGroupName:      resGroup,
+		NamespaceName:          namespaceName,
+		Location:               d.Get("location").(string),
+		PartitionCount:         &partitionCount,
+		MessageRetentionInDays: &messageRetention,
+		CaptureDescription:     expandArmEventHubCaptureDescription(d),
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating EventHub %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating EventHub %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getEventHub{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		NamespaceName:     namespaceName,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading EventHub %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading EventHub %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getEventHubResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read EventHub %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmEventHubRead(d, meta)
+}
+
+func resourceArmEventHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getEventHub{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure EventHub %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] EventHub %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure EventHub %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getEventHubResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("namespace_name", id.Path["namespaces"])
+	if resp.Location != nil {
+		d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	}
+	d.Set("partition_count", resp.PartitionCount)
+	d.Set("message_retention", resp.MessageRetentionInDays)
+	d.Set("partition_ids", resp.PartitionIds)
+
+	if err := d.Set("capture_description", flattenArmEventHubCaptureDescription(resp.CaptureDescription)); err != nil {
+		return fmt.Errorf("Error flattening `capture_description` for EventHub %q: %s", *resp.Name, err)
+	}
+
+	return nil
+}
+
+func resourceArmEventHubDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmEventHubResource(d, meta, "EventHub")
+}
+
+func expandArmEventHubCaptureDescription(d *schema.ResourceData) *eventHubCaptureDescription {
+	configs := d.Get("capture_description").([]interface{})
+	if len(configs) == 0 {
+		return nil
+	}
+	config := configs[0].(map[string]interface{})
+
+	description := &eventHubCaptureDescription{
+		Enabled:           azure.Bool(config["enabled"].(bool)),
+		Encoding:          azure.String(config["encoding"].(string)),
+		IntervalInSeconds: azure.Int32(int32(config["interval_in_seconds"].(int))),
+		SizeLimitInBytes:  azure.Int32(int32(config["size_limit_in_bytes"].(int))),
+	}
+
+	if destinations := config["destination"].([]interface{}); len(destinations) > 0 {
+		destination := destinations[0].(map[string]interface{})
+		description.Destination = &eventHubCaptureDestination{
+			Name: azure.String(destination["name"].(string)),
+			Properties: &eventHubCaptureDestinationProperties{
+				ArchiveNameFormat:        azure.String(destination["archive_name_format"].(string)),
+				BlobContainer:            azure.String(destination["blob_container_name"].(string)),
+				StorageAccountResourceID: azure.String(destination["storage_account_id"].(string)),
+			},
+		}
+	}
+
+	return description
+}
+
+func flattenArmEventHubCaptureDescription(description *eventHubCaptureDescription) []interface{} {
+	if description == nil {
+		return []interface{}{}
+	}
+
+	flattened := make(map[string]interface{})
+	if description.Enabled != nil {
+		flattened["enabled"] = *description.Enabled
+	}
+	if description.Encoding != nil {
+		flattened["encoding"] = *description.Encoding
+	}
+	if description.IntervalInSeconds != nil {
+		flattened["interval_in_seconds"] = int(*description.IntervalInSeconds)
+	}
+	if description.SizeLimitInBytes != nil {
+		flattened["size_limit_in_bytes"] = int(*description.SizeLimitInBytes)
+	}
+
+	if destination := description.Destination; destination != nil {
+		flattenedDestination := make(map[string]interface{})
+		if destination.Name != nil {
+			flattenedDestination["name"] = *destination.Name
+		}
+		if props := destination.Properties; props != nil {
+			if props.ArchiveNameFormat != nil {
+				flattenedDestination["archive_name_format"] = *props.ArchiveNameFormat
+			}
+			if props.BlobContainer != nil {
+				flattenedDestination["blob_container_name"] = *props.BlobContainer
+			}
+			if props.StorageAccountResourceID != nil {
+				flattenedDestination["storage_account_id"] = *props.StorageAccountResourceID
+			}
+		}
+		flattened["destination"] = []interface{}{flattenedDestination}
+	}
+
+	return []interface{}{flattened}
+}
@@ -0,0 +1,57 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmEventHubAuthorizationRule() *schema.Resource {
+	ruleSchema := serviceBusAuthorizationRuleSchema()
+	ruleSchema["eventhub_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmEventHubAuthorizationRuleCreate,
+		Read:   resourceArmEventHubAuthorizationRuleRead,
+		Update: resourceArmEventHubAuthorizationRuleCreate,
+		Delete: resourceArmEventHubAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: ruleSchema,
+	}
+}
+
+func resourceArmEventHubAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	namespacePath := eventHubNamespaceURLPath(d.Get("resource_group_name").(string), d.Get("namespace_name").(string))
+	parentPath := fmt.Sprintf("%s/eventhubs/%s", namespacePath, d.Get("eventhub_name").(string))
+
+	if err := createArmEventHubAuthorizationRule(d, meta, parentPath); err != nil {
+		return err
+	}
+
+	return resourceArmEventHubAuthorizationRuleRead(d, meta)
+}
+
+func resourceArmEventHubAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := readArmEventHubAuthorizationRule(d, meta)
+	if err != nil {
+		return err
+	}
+	if id == nil {
+		return nil
+	}
+
+	d.Set("eventhub_name", id.Path["eventhubs"])
+
+	return nil
+}
+
+func resourceArmEventHubAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmEventHubResource(d, meta, "EventHub Authorization Rule")
+}
@@ -0,0 +1,117 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMEventHubAuthorizationRule_listenSend(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMEventHubAuthorizationRule_listenSend, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubAuthorizationRuleExists("azurerm_eventhub_authorization_rule.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_authorization_rule.test", "listen", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_authorization_rule.test", "send", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_authorization_rule.test", "manage", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMEventHubAuthorizationRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getEventHubAuthorizationRule{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get EventHub Authorization Rule: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get EventHub Authorization Rule: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMEventHubAuthorizationRuleDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_eventhub_authorization_rule" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getEventHubAuthorizationRule{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get EventHub Authorization Rule: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: EventHub Authorization Rule still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMEventHubAuthorizationRule_listenSend = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+    name = "acctesteventhubnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+    name = "acctesteventhub-%d"
+    namespace_name = "${azurerm_eventhub_namespace.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    partition_count = 2
+    message_retention = 1
+}
+
+resource "azurerm_eventhub_authorization_rule" "test" {
+    name = "acctestrule-%d"
+    namespace_name = "${azurerm_eventhub_namespace.test.name}"
+    eventhub_name = "${azurerm_eventhub.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    listen = true
+    send = true
+}
+`
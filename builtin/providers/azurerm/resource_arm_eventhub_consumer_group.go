@@ -0,0 +1,152 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmEventHubConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmEventHubConsumerGroupCreate,
+		Read:   resourceArmEventHubConsumerGroupRead,
+		Update: resourceArmEventHubConsumerGroupCreate,
+		Delete: resourceArmEventHubConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"eventhub_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"user_metadata": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmEventHubConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM EventHub Consumer Group creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	eventHubName := d.Get("eventhub_name").(string)
+
+	command := &createOrUpdateEventHubConsumerGroup{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		NamespaceName:     namespaceName,
+		EventHubName:      eventHubName,
+	}
+
+	if v, ok := d.GetOk("user_metadata"); ok {
+		command.UserMetadata = azure.String(v.(string))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating EventHub Consumer Group %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating EventHub Consumer Group %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getEventHubConsumerGroup{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		NamespaceName:     namespaceName,
+		EventHubName:      eventHubName,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading EventHub Consumer Group %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading EventHub Consumer Group %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getEventHubConsumerGroupResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read EventHub Consumer Group %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmEventHubConsumerGroupRead(d, meta)
+}
+
+func resourceArmEventHubConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getEventHubConsumerGroup{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure EventHub Consumer Group %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] EventHub Consumer Group %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure EventHub Consumer Group %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getEventHubConsumerGroupResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("namespace_name", id.Path["namespaces"])
+	d.Set("eventhub_name", id.Path["eventhubs"])
+	d.Set("user_metadata", resp.UserMetadata)
+
+	return nil
+}
+
+func resourceArmEventHubConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmEventHubResource(d, meta, "EventHub Consumer Group")
+}
@@ -0,0 +1,256 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+// eventHubNamespaceDefaultAuthorizationRuleName is the name of the rule
+// which Azure creates on every namespace, granting full access to it.
+const eventHubNamespaceDefaultAuthorizationRuleName = "RootManageSharedAccessKey"
+
+func resourceArmEventHubNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmEventHubNamespaceCreate,
+		Read:   resourceArmEventHubNamespaceRead,
+		Update: resourceArmEventHubNamespaceCreate,
+		Delete: resourceArmEventHubNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard"}, false),
+			},
+
+			"capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
+			"auto_inflate_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"maximum_throughput_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
+			"default_primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmEventHubNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM EventHub Namespace creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	sku := d.Get("sku").(string)
+	autoInflateEnabled := d.Get("auto_inflate_enabled").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateEventHubNamespace{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		Sku: &eventHubSku{
+			Name:     azure.String(sku),
+			Tier:     azure.String(sku),
+			Capacity: azure.Int32(int32(d.Get("capacity").(int))),
+		},
+		IsAutoInflateEnabled: azure.Bool(autoInflateEnabled),
+	}
+
+	if v, ok := d.GetOk("maximum_throughput_units"); ok {
+		if !autoInflateEnabled {
+			return fmt.Errorf("[ERROR] maximum_throughput_units can only be set when auto_inflate_enabled is true")
+		}
+		command.MaximumThroughputUnits = azure.Int32(int32(v.(int)))
+	}
+	if autoInflateEnabled && sku != "Standard" {
+		return fmt.Errorf("[ERROR] auto_inflate_enabled can only be set on a Standard EventHub Namespace")
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating EventHub Namespace %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating EventHub Namespace %q: %s", name, createResponse.Error)
+	}
+
+	getNamespaceCommand := &getEventHubNamespace{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = getNamespaceCommand
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading EventHub Namespace %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading EventHub Namespace %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getEventHubNamespaceResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read EventHub Namespace %s (resource group %s) ID", name, resGroup)
+	}
+
+	log.Printf("[DEBUG] Waiting for EventHub Namespace (%s) to become available", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Created", "Activating", "Updating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    azureStateRefreshFunc(*resp.ID, client, getNamespaceCommand),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EventHub Namespace (%s) to become available: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmEventHubNamespaceRead(d, meta)
+}
+
+func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["namespaces"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getEventHubNamespace{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure EventHub Namespace %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] EventHub Namespace %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure EventHub Namespace %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getEventHubNamespaceResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("auto_inflate_enabled", resp.IsAutoInflateEnabled)
+	d.Set("maximum_throughput_units", resp.MaximumThroughputUnits)
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+		d.Set("capacity", sku.Capacity)
+	}
+
+	keysRequest := rivieraClient.NewRequest()
+	keysRequest.Command = &listEventHubAuthorizationRuleKeys{
+		Name:       eventHubNamespaceDefaultAuthorizationRuleName,
+		ParentPath: eventHubNamespaceURLPath(id.ResourceGroup, name),
+	}
+
+	keysResponse, err := keysRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error listing default keys for EventHub Namespace %q: %s", name, err)
+	}
+	if keysResponse.IsSuccessful() {
+		keys := keysResponse.Parsed.(*listEventHubAuthorizationRuleKeysResponse)
+		d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
+		d.Set("default_secondary_connection_string", keys.SecondaryConnectionString)
+		d.Set("default_primary_key", keys.PrimaryKey)
+		d.Set("default_secondary_key", keys.SecondaryKey)
+	} else {
+		// The default rule can be removed from a namespace, which leaves
+		// nothing to export.
+		log.Printf("[WARN] Unable to list default keys for EventHub Namespace %q: %s", name, keysResponse.Error)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmEventHubResource(d, meta, "EventHub Namespace")
+}
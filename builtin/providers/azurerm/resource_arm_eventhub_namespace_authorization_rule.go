@@ -0,0 +1,38 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmEventHubNamespaceAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmEventHubNamespaceAuthorizationRuleCreate,
+		Read:   resourceArmEventHubNamespaceAuthorizationRuleRead,
+		Update: resourceArmEventHubNamespaceAuthorizationRuleCreate,
+		Delete: resourceArmEventHubNamespaceAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: serviceBusAuthorizationRuleSchema(),
+	}
+}
+
+func resourceArmEventHubNamespaceAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	parentPath := eventHubNamespaceURLPath(d.Get("resource_group_name").(string), d.Get("namespace_name").(string))
+
+	if err := createArmEventHubAuthorizationRule(d, meta, parentPath); err != nil {
+		return err
+	}
+
+	return resourceArmEventHubNamespaceAuthorizationRuleRead(d, meta)
+}
+
+func resourceArmEventHubNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := readArmEventHubAuthorizationRule(d, meta)
+	return err
+}
+
+func resourceArmEventHubNamespaceAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmEventHubResource(d, meta, "EventHub Authorization Rule")
+}
@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMEventHubNamespaceSku_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Basic",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Standard",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Premium",
+			ErrCount: 1,
+		},
+		{
+			Value:    "standard",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmEventHubNamespace().Schema["sku"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "sku")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Namespace SKU %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMEventHubNamespaceThroughputUnits_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    20,
+			ErrCount: 0,
+		},
+		{
+			Value:    21,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmEventHubNamespace().Schema["capacity"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "capacity")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Namespace throughput units %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMEventHubNamespace_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMEventHubNamespace_basic, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceExists("azurerm_eventhub_namespace.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_namespace.test", "sku", "Basic"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_namespace.test", "capacity", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMEventHubNamespace_autoInflate(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMEventHubNamespace_basic, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMEventHubNamespace_autoInflate, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceExists("azurerm_eventhub_namespace.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_namespace.test", "auto_inflate_enabled", "false"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceExists("azurerm_eventhub_namespace.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_namespace.test", "sku", "Standard"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_namespace.test", "auto_inflate_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub_namespace.test", "maximum_throughput_units", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMEventHubNamespaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getEventHubNamespace{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get EventHub Namespace: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get EventHub Namespace: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMEventHubNamespaceDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_eventhub_namespace" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getEventHubNamespace{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get EventHub Namespace: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: EventHub Namespace still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMEventHubNamespace_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+    name = "acctesteventhubnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Basic"
+}
+`
+
+var testAccAzureRMEventHubNamespace_autoInflate = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+    name = "acctesteventhubnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+    capacity = 2
+    auto_inflate_enabled = true
+    maximum_throughput_units = 10
+}
+`
@@ -0,0 +1,383 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMEventHubPartitionCount_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    1,
+			ErrCount: 1,
+		},
+		{
+			Value:    2,
+			ErrCount: 0,
+		},
+		{
+			Value:    32,
+			ErrCount: 0,
+		},
+		{
+			Value:    33,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmEventHub().Schema["partition_count"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "partition_count")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Partition Count %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMEventHubMessageRetention_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    7,
+			ErrCount: 0,
+		},
+		{
+			Value:    8,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmEventHub().Schema["message_retention"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "message_retention")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Message Retention %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMEventHubCaptureEncoding_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Avro",
+			ErrCount: 0,
+		},
+		{
+			Value:    "AvroDeflate",
+			ErrCount: 0,
+		},
+		{
+			Value:    "avro",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Json",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmEventHub().Schema["capture_description"].Elem.(*schema.Resource).Schema["encoding"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "encoding")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Capture encoding %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMEventHubCaptureInterval_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    59,
+			ErrCount: 1,
+		},
+		{
+			Value:    60,
+			ErrCount: 0,
+		},
+		{
+			Value:    900,
+			ErrCount: 0,
+		},
+		{
+			Value:    901,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmEventHub().Schema["capture_description"].Elem.(*schema.Resource).Schema["interval_in_seconds"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "interval_in_seconds")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Capture interval %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMEventHubCaptureSizeLimit_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    10485759,
+			ErrCount: 1,
+		},
+		{
+			Value:    10485760,
+			ErrCount: 0,
+		},
+		{
+			Value:    524288000,
+			ErrCount: 0,
+		},
+		{
+			Value:    524288001,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmEventHub().Schema["capture_description"].Elem.(*schema.Resource).Schema["size_limit_in_bytes"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "size_limit_in_bytes")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM EventHub Capture size limit %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMEventHub_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMEventHub_basic, ri, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMEventHub_update, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubExists("azurerm_eventhub.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub.test", "partition_count", "2"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub.test", "message_retention", "1"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubExists("azurerm_eventhub.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub.test", "message_retention", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMEventHub_capture(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := fmt.Sprintf(testAccAzureRMEventHub_capture, ri, ri, rs, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubExists("azurerm_eventhub.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub.test", "capture_description.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub.test", "capture_description.0.enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_eventhub.test", "capture_description.0.encoding", "Avro"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMEventHubExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getEventHub{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get EventHub: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get EventHub: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMEventHubDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_eventhub" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getEventHub{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get EventHub: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: EventHub still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMEventHub_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+    name = "acctesteventhubnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+    name = "acctesteventhub-%d"
+    namespace_name = "${azurerm_eventhub_namespace.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    partition_count = 2
+    message_retention = 1
+}
+`
+
+var testAccAzureRMEventHub_update = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+    name = "acctesteventhubnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+    name = "acctesteventhub-%d"
+    namespace_name = "${azurerm_eventhub_namespace.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    partition_count = 2
+    message_retention = 5
+}
+`
+
+var testAccAzureRMEventHub_capture = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+    name = "acctesteventhubnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+}
+
+resource "azurerm_storage_account" "test" {
+    name = "acctestacc%s"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    account_type = "Standard_LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+    name = "acctest"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    container_access_type = "private"
+}
+
+resource "azurerm_eventhub" "test" {
+    name = "acctesteventhub-%d"
+    namespace_name = "${azurerm_eventhub_namespace.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    partition_count = 2
+    message_retention = 1
+
+    capture_description {
+        enabled = true
+        encoding = "Avro"
+        interval_in_seconds = 60
+        size_limit_in_bytes = 10485760
+
+        destination {
+            name = "EventHubArchive.AzureBlockBlob"
+            archive_name_format = "{Namespace}/{EventHub}/{PartitionId}/{Year}/{Month}/{Day}/{Hour}/{Minute}/{Second}"
+            blob_container_name = "${azurerm_storage_container.test.name}"
+            storage_account_id = "${azurerm_storage_account.test.id}"
+        }
+    }
+}
+`
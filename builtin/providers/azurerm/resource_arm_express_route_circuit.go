@@ -0,0 +1,260 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmExpressRouteCircuit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteCircuitCreateOrUpdate,
+		Read:   resourceArmExpressRouteCircuitRead,
+		Update: resourceArmExpressRouteCircuitCreateOrUpdate,
+		Delete: resourceArmExpressRouteCircuitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"service_provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"peering_location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"bandwidth_in_mbps": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(network.ExpressRouteCircuitSkuTierStandard), string(network.ExpressRouteCircuitSkuTierPremium)}, false),
+						},
+
+						"family": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(network.MeteredData), string(network.UnlimitedData)}, false),
+						},
+					},
+				},
+			},
+
+			"allow_classic_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"service_provider_provisioning_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmExpressRouteCircuitCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ercClient := meta.(*ArmClient).expressRouteCircuitClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM ExpressRoute Circuit creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	location := d.Get("location").(string)
+	serviceProviderName := d.Get("service_provider_name").(string)
+	peeringLocation := d.Get("peering_location").(string)
+	bandwidthInMbps := int32(d.Get("bandwidth_in_mbps").(int))
+	allowClassicOperations := d.Get("allow_classic_operations").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	armMutexKV.Lock(name)
+	defer armMutexKV.Unlock(name)
+
+	properties := network.ExpressRouteCircuitPropertiesFormat{
+		AllowClassicOperations: &allowClassicOperations,
+		ServiceProviderProperties: &network.ExpressRouteCircuitServiceProviderProperties{
+			ServiceProviderName: &serviceProviderName,
+			PeeringLocation:     &peeringLocation,
+			BandwidthInMbps:     &bandwidthInMbps,
+		},
+	}
+
+	// The circuit is replaced as a whole, so the peerings and authorizations
+	// managed through their own resources are sent back unchanged.
+	if !d.IsNewResource() {
+		existing, err := ercClient.Get(resGroup, name)
+		if err != nil && existing.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Error retrieving ExpressRoute Circuit %q (Resource Group %q): %s", name, resGroup, err)
+		}
+		if existing.Properties != nil {
+			properties.Peerings = existing.Properties.Peerings
+			properties.Authorizations = existing.Properties.Authorizations
+		}
+	}
+
+	circuit := network.ExpressRouteCircuit{
+		Name:       &name,
+		Location:   &location,
+		Sku:        expandArmExpressRouteCircuitSku(d),
+		Properties: &properties,
+		Tags:       expandTags(tags),
+	}
+
+	_, err := ercClient.CreateOrUpdate(resGroup, name, circuit, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error creating ExpressRoute Circuit %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
+	read, err := ercClient.Get(resGroup, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ExpressRoute Circuit %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteCircuitRead(d, meta)
+}
+
+func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) error {
+	ercClient := meta.(*ArmClient).expressRouteCircuitClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["expressRouteCircuits"]
+
+	resp, err := ercClient.Get(resGroup, name)
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] ExpressRoute Circuit %q not found - removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure ExpressRoute Circuit %s: %s", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if resp.Sku != nil {
+		if err := d.Set("sku", flattenArmExpressRouteCircuitSku(resp.Sku)); err != nil {
+			return fmt.Errorf("Error flattening `sku`: %s", err)
+		}
+	}
+
+	if props := resp.Properties; props != nil {
+		if spp := props.ServiceProviderProperties; spp != nil {
+			d.Set("service_provider_name", spp.ServiceProviderName)
+			d.Set("peering_location", spp.PeeringLocation)
+			if spp.BandwidthInMbps != nil {
+				d.Set("bandwidth_in_mbps", int(*spp.BandwidthInMbps))
+			}
+		}
+
+		d.Set("allow_classic_operations", props.AllowClassicOperations)
+		d.Set("service_provider_provisioning_state", string(props.ServiceProviderProvisioningState))
+		d.Set("service_key", props.ServiceKey)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmExpressRouteCircuitDelete(d *schema.ResourceData, meta interface{}) error {
+	ercClient := meta.(*ArmClient).expressRouteCircuitClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["expressRouteCircuits"]
+
+	armMutexKV.Lock(name)
+	defer armMutexKV.Unlock(name)
+
+	_, err = ercClient.Delete(resGroup, name, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error deleting ExpressRoute Circuit %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmExpressRouteCircuitSku(d *schema.ResourceData) *network.ExpressRouteCircuitSku {
+	skuSettings := d.Get("sku").([]interface{})
+	sku := skuSettings[0].(map[string]interface{})
+
+	tier := sku["tier"].(string)
+	family := sku["family"].(string)
+	name := fmt.Sprintf("%s_%s", tier, family)
+
+	return &network.ExpressRouteCircuitSku{
+		Name:   &name,
+		Tier:   network.ExpressRouteCircuitSkuTier(tier),
+		Family: network.ExpressRouteCircuitSkuFamily(family),
+	}
+}
+
+func flattenArmExpressRouteCircuitSku(sku *network.ExpressRouteCircuitSku) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"tier":   string(sku.Tier),
+			"family": string(sku.Family),
+		},
+	}
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmExpressRouteCircuitAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteCircuitAuthorizationCreate,
+		Read:   resourceArmExpressRouteCircuitAuthorizationRead,
+		Delete: resourceArmExpressRouteCircuitAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"express_route_circuit_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"authorization_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"authorization_use_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmExpressRouteCircuitAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
+	ercaClient := meta.(*ArmClient).expressRouteAuthsClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM ExpressRoute Circuit Authorization creation.")
+
+	name := d.Get("name").(string)
+	circuitName := d.Get("express_route_circuit_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	authorization := network.ExpressRouteCircuitAuthorization{
+		Name:       &name,
+		Properties: &network.AuthorizationPropertiesFormat{},
+	}
+
+	armMutexKV.Lock(circuitName)
+	defer armMutexKV.Unlock(circuitName)
+
+	_, err := ercaClient.CreateOrUpdate(resGroup, circuitName, name, authorization, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error creating ExpressRoute Circuit Authorization %q (Circuit %q / Resource Group %q): %s", name, circuitName, resGroup, err)
+	}
+
+	read, err := ercaClient.Get(resGroup, circuitName, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ExpressRoute Circuit Authorization %s/%s (resource group %s) ID", circuitName, name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteCircuitAuthorizationRead(d, meta)
+}
+
+func resourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
+	ercaClient := meta.(*ArmClient).expressRouteAuthsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	name := id.Path["authorizations"]
+
+	resp, err := ercaClient.Get(resGroup, circuitName, name)
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] ExpressRoute Circuit Authorization %q (Circuit %q) not found - removing from state", name, circuitName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure ExpressRoute Circuit Authorization %s: %s", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("express_route_circuit_name", circuitName)
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.Properties; props != nil {
+		d.Set("authorization_key", props.AuthorizationKey)
+		d.Set("authorization_use_status", string(props.AuthorizationUseStatus))
+	}
+
+	return nil
+}
+
+func resourceArmExpressRouteCircuitAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
+	ercaClient := meta.(*ArmClient).expressRouteAuthsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	name := id.Path["authorizations"]
+
+	armMutexKV.Lock(circuitName)
+	defer armMutexKV.Unlock(circuitName)
+
+	_, err = ercaClient.Delete(resGroup, circuitName, name, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error deleting ExpressRoute Circuit Authorization %q (Circuit %q / Resource Group %q): %s", name, circuitName, resGroup, err)
+	}
+
+	return nil
+}
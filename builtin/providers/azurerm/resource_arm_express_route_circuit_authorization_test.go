@@ -0,0 +1,114 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMExpressRouteCircuitAuthorization_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMExpressRouteCircuitAuthorization_basic, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitAuthorizationExists("azurerm_express_route_circuit_authorization.test"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit_authorization.test", "authorization_use_status", "Available"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRouteCircuitAuthorizationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		circuitName := rs.Primary.Attributes["express_route_circuit_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for ExpressRoute Circuit Authorization: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).expressRouteAuthsClient
+
+		resp, err := conn.Get(resourceGroup, circuitName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on expressRouteAuthsClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: ExpressRoute Circuit Authorization %q (circuit: %q, resource group: %q) does not exist", name, circuitName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRouteCircuitAuthorizationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).expressRouteAuthsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_circuit_authorization" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		circuitName := rs.Primary.Attributes["express_route_circuit_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, circuitName, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("ExpressRoute Circuit Authorization still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMExpressRouteCircuitAuthorization_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+    name = "acctest-erc-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    service_provider_name = "Equinix"
+    peering_location = "Silicon Valley"
+    bandwidth_in_mbps = 50
+
+    sku {
+        tier = "Standard"
+        family = "MeteredData"
+    }
+}
+
+resource "azurerm_express_route_circuit_authorization" "test" {
+    name = "acctest-erca-%d"
+    express_route_circuit_name = "${azurerm_express_route_circuit.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`
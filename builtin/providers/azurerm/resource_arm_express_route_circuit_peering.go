@@ -0,0 +1,268 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmExpressRouteCircuitPeering() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteCircuitPeeringCreateOrUpdate,
+		Read:   resourceArmExpressRouteCircuitPeeringRead,
+		Update: resourceArmExpressRouteCircuitPeeringCreateOrUpdate,
+		Delete: resourceArmExpressRouteCircuitPeeringDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"peering_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(network.AzurePrivatePeering), string(network.AzurePublicPeering), string(network.MicrosoftPeering)}, false),
+			},
+
+			"express_route_circuit_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"primary_peer_address_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"secondary_peer_address_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"peer_asn": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"shared_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"microsoft_peering_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advertised_public_prefixes": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"azure_asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"primary_azure_port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_azure_port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmExpressRouteCircuitPeeringCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ercpClient := meta.(*ArmClient).expressRoutePeeringsClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM ExpressRoute Circuit Peering creation.")
+
+	// Azure requires a peering to be named after its type.
+	peeringType := d.Get("peering_type").(string)
+	circuitName := d.Get("express_route_circuit_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	primaryPeerAddressPrefix := d.Get("primary_peer_address_prefix").(string)
+	secondaryPeerAddressPrefix := d.Get("secondary_peer_address_prefix").(string)
+	vlanID := int32(d.Get("vlan_id").(int))
+
+	properties := network.ExpressRouteCircuitPeeringPropertiesFormat{
+		PeeringType:                network.ExpressRouteCircuitPeeringType(peeringType),
+		PrimaryPeerAddressPrefix:   &primaryPeerAddressPrefix,
+		SecondaryPeerAddressPrefix: &secondaryPeerAddressPrefix,
+		VlanID:                     &vlanID,
+	}
+
+	if v, ok := d.GetOk("peer_asn"); ok {
+		peerASN := int32(v.(int))
+		properties.PeerASN = &peerASN
+	}
+
+	if v, ok := d.GetOk("shared_key"); ok {
+		sharedKey := v.(string)
+		properties.SharedKey = &sharedKey
+	}
+
+	if _, ok := d.GetOk("microsoft_peering_config"); ok {
+		properties.MicrosoftPeeringConfig = expandArmExpressRouteCircuitPeeringMicrosoftConfig(d)
+	} else if peeringType == string(network.MicrosoftPeering) {
+		return fmt.Errorf("`microsoft_peering_config` must be specified when `peering_type` is `MicrosoftPeering`")
+	}
+
+	peering := network.ExpressRouteCircuitPeering{
+		Name:       &peeringType,
+		Properties: &properties,
+	}
+
+	// Peerings are part of the circuit, which can only be changed by one
+	// request at a time.
+	armMutexKV.Lock(circuitName)
+	defer armMutexKV.Unlock(circuitName)
+
+	_, err := ercpClient.CreateOrUpdate(resGroup, circuitName, peeringType, peering, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error creating ExpressRoute Circuit Peering %q (Circuit %q / Resource Group %q): %s", peeringType, circuitName, resGroup, err)
+	}
+
+	read, err := ercpClient.Get(resGroup, circuitName, peeringType)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ExpressRoute Circuit Peering %s/%s (resource group %s) ID", circuitName, peeringType, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteCircuitPeeringRead(d, meta)
+}
+
+func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	ercpClient := meta.(*ArmClient).expressRoutePeeringsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	peeringType := id.Path["peerings"]
+
+	resp, err := ercpClient.Get(resGroup, circuitName, peeringType)
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] ExpressRoute Circuit Peering %q (Circuit %q) not found - removing from state", peeringType, circuitName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure ExpressRoute Circuit Peering %s: %s", peeringType, err)
+	}
+
+	d.Set("peering_type", peeringType)
+	d.Set("express_route_circuit_name", circuitName)
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.Properties; props != nil {
+		d.Set("primary_peer_address_prefix", props.PrimaryPeerAddressPrefix)
+		d.Set("secondary_peer_address_prefix", props.SecondaryPeerAddressPrefix)
+		d.Set("primary_azure_port", props.PrimaryAzurePort)
+		d.Set("secondary_azure_port", props.SecondaryAzurePort)
+
+		if props.VlanID != nil {
+			d.Set("vlan_id", int(*props.VlanID))
+		}
+		if props.PeerASN != nil {
+			d.Set("peer_asn", int(*props.PeerASN))
+		}
+		if props.AzureASN != nil {
+			d.Set("azure_asn", int(*props.AzureASN))
+		}
+
+		if err := d.Set("microsoft_peering_config", flattenArmExpressRouteCircuitPeeringMicrosoftConfig(props.MicrosoftPeeringConfig)); err != nil {
+			return fmt.Errorf("Error flattening `microsoft_peering_config`: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmExpressRouteCircuitPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+	ercpClient := meta.(*ArmClient).expressRoutePeeringsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	peeringType := id.Path["peerings"]
+
+	armMutexKV.Lock(circuitName)
+	defer armMutexKV.Unlock(circuitName)
+
+	_, err = ercpClient.Delete(resGroup, circuitName, peeringType, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error deleting ExpressRoute Circuit Peering %q (Circuit %q / Resource Group %q): %s", peeringType, circuitName, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmExpressRouteCircuitPeeringMicrosoftConfig(d *schema.ResourceData) *network.ExpressRouteCircuitPeeringConfig {
+	configs := d.Get("microsoft_peering_config").([]interface{})
+	config := configs[0].(map[string]interface{})
+
+	prefixes := make([]string, 0)
+	for _, prefix := range config["advertised_public_prefixes"].([]interface{}) {
+		prefixes = append(prefixes, prefix.(string))
+	}
+
+	return &network.ExpressRouteCircuitPeeringConfig{
+		AdvertisedPublicPrefixes: &prefixes,
+	}
+}
+
+func flattenArmExpressRouteCircuitPeeringMicrosoftConfig(config *network.ExpressRouteCircuitPeeringConfig) []interface{} {
+	if config == nil || config.AdvertisedPublicPrefixes == nil {
+		return []interface{}{}
+	}
+
+	prefixes := make([]interface{}, 0, len(*config.AdvertisedPublicPrefixes))
+	for _, prefix := range *config.AdvertisedPublicPrefixes {
+		prefixes = append(prefixes, prefix)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"advertised_public_prefixes": prefixes,
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMExpressRouteCircuitPeeringType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "AzurePrivatePeering",
+			ErrCount: 0,
+		},
+		{
+			Value:    "AzurePublicPeering",
+			ErrCount: 0,
+		},
+		{
+			Value:    "MicrosoftPeering",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmExpressRouteCircuitPeering().Schema["peering_type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "peering_type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM ExpressRoute Circuit Peering type to trigger a validation error")
+		}
+	}
+}
+
+func TestAccAzureRMExpressRouteCircuitPeering_azurePrivatePeering(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMExpressRouteCircuitPeering_azurePrivatePeering, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitPeeringExists("azurerm_express_route_circuit_peering.test"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit_peering.test", "peering_type", "AzurePrivatePeering"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit_peering.test", "vlan_id", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRouteCircuitPeeringExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		peeringType := rs.Primary.Attributes["peering_type"]
+		circuitName := rs.Primary.Attributes["express_route_circuit_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for ExpressRoute Circuit Peering: %s", peeringType)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).expressRoutePeeringsClient
+
+		resp, err := conn.Get(resourceGroup, circuitName, peeringType)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on expressRoutePeeringsClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: ExpressRoute Circuit Peering %q (circuit: %q, resource group: %q) does not exist", peeringType, circuitName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRouteCircuitPeeringDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).expressRoutePeeringsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_circuit_peering" {
+			continue
+		}
+
+		peeringType := rs.Primary.Attributes["peering_type"]
+		circuitName := rs.Primary.Attributes["express_route_circuit_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, circuitName, peeringType)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("ExpressRoute Circuit Peering still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMExpressRouteCircuitPeering_azurePrivatePeering = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+    name = "acctest-erc-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    service_provider_name = "Equinix"
+    peering_location = "Silicon Valley"
+    bandwidth_in_mbps = 50
+
+    sku {
+        tier = "Standard"
+        family = "MeteredData"
+    }
+}
+
+resource "azurerm_express_route_circuit_peering" "test" {
+    peering_type = "AzurePrivatePeering"
+    express_route_circuit_name = "${azurerm_express_route_circuit.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    peer_asn = 100
+    primary_peer_address_prefix = "192.168.1.0/30"
+    secondary_peer_address_prefix = "192.168.2.0/30"
+    vlan_id = 100
+}
+`
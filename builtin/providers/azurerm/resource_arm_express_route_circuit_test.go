@@ -0,0 +1,248 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMExpressRouteCircuitSkuTier_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Standard",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Premium",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmExpressRouteCircuit().Schema["sku"].Elem.(*schema.Resource).Schema["tier"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "tier")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM ExpressRoute Circuit SKU tier to trigger a validation error")
+		}
+	}
+}
+
+func TestResourceAzureRMExpressRouteCircuitSkuFamily_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "MeteredData",
+			ErrCount: 0,
+		},
+		{
+			Value:    "UnlimitedData",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmExpressRouteCircuit().Schema["sku"].Elem.(*schema.Resource).Schema["family"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "family")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM ExpressRoute Circuit SKU family to trigger a validation error")
+		}
+	}
+}
+
+func TestAccAzureRMExpressRouteCircuit_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMExpressRouteCircuit_basic, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit.test", "sku.0.tier", "Standard"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit.test", "service_provider_provisioning_state", "NotProvisioned"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMExpressRouteCircuit_withTags(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMExpressRouteCircuit_withTags, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMExpressRouteCircuit_withTagsUpdate, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit.test", "tags.environment", "Production"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitExists("azurerm_express_route_circuit.test"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("azurerm_express_route_circuit.test", "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRouteCircuitExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for ExpressRoute Circuit: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient
+
+		resp, err := conn.Get(resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on expressRouteCircuitClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: ExpressRoute Circuit %q (resource group: %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRouteCircuitDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_circuit" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("ExpressRoute Circuit still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMExpressRouteCircuit_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+    name = "acctest-erc-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    service_provider_name = "Equinix"
+    peering_location = "Silicon Valley"
+    bandwidth_in_mbps = 50
+
+    sku {
+        tier = "Standard"
+        family = "MeteredData"
+    }
+}
+`
+
+var testAccAzureRMExpressRouteCircuit_withTags = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+    name = "acctest-erc-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    service_provider_name = "Equinix"
+    peering_location = "Silicon Valley"
+    bandwidth_in_mbps = 50
+
+    sku {
+        tier = "Standard"
+        family = "MeteredData"
+    }
+
+    tags {
+        environment = "Production"
+        cost_center = "MSFT"
+    }
+}
+`
+
+var testAccAzureRMExpressRouteCircuit_withTagsUpdate = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+    name = "acctest-erc-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    service_provider_name = "Equinix"
+    peering_location = "Silicon Valley"
+    bandwidth_in_mbps = 50
+
+    sku {
+        tier = "Standard"
+        family = "MeteredData"
+    }
+
+    tags {
+        environment = "staging"
+    }
+}
+`
@@ -0,0 +1,522 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+// functionAppManagedSettings are the app settings which are derived from the
+// arguments of a Function App, and so are excluded from `app_settings`.
+var functionAppManagedSettings = []string{
+	"AzureWebJobsDashboard",
+	"AzureWebJobsStorage",
+	"FUNCTIONS_EXTENSION_VERSION",
+	"WEBSITE_CONTENTAZUREFILECONNECTIONSTRING",
+	"WEBSITE_CONTENTSHARE",
+	"WEBSITE_RUN_FROM_ZIP",
+}
+
+func resourceArmFunctionApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFunctionAppCreateOrUpdate,
+		Read:   resourceArmFunctionAppRead,
+		Update: resourceArmFunctionAppCreateOrUpdate,
+		Delete: resourceArmFunctionAppDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmFunctionAppName,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_service_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"storage_connection_string": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "~1",
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"client_affinity_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"app_settings": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"site_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"always_on": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"use_32_bit_worker_process": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"websockets_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"source_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repo_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"branch": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "master",
+						},
+
+						"manual_integration": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"zip_deploy_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"default_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ip_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmFunctionAppCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Function App creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	appServicePlanID := d.Get("app_service_plan_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	consumptionPlan, err := isArmFunctionAppConsumptionPlan(rivieraClient, appServicePlanID)
+	if err != nil {
+		return err
+	}
+
+	// The app settings are sent as part of the site so that the content
+	// share required by consumption plans exists before the app starts.
+	siteConfig := expandArmFunctionAppSiteConfig(d)
+	appSettings := expandArmFunctionAppAppSettings(d, consumptionPlan)
+	siteConfig.AppSettings = &appSettings
+
+	command := &createOrUpdateFunctionApp{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		Kind:              azure.String("functionapp"),
+		ServerFarmID:      azure.String(appServicePlanID),
+		Enabled:           azure.Bool(d.Get("enabled").(bool)),
+		SiteConfig:        siteConfig,
+	}
+
+	if v, ok := d.GetOk("client_affinity_enabled"); ok {
+		command.ClientAffinityEnabled = azure.Bool(v.(bool))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Function App %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Function App %q: %s", name, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getAppServiceResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Function App %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	if d.HasChange("source_control") {
+		if err := updateArmFunctionAppSourceControl(d, meta, name, resGroup); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmFunctionAppRead(d, meta)
+}
+
+func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getAppService{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Function App %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Function App %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Function App %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getAppServiceResponse)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("app_service_plan_id", resp.ServerFarmID)
+	d.Set("enabled", resp.Enabled)
+	d.Set("client_affinity_enabled", resp.ClientAffinityEnabled)
+	d.Set("default_hostname", resp.DefaultHostName)
+	d.Set("outbound_ip_addresses", resp.OutboundIPAddresses)
+
+	configRequest := rivieraClient.NewRequest()
+	configRequest.Command = &getAppServiceSiteConfig{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	configResponse, err := configRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading site configuration of Function App %q: %s", name, err)
+	}
+	if !configResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading site configuration of Function App %q: %s", name, configResponse.Error)
+	}
+
+	siteConfig := flattenArmFunctionAppSiteConfig(configResponse.Parsed.(*appServiceSiteConfig))
+	if err := d.Set("site_config", siteConfig); err != nil {
+		return fmt.Errorf("Error flattening `site_config` for Function App %q: %s", name, err)
+	}
+
+	settingsRequest := rivieraClient.NewRequest()
+	settingsRequest.Command = &listAppServiceAppSettings{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	settingsResponse, err := settingsRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading app settings of Function App %q: %s", name, err)
+	}
+	if !settingsResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading app settings of Function App %q: %s", name, settingsResponse.Error)
+	}
+
+	settings := settingsResponse.Parsed.(*listAppServiceAppSettingsResponse).Properties
+	d.Set("storage_connection_string", settings["AzureWebJobsStorage"])
+	d.Set("version", settings["FUNCTIONS_EXTENSION_VERSION"])
+	d.Set("zip_deploy_url", settings["WEBSITE_RUN_FROM_ZIP"])
+
+	appSettings := make(map[string]string)
+	for k, v := range settings {
+		appSettings[k] = v
+	}
+	for _, k := range functionAppManagedSettings {
+		delete(appSettings, k)
+	}
+	if err := d.Set("app_settings", appSettings); err != nil {
+		return fmt.Errorf("Error flattening `app_settings` for Function App %q: %s", name, err)
+	}
+
+	sourceControlRequest := rivieraClient.NewRequest()
+	sourceControlRequest.Command = &getAppServiceSourceControl{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	sourceControlResponse, err := sourceControlRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading source control of Function App %q: %s", name, err)
+	}
+	if sourceControlResponse.IsSuccessful() {
+		sourceControl := flattenArmFunctionAppSourceControl(sourceControlResponse.Parsed.(*appServiceSourceControl))
+		if err := d.Set("source_control", sourceControl); err != nil {
+			return fmt.Errorf("Error flattening `source_control` for Function App %q: %s", name, err)
+		}
+	} else if sourceControlResponse.HTTP.StatusCode == http.StatusNotFound {
+		d.Set("source_control", []interface{}{})
+	} else {
+		return fmt.Errorf("Error reading source control of Function App %q: %s", name, sourceControlResponse.Error)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmFunctionAppDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteAppService{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Function App %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Function App %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func updateArmFunctionAppSourceControl(d *schema.ResourceData, meta interface{}, name, resGroup string) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	sourceControls := d.Get("source_control").([]interface{})
+	if len(sourceControls) == 0 {
+		deleteRequest := rivieraClient.NewRequest()
+		deleteRequest.Command = &deleteAppServiceSourceControl{
+			Name:              name,
+			ResourceGroupName: resGroup,
+		}
+
+		deleteResponse, err := deleteRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Error removing source control of Function App %q: %s", name, err)
+		}
+		if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Error removing source control of Function App %q: %s", name, deleteResponse.Error)
+		}
+
+		return nil
+	}
+
+	sourceControl := sourceControls[0].(map[string]interface{})
+
+	updateRequest := rivieraClient.NewRequest()
+	updateRequest.Command = &updateAppServiceSourceControl{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		SourceControl: appServiceSourceControl{
+			RepoURL:             azure.String(sourceControl["repo_url"].(string)),
+			Branch:              azure.String(sourceControl["branch"].(string)),
+			IsManualIntegration: azure.Bool(sourceControl["manual_integration"].(bool)),
+		},
+	}
+
+	updateResponse, err := updateRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error updating source control of Function App %q: %s", name, err)
+	}
+	if !updateResponse.IsSuccessful() {
+		return fmt.Errorf("Error updating source control of Function App %q: %s", name, updateResponse.Error)
+	}
+
+	return nil
+}
+
+// isArmFunctionAppConsumptionPlan returns whether the App Service Plan is a
+// consumption plan, which requires the app's content to be kept in a file
+// share in the storage account.
+func isArmFunctionAppConsumptionPlan(rivieraClient *azure.Client, appServicePlanID string) (bool, error) {
+	readRequest := rivieraClient.NewRequestForURI(appServicePlanID)
+	readRequest.Command = &getAppServicePlan{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return false, fmt.Errorf("Error reading App Service Plan %s: %s", appServicePlanID, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return false, fmt.Errorf("Error reading App Service Plan %s: %s", appServicePlanID, readResponse.Error)
+	}
+
+	plan := readResponse.Parsed.(*getAppServicePlanResponse)
+	if plan.Sku == nil || plan.Sku.Tier == nil {
+		return false, nil
+	}
+
+	return strings.EqualFold(*plan.Sku.Tier, "Dynamic"), nil
+}
+
+func expandArmFunctionAppAppSettings(d *schema.ResourceData, consumptionPlan bool) []appServiceNameValuePair {
+	storageConnectionString := d.Get("storage_connection_string").(string)
+
+	settings := map[string]string{
+		"AzureWebJobsDashboard":       storageConnectionString,
+		"AzureWebJobsStorage":         storageConnectionString,
+		"FUNCTIONS_EXTENSION_VERSION": d.Get("version").(string),
+	}
+
+	if consumptionPlan {
+		settings["WEBSITE_CONTENTAZUREFILECONNECTIONSTRING"] = storageConnectionString
+		settings["WEBSITE_CONTENTSHARE"] = strings.ToLower(d.Get("name").(string))
+	}
+
+	if v, ok := d.GetOk("zip_deploy_url"); ok {
+		settings["WEBSITE_RUN_FROM_ZIP"] = v.(string)
+	}
+
+	for k, v := range d.Get("app_settings").(map[string]interface{}) {
+		settings[k] = v.(string)
+	}
+
+	result := make([]appServiceNameValuePair, 0, len(settings))
+	for k, v := range settings {
+		result = append(result, appServiceNameValuePair{
+			Name:  azure.String(k),
+			Value: azure.String(v),
+		})
+	}
+
+	return result
+}
+
+func expandArmFunctionAppSiteConfig(d *schema.ResourceData) *functionAppSiteConfig {
+	siteConfig := &functionAppSiteConfig{}
+
+	configs := d.Get("site_config").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return siteConfig
+	}
+
+	config := configs[0].(map[string]interface{})
+
+	siteConfig.AlwaysOn = azure.Bool(config["always_on"].(bool))
+	siteConfig.Use32BitWorkerProcess = azure.Bool(config["use_32_bit_worker_process"].(bool))
+	siteConfig.WebSocketsEnabled = azure.Bool(config["websockets_enabled"].(bool))
+
+	return siteConfig
+}
+
+func flattenArmFunctionAppSiteConfig(config *appServiceSiteConfig) []interface{} {
+	result := make(map[string]interface{})
+
+	if config.AlwaysOn != nil {
+		result["always_on"] = *config.AlwaysOn
+	}
+	if config.Use32BitWorkerProcess != nil {
+		result["use_32_bit_worker_process"] = *config.Use32BitWorkerProcess
+	}
+	if config.WebSocketsEnabled != nil {
+		result["websockets_enabled"] = *config.WebSocketsEnabled
+	}
+
+	return []interface{}{result}
+}
+
+func flattenArmFunctionAppSourceControl(sourceControl *appServiceSourceControl) []interface{} {
+	if sourceControl.RepoURL == nil || *sourceControl.RepoURL == "" {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"repo_url": *sourceControl.RepoURL,
+	}
+	if sourceControl.Branch != nil {
+		result["branch"] = *sourceControl.Branch
+	}
+	if sourceControl.IsManualIntegration != nil {
+		result["manual_integration"] = *sourceControl.IsManualIntegration
+	}
+
+	return []interface{}{result}
+}
+
+func validateArmFunctionAppName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z-]{2,60}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters and dashes and must be between 2 and 60 characters", k))
+	}
+	return
+}
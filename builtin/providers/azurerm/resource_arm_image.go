@@ -0,0 +1,413 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmImageCreate,
+		Read:   resourceArmImageRead,
+		Update: resourceArmImageCreate,
+		Delete: resourceArmImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_virtual_machine_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"source_virtual_machine_id", "os_disk"},
+			},
+
+			"os_disk": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"source_virtual_machine_id", "os_disk"},
+				MaxItems:     1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Linux", "Windows"}, false),
+						},
+
+						"os_state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "Generalized",
+							ValidateFunc: validation.StringInSlice([]string{"Generalized", "Specialized"}, false),
+						},
+
+						"managed_disk_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"blob_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+
+						"caching": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"None", "ReadOnly", "ReadWrite"}, false),
+						},
+
+						"size_gb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"data_disk": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lun": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"managed_disk_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"blob_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+
+						"caching": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"None", "ReadOnly", "ReadWrite"}, false),
+						},
+
+						"size_gb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmImageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Image creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateImage{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+	}
+
+	if v, ok := d.GetOk("source_virtual_machine_id"); ok {
+		command.SourceVirtualMachine = &imageSubResource{
+			ID: azure.String(v.(string)),
+		}
+	} else {
+		command.StorageProfile = expandArmImageStorageProfile(d)
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Image %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Image %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getImage{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Image %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Image %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getImageResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Image %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmImageRead(d, meta)
+}
+
+func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getImage{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Image %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Image %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Image %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getImageResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if resp.SourceVirtualMachine != nil {
+		d.Set("source_virtual_machine_id", resp.SourceVirtualMachine.ID)
+	}
+
+	if profile := resp.StorageProfile; profile != nil {
+		if profile.OSDisk != nil {
+			if err := d.Set("os_disk", flattenArmImageOSDisk(profile.OSDisk)); err != nil {
+				return fmt.Errorf("Error flattening `os_disk` for Image %q: %s", *resp.Name, err)
+			}
+		}
+
+		if err := d.Set("data_disk", flattenArmImageDataDisks(profile.DataDisks)); err != nil {
+			return fmt.Errorf("Error flattening `data_disk` for Image %q: %s", *resp.Name, err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteImage{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Image %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Image %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmImageStorageProfile(d *schema.ResourceData) *imageStorageProfile {
+	profile := &imageStorageProfile{}
+
+	if disks := d.Get("os_disk").([]interface{}); len(disks) > 0 {
+		config := disks[0].(map[string]interface{})
+
+		osDisk := &imageOSDisk{
+			OSType:  azure.String(config["os_type"].(string)),
+			OSState: azure.String(config["os_state"].(string)),
+		}
+		if v := config["managed_disk_id"].(string); v != "" {
+			osDisk.ManagedDisk = &imageSubResource{ID: azure.String(v)}
+		}
+		if v := config["snapshot_id"].(string); v != "" {
+			osDisk.Snapshot = &imageSubResource{ID: azure.String(v)}
+		}
+		if v := config["blob_uri"].(string); v != "" {
+			osDisk.BlobURI = azure.String(v)
+		}
+		if v := config["caching"].(string); v != "" {
+			osDisk.Caching = azure.String(v)
+		}
+		if v := config["size_gb"].(int); v > 0 {
+			osDisk.DiskSizeGB = azure.Int32(int32(v))
+		}
+
+		profile.OSDisk = osDisk
+	}
+
+	for _, raw := range d.Get("data_disk").([]interface{}) {
+		config := raw.(map[string]interface{})
+
+		dataDisk := imageDataDisk{
+			Lun: azure.Int32(int32(config["lun"].(int))),
+		}
+		if v := config["managed_disk_id"].(string); v != "" {
+			dataDisk.ManagedDisk = &imageSubResource{ID: azure.String(v)}
+		}
+		if v := config["snapshot_id"].(string); v != "" {
+			dataDisk.Snapshot = &imageSubResource{ID: azure.String(v)}
+		}
+		if v := config["blob_uri"].(string); v != "" {
+			dataDisk.BlobURI = azure.String(v)
+		}
+		if v := config["caching"].(string); v != "" {
+			dataDisk.Caching = azure.String(v)
+		}
+		if v := config["size_gb"].(int); v > 0 {
+			dataDisk.DiskSizeGB = azure.Int32(int32(v))
+		}
+
+		profile.DataDisks = append(profile.DataDisks, dataDisk)
+	}
+
+	return profile
+}
+
+func flattenArmImageOSDisk(disk *imageOSDisk) []interface{} {
+	result := map[string]interface{}{}
+
+	if disk.OSType != nil {
+		result["os_type"] = *disk.OSType
+	}
+	if disk.OSState != nil {
+		result["os_state"] = *disk.OSState
+	}
+	if disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
+		result["managed_disk_id"] = *disk.ManagedDisk.ID
+	}
+	if disk.Snapshot != nil && disk.Snapshot.ID != nil {
+		result["snapshot_id"] = *disk.Snapshot.ID
+	}
+	if disk.BlobURI != nil {
+		result["blob_uri"] = *disk.BlobURI
+	}
+	if disk.Caching != nil {
+		result["caching"] = *disk.Caching
+	}
+	if disk.DiskSizeGB != nil {
+		result["size_gb"] = int(*disk.DiskSizeGB)
+	}
+
+	return []interface{}{result}
+}
+
+func flattenArmImageDataDisks(disks []imageDataDisk) []interface{} {
+	result := make([]interface{}, 0, len(disks))
+
+	for _, disk := range disks {
+		flattened := map[string]interface{}{}
+
+		if disk.Lun != nil {
+			flattened["lun"] = int(*disk.Lun)
+		}
+		if disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
+			flattened["managed_disk_id"] = *disk.ManagedDisk.ID
+		}
+		if disk.Snapshot != nil && disk.Snapshot.ID != nil {
+			flattened["snapshot_id"] = *disk.Snapshot.ID
+		}
+		if disk.BlobURI != nil {
+			flattened["blob_uri"] = *disk.BlobURI
+		}
+		if disk.Caching != nil {
+			flattened["caching"] = *disk.Caching
+		}
+		if disk.DiskSizeGB != nil {
+			flattened["size_gb"] = int(*disk.DiskSizeGB)
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result
+}
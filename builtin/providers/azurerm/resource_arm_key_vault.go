@@ -0,0 +1,371 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmKeyVault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCreate,
+		Read:   resourceArmKeyVaultRead,
+		Update: resourceArmKeyVaultCreate,
+		Delete: resourceArmKeyVaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultName,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"standard", "premium"}, false),
+						},
+					},
+				},
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"access_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 16,
+				Elem: &schema.Resource{
+					Schema: keyVaultAccessPolicySchema(),
+				},
+			},
+
+			"enabled_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"enabled_for_disk_encryption": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"enabled_for_template_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// keyVaultAccessPolicySchema returns the fields which describe a single access
+// policy. They are shared between the access_policy blocks of azurerm_key_vault
+// and the standalone azurerm_key_vault_access_policy resource.
+func keyVaultAccessPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tenant_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"object_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"application_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"key_permissions": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"all", "backup", "create", "decrypt", "delete", "encrypt", "get", "import", "list", "restore", "sign", "unwrapkey", "update", "verify", "wrapkey"}, true),
+			},
+		},
+
+		"secret_permissions": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"all", "backup", "delete", "get", "list", "restore", "set"}, true),
+			},
+		},
+
+		"certificate_permissions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"all", "create", "delete", "deleteissuers", "get", "getissuers", "import", "list", "listissuers", "managecontacts", "manageissuers", "setissuers", "update"}, true),
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Key Vault creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	armMutexKV.Lock(name)
+	defer armMutexKV.Unlock(name)
+
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateKeyVault{
+		Name:                         name,
+		ResourceGroupName:            resGroup,
+		Location:                     d.Get("location").(string),
+		Tags:                         *expandedTags,
+		TenantID:                     azure.String(d.Get("tenant_id").(string)),
+		Sku:                          expandKeyVaultSku(d),
+		AccessPolicies:               expandKeyVaultAccessPolicies(d),
+		EnabledForDeployment:         azure.Bool(d.Get("enabled_for_deployment").(bool)),
+		EnabledForDiskEncryption:     azure.Bool(d.Get("enabled_for_disk_encryption").(bool)),
+		EnabledForTemplateDeployment: azure.Bool(d.Get("enabled_for_template_deployment").(bool)),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Key Vault %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Key Vault %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getKeyVault{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Key Vault %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Key Vault %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getKeyVaultResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Key Vault %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmKeyVaultRead(d, meta)
+}
+
+func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getKeyVault{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Key Vault %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Key Vault %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Key Vault %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getKeyVaultResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("tenant_id", resp.TenantID)
+	d.Set("vault_uri", resp.VaultURI)
+	d.Set("enabled_for_deployment", resp.EnabledForDeployment)
+	d.Set("enabled_for_disk_encryption", resp.EnabledForDiskEncryption)
+	d.Set("enabled_for_template_deployment", resp.EnabledForTemplateDeployment)
+
+	if err := d.Set("sku", flattenKeyVaultSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error flattening `sku` for Key Vault %q: %s", *resp.Name, err)
+	}
+
+	policies := make([]interface{}, 0, len(resp.AccessPolicies))
+	for _, policy := range resp.AccessPolicies {
+		policies = append(policies, flattenKeyVaultAccessPolicy(policy))
+	}
+	if err := d.Set("access_policy", policies); err != nil {
+		return fmt.Errorf("Error flattening `access_policy` for Key Vault %q: %s", *resp.Name, err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteKeyVault{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Key Vault %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Key Vault %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandKeyVaultSku(d *schema.ResourceData) *keyVaultSku {
+	skus := d.Get("sku").([]interface{})
+	sku := skus[0].(map[string]interface{})
+
+	return &keyVaultSku{
+		Family: azure.String("A"),
+		Name:   azure.String(sku["name"].(string)),
+	}
+}
+
+func expandKeyVaultAccessPolicies(d *schema.ResourceData) []keyVaultAccessPolicy {
+	policies := d.Get("access_policy").([]interface{})
+	result := make([]keyVaultAccessPolicy, 0, len(policies))
+
+	for _, policySet := range policies {
+		result = append(result, expandKeyVaultAccessPolicy(policySet.(map[string]interface{})))
+	}
+
+	return result
+}
+
+func expandKeyVaultAccessPolicy(policy map[string]interface{}) keyVaultAccessPolicy {
+	result := keyVaultAccessPolicy{
+		TenantID: azure.String(policy["tenant_id"].(string)),
+		ObjectID: azure.String(policy["object_id"].(string)),
+		Permissions: keyVaultPermissions{
+			Keys:         expandKeyVaultPermissionList(policy["key_permissions"]),
+			Secrets:      expandKeyVaultPermissionList(policy["secret_permissions"]),
+			Certificates: expandKeyVaultPermissionList(policy["certificate_permissions"]),
+		},
+	}
+
+	if v, ok := policy["application_id"]; ok && v.(string) != "" {
+		result.ApplicationID = azure.String(v.(string))
+	}
+
+	return result
+}
+
+func expandKeyVaultPermissionList(v interface{}) []string {
+	permissions := make([]string, 0)
+	if v == nil {
+		return permissions
+	}
+
+	for _, permission := range v.([]interface{}) {
+		permissions = append(permissions, permission.(string))
+	}
+
+	return permissions
+}
+
+func flattenKeyVaultSku(sku *keyVaultSku) []interface{} {
+	result := make([]interface{}, 0, 1)
+	if sku == nil || sku.Name == nil {
+		return result
+	}
+
+	return append(result, map[string]interface{}{
+		"name": *sku.Name,
+	})
+}
+
+func flattenKeyVaultAccessPolicy(policy keyVaultAccessPolicy) map[string]interface{} {
+	result := map[string]interface{}{
+		"key_permissions":         policy.Permissions.Keys,
+		"secret_permissions":      policy.Permissions.Secrets,
+		"certificate_permissions": policy.Permissions.Certificates,
+	}
+
+	if policy.TenantID != nil {
+		result["tenant_id"] = *policy.TenantID
+	}
+	if policy.ObjectID != nil {
+		result["object_id"] = *policy.ObjectID
+	}
+	if policy.ApplicationID != nil {
+		result["application_id"] = *policy.ApplicationID
+	}
+
+	return result
+}
+
+func validateKeyVaultName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9-]{3,24}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters and dashes and must be between 3-24 chars", k))
+	}
+
+	return
+}
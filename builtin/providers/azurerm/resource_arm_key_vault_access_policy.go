@@ -0,0 +1,231 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmKeyVaultAccessPolicy() *schema.Resource {
+	policySchema := keyVaultAccessPolicySchema()
+	for _, key := range []string{"tenant_id", "object_id", "application_id"} {
+		policySchema[key].ForceNew = true
+	}
+
+	policySchema["vault_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	policySchema["resource_group_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmKeyVaultAccessPolicyCreate,
+		Read:   resourceArmKeyVaultAccessPolicyRead,
+		Update: resourceArmKeyVaultAccessPolicyCreate,
+		Delete: resourceArmKeyVaultAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: policySchema,
+	}
+}
+
+func resourceArmKeyVaultAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	vaultName := d.Get("vault_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	armMutexKV.Lock(vaultName)
+	defer armMutexKV.Unlock(vaultName)
+
+	vault, err := getKeyVaultForAccessPolicy(meta, resGroup, vaultName)
+	if err != nil {
+		return err
+	}
+	if vault == nil {
+		return fmt.Errorf("Key Vault %q (resource group %q) was not found", vaultName, resGroup)
+	}
+
+	policy := expandKeyVaultAccessPolicy(map[string]interface{}{
+		"tenant_id":               d.Get("tenant_id"),
+		"object_id":               d.Get("object_id"),
+		"application_id":          d.Get("application_id"),
+		"key_permissions":         d.Get("key_permissions"),
+		"secret_permissions":      d.Get("secret_permissions"),
+		"certificate_permissions": d.Get("certificate_permissions"),
+	})
+
+	policies := make([]keyVaultAccessPolicy, 0, len(vault.AccessPolicies)+1)
+	for _, existing := range vault.AccessPolicies {
+		if !keyVaultAccessPolicyMatches(existing, *policy.ObjectID, d.Get("application_id").(string)) {
+			policies = append(policies, existing)
+		}
+	}
+	policies = append(policies, policy)
+
+	if err := updateKeyVaultAccessPolicies(meta, resGroup, vault, policies); err != nil {
+		return err
+	}
+
+	id := fmt.Sprintf("%s/objectId/%s", *vault.ID, *policy.ObjectID)
+	if policy.ApplicationID != nil {
+		id = fmt.Sprintf("%s/applicationId/%s", id, *policy.ApplicationID)
+	}
+	d.SetId(id)
+
+	return resourceArmKeyVaultAccessPolicyRead(d, meta)
+}
+
+func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	objectID := id.Path["objectId"]
+	applicationID := id.Path["applicationId"]
+
+	vault, err := getKeyVaultForAccessPolicy(meta, resGroup, vaultName)
+	if err != nil {
+		return err
+	}
+	if vault == nil {
+		log.Printf("[INFO] Key Vault %q not found - removing access policy %q from state", vaultName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var policy *keyVaultAccessPolicy
+	for _, existing := range vault.AccessPolicies {
+		if keyVaultAccessPolicyMatches(existing, objectID, applicationID) {
+			p := existing
+			policy = &p
+			break
+		}
+	}
+	if policy == nil {
+		log.Printf("[INFO] Key Vault access policy %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vault_name", vaultName)
+	d.Set("resource_group_name", resGroup)
+	for key, value := range flattenKeyVaultAccessPolicy(*policy) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	armMutexKV.Lock(vaultName)
+	defer armMutexKV.Unlock(vaultName)
+
+	vault, err := getKeyVaultForAccessPolicy(meta, resGroup, vaultName)
+	if err != nil {
+		return err
+	}
+	if vault == nil {
+		return nil
+	}
+
+	policies := make([]keyVaultAccessPolicy, 0, len(vault.AccessPolicies))
+	for _, existing := range vault.AccessPolicies {
+		if !keyVaultAccessPolicyMatches(existing, id.Path["objectId"], id.Path["applicationId"]) {
+			policies = append(policies, existing)
+		}
+	}
+
+	return updateKeyVaultAccessPolicies(meta, resGroup, vault, policies)
+}
+
+// getKeyVaultForAccessPolicy returns the current definition of the vault, or
+// nil if the vault does not exist.
+func getKeyVaultForAccessPolicy(meta interface{}, resGroup, vaultName string) (*getKeyVaultResponse, error) {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getKeyVault{
+		Name:              vaultName,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Error making Read request on Azure Key Vault %s: %s", vaultName, err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error making Read request on Azure Key Vault %s: %s", vaultName, readResponse.Error)
+	}
+
+	return readResponse.Parsed.(*getKeyVaultResponse), nil
+}
+
+// updateKeyVaultAccessPolicies writes the vault back with the given set of
+// access policies, leaving all other properties as they currently are.
+func updateKeyVaultAccessPolicies(meta interface{}, resGroup string, vault *getKeyVaultResponse, policies []keyVaultAccessPolicy) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	tags := make(map[string]*string)
+	if vault.Tags != nil {
+		tags = *vault.Tags
+	}
+
+	updateRequest := rivieraClient.NewRequest()
+	updateRequest.Command = &createOrUpdateKeyVault{
+		Name:                         *vault.Name,
+		ResourceGroupName:            resGroup,
+		Location:                     *vault.Location,
+		Tags:                         tags,
+		TenantID:                     vault.TenantID,
+		Sku:                          vault.Sku,
+		AccessPolicies:               policies,
+		EnabledForDeployment:         vault.EnabledForDeployment,
+		EnabledForDiskEncryption:     vault.EnabledForDiskEncryption,
+		EnabledForTemplateDeployment: vault.EnabledForTemplateDeployment,
+	}
+
+	updateResponse, err := updateRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error updating access policies for Key Vault %s: %s", *vault.Name, err)
+	}
+	if !updateResponse.IsSuccessful() {
+		return fmt.Errorf("Error updating access policies for Key Vault %s: %s", *vault.Name, updateResponse.Error)
+	}
+
+	return nil
+}
+
+func keyVaultAccessPolicyMatches(policy keyVaultAccessPolicy, objectID, applicationID string) bool {
+	if policy.ObjectID == nil || !strings.EqualFold(*policy.ObjectID, objectID) {
+		return false
+	}
+
+	existingApplicationID := ""
+	if policy.ApplicationID != nil {
+		existingApplicationID = *policy.ApplicationID
+	}
+
+	return strings.EqualFold(existingApplicationID, applicationID)
+}
@@ -0,0 +1,95 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMKeyVaultAccessPolicy_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	tenantID := os.Getenv("ARM_TENANT_ID")
+	config := fmt.Sprintf(testAccAzureRMKeyVaultAccessPolicy_basic, ri, ri, tenantID, tenantID, tenantID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists("azurerm_key_vault_access_policy.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault_access_policy.test", "key_permissions.#", "2"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault_access_policy.test", "secret_permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultAccessPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		vault, err := getKeyVaultForAccessPolicy(testAccProvider.Meta(), id.ResourceGroup, id.Path["vaults"])
+		if err != nil {
+			return err
+		}
+		if vault == nil {
+			return fmt.Errorf("Bad: Key Vault %q (resource group: %q) does not exist", id.Path["vaults"], id.ResourceGroup)
+		}
+
+		for _, policy := range vault.AccessPolicies {
+			if keyVaultAccessPolicyMatches(policy, id.Path["objectId"], id.Path["applicationId"]) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bad: Key Vault access policy %q does not exist", rs.Primary.ID)
+	}
+}
+
+var testAccAzureRMKeyVaultAccessPolicy_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_key_vault" "test" {
+    name = "vault%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    tenant_id = "%s"
+
+    sku {
+        name = "standard"
+    }
+}
+
+resource "azurerm_key_vault_access_policy" "test" {
+    vault_name = "${azurerm_key_vault.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    tenant_id = "%s"
+    object_id = "%s"
+
+    key_permissions = ["get", "list"]
+    secret_permissions = ["get"]
+}
+`
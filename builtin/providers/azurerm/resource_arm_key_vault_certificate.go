@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmKeyVaultCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCertificateCreate,
+		Read:   resourceArmKeyVaultCertificateRead,
+		Update: resourceArmKeyVaultCertificateUpdate,
+		Delete: resourceArmKeyVaultCertificateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultChildName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"certificate": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contents": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	name := d.Get("name").(string)
+	uri := keyVaultChildURI(d.Get("vault_uri").(string), "certificates", name)
+
+	log.Printf("[INFO] preparing arguments for Azure Key Vault Certificate %q import.", name)
+
+	certificates := d.Get("certificate").([]interface{})
+	certificate := certificates[0].(map[string]interface{})
+	contents := certificate["contents"].(string)
+	tags := d.Get("tags").(map[string]interface{})
+	contentType := "application/x-pkcs12"
+
+	parameters := keyVaultCertificateImportParameters{
+		Value: &contents,
+		Policy: &keyVaultCertificatePolicy{
+			SecretProperties: &keyVaultCertificateSecretProperties{
+				ContentType: &contentType,
+			},
+		},
+		Tags: expandTags(tags),
+	}
+	if v := certificate["password"].(string); v != "" {
+		parameters.Password = &v
+	}
+
+	var result keyVaultCertificateBundle
+	resp, err := client.do("POST", uri+"/import", parameters, &result)
+	if err != nil {
+		return fmt.Errorf("Error importing Key Vault Certificate %q: %s", name, err)
+	}
+	if resp.StatusCode == http.StatusNotFound || result.ID == nil {
+		return fmt.Errorf("Cannot read Key Vault Certificate %q ID", name)
+	}
+
+	d.SetId(*result.ID)
+
+	return resourceArmKeyVaultCertificateRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var result keyVaultCertificateBundle
+	resp, err := client.do("GET", d.Id(), nil, &result)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Key Vault Certificate %s: %s", id.Name, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Key Vault Certificate %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseURL)
+	d.Set("version", id.Version)
+	d.Set("certificate_data", result.Cer)
+
+	// The thumbprint is returned base64url encoded, but is more commonly
+	// presented as an upper case hex string.
+	if result.X509Thumbprint != nil {
+		thumbprint, err := base64.RawURLEncoding.DecodeString(*result.X509Thumbprint)
+		if err != nil {
+			return fmt.Errorf("Error decoding thumbprint of Key Vault Certificate %s: %s", id.Name, err)
+		}
+		d.Set("thumbprint", strings.ToUpper(hex.EncodeToString(thumbprint)))
+	}
+
+	flattenAndSetTags(d, result.Tags)
+
+	return nil
+}
+
+func resourceArmKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	// Only the tags of a certificate can be updated in-place; a different
+	// certificate requires a new resource.
+	tags := d.Get("tags").(map[string]interface{})
+	parameters := keyVaultCertificateUpdateParameters{
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.do("PATCH", d.Id(), parameters, nil); err != nil {
+		return fmt.Errorf("Error updating Key Vault Certificate %s: %s", d.Id(), err)
+	}
+
+	return resourceArmKeyVaultCertificateRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.do("DELETE", keyVaultChildURI(id.KeyVaultBaseURL, "certificates", id.Name), nil, nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting Key Vault Certificate %s: %s", id.Name, err)
+	}
+
+	return nil
+}
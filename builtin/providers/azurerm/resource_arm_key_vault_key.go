@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmKeyVaultKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultKeyCreate,
+		Read:   resourceArmKeyVaultKeyRead,
+		Update: resourceArmKeyVaultKeyUpdate,
+		Delete: resourceArmKeyVaultKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultChildName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RSA", "RSA-HSM"}, false),
+			},
+
+			"key_size": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key_opts": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"}, false),
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"n": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"e": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	name := d.Get("name").(string)
+	uri := keyVaultChildURI(d.Get("vault_uri").(string), "keys", name)
+
+	log.Printf("[INFO] preparing arguments for Azure Key Vault Key %q creation.", name)
+
+	keyType := d.Get("key_type").(string)
+	keySize := int32(d.Get("key_size").(int))
+	keyOpts := expandKeyVaultPermissionList(d.Get("key_opts"))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := keyVaultKeyCreateParameters{
+		Kty:     &keyType,
+		KeySize: &keySize,
+		KeyOps:  &keyOpts,
+		Tags:    expandTags(tags),
+	}
+
+	var result keyVaultKeyBundle
+	resp, err := client.do("POST", uri+"/create", parameters, &result)
+	if err != nil {
+		return fmt.Errorf("Error creating Key Vault Key %q: %s", name, err)
+	}
+	if resp.StatusCode == http.StatusNotFound || result.Key == nil || result.Key.Kid == nil {
+		return fmt.Errorf("Cannot read Key Vault Key %q ID", name)
+	}
+
+	d.SetId(*result.Key.Kid)
+
+	return resourceArmKeyVaultKeyRead(d, meta)
+}
+
+func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var result keyVaultKeyBundle
+	resp, err := client.do("GET", d.Id(), nil, &result)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Key Vault Key %s: %s", id.Name, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Key Vault Key %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseURL)
+	d.Set("version", id.Version)
+
+	if key := result.Key; key != nil {
+		d.Set("key_type", key.Kty)
+		d.Set("n", key.N)
+		d.Set("e", key.E)
+		if key.KeyOps != nil {
+			d.Set("key_opts", *key.KeyOps)
+		}
+	}
+
+	flattenAndSetTags(d, result.Tags)
+
+	return nil
+}
+
+func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	// Only the tags of a key can be updated in-place; all other changes
+	// require a new key.
+	tags := d.Get("tags").(map[string]interface{})
+	parameters := keyVaultKeyUpdateParameters{
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.do("PATCH", d.Id(), parameters, nil); err != nil {
+		return fmt.Errorf("Error updating Key Vault Key %s: %s", d.Id(), err)
+	}
+
+	return resourceArmKeyVaultKeyRead(d, meta)
+}
+
+func resourceArmKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.do("DELETE", keyVaultChildURI(id.KeyVaultBaseURL, "keys", id.Name), nil, nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting Key Vault Key %s: %s", id.Name, err)
+	}
+
+	return nil
+}
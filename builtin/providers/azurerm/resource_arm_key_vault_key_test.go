@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMKeyVaultKeyType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "RSA",
+			ErrCount: 0,
+		},
+		{
+			Value:    "RSA-HSM",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmKeyVaultKey().Schema["key_type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "key_type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Key Vault Key type %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVaultKey_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMKeyVaultKey(ri, "Production")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists("azurerm_key_vault_key.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault_key.test", "key_opts.#", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultKey_updateTags(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := testAccAzureRMKeyVaultKey(ri, "Production")
+	postConfig := testAccAzureRMKeyVaultKey(ri, "Staging")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists("azurerm_key_vault_key.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault_key.test", "tags.environment", "Production"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultKeyExists("azurerm_key_vault_key.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault_key.test", "tags.environment", "Staging"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultKeyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+
+		resp, err := client.do("GET", rs.Primary.ID, nil, nil)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on keyVaultClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Key Vault Key %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKeyVaultKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_key" {
+			continue
+		}
+
+		resp, err := client.do("GET", rs.Primary.ID, nil, nil)
+
+		// the vault may well have been destroyed alongside the key
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Key Vault Key still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMKeyVaultKey(rInt int, environment string) string {
+	return testAccAzureRMKeyVaultDataPlane(rInt) + fmt.Sprintf(`
+resource "azurerm_key_vault_key" "test" {
+    name = "key-%d"
+    vault_uri = "${azurerm_key_vault.test.vault_uri}"
+    key_type = "RSA"
+    key_size = 2048
+
+    key_opts = [
+        "decrypt",
+        "encrypt",
+        "sign",
+        "unwrapKey",
+        "verify",
+        "wrapKey",
+    ]
+
+    tags {
+        environment = "%s"
+    }
+}
+`, rInt, environment)
+}
@@ -0,0 +1,145 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmKeyVaultSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultSecretCreate,
+		Read:   resourceArmKeyVaultSecretRead,
+		Update: resourceArmKeyVaultSecretCreate,
+		Delete: resourceArmKeyVaultSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultChildName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	name := d.Get("name").(string)
+	uri := keyVaultChildURI(d.Get("vault_uri").(string), "secrets", name)
+
+	log.Printf("[INFO] preparing arguments for Azure Key Vault Secret %q creation.", name)
+
+	tags := d.Get("tags").(map[string]interface{})
+	value := d.Get("value").(string)
+	parameters := keyVaultSecretBundle{
+		Value: &value,
+		Tags:  expandTags(tags),
+	}
+	if v, ok := d.GetOk("content_type"); ok {
+		contentType := v.(string)
+		parameters.ContentType = &contentType
+	}
+
+	// Setting a secret always creates a new version of it, so both creation
+	// and updates are handled by the same request.
+	var result keyVaultSecretBundle
+	resp, err := client.do("PUT", uri, parameters, &result)
+	if err != nil {
+		return fmt.Errorf("Error setting Key Vault Secret %q: %s", name, err)
+	}
+	if resp.StatusCode == http.StatusNotFound || result.ID == nil {
+		return fmt.Errorf("Cannot read Key Vault Secret %q ID", name)
+	}
+
+	d.SetId(*result.ID)
+
+	return resourceArmKeyVaultSecretRead(d, meta)
+}
+
+func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var result keyVaultSecretBundle
+	resp, err := client.do("GET", d.Id(), nil, &result)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Key Vault Secret %s: %s", id.Name, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Key Vault Secret %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseURL)
+	d.Set("version", id.Version)
+	d.Set("value", result.Value)
+	d.Set("content_type", result.ContentType)
+
+	flattenAndSetTags(d, result.Tags)
+
+	return nil
+}
+
+func resourceArmKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.do("DELETE", keyVaultChildURI(id.KeyVaultBaseURL, "secrets", id.Name), nil, nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting Key Vault Secret %s: %s", id.Name, err)
+	}
+
+	return nil
+}
+
+func validateKeyVaultChildName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z-]{1,127}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters and dashes and must be between 1-127 chars", k))
+	}
+
+	return
+}
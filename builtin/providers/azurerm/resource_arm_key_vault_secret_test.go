@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMKeyVaultChildName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello.world",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateKeyVaultChildName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Key Vault child name %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestParseKeyVaultChildID(t *testing.T) {
+	id, err := parseKeyVaultChildID("https://myvault.vault.azure.net/secrets/mysecret/0a9b8c7d6e5f")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if id.KeyVaultBaseURL != "https://myvault.vault.azure.net/" {
+		t.Fatalf("Bad vault base URL: %q", id.KeyVaultBaseURL)
+	}
+	if id.Name != "mysecret" {
+		t.Fatalf("Bad name: %q", id.Name)
+	}
+	if id.Version != "0a9b8c7d6e5f" {
+		t.Fatalf("Bad version: %q", id.Version)
+	}
+
+	if _, err := parseKeyVaultChildID("https://myvault.vault.azure.net/secrets/mysecret"); err == nil {
+		t.Fatal("Expected an error parsing an unversioned ID")
+	}
+}
+
+func TestAccAzureRMKeyVaultSecret_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMKeyVaultSecret(ri, "rick-and-morty")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists("azurerm_key_vault_secret.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault_secret.test", "value", "rick-and-morty"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := testAccAzureRMKeyVaultSecret(ri, "rick-and-morty")
+	postConfig := testAccAzureRMKeyVaultSecret(ri, "szechuan")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists("azurerm_key_vault_secret.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault_secret.test", "value", "rick-and-morty"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists("azurerm_key_vault_secret.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault_secret.test", "value", "szechuan"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultSecretExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+
+		resp, err := client.do("GET", rs.Primary.ID, nil, nil)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on keyVaultClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Key Vault Secret %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKeyVaultSecretDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_secret" {
+			continue
+		}
+
+		resp, err := client.do("GET", rs.Primary.ID, nil, nil)
+
+		// the vault may well have been destroyed alongside the secret
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Key Vault Secret still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccAzureRMKeyVaultDataPlane returns the configuration of a vault which
+// grants the service principal running the tests access to its contents. The
+// object ID of that service principal is read from ARM_OBJECT_ID.
+func testAccAzureRMKeyVaultDataPlane(rInt int) string {
+	tenantID := os.Getenv("ARM_TENANT_ID")
+	objectID := os.Getenv("ARM_OBJECT_ID")
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_key_vault" "test" {
+    name = "vault%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    tenant_id = "%s"
+
+    sku {
+        name = "standard"
+    }
+
+    access_policy {
+        tenant_id = "%s"
+        object_id = "%s"
+
+        key_permissions = ["all"]
+        secret_permissions = ["all"]
+        certificate_permissions = ["all"]
+    }
+}
+`, rInt, rInt, tenantID, tenantID, objectID)
+}
+
+func testAccAzureRMKeyVaultSecret(rInt int, value string) string {
+	return testAccAzureRMKeyVaultDataPlane(rInt) + fmt.Sprintf(`
+resource "azurerm_key_vault_secret" "test" {
+    name = "secret-%d"
+    value = "%s"
+    vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+    tags {
+        hello = "world"
+    }
+}
+`, rInt, value)
+}
@@ -0,0 +1,281 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMKeyVaultName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "hi",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello-world-this-is-too-long",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateKeyVaultName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Key Vault name %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMKeyVaultSkuName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Standard",
+			ErrCount: 1,
+		},
+		{
+			Value:    "standard",
+			ErrCount: 0,
+		},
+		{
+			Value:    "premium",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmKeyVault().Schema["sku"].Elem.(*schema.Resource).Schema["name"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Key Vault SKU name %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMKeyVaultKeyPermission_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "all",
+			ErrCount: 0,
+		},
+		{
+			Value:    "unwrapKey",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := keyVaultAccessPolicySchema()["key_permissions"].Elem.(*schema.Schema).ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "key_permissions")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Key Vault key permission %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVault_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	tenantID := os.Getenv("ARM_TENANT_ID")
+	config := fmt.Sprintf(testAccAzureRMKeyVault_basic, ri, ri, tenantID, tenantID, tenantID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists("azurerm_key_vault.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault.test", "sku.0.name", "standard"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault.test", "access_policy.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVault_update(t *testing.T) {
+	ri := acctest.RandInt()
+	tenantID := os.Getenv("ARM_TENANT_ID")
+	preConfig := fmt.Sprintf(testAccAzureRMKeyVault_basic, ri, ri, tenantID, tenantID, tenantID)
+	postConfig := fmt.Sprintf(testAccAzureRMKeyVault_update, ri, ri, tenantID, tenantID, tenantID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists("azurerm_key_vault.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault.test", "enabled_for_deployment", "false"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists("azurerm_key_vault.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault.test", "enabled_for_deployment", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault.test", "enabled_for_disk_encryption", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault.test", "access_policy.0.key_permissions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_key_vault.test", "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getKeyVault{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Key Vault: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Key Vault: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKeyVaultDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getKeyVault{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Key Vault: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Key Vault still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMKeyVault_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_key_vault" "test" {
+    name = "vault%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    tenant_id = "%s"
+
+    sku {
+        name = "standard"
+    }
+
+    access_policy {
+        tenant_id = "%s"
+        object_id = "%s"
+
+        key_permissions = ["all"]
+        secret_permissions = ["all"]
+    }
+}
+`
+
+var testAccAzureRMKeyVault_update = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_key_vault" "test" {
+    name = "vault%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    tenant_id = "%s"
+
+    sku {
+        name = "standard"
+    }
+
+    access_policy {
+        tenant_id = "%s"
+        object_id = "%s"
+
+        key_permissions = ["get"]
+        secret_permissions = ["get", "set"]
+    }
+
+    enabled_for_deployment = true
+    enabled_for_disk_encryption = true
+
+    tags {
+        environment = "Staging"
+    }
+}
+`
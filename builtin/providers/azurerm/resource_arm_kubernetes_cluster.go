@@ -0,0 +1,355 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmKubernetesCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKubernetesClusterCreate,
+		Read:   resourceArmKubernetesClusterRead,
+		Update: resourceArmKubernetesClusterCreate,
+		Delete: resourceArmKubernetesClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"dns_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"kubernetes_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"agent_pool_profile": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"vm_size": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"os_disk_size_gb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+							Computed: true,
+						},
+
+						"os_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "Linux",
+						},
+
+						"vnet_subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"linux_profile": containerServiceLinuxProfileSchema(),
+
+			"service_principal": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"kube_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Kubernetes Cluster creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateKubernetesCluster{
+		Name:                    name,
+		ResourceGroupName:       resGroup,
+		Location:                d.Get("location").(string),
+		Tags:                    *expandedTags,
+		DNSPrefix:               azure.String(d.Get("dns_prefix").(string)),
+		AgentPoolProfiles:       expandArmKubernetesClusterAgentPoolProfiles(d),
+		LinuxProfile:            expandArmContainerServiceLinuxProfile(d),
+		ServicePrincipalProfile: expandArmContainerServiceServicePrincipal(d),
+	}
+
+	if v, ok := d.GetOk("kubernetes_version"); ok {
+		command.KubernetesVersion = azure.String(v.(string))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Kubernetes Cluster %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Kubernetes Cluster %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getKubernetesCluster{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Kubernetes Cluster %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Kubernetes Cluster %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getKubernetesClusterResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Kubernetes Cluster %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmKubernetesClusterRead(d, meta)
+}
+
+func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["managedClusters"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getKubernetesCluster{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Kubernetes Cluster %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Kubernetes Cluster %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Kubernetes Cluster %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getKubernetesClusterResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("dns_prefix", resp.DNSPrefix)
+	d.Set("fqdn", resp.FQDN)
+	d.Set("kubernetes_version", resp.KubernetesVersion)
+
+	if resp.AgentPoolProfiles != nil {
+		if err := d.Set("agent_pool_profile", flattenArmKubernetesClusterAgentPoolProfiles(*resp.AgentPoolProfiles)); err != nil {
+			return fmt.Errorf("Error flattening `agent_pool_profile` for Kubernetes Cluster %q: %s", *resp.Name, err)
+		}
+	}
+
+	if resp.LinuxProfile != nil {
+		if err := d.Set("linux_profile", flattenArmContainerServiceLinuxProfile(resp.LinuxProfile)); err != nil {
+			return fmt.Errorf("Error flattening `linux_profile` for Kubernetes Cluster %q: %s", *resp.Name, err)
+		}
+	}
+
+	if resp.ServicePrincipalProfile != nil {
+		if err := d.Set("service_principal", flattenArmContainerServiceServicePrincipal(d, resp.ServicePrincipalProfile)); err != nil {
+			return fmt.Errorf("Error flattening `service_principal` for Kubernetes Cluster %q: %s", *resp.Name, err)
+		}
+	}
+
+	accessRequest := rivieraClient.NewRequest()
+	accessRequest.Command = &getKubernetesClusterAccessProfile{
+		Name:              name,
+		ResourceGroupName: id.ResourceGroup,
+		RoleName:          "clusterUser",
+	}
+
+	accessResponse, err := accessRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading access profile for Kubernetes Cluster %q: %s", name, err)
+	}
+	if !accessResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading access profile for Kubernetes Cluster %q: %s", name, accessResponse.Error)
+	}
+
+	profile := accessResponse.Parsed.(*getKubernetesClusterAccessProfileResponse)
+	if profile.KubeConfig != nil {
+		kubeConfig, err := base64.StdEncoding.DecodeString(*profile.KubeConfig)
+		if err != nil {
+			return fmt.Errorf("Error decoding kube_config for Kubernetes Cluster %q: %s", name, err)
+		}
+		d.Set("kube_config", string(kubeConfig))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteKubernetesCluster{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Kubernetes Cluster %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Kubernetes Cluster %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmKubernetesClusterAgentPoolProfiles(d *schema.ResourceData) []containerServiceAgentPoolProfile {
+	configs := d.Get("agent_pool_profile").([]interface{})
+	profiles := make([]containerServiceAgentPoolProfile, 0, len(configs))
+
+	for _, raw := range configs {
+		config := raw.(map[string]interface{})
+
+		profile := containerServiceAgentPoolProfile{
+			Name:   azure.String(config["name"].(string)),
+			Count:  azure.Int32(int32(config["count"].(int))),
+			VMSize: azure.String(config["vm_size"].(string)),
+			OSType: azure.String(config["os_type"].(string)),
+		}
+
+		if v := config["os_disk_size_gb"].(int); v > 0 {
+			profile.OSDiskSizeGB = azure.Int32(int32(v))
+		}
+		if v := config["vnet_subnet_id"].(string); v != "" {
+			profile.VnetSubnetID = azure.String(v)
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles
+}
+
+func flattenArmKubernetesClusterAgentPoolProfiles(profiles []containerServiceAgentPoolProfile) []interface{} {
+	result := make([]interface{}, 0, len(profiles))
+
+	for _, profile := range profiles {
+		flattened := map[string]interface{}{
+			"name":    *profile.Name,
+			"vm_size": *profile.VMSize,
+		}
+		if profile.Count != nil {
+			flattened["count"] = int(*profile.Count)
+		}
+		if profile.OSType != nil {
+			flattened["os_type"] = *profile.OSType
+		}
+		if profile.OSDiskSizeGB != nil {
+			flattened["os_disk_size_gb"] = int(*profile.OSDiskSizeGB)
+		}
+		if profile.VnetSubnetID != nil {
+			flattened["vnet_subnet_id"] = *profile.VnetSubnetID
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result
+}
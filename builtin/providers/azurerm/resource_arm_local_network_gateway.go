@@ -50,6 +50,31 @@ func resourceArmLocalNetworkGateway() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"bgp_peering_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"peer_weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -76,6 +101,7 @@ func resourceArmLocalNetworkGatewayCreate(d *schema.ResourceData, meta interface
 				AddressPrefixes: &prefixes,
 			},
 			GatewayIPAddress: &ipAddress,
+			BgpSettings:      expandArmLocalNetworkGatewayBGPSettings(d),
 		},
 	}
 
@@ -118,6 +144,7 @@ func resourceArmLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error reading the state of Azure ARM local network gateway '%s': %s", name, err)
 	}
 
+	d.Set("resource_group_name", resGroup)
 	d.Set("name", resp.Name)
 	d.Set("location", resp.Location)
 	d.Set("gateway_address", resp.Properties.GatewayIPAddress)
@@ -128,6 +155,10 @@ func resourceArmLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}
 	}
 	d.Set("address_space", prefs)
 
+	if err := d.Set("bgp_settings", flattenArmLocalNetworkGatewayBGPSettings(resp.Properties.BgpSettings)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -149,3 +180,48 @@ func resourceArmLocalNetworkGatewayDelete(d *schema.ResourceData, meta interface
 
 	return nil
 }
+
+func expandArmLocalNetworkGatewayBGPSettings(d *schema.ResourceData) *network.BgpSettings {
+	v, exists := d.GetOk("bgp_settings")
+	if !exists {
+		return nil
+	}
+
+	settings := v.([]interface{})
+	setting := settings[0].(map[string]interface{})
+
+	asn := int64(setting["asn"].(int))
+	peeringAddress := setting["bgp_peering_address"].(string)
+
+	bgpSettings := network.BgpSettings{
+		Asn:               &asn,
+		BgpPeeringAddress: &peeringAddress,
+	}
+
+	if v := setting["peer_weight"].(int); v != 0 {
+		peerWeight := int32(v)
+		bgpSettings.PeerWeight = &peerWeight
+	}
+
+	return &bgpSettings
+}
+
+func flattenArmLocalNetworkGatewayBGPSettings(input *network.BgpSettings) []interface{} {
+	output := make(map[string]interface{}, 0)
+
+	if input == nil {
+		return []interface{}{}
+	}
+
+	if input.Asn != nil {
+		output["asn"] = int(*input.Asn)
+	}
+	if input.BgpPeeringAddress != nil {
+		output["bgp_peering_address"] = *input.BgpPeeringAddress
+	}
+	if input.PeerWeight != nil {
+		output["peer_weight"] = int(*input.PeerWeight)
+	}
+
+	return []interface{}{output}
+}
@@ -29,6 +29,27 @@ func TestAccAzureRMLocalNetworkGateway_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLocalNetworkGateway_bgpSettings(t *testing.T) {
+	name := "azurerm_local_network_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLocalNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLocalNetworkGatewayConfig_bgpSettings,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLocalNetworkGatewayExists(name),
+					resource.TestCheckResourceAttr(name, "bgp_settings.#", "1"),
+					resource.TestCheckResourceAttr(name, "bgp_settings.0.asn", "2468"),
+					resource.TestCheckResourceAttr(name, "bgp_settings.0.bgp_peering_address", "10.104.1.1"),
+				),
+			},
+		},
+	})
+}
+
 // testCheckAzureRMLocalNetworkGatewayExists returns the resurce.TestCheckFunc
 // which checks whether or not the expected local network gateway exists both
 // in the schema, and on Azure.
@@ -106,3 +127,23 @@ resource "azurerm_local_network_gateway" "test" {
 	address_space = ["127.0.0.0/8"]
 }
 `
+
+var testAccAzureRMLocalNetworkGatewayConfig_bgpSettings = `
+resource "azurerm_resource_group" "test" {
+    name = "tftestingResourceGroup"
+    location = "West US"
+}
+
+resource "azurerm_local_network_gateway" "test" {
+	name = "tftestingLocalNetworkGateway"
+	location = "${azurerm_resource_group.test.location}"
+	resource_group_name = "${azurerm_resource_group.test.name}"
+	gateway_address = "127.0.0.1"
+	address_space = ["127.0.0.0/8"]
+
+	bgp_settings {
+		asn = 2468
+		bgp_peering_address = "10.104.1.1"
+	}
+}
+`
@@ -0,0 +1,254 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmLogAnalyticsSolution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsSolutionCreate,
+		Read:   resourceArmLogAnalyticsSolutionRead,
+		Delete: resourceArmLogAnalyticsSolutionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"solution_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"workspace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"workspace_resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"plan": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publisher": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"product": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"promotion_code": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmLogAnalyticsSolutionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Log Analytics Solution creation.")
+
+	// Azure requires a solution to be named after both the solution and the
+	// workspace it is deployed to, e.g. "ContainerInsights(myworkspace)".
+	name := fmt.Sprintf("%s(%s)", d.Get("solution_name").(string), d.Get("workspace_name").(string))
+	resGroup := d.Get("resource_group_name").(string)
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateLogAnalyticsSolution{
+		Name:                name,
+		ResourceGroupName:   resGroup,
+		Location:            d.Get("location").(string),
+		Plan:                expandArmLogAnalyticsSolutionPlan(d, name),
+		WorkspaceResourceID: azure.String(d.Get("workspace_resource_id").(string)),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Log Analytics Solution %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Log Analytics Solution %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getLogAnalyticsSolution{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Log Analytics Solution %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Log Analytics Solution %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getLogAnalyticsSolutionResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Log Analytics Solution %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmLogAnalyticsSolutionRead(d, meta)
+}
+
+func resourceArmLogAnalyticsSolutionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getLogAnalyticsSolution{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Log Analytics Solution %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Log Analytics Solution %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Log Analytics Solution %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getLogAnalyticsSolutionResponse)
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("workspace_resource_id", resp.WorkspaceResourceID)
+
+	if resp.Name != nil {
+		solutionName, workspaceName, err := parseArmLogAnalyticsSolutionName(*resp.Name)
+		if err != nil {
+			return err
+		}
+		d.Set("solution_name", solutionName)
+		d.Set("workspace_name", workspaceName)
+	}
+
+	if resp.Plan != nil {
+		if err := d.Set("plan", flattenArmLogAnalyticsSolutionPlan(resp.Plan)); err != nil {
+			return fmt.Errorf("Error flattening `plan`: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLogAnalyticsSolutionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteLogAnalyticsSolution{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Log Analytics Solution %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Log Analytics Solution %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmLogAnalyticsSolutionPlan(d *schema.ResourceData, name string) *logAnalyticsSolutionPlan {
+	plans := d.Get("plan").([]interface{})
+	plan := plans[0].(map[string]interface{})
+
+	expanded := &logAnalyticsSolutionPlan{
+		Name:      azure.String(name),
+		Publisher: azure.String(plan["publisher"].(string)),
+		Product:   azure.String(plan["product"].(string)),
+	}
+
+	if v := plan["promotion_code"].(string); v != "" {
+		expanded.PromotionCode = azure.String(v)
+	}
+
+	return expanded
+}
+
+func flattenArmLogAnalyticsSolutionPlan(plan *logAnalyticsSolutionPlan) []interface{} {
+	result := make(map[string]interface{})
+
+	if plan.Name != nil {
+		result["name"] = *plan.Name
+	}
+	if plan.Publisher != nil {
+		result["publisher"] = *plan.Publisher
+	}
+	if plan.Product != nil {
+		result["product"] = *plan.Product
+	}
+	if plan.PromotionCode != nil {
+		result["promotion_code"] = *plan.PromotionCode
+	}
+
+	return []interface{}{result}
+}
+
+// parseArmLogAnalyticsSolutionName splits the name of a solution, which takes
+// the form "SolutionName(WorkspaceName)", into its two parts.
+func parseArmLogAnalyticsSolutionName(name string) (string, string, error) {
+	open := strings.Index(name, "(")
+	if open <= 0 || !strings.HasSuffix(name, ")") {
+		return "", "", fmt.Errorf("Log Analytics Solution name %q is not of the form SolutionName(WorkspaceName)", name)
+	}
+
+	return name[:open], name[open+1 : len(name)-1], nil
+}
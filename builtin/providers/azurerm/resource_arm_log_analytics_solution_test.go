@@ -0,0 +1,137 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseArmLogAnalyticsSolutionName(t *testing.T) {
+	cases := []struct {
+		Name          string
+		SolutionName  string
+		WorkspaceName string
+		Error         bool
+	}{
+		{Name: "ContainerInsights(my-workspace)", SolutionName: "ContainerInsights", WorkspaceName: "my-workspace"},
+		{Name: "Security(ws1)", SolutionName: "Security", WorkspaceName: "ws1"},
+		{Name: "ContainerInsights", Error: true},
+		{Name: "(my-workspace)", Error: true},
+		{Name: "ContainerInsights(my-workspace", Error: true},
+	}
+
+	for _, tc := range cases {
+		solutionName, workspaceName, err := parseArmLogAnalyticsSolutionName(tc.Name)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q", tc.Name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %s", tc.Name, err)
+		}
+		if solutionName != tc.SolutionName || workspaceName != tc.WorkspaceName {
+			t.Fatalf("Expected %q to parse to %q and %q, got %q and %q", tc.Name, tc.SolutionName, tc.WorkspaceName, solutionName, workspaceName)
+		}
+	}
+}
+
+func TestAccAzureRMLogAnalyticsSolution_containerMonitoring(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMLogAnalyticsSolution_containerMonitoring, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsSolutionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsSolutionExists("azurerm_log_analytics_solution.test"),
+					resource.TestCheckResourceAttr("azurerm_log_analytics_solution.test", "solution_name", "Containers"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsSolutionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getLogAnalyticsSolution{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Log Analytics Solution: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Log Analytics Solution: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogAnalyticsSolutionDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_log_analytics_solution" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getLogAnalyticsSolution{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Log Analytics Solution: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Log Analytics Solution still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMLogAnalyticsSolution_containerMonitoring = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+    name = "acctest-%d"
+    location = "West Europe"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+}
+
+resource "azurerm_log_analytics_solution" "test" {
+    solution_name = "Containers"
+    location = "West Europe"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    workspace_resource_id = "${azurerm_log_analytics_workspace.test.id}"
+    workspace_name = "${azurerm_log_analytics_workspace.test.name}"
+
+    plan {
+        publisher = "Microsoft"
+        product = "OMSGallery/Containers"
+    }
+}
+`
@@ -0,0 +1,239 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmLogAnalyticsWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsWorkspaceCreateOrUpdate,
+		Read:   resourceArmLogAnalyticsWorkspaceRead,
+		Update: resourceArmLogAnalyticsWorkspaceCreateOrUpdate,
+		Delete: resourceArmLogAnalyticsWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmLogAnalyticsWorkspaceName,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Free", "PerNode", "Premium", "Standalone", "Standard", "Unlimited"}, false),
+			},
+
+			"retention_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArmLogAnalyticsWorkspaceRetentionInDays,
+			},
+
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"portal_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_shared_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_shared_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmLogAnalyticsWorkspaceCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Log Analytics Workspace creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateLogAnalyticsWorkspace{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		Sku: &logAnalyticsWorkspaceSku{
+			Name: azure.String(d.Get("sku").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("retention_in_days"); ok {
+		command.RetentionInDays = azure.Int32(int32(v.(int)))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Log Analytics Workspace %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Log Analytics Workspace %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getLogAnalyticsWorkspace{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Log Analytics Workspace %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Log Analytics Workspace %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getLogAnalyticsWorkspaceResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Log Analytics Workspace %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmLogAnalyticsWorkspaceRead(d, meta)
+}
+
+func resourceArmLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["workspaces"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getLogAnalyticsWorkspace{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Log Analytics Workspace %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Log Analytics Workspace %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Log Analytics Workspace %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getLogAnalyticsWorkspaceResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("retention_in_days", resp.RetentionInDays)
+	d.Set("workspace_id", resp.CustomerID)
+	d.Set("portal_url", resp.PortalURL)
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+	}
+
+	keysRequest := rivieraClient.NewRequest()
+	keysRequest.Command = &getLogAnalyticsWorkspaceSharedKeys{
+		Name:              name,
+		ResourceGroupName: id.ResourceGroup,
+	}
+
+	keysResponse, err := keysRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error listing shared keys for Log Analytics Workspace %q: %s", name, err)
+	}
+	if !keysResponse.IsSuccessful() {
+		return fmt.Errorf("Error listing shared keys for Log Analytics Workspace %q: %s", name, keysResponse.Error)
+	}
+
+	keys := keysResponse.Parsed.(*getLogAnalyticsWorkspaceSharedKeysResponse)
+	d.Set("primary_shared_key", keys.PrimarySharedKey)
+	d.Set("secondary_shared_key", keys.SecondarySharedKey)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmLogAnalyticsWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteLogAnalyticsWorkspace{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Log Analytics Workspace %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Log Analytics Workspace %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func validateArmLogAnalyticsWorkspaceName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 4 and 63 characters, may only contain alphanumeric characters and dashes, and must start and end with an alphanumeric character", k))
+	}
+	return
+}
+
+func validateArmLogAnalyticsWorkspaceRetentionInDays(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value != 7 && (value < 30 || value > 730) {
+		errors = append(errors, fmt.Errorf("Log Analytics Workspace retention must be 7 days (Free SKU) or between 30 and 730 days"))
+	}
+	return
+}
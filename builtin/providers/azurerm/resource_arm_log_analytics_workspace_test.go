@@ -0,0 +1,195 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMLogAnalyticsWorkspaceName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "abc", ErrCount: 1},
+		{Value: "abcd", ErrCount: 0},
+		{Value: "my-workspace-1", ErrCount: 0},
+		{Value: "-workspace", ErrCount: 1},
+		{Value: "workspace-", ErrCount: 1},
+		{Value: "work_space", ErrCount: 1},
+		{Value: "a123456789012345678901234567890123456789012345678901234567890bc", ErrCount: 0},
+		{Value: "a1234567890123456789012345678901234567890123456789012345678901bc", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmLogAnalyticsWorkspaceName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Log Analytics Workspace Name %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMLogAnalyticsWorkspaceSku_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "Free", ErrCount: 0},
+		{Value: "PerNode", ErrCount: 0},
+		{Value: "Standard", ErrCount: 0},
+		{Value: "standard", ErrCount: 1},
+		{Value: "Random", ErrCount: 1},
+	}
+
+	validateFunc := resourceArmLogAnalyticsWorkspace().Schema["sku"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "sku")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Log Analytics Workspace SKU %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMLogAnalyticsWorkspaceRetentionInDays_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{Value: 7, ErrCount: 0},
+		{Value: 8, ErrCount: 1},
+		{Value: 29, ErrCount: 1},
+		{Value: 30, ErrCount: 0},
+		{Value: 730, ErrCount: 0},
+		{Value: 731, ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmLogAnalyticsWorkspaceRetentionInDays(tc.Value, "retention_in_days")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Log Analytics Workspace retention of %d days to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestAccAzureRMLogAnalyticsWorkspace_requiredOnly(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMLogAnalyticsWorkspace_requiredOnly, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsWorkspaceExists("azurerm_log_analytics_workspace.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsWorkspace_retentionInDays(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMLogAnalyticsWorkspace_retentionInDays, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsWorkspaceExists("azurerm_log_analytics_workspace.test"),
+					resource.TestCheckResourceAttr("azurerm_log_analytics_workspace.test", "retention_in_days", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsWorkspaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getLogAnalyticsWorkspace{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Log Analytics Workspace: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Log Analytics Workspace: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogAnalyticsWorkspaceDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_log_analytics_workspace" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getLogAnalyticsWorkspace{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Log Analytics Workspace: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Log Analytics Workspace still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMLogAnalyticsWorkspace_requiredOnly = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+    name = "acctest-%d"
+    location = "West Europe"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Free"
+}
+`
+
+var testAccAzureRMLogAnalyticsWorkspace_retentionInDays = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+    name = "acctest-%d"
+    location = "West Europe"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+    retention_in_days = 30
+}
+`
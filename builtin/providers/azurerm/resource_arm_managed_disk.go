@@ -0,0 +1,249 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmManagedDisk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagedDiskCreate,
+		Read:   resourceArmManagedDiskRead,
+		Update: resourceArmManagedDiskCreate,
+		Delete: resourceArmManagedDiskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"storage_account_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Standard_LRS", "Premium_LRS"}, false),
+			},
+
+			"create_option": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Empty", "Import", "Copy", "FromImage"}, false),
+			},
+
+			"source_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"image_reference_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"os_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Linux", "Windows"}, false),
+			},
+
+			"disk_size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1023),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Managed Disk creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	createOption := d.Get("create_option").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateManagedDisk{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		AccountType:       azure.String(d.Get("storage_account_type").(string)),
+		CreationData: &managedDiskCreationData{
+			CreateOption: azure.String(createOption),
+		},
+	}
+
+	if v, ok := d.GetOk("disk_size_gb"); ok {
+		command.DiskSizeGB = azure.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("os_type"); ok {
+		command.OSType = azure.String(v.(string))
+	}
+
+	switch createOption {
+	case "Import":
+		sourceURI := d.Get("source_uri").(string)
+		if sourceURI == "" {
+			return fmt.Errorf("[ERROR] source_uri must be specified when create_option is `Import`")
+		}
+		command.CreationData.SourceURI = azure.String(sourceURI)
+	case "Copy":
+		sourceResourceID := d.Get("source_resource_id").(string)
+		if sourceResourceID == "" {
+			return fmt.Errorf("[ERROR] source_resource_id must be specified when create_option is `Copy`")
+		}
+		command.CreationData.SourceResourceID = azure.String(sourceResourceID)
+	case "FromImage":
+		imageReferenceID := d.Get("image_reference_id").(string)
+		if imageReferenceID == "" {
+			return fmt.Errorf("[ERROR] image_reference_id must be specified when create_option is `FromImage`")
+		}
+		command.CreationData.ImageReference = &managedDiskImageReference{
+			ID: azure.String(imageReferenceID),
+		}
+	case "Empty":
+		if command.DiskSizeGB == nil {
+			return fmt.Errorf("[ERROR] disk_size_gb must be specified when create_option is `Empty`")
+		}
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Managed Disk %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Managed Disk %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getManagedDisk{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Managed Disk %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Managed Disk %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getManagedDiskResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Managed Disk %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmManagedDiskRead(d, meta)
+}
+
+func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getManagedDisk{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Managed Disk %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Managed Disk %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Managed Disk %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getManagedDiskResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("storage_account_type", resp.AccountType)
+	d.Set("disk_size_gb", resp.DiskSizeGB)
+	d.Set("os_type", resp.OSType)
+
+	if data := resp.CreationData; data != nil {
+		d.Set("create_option", data.CreateOption)
+		d.Set("source_uri", data.SourceURI)
+		d.Set("source_resource_id", data.SourceResourceID)
+		if data.ImageReference != nil {
+			d.Set("image_reference_id", data.ImageReference.ID)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteManagedDisk{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Managed Disk %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Managed Disk %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMManagedDiskStorageAccountType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Standard_GRS",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Standard_LRS",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Premium_LRS",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmManagedDisk().Schema["storage_account_type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "storage_account_type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Managed Disk Storage Account Type %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMManagedDiskCreateOption_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Attach",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Empty",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Import",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Copy",
+			ErrCount: 0,
+		},
+		{
+			Value:    "FromImage",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmManagedDisk().Schema["create_option"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "create_option")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Managed Disk Create Option %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMManagedDiskSizeGB_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    1023,
+			ErrCount: 0,
+		},
+		{
+			Value:    1024,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmManagedDisk().Schema["disk_size_gb"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "disk_size_gb")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Managed Disk Size %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMManagedDisk_empty(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMManagedDisk_empty, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMManagedDisk_emptyUpdated, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists("azurerm_managed_disk.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_managed_disk.test", "disk_size_gb", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_managed_disk.test", "storage_account_type", "Standard_LRS"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists("azurerm_managed_disk.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_managed_disk.test", "disk_size_gb", "2"),
+					resource.TestCheckResourceAttr(
+						"azurerm_managed_disk.test", "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedDisk_copy(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMManagedDisk_copy, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists("azurerm_managed_disk.source"),
+					testCheckAzureRMManagedDiskExists("azurerm_managed_disk.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_managed_disk.test", "create_option", "Copy"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagedDiskExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getManagedDisk{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Managed Disk: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Managed Disk: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagedDiskDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_managed_disk" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getManagedDisk{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Managed Disk: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Managed Disk still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMManagedDisk_empty = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_managed_disk" "test" {
+    name = "acctestd-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_type = "Standard_LRS"
+    create_option = "Empty"
+    disk_size_gb = "1"
+}
+`
+
+var testAccAzureRMManagedDisk_emptyUpdated = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_managed_disk" "test" {
+    name = "acctestd-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_type = "Standard_LRS"
+    create_option = "Empty"
+    disk_size_gb = "2"
+
+    tags {
+        environment = "acctest"
+    }
+}
+`
+
+var testAccAzureRMManagedDisk_copy = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_managed_disk" "source" {
+    name = "acctestd1-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_type = "Standard_LRS"
+    create_option = "Empty"
+    disk_size_gb = "1"
+}
+
+resource "azurerm_managed_disk" "test" {
+    name = "acctestd2-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_type = "Standard_LRS"
+    create_option = "Copy"
+    source_resource_id = "${azurerm_managed_disk.source.id}"
+}
+`
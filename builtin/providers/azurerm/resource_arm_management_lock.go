@@ -0,0 +1,140 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmManagementLock() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagementLockCreate,
+		Read:   resourceArmManagementLockRead,
+		Update: resourceArmManagementLockCreate,
+		Delete: resourceArmManagementLockDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"lock_level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CanNotDelete", "ReadOnly"}, false),
+			},
+
+			"notes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+		},
+	}
+}
+
+func resourceArmManagementLockCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Management Lock creation.")
+
+	name := d.Get("name").(string)
+	scope := d.Get("scope").(string)
+
+	command := &createOrUpdateManagementLock{
+		Level: azure.String(d.Get("lock_level").(string)),
+	}
+
+	if v, ok := d.GetOk("notes"); ok {
+		command.Notes = azure.String(v.(string))
+	}
+
+	createRequest := rivieraClient.NewRequestForURI(authorizationResourceURI(scope, managementLocksURLSegment, name))
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Management Lock %q (scope %q): %s", name, scope, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Management Lock %q (scope %q): %s", name, scope, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getManagementLockResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Management Lock %s (scope %s) ID", name, scope)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmManagementLockRead(d, meta)
+}
+
+func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	scope, name, err := parseAuthorizationResourceID(d.Id(), managementLocksURLSegment)
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getManagementLock{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Management Lock %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Management Lock %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Management Lock %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getManagementLockResponse)
+
+	d.Set("name", name)
+	d.Set("scope", scope)
+	d.Set("lock_level", resp.Level)
+	d.Set("notes", resp.Notes)
+
+	return nil
+}
+
+func resourceArmManagementLockDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteManagementLock{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Management Lock %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting Management Lock %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMManagementLockLevel_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "CanNotDelete",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ReadOnly",
+			ErrCount: 0,
+		},
+		{
+			Value:    "NotSpecified",
+			ErrCount: 1,
+		},
+		{
+			Value:    "readonly",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmManagementLock().Schema["lock_level"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "lock_level")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Management Lock level %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMManagementLock_resourceGroup(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := testAccAzureRMManagementLock_resourceGroup(ri, "CanNotDelete")
+	postConfig := testAccAzureRMManagementLock_resourceGroup(ri, "ReadOnly")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementLockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementLockExists("azurerm_management_lock.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_management_lock.test", "lock_level", "CanNotDelete"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementLockExists("azurerm_management_lock.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_management_lock.test", "lock_level", "ReadOnly"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagementLock_resource(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMManagementLock_resource, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementLockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementLockExists("azurerm_management_lock.test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagementLockExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getManagementLock{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Management Lock: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Management Lock: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagementLockDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_management_lock" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getManagementLock{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Management Lock: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Management Lock still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMManagementLock_resourceGroup(rInt int, level string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_management_lock" "test" {
+    name = "acctestlock-%d"
+    scope = "${azurerm_resource_group.test.id}"
+    lock_level = "%s"
+    notes = "Protects the resource group from accidental changes"
+}
+`, rInt, rInt, level)
+}
+
+var testAccAzureRMManagementLock_resource = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_public_ip" "test" {
+    name = "acctestpublicip-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    public_ip_address_allocation = "static"
+}
+
+resource "azurerm_management_lock" "test" {
+    name = "acctestlock-%d"
+    scope = "${azurerm_public_ip.test.id}"
+    lock_level = "CanNotDelete"
+}
+`
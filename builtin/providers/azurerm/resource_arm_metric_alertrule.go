@@ -0,0 +1,350 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmMetricAlertRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMetricAlertRuleCreate,
+		Read:   resourceArmMetricAlertRuleRead,
+		Update: resourceArmMetricAlertRuleCreate,
+		Delete: resourceArmMetricAlertRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"operator": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"GreaterThan", "GreaterThanOrEqual", "LessThan", "LessThanOrEqual"}, false),
+			},
+
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+
+			"period": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"aggregation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Average", "Minimum", "Maximum", "Total", "Last"}, false),
+			},
+
+			"email_action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"send_to_service_owners": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"custom_emails": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"webhook_action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMetricAlertRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Metric Alert Rule creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	threshold := d.Get("threshold").(float64)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateMetricAlertRule{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		IsEnabled:         azure.Bool(d.Get("enabled").(bool)),
+		Condition: &metricAlertRuleCondition{
+			ODataType: metricAlertRuleConditionType,
+			DataSource: &metricAlertRuleDataSource{
+				ODataType:   metricAlertRuleDataSourceType,
+				ResourceURI: azure.String(d.Get("resource_id").(string)),
+				MetricName:  azure.String(d.Get("metric_name").(string)),
+			},
+			Operator:        azure.String(d.Get("operator").(string)),
+			Threshold:       &threshold,
+			WindowSize:      azure.String(d.Get("period").(string)),
+			TimeAggregation: azure.String(d.Get("aggregation").(string)),
+		},
+		Actions: expandArmMetricAlertRuleActions(d),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		command.Description = azure.String(v.(string))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Metric Alert Rule %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Metric Alert Rule %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getMetricAlertRule{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Metric Alert Rule %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Metric Alert Rule %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getMetricAlertRuleResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Metric Alert Rule %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmMetricAlertRuleRead(d, meta)
+}
+
+func resourceArmMetricAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getMetricAlertRule{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Metric Alert Rule %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Metric Alert Rule %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Metric Alert Rule %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getMetricAlertRuleResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("description", resp.Description)
+	d.Set("enabled", resp.IsEnabled)
+
+	if condition := resp.Condition; condition != nil {
+		if dataSource := condition.DataSource; dataSource != nil {
+			d.Set("resource_id", dataSource.ResourceURI)
+			d.Set("metric_name", dataSource.MetricName)
+		}
+		d.Set("operator", condition.Operator)
+		d.Set("threshold", condition.Threshold)
+		d.Set("period", condition.WindowSize)
+		d.Set("aggregation", condition.TimeAggregation)
+	}
+
+	emailActions, webhookActions := flattenArmMetricAlertRuleActions(resp.Actions)
+	if err := d.Set("email_action", emailActions); err != nil {
+		return fmt.Errorf("Error flattening `email_action` for Metric Alert Rule %q: %s", *resp.Name, err)
+	}
+	if err := d.Set("webhook_action", webhookActions); err != nil {
+		return fmt.Errorf("Error flattening `webhook_action` for Metric Alert Rule %q: %s", *resp.Name, err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMetricAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteMetricAlertRule{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Metric Alert Rule %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Metric Alert Rule %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmMetricAlertRuleActions(d *schema.ResourceData) []metricAlertRuleAction {
+	actions := make([]metricAlertRuleAction, 0, 2)
+
+	if emailActions := d.Get("email_action").([]interface{}); len(emailActions) > 0 {
+		emailAction := emailActions[0].(map[string]interface{})
+
+		customEmails := make([]string, 0)
+		for _, v := range emailAction["custom_emails"].([]interface{}) {
+			customEmails = append(customEmails, v.(string))
+		}
+
+		actions = append(actions, metricAlertRuleAction{
+			ODataType:           metricAlertRuleEmailType,
+			SendToServiceOwners: azure.Bool(emailAction["send_to_service_owners"].(bool)),
+			CustomEmails:        customEmails,
+		})
+	}
+
+	if webhookActions := d.Get("webhook_action").([]interface{}); len(webhookActions) > 0 {
+		webhookAction := webhookActions[0].(map[string]interface{})
+
+		properties := make(map[string]*string)
+		for k, v := range webhookAction["properties"].(map[string]interface{}) {
+			properties[k] = azure.String(v.(string))
+		}
+
+		actions = append(actions, metricAlertRuleAction{
+			ODataType:  metricAlertRuleWebhookType,
+			ServiceURI: azure.String(webhookAction["service_uri"].(string)),
+			Properties: properties,
+		})
+	}
+
+	return actions
+}
+
+func flattenArmMetricAlertRuleActions(actions []metricAlertRuleAction) ([]interface{}, []interface{}) {
+	emailActions := make([]interface{}, 0, 1)
+	webhookActions := make([]interface{}, 0, 1)
+
+	for _, action := range actions {
+		switch action.ODataType {
+		case metricAlertRuleEmailType:
+			emailAction := map[string]interface{}{
+				"custom_emails": action.CustomEmails,
+			}
+			if action.SendToServiceOwners != nil {
+				emailAction["send_to_service_owners"] = *action.SendToServiceOwners
+			}
+			emailActions = append(emailActions, emailAction)
+
+		case metricAlertRuleWebhookType:
+			properties := make(map[string]interface{})
+			for k, v := range action.Properties {
+				if v != nil {
+					properties[k] = *v
+				}
+			}
+
+			webhookAction := map[string]interface{}{
+				"properties": properties,
+			}
+			if action.ServiceURI != nil {
+				webhookAction["service_uri"] = *action.ServiceURI
+			}
+			webhookActions = append(webhookActions, webhookAction)
+		}
+	}
+
+	return emailActions, webhookActions
+}
@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMMetricAlertRuleOperator_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "GreaterThan",
+			ErrCount: 0,
+		},
+		{
+			Value:    "LessThanOrEqual",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Equals",
+			ErrCount: 1,
+		},
+		{
+			Value:    "greaterthan",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmMetricAlertRule().Schema["operator"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "operator")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Metric Alert Rule operator %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMMetricAlertRuleAggregation_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Average",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Last",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Count",
+			ErrCount: 1,
+		},
+		{
+			Value:    "average",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmMetricAlertRule().Schema["aggregation"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "aggregation")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Metric Alert Rule aggregation %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMMetricAlertRule_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMMetricAlertRule_basic, ri, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMMetricAlertRule_actions, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMetricAlertRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMetricAlertRuleExists("azurerm_metric_alertrule.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_metric_alertrule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_metric_alertrule.test", "threshold", "75"),
+					resource.TestCheckResourceAttr(
+						"azurerm_metric_alertrule.test", "email_action.#", "0"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMetricAlertRuleExists("azurerm_metric_alertrule.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_metric_alertrule.test", "threshold", "90"),
+					resource.TestCheckResourceAttr(
+						"azurerm_metric_alertrule.test", "email_action.0.send_to_service_owners", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_metric_alertrule.test", "email_action.0.custom_emails.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_metric_alertrule.test", "webhook_action.0.service_uri", "https://example.com/alert"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMetricAlertRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getMetricAlertRule{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Metric Alert Rule: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Metric Alert Rule: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMetricAlertRuleDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_metric_alertrule" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getMetricAlertRule{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Metric Alert Rule: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Metric Alert Rule still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMMetricAlertRule_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Basic"
+        size = "B1"
+    }
+}
+
+resource "azurerm_metric_alertrule" "test" {
+    name = "acctestalertrule-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    description = "High CPU usage"
+
+    resource_id = "${azurerm_app_service_plan.test.id}"
+    metric_name = "CpuPercentage"
+    operator = "GreaterThan"
+    threshold = 75
+    aggregation = "Average"
+    period = "PT5M"
+}
+`
+
+var testAccAzureRMMetricAlertRule_actions = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_app_service_plan" "test" {
+    name = "acctestasp-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    sku {
+        tier = "Basic"
+        size = "B1"
+    }
+}
+
+resource "azurerm_metric_alertrule" "test" {
+    name = "acctestalertrule-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    description = "High CPU usage"
+
+    resource_id = "${azurerm_app_service_plan.test.id}"
+    metric_name = "CpuPercentage"
+    operator = "GreaterThan"
+    threshold = 90
+    aggregation = "Average"
+    period = "PT5M"
+
+    email_action {
+        send_to_service_owners = true
+        custom_emails = ["admin@example.com"]
+    }
+
+    webhook_action {
+        service_uri = "https://example.com/alert"
+
+        properties {
+            severity = "critical"
+        }
+    }
+}
+`
@@ -0,0 +1,377 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+// activityLogAlertCriteriaFields maps the arguments of the criteria block to
+// the activity log fields which they match.
+var activityLogAlertCriteriaFields = map[string]string{
+	"category":          "category",
+	"operation_name":    "operationName",
+	"resource_provider": "resourceProvider",
+	"resource_type":     "resourceType",
+	"resource_group":    "resourceGroup",
+	"resource_id":       "resourceId",
+	"caller":            "caller",
+	"level":             "level",
+	"status":            "status",
+}
+
+func resourceArmMonitorActivityLogAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorActivityLogAlertCreate,
+		Read:   resourceArmMonitorActivityLogAlertRead,
+		Update: resourceArmMonitorActivityLogAlertCreate,
+		Delete: resourceArmMonitorActivityLogAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Administrative", "Autoscale", "Policy", "Recommendation", "Security", "ServiceHealth"}, false),
+						},
+
+						"operation_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"resource_provider": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"resource_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"resource_group": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"resource_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"caller": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Verbose", "Informational", "Warning", "Error", "Critical"}, false),
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_group_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArmActivityLogAlertActionGroupID,
+						},
+
+						"webhook_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorActivityLogAlertCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Activity Log Alert creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	scopes := make([]string, 0)
+	for _, scope := range d.Get("scopes").(*schema.Set).List() {
+		scopes = append(scopes, scope.(string))
+	}
+
+	command := &createOrUpdateActivityLogAlert{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		// Activity Log Alerts aren't regional, so are always created in the
+		// Global location.
+		Location:  "Global",
+		Tags:      *expandedTags,
+		Scopes:    scopes,
+		Enabled:   azure.Bool(d.Get("enabled").(bool)),
+		Condition: expandArmActivityLogAlertCriteria(d),
+		Actions:   expandArmActivityLogAlertActions(d),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		command.Description = azure.String(v.(string))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Activity Log Alert %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Activity Log Alert %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getActivityLogAlert{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Activity Log Alert %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Activity Log Alert %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getActivityLogAlertResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Activity Log Alert %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmMonitorActivityLogAlertRead(d, meta)
+}
+
+func resourceArmMonitorActivityLogAlertRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getActivityLogAlert{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Activity Log Alert %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Activity Log Alert %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Activity Log Alert %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getActivityLogAlertResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("scopes", resp.Scopes)
+	d.Set("description", resp.Description)
+	d.Set("enabled", resp.Enabled)
+
+	if err := d.Set("criteria", flattenArmActivityLogAlertCriteria(resp.Condition)); err != nil {
+		return fmt.Errorf("Error flattening `criteria` for Activity Log Alert %q: %s", *resp.Name, err)
+	}
+	if err := d.Set("action", flattenArmActivityLogAlertActions(resp.Actions)); err != nil {
+		return fmt.Errorf("Error flattening `action` for Activity Log Alert %q: %s", *resp.Name, err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMonitorActivityLogAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteActivityLogAlert{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Activity Log Alert %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Activity Log Alert %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmActivityLogAlertCriteria(d *schema.ResourceData) *activityLogAlertCondition {
+	criteria := d.Get("criteria").([]interface{})[0].(map[string]interface{})
+
+	conditions := make([]activityLogAlertLeafCondition, 0)
+	for key, field := range activityLogAlertCriteriaFields {
+		if value := criteria[key].(string); value != "" {
+			conditions = append(conditions, activityLogAlertLeafCondition{
+				Field:  azure.String(field),
+				Equals: azure.String(value),
+			})
+		}
+	}
+
+	return &activityLogAlertCondition{
+		AllOf: conditions,
+	}
+}
+
+func expandArmActivityLogAlertActions(d *schema.ResourceData) *activityLogAlertActions {
+	actions := &activityLogAlertActions{
+		ActionGroups: make([]activityLogAlertActionGroup, 0),
+	}
+
+	for _, raw := range d.Get("action").([]interface{}) {
+		action := raw.(map[string]interface{})
+
+		properties := make(map[string]*string)
+		for k, v := range action["webhook_properties"].(map[string]interface{}) {
+			properties[k] = azure.String(v.(string))
+		}
+
+		actions.ActionGroups = append(actions.ActionGroups, activityLogAlertActionGroup{
+			ActionGroupID:     azure.String(action["action_group_id"].(string)),
+			WebhookProperties: properties,
+		})
+	}
+
+	return actions
+}
+
+func flattenArmActivityLogAlertCriteria(condition *activityLogAlertCondition) []interface{} {
+	if condition == nil {
+		return []interface{}{}
+	}
+
+	criteria := make(map[string]interface{})
+	for _, leaf := range condition.AllOf {
+		if leaf.Field == nil || leaf.Equals == nil {
+			continue
+		}
+		for key, field := range activityLogAlertCriteriaFields {
+			if strings.EqualFold(field, *leaf.Field) {
+				criteria[key] = *leaf.Equals
+			}
+		}
+	}
+
+	return []interface{}{criteria}
+}
+
+func flattenArmActivityLogAlertActions(actions *activityLogAlertActions) []interface{} {
+	if actions == nil {
+		return []interface{}{}
+	}
+
+	result := make([]interface{}, 0, len(actions.ActionGroups))
+	for _, actionGroup := range actions.ActionGroups {
+		properties := make(map[string]interface{})
+		for k, v := range actionGroup.WebhookProperties {
+			if v != nil {
+				properties[k] = *v
+			}
+		}
+
+		action := map[string]interface{}{
+			"webhook_properties": properties,
+		}
+		if actionGroup.ActionGroupID != nil {
+			action["action_group_id"] = *actionGroup.ActionGroupID
+		}
+		result = append(result, action)
+	}
+
+	return result
+}
+
+func validateArmActivityLogAlertActionGroupID(v interface{}, k string) (ws []string, errors []error) {
+	id, err := parseAzureResourceID(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be the ID of an Action Group: %s", k, err))
+		return
+	}
+
+	if id.Path["actionGroups"] == "" && id.Path["actiongroups"] == "" {
+		errors = append(errors, fmt.Errorf("%q must be the ID of an Action Group", k))
+	}
+	return
+}
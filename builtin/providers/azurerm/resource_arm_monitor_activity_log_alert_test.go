@@ -0,0 +1,229 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMActivityLogAlertCategory_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Administrative",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ServiceHealth",
+			ErrCount: 0,
+		},
+		{
+			Value:    "administrative",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Alert",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmMonitorActivityLogAlert().Schema["criteria"].Elem.(*schema.Resource).Schema["category"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "category")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Activity Log Alert category %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMActivityLogAlertLevel_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Warning",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Critical",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Info",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmMonitorActivityLogAlert().Schema["criteria"].Elem.(*schema.Resource).Schema["level"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "level")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Activity Log Alert level %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMActivityLogAlertActionGroupID_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/actionGroups/group1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "group1",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmActivityLogAlertActionGroupID(tc.Value, "action_group_id")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Activity Log Alert action group ID %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMMonitorActivityLogAlert_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMMonitorActivityLogAlert_basic, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMMonitorActivityLogAlert_updated, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActivityLogAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists("azurerm_monitor_activity_log_alert.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_monitor_activity_log_alert.test", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_monitor_activity_log_alert.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_monitor_activity_log_alert.test", "criteria.0.category", "Administrative"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists("azurerm_monitor_activity_log_alert.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_monitor_activity_log_alert.test", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"azurerm_monitor_activity_log_alert.test", "criteria.0.operation_name", "Microsoft.Storage/storageAccounts/write"),
+					resource.TestCheckResourceAttr(
+						"azurerm_monitor_activity_log_alert.test", "criteria.0.level", "Informational"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorActivityLogAlertExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getActivityLogAlert{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Activity Log Alert: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Activity Log Alert: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorActivityLogAlertDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_activity_log_alert" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getActivityLogAlert{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Activity Log Alert: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Activity Log Alert still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMMonitorActivityLogAlert_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+    name = "acctestactivitylogalert-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    scopes = ["${azurerm_resource_group.test.id}"]
+
+    criteria {
+        category = "Administrative"
+    }
+}
+`
+
+var testAccAzureRMMonitorActivityLogAlert_updated = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+    name = "acctestactivitylogalert-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    scopes = ["${azurerm_resource_group.test.id}"]
+    description = "Storage account changes"
+    enabled = false
+
+    criteria {
+        category = "Administrative"
+        operation_name = "Microsoft.Storage/storageAccounts/write"
+        resource_type = "Microsoft.Storage/storageAccounts"
+        level = "Informational"
+    }
+}
+`
@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceBackendAddressPoolAssociationCreate,
+		Read:   resourceArmNetworkInterfaceBackendAddressPoolAssociationRead,
+		Delete: resourceArmNetworkInterfaceBackendAddressPoolAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"backend_address_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	ifaceClient := meta.(*ArmClient).ifaceClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Network Interface Backend Address Pool Association creation.")
+
+	networkInterfaceID := d.Get("network_interface_id").(string)
+	ipConfigurationName := d.Get("ip_configuration_name").(string)
+	backendAddressPoolID := d.Get("backend_address_pool_id").(string)
+
+	id, err := parseAzureResourceID(networkInterfaceID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	armMutexKV.Lock(nicName)
+	defer armMutexKV.Unlock(nicName)
+
+	iface, err := ifaceClient.Get(resGroup, nicName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %s", nicName, resGroup, err)
+	}
+
+	props, err := findArmNetworkInterfaceIPConfiguration(iface, ipConfigurationName)
+	if err != nil {
+		return fmt.Errorf("Error associating Backend Address Pool with Network Interface %q (Resource Group %q): %s", nicName, resGroup, err)
+	}
+
+	pools := make([]network.BackendAddressPool, 0)
+	if props.LoadBalancerBackendAddressPools != nil {
+		pools = *props.LoadBalancerBackendAddressPools
+	}
+
+	for _, pool := range pools {
+		if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolID) {
+			return fmt.Errorf("IP Configuration %q of Network Interface %q (Resource Group %q) is already associated with Backend Address Pool %q", ipConfigurationName, nicName, resGroup, backendAddressPoolID)
+		}
+	}
+
+	pools = append(pools, network.BackendAddressPool{
+		ID: &backendAddressPoolID,
+	})
+	props.LoadBalancerBackendAddressPools = &pools
+
+	_, err = ifaceClient.CreateOrUpdate(resGroup, nicName, iface, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error associating Backend Address Pool %q with IP Configuration %q of Network Interface %q (Resource Group %q): %s", backendAddressPoolID, ipConfigurationName, nicName, resGroup, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/ipConfigurations/%s|%s", networkInterfaceID, ipConfigurationName, backendAddressPoolID))
+
+	return resourceArmNetworkInterfaceBackendAddressPoolAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	ifaceClient := meta.(*ArmClient).ifaceClient
+
+	id, backendAddressPoolID, err := parseArmNetworkInterfaceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+	ipConfigurationName := id.Path["ipConfigurations"]
+
+	resp, err := ifaceClient.Get(resGroup, nicName, "")
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Network Interface %q (Resource Group %q) not found - removing Backend Address Pool Association from state", nicName, resGroup)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Network Interface %s: %s", nicName, err)
+	}
+
+	props, err := findArmNetworkInterfaceIPConfiguration(resp, ipConfigurationName)
+	if err != nil {
+		log.Printf("[INFO] %s - removing Backend Address Pool Association from state", err)
+		d.SetId("")
+		return nil
+	}
+
+	found := false
+	if props.LoadBalancerBackendAddressPools != nil {
+		for _, pool := range *props.LoadBalancerBackendAddressPools {
+			if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolID) {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[INFO] IP Configuration %q of Network Interface %q (Resource Group %q) is not associated with Backend Address Pool %q - removing from state", ipConfigurationName, nicName, resGroup, backendAddressPoolID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_id", resp.ID)
+	d.Set("ip_configuration_name", ipConfigurationName)
+	d.Set("backend_address_pool_id", backendAddressPoolID)
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	ifaceClient := meta.(*ArmClient).ifaceClient
+
+	id, backendAddressPoolID, err := parseArmNetworkInterfaceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+	ipConfigurationName := id.Path["ipConfigurations"]
+
+	armMutexKV.Lock(nicName)
+	defer armMutexKV.Unlock(nicName)
+
+	iface, err := ifaceClient.Get(resGroup, nicName, "")
+	if iface.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %s", nicName, resGroup, err)
+	}
+
+	props, err := findArmNetworkInterfaceIPConfiguration(iface, ipConfigurationName)
+	if err != nil || props.LoadBalancerBackendAddressPools == nil {
+		return nil
+	}
+
+	pools := make([]network.BackendAddressPool, 0)
+	for _, pool := range *props.LoadBalancerBackendAddressPools {
+		if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolID) {
+			continue
+		}
+		pools = append(pools, pool)
+	}
+	props.LoadBalancerBackendAddressPools = &pools
+
+	_, err = ifaceClient.CreateOrUpdate(resGroup, nicName, iface, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error removing Backend Address Pool %q from IP Configuration %q of Network Interface %q (Resource Group %q): %s", backendAddressPoolID, ipConfigurationName, nicName, resGroup, err)
+	}
+
+	return nil
+}
+
+// parseArmNetworkInterfaceAssociationID splits the ID of an association
+// between the IP Configuration of a Network Interface and a Load Balancer
+// resource, in the format `{ipConfigurationId}|{associatedResourceId}`.
+func parseArmNetworkInterfaceAssociationID(input string) (*ResourceID, string, error) {
+	parts := strings.Split(input, "|")
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, "", fmt.Errorf("Expected the ID to be in the format `{ipConfigurationId}|{resourceId}` but got %q", input)
+	}
+
+	id, err := parseAzureResourceID(parts[0])
+	if err != nil {
+		return nil, "", err
+	}
+	if id.Path["networkInterfaces"] == "" || id.Path["ipConfigurations"] == "" {
+		return nil, "", fmt.Errorf("Expected %q to be the ID of a Network Interface IP Configuration", parts[0])
+	}
+
+	return id, parts[1], nil
+}
+
+// findArmNetworkInterfaceIPConfiguration returns the properties of the named
+// IP Configuration of a Network Interface, which can be modified in place
+// before the Network Interface is updated.
+func findArmNetworkInterfaceIPConfiguration(iface network.Interface, name string) (*network.InterfaceIPConfigurationPropertiesFormat, error) {
+	if iface.Properties != nil && iface.Properties.IPConfigurations != nil {
+		for _, config := range *iface.Properties.IPConfigurations {
+			if config.Name != nil && strings.EqualFold(*config.Name, name) && config.Properties != nil {
+				return config.Properties, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("IP Configuration %q was not found on the Network Interface", name)
+}
@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMNetworkInterfaceAssociationID_parse(t *testing.T) {
+	nicID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1"
+	poolID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"
+
+	cases := []struct {
+		ID       string
+		ErrCount int
+	}{
+		{
+			ID:       fmt.Sprintf("%s/ipConfigurations/config1|%s", nicID, poolID),
+			ErrCount: 0,
+		},
+		{
+			ID:       fmt.Sprintf("%s/ipConfigurations/config1", nicID),
+			ErrCount: 1,
+		},
+		{
+			ID:       fmt.Sprintf("%s|%s", nicID, poolID),
+			ErrCount: 1,
+		},
+		{
+			ID:       fmt.Sprintf("%s/ipConfigurations/config1|", nicID),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		id, resourceID, err := parseArmNetworkInterfaceAssociationID(tc.ID)
+		if tc.ErrCount == 0 {
+			if err != nil {
+				t.Fatalf("Expected %q to parse but got: %s", tc.ID, err)
+			}
+			if id.Path["networkInterfaces"] != "nic1" || id.Path["ipConfigurations"] != "config1" || resourceID != poolID {
+				t.Fatalf("Unexpected result parsing %q: %#v, %q", tc.ID, id, resourceID)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected %q to fail to parse", tc.ID)
+		}
+	}
+}
+
+func TestAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociationExists("azurerm_network_interface_backend_address_pool_association.test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		associated, err := testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociated(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !associated {
+			return fmt.Errorf("Bad: Backend Address Pool Association %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_interface_backend_address_pool_association" {
+			continue
+		}
+
+		associated, err := testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociated(rs.Primary.ID)
+		if err != nil {
+			return nil
+		}
+		if associated {
+			return fmt.Errorf("Backend Address Pool Association %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociated(associationID string) (bool, error) {
+	conn := testAccProvider.Meta().(*ArmClient).ifaceClient
+
+	id, poolID, err := parseArmNetworkInterfaceAssociationID(associationID)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := conn.Get(id.ResourceGroup, id.Path["networkInterfaces"], "")
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("Bad: Get on ifaceClient: %s", err)
+	}
+
+	props, err := findArmNetworkInterfaceIPConfiguration(resp, id.Path["ipConfigurations"])
+	if err != nil || props.LoadBalancerBackendAddressPools == nil {
+		return false, nil
+	}
+
+	for _, pool := range *props.LoadBalancerBackendAddressPools {
+		if pool.ID != nil && strings.EqualFold(*pool.ID, poolID) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// testAccAzureRMNetworkInterfaceAssociationTemplate returns a configuration
+// which creates a Network Interface alongside the Load Balancer created by
+// testAccAzureRMLoadBalancerTemplate.
+func testAccAzureRMNetworkInterfaceAssociationTemplate(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctestvirtnet%d"
+    address_space = ["10.0.0.0/16"]
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+    name = "acctestsubnet%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+    name = "acctestni-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    ip_configuration {
+        name = "testconfiguration1"
+        subnet_id = "${azurerm_subnet.test.id}"
+        private_ip_address_allocation = "dynamic"
+    }
+}
+`, testAccAzureRMLoadBalancerTemplate(rInt), rInt, rInt, rInt)
+}
+
+func testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_backend_address_pool_association" "test" {
+    network_interface_id = "${azurerm_network_interface.test.id}"
+    ip_configuration_name = "testconfiguration1"
+    backend_address_pool_id = "${azurerm_template_deployment.test.outputs.loadBalancerId}/backendAddressPools/backend"
+}
+`, testAccAzureRMNetworkInterfaceAssociationTemplate(rInt))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmNetworkInterface() *schema.Resource {
@@ -18,6 +19,9 @@ func resourceArmNetworkInterface() *schema.Resource {
 		Read:   resourceArmNetworkInterfaceRead,
 		Update: resourceArmNetworkInterfaceCreate,
 		Delete: resourceArmNetworkInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -86,7 +90,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 						"private_ip_address_allocation": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateNetworkInterfacePrivateIpAddressAllocation,
+							ValidateFunc: validation.StringInSlice([]string{"Static", "Dynamic"}, true),
 						},
 
 						"public_ip_address_id": {
@@ -255,6 +259,18 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 
 	iface := *resp.Properties
 
+	d.Set("name", resp.Name)
+	d.Set("location", resp.Location)
+	d.Set("resource_group_name", resGroup)
+
+	if iface.NetworkSecurityGroup != nil {
+		d.Set("network_security_group_id", iface.NetworkSecurityGroup.ID)
+	}
+
+	if iface.EnableIPForwarding != nil {
+		d.Set("enable_ip_forwarding", *iface.EnableIPForwarding)
+	}
+
 	if iface.MacAddress != nil {
 		if *iface.MacAddress != "" {
 			d.Set("mac_address", iface.MacAddress)
@@ -332,19 +348,6 @@ func resourceArmNetworkInterfaceIpConfigurationHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func validateNetworkInterfacePrivateIpAddressAllocation(v interface{}, k string) (ws []string, errors []error) {
-	value := strings.ToLower(v.(string))
-	allocations := map[string]bool{
-		"static":  true,
-		"dynamic": true,
-	}
-
-	if !allocations[value] {
-		errors = append(errors, fmt.Errorf("Network Interface Allocations can only be Static or Dynamic"))
-	}
-	return
-}
-
 func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]network.InterfaceIPConfiguration, error) {
 	configs := d.Get("ip_configuration").(*schema.Set).List()
 	ipConfigs := make([]network.InterfaceIPConfiguration, 0, len(configs))
@@ -0,0 +1,192 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmNetworkInterfaceNatRuleAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceNatRuleAssociationCreate,
+		Read:   resourceArmNetworkInterfaceNatRuleAssociationRead,
+		Delete: resourceArmNetworkInterfaceNatRuleAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"nat_rule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmNetworkInterfaceNatRuleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	ifaceClient := meta.(*ArmClient).ifaceClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Network Interface NAT Rule Association creation.")
+
+	networkInterfaceID := d.Get("network_interface_id").(string)
+	ipConfigurationName := d.Get("ip_configuration_name").(string)
+	natRuleID := d.Get("nat_rule_id").(string)
+
+	id, err := parseAzureResourceID(networkInterfaceID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	armMutexKV.Lock(nicName)
+	defer armMutexKV.Unlock(nicName)
+
+	iface, err := ifaceClient.Get(resGroup, nicName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %s", nicName, resGroup, err)
+	}
+
+	props, err := findArmNetworkInterfaceIPConfiguration(iface, ipConfigurationName)
+	if err != nil {
+		return fmt.Errorf("Error associating NAT Rule with Network Interface %q (Resource Group %q): %s", nicName, resGroup, err)
+	}
+
+	rules := make([]network.InboundNatRule, 0)
+	if props.LoadBalancerInboundNatRules != nil {
+		rules = *props.LoadBalancerInboundNatRules
+	}
+
+	for _, rule := range rules {
+		if rule.ID != nil && strings.EqualFold(*rule.ID, natRuleID) {
+			return fmt.Errorf("IP Configuration %q of Network Interface %q (Resource Group %q) is already associated with NAT Rule %q", ipConfigurationName, nicName, resGroup, natRuleID)
+		}
+	}
+
+	rules = append(rules, network.InboundNatRule{
+		ID: &natRuleID,
+	})
+	props.LoadBalancerInboundNatRules = &rules
+
+	_, err = ifaceClient.CreateOrUpdate(resGroup, nicName, iface, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error associating NAT Rule %q with IP Configuration %q of Network Interface %q (Resource Group %q): %s", natRuleID, ipConfigurationName, nicName, resGroup, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/ipConfigurations/%s|%s", networkInterfaceID, ipConfigurationName, natRuleID))
+
+	return resourceArmNetworkInterfaceNatRuleAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceNatRuleAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	ifaceClient := meta.(*ArmClient).ifaceClient
+
+	id, natRuleID, err := parseArmNetworkInterfaceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+	ipConfigurationName := id.Path["ipConfigurations"]
+
+	resp, err := ifaceClient.Get(resGroup, nicName, "")
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Network Interface %q (Resource Group %q) not found - removing NAT Rule Association from state", nicName, resGroup)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Network Interface %s: %s", nicName, err)
+	}
+
+	props, err := findArmNetworkInterfaceIPConfiguration(resp, ipConfigurationName)
+	if err != nil {
+		log.Printf("[INFO] %s - removing NAT Rule Association from state", err)
+		d.SetId("")
+		return nil
+	}
+
+	found := false
+	if props.LoadBalancerInboundNatRules != nil {
+		for _, rule := range *props.LoadBalancerInboundNatRules {
+			if rule.ID != nil && strings.EqualFold(*rule.ID, natRuleID) {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[INFO] IP Configuration %q of Network Interface %q (Resource Group %q) is not associated with NAT Rule %q - removing from state", ipConfigurationName, nicName, resGroup, natRuleID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_id", resp.ID)
+	d.Set("ip_configuration_name", ipConfigurationName)
+	d.Set("nat_rule_id", natRuleID)
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceNatRuleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	ifaceClient := meta.(*ArmClient).ifaceClient
+
+	id, natRuleID, err := parseArmNetworkInterfaceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+	ipConfigurationName := id.Path["ipConfigurations"]
+
+	armMutexKV.Lock(nicName)
+	defer armMutexKV.Unlock(nicName)
+
+	iface, err := ifaceClient.Get(resGroup, nicName, "")
+	if iface.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %s", nicName, resGroup, err)
+	}
+
+	props, err := findArmNetworkInterfaceIPConfiguration(iface, ipConfigurationName)
+	if err != nil || props.LoadBalancerInboundNatRules == nil {
+		return nil
+	}
+
+	rules := make([]network.InboundNatRule, 0)
+	for _, rule := range *props.LoadBalancerInboundNatRules {
+		if rule.ID != nil && strings.EqualFold(*rule.ID, natRuleID) {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	props.LoadBalancerInboundNatRules = &rules
+
+	_, err = ifaceClient.CreateOrUpdate(resGroup, nicName, iface, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error removing NAT Rule %q from IP Configuration %q of Network Interface %q (Resource Group %q): %s", natRuleID, ipConfigurationName, nicName, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMNetworkInterfaceNatRuleAssociation_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMNetworkInterfaceNatRuleAssociation_basic(ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceNatRuleAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceNatRuleAssociationExists("azurerm_network_interface_nat_rule_association.test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkInterfaceNatRuleAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		associated, err := testCheckAzureRMNetworkInterfaceNatRuleAssociated(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !associated {
+			return fmt.Errorf("Bad: NAT Rule Association %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkInterfaceNatRuleAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_interface_nat_rule_association" {
+			continue
+		}
+
+		associated, err := testCheckAzureRMNetworkInterfaceNatRuleAssociated(rs.Primary.ID)
+		if err != nil {
+			return nil
+		}
+		if associated {
+			return fmt.Errorf("NAT Rule Association %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMNetworkInterfaceNatRuleAssociated(associationID string) (bool, error) {
+	conn := testAccProvider.Meta().(*ArmClient).ifaceClient
+
+	id, ruleID, err := parseArmNetworkInterfaceAssociationID(associationID)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := conn.Get(id.ResourceGroup, id.Path["networkInterfaces"], "")
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("Bad: Get on ifaceClient: %s", err)
+	}
+
+	props, err := findArmNetworkInterfaceIPConfiguration(resp, id.Path["ipConfigurations"])
+	if err != nil || props.LoadBalancerInboundNatRules == nil {
+		return false, nil
+	}
+
+	for _, rule := range *props.LoadBalancerInboundNatRules {
+		if rule.ID != nil && strings.EqualFold(*rule.ID, ruleID) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAzureRMNetworkInterfaceNatRuleAssociation_basic(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_nat_rule_association" "test" {
+    network_interface_id = "${azurerm_network_interface.test.id}"
+    ip_configuration_name = "testconfiguration1"
+    nat_rule_id = "${azurerm_template_deployment.test.outputs.loadBalancerId}/inboundNatRules/ssh"
+}
+`, testAccAzureRMNetworkInterfaceAssociationTemplate(rInt))
+}
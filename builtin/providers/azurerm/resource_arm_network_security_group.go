@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmNetworkSecurityGroup() *schema.Resource {
@@ -70,7 +71,7 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 						"protocol": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateNetworkSecurityRuleProtocol,
+							ValidateFunc: validation.StringInSlice([]string{"Tcp", "Udp", "*"}, true),
 						},
 
 						"source_port_range": {
@@ -96,7 +97,7 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 						"access": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateNetworkSecurityRuleAccess,
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, true),
 						},
 
 						"priority": {
@@ -115,7 +116,7 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 						"direction": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateNetworkSecurityRuleDirection,
+							ValidateFunc: validation.StringInSlice([]string{"Inbound", "Outbound"}, true),
 						},
 					},
 				},
@@ -136,9 +137,29 @@ func resourceArmNetworkSecurityGroupCreate(d *schema.ResourceData, meta interfac
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
-	sgRules, sgErr := expandAzureRmSecurityRules(d)
-	if sgErr != nil {
-		return fmt.Errorf("Error Building list of Network Security Group Rules: %s", sgErr)
+	// Rules may also be managed by azurerm_network_security_rule resources,
+	// which update this group independently; serialise both against its name.
+	armMutexKV.Lock(name)
+	defer armMutexKV.Unlock(name)
+
+	var sgRules []network.SecurityRule
+	if !d.IsNewResource() && !d.HasChange("security_rule") {
+		// The group is replaced as a whole, so when the inline rules haven't
+		// changed send the rules which currently exist, so that those added
+		// through azurerm_network_security_rule are kept.
+		existing, err := secClient.Get(resGroup, name, "")
+		if err != nil && existing.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %s", name, resGroup, err)
+		}
+		if existing.Properties != nil && existing.Properties.SecurityRules != nil {
+			sgRules = *existing.Properties.SecurityRules
+		}
+	} else {
+		var sgErr error
+		sgRules, sgErr = expandAzureRmSecurityRules(d)
+		if sgErr != nil {
+			return fmt.Errorf("Error Building list of Network Security Group Rules: %s", sgErr)
+		}
 	}
 
 	sg := network.SecurityGroup{
@@ -203,6 +224,7 @@ func resourceArmNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{
 		d.Set("security_rule", flattenNetworkSecurityRules(resp.Properties.SecurityRules))
 	}
 
+	d.Set("resource_group_name", resGroup)
 	d.Set("name", resp.Name)
 	d.Set("location", resp.Location)
 	flattenAndSetTags(d, resp.Tags)
@@ -2,10 +2,14 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmNetworkSecurityRule() *schema.Resource {
@@ -34,6 +38,7 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			"network_security_group_name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 
 			"description": {
@@ -52,7 +57,7 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			"protocol": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateNetworkSecurityRuleProtocol,
+				ValidateFunc: validation.StringInSlice([]string{"Tcp", "Udp", "*"}, true),
 			},
 
 			"source_port_range": {
@@ -78,7 +83,7 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			"access": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateNetworkSecurityRuleAccess,
+				ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, true),
 			},
 
 			"priority": {
@@ -97,7 +102,7 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			"direction": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateNetworkSecurityRuleDirection,
+				ValidateFunc: validation.StringInSlice([]string{"Inbound", "Outbound"}, true),
 			},
 		},
 	}
@@ -144,7 +149,37 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 		Properties: &properties,
 	}
 
-	_, err := secClient.CreateOrUpdate(resGroup, nsgName, name, sgr, make(chan struct{}))
+	// Rules may be added to the same Network Security Group by other
+	// configurations, or inline by the group itself. Each write is made
+	// conditional on the ETag of the rule so that a concurrent change isn't
+	// silently overwritten, and is retried whilst the group is busy.
+	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		existing, err := secClient.Get(resGroup, nsgName, name)
+		if err != nil && existing.StatusCode != http.StatusNotFound {
+			return resource.NonRetryableError(fmt.Errorf("Error retrieving Network Security Rule %q (Network Security Group %q / Resource Group %q): %s", name, nsgName, resGroup, err))
+		}
+
+		etag := ""
+		if existing.StatusCode != http.StatusNotFound {
+			if d.IsNewResource() {
+				return resource.NonRetryableError(fmt.Errorf("Network Security Rule %q already exists in Network Security Group %q (Resource Group %q) - to be managed via Terraform this resource needs to be imported into the State.", name, nsgName, resGroup))
+			}
+			if existing.Etag != nil {
+				etag = *existing.Etag
+			}
+		}
+
+		resp, err := createOrUpdateArmNetworkSecurityRule(secClient, resGroup, nsgName, name, sgr, etag)
+		if err != nil {
+			if isRetryableArmNetworkSecurityRuleResponse(resp) {
+				log.Printf("[DEBUG] Network Security Rule %q (Network Security Group %q) was modified concurrently, retrying: %s", name, nsgName, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -176,6 +211,7 @@ func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}
 
 	resp, err := secRuleClient.Get(resGroup, networkSGName, sgRuleName)
 	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Network Security Rule %q (Network Security Group %q) not found - removing from state", sgRuleName, networkSGName)
 		d.SetId("")
 		return nil
 	}
@@ -183,6 +219,8 @@ func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error making Read request on Azure Network Security Rule %s: %s", sgRuleName, err)
 	}
 
+	d.Set("resource_group_name", resGroup)
+	d.Set("network_security_group_name", networkSGName)
 	d.Set("access", resp.Properties.Access)
 	d.Set("destination_address_prefix", resp.Properties.DestinationAddressPrefix)
 	d.Set("destination_port_range", resp.Properties.DestinationPortRange)
@@ -212,7 +250,59 @@ func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface
 	armMutexKV.Lock(nsgName)
 	defer armMutexKV.Unlock(nsgName)
 
-	_, err = secRuleClient.Delete(resGroup, nsgName, sgRuleName, make(chan struct{}))
+	return resource.Retry(10*time.Minute, func() *resource.RetryError {
+		resp, err := secRuleClient.Delete(resGroup, nsgName, sgRuleName, make(chan struct{}))
+		if err != nil {
+			if isRetryableArmNetworkSecurityRuleResponse(resp.Response) {
+				log.Printf("[DEBUG] Network Security Group %q is busy, retrying deletion of Network Security Rule %q: %s", nsgName, sgRuleName, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// createOrUpdateArmNetworkSecurityRule PUTs the rule with an If-Match
+// precondition on etag, or If-None-Match when the rule is expected not to
+// exist, so that the request fails rather than overwriting a concurrent change.
+func createOrUpdateArmNetworkSecurityRule(client network.SecurityRulesClient, resGroup, nsgName, name string, rule network.SecurityRule, etag string) (*http.Response, error) {
+	req, err := client.CreateOrUpdatePreparer(resGroup, nsgName, name, rule, make(chan struct{}))
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing request for Network Security Rule %q: %s", name, err)
+	}
+
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	} else {
+		req.Header.Set("If-None-Match", "*")
+	}
+
+	resp, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		return resp, fmt.Errorf("Error sending request for Network Security Rule %q: %s", name, err)
+	}
+
+	if _, err := client.CreateOrUpdateResponder(resp); err != nil {
+		return resp, fmt.Errorf("Error creating or updating Network Security Rule %q: %s", name, err)
+	}
+
+	return resp, nil
+}
+
+// isRetryableArmNetworkSecurityRuleResponse returns whether a failed request
+// against a rule was rejected because the rule or its Network Security Group
+// was being modified at the same time.
+func isRetryableArmNetworkSecurityRuleResponse(resp *http.Response) bool {
+	if resp == nil {
+		return false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusConflict, http.StatusPreconditionFailed, 429:
+		return true
+	}
 
-	return err
+	return false
 }
@@ -9,6 +9,121 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestResourceAzureRMNetworkSecurityRuleProtocol_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "tcp",
+			ErrCount: 0,
+		},
+		{
+			Value:    "TCP",
+			ErrCount: 0,
+		},
+		{
+			Value:    "*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Udp",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Tcp",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmNetworkSecurityRule().Schema["protocol"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "azurerm_network_security_rule")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Network Security Rule protocol to trigger a validation error")
+		}
+	}
+}
+
+func TestResourceAzureRMNetworkSecurityRuleAccess_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Allow",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Deny",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ALLOW",
+			ErrCount: 0,
+		},
+		{
+			Value:    "deny",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmNetworkSecurityRule().Schema["access"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "azurerm_network_security_rule")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Network Security Rule access to trigger a validation error")
+		}
+	}
+}
+
+func TestResourceAzureRMNetworkSecurityRuleDirection_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Inbound",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Outbound",
+			ErrCount: 0,
+		},
+		{
+			Value:    "INBOUND",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Inbound",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmNetworkSecurityRule().Schema["direction"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "azurerm_network_security_rule")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Network Security Rule direction to trigger a validation error")
+		}
+	}
+}
+
 func TestAccAzureRMNetworkSecurityRule_basic(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -50,6 +165,26 @@ func TestAccAzureRMNetworkSecurityRule_addingRules(t *testing.T) {
 	})
 }
 
+func TestResourceAzureRMNetworkSecurityRule_retryableResponse(t *testing.T) {
+	cases := []struct {
+		Response  *http.Response
+		Retryable bool
+	}{
+		{Response: nil, Retryable: false},
+		{Response: &http.Response{StatusCode: http.StatusOK}, Retryable: false},
+		{Response: &http.Response{StatusCode: http.StatusBadRequest}, Retryable: false},
+		{Response: &http.Response{StatusCode: http.StatusConflict}, Retryable: true},
+		{Response: &http.Response{StatusCode: http.StatusPreconditionFailed}, Retryable: true},
+		{Response: &http.Response{StatusCode: 429}, Retryable: true},
+	}
+
+	for _, tc := range cases {
+		if v := isRetryableArmNetworkSecurityRuleResponse(tc.Response); v != tc.Retryable {
+			t.Fatalf("Expected %#v to be retryable: %t, got %t", tc.Response, tc.Retryable, v)
+		}
+	}
+}
+
 func testCheckAzureRMNetworkSecurityRuleExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
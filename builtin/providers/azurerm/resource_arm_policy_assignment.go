@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmPolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPolicyAssignmentCreate,
+		Read:   resourceArmPolicyAssignmentRead,
+		Update: resourceArmPolicyAssignmentCreate,
+		Delete: resourceArmPolicyAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"policy_definition_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmPolicyDefinitionID,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"parameters": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArmPolicyJson,
+				StateFunc:    normalizeJson,
+			},
+
+			"not_scopes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"enforce": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceArmPolicyAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Policy Assignment creation.")
+
+	name := d.Get("name").(string)
+	scope := d.Get("scope").(string)
+
+	// Policies which aren't enforced are still evaluated, so that their
+	// effect can be reviewed before they're enforced.
+	enforcementMode := "Default"
+	if !d.Get("enforce").(bool) {
+		enforcementMode = "DoNotEnforce"
+	}
+
+	command := &createOrUpdatePolicyAssignment{
+		Scope:              azure.String(scope),
+		PolicyDefinitionID: azure.String(d.Get("policy_definition_id").(string)),
+		NotScopes:          expandArmPolicyAssignmentNotScopes(d),
+		EnforcementMode:    azure.String(enforcementMode),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		command.DisplayName = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		command.Description = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		parameters, err := expandArmPolicyJson(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing the `parameters` of Policy Assignment %q: %s", name, err)
+		}
+		command.Parameters = parameters
+	}
+
+	createRequest := rivieraClient.NewRequestForURI(authorizationResourceURI(scope, policyAssignmentsURLSegment, name))
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Policy Assignment %q (scope %q): %s", name, scope, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Policy Assignment %q (scope %q): %s", name, scope, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getPolicyAssignmentResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Policy Assignment %s (scope %s) ID", name, scope)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPolicyAssignmentRead(d, meta)
+}
+
+func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	scope, name, err := parseAuthorizationResourceID(d.Id(), policyAssignmentsURLSegment)
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getPolicyAssignment{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Policy Assignment %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Policy Assignment %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Policy Assignment %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getPolicyAssignmentResponse)
+
+	d.Set("name", name)
+	d.Set("scope", scope)
+	d.Set("policy_definition_id", resp.PolicyDefinitionID)
+	d.Set("display_name", resp.DisplayName)
+	d.Set("description", resp.Description)
+	d.Set("not_scopes", resp.NotScopes)
+	d.Set("enforce", resp.EnforcementMode == nil || !strings.EqualFold(*resp.EnforcementMode, "DoNotEnforce"))
+
+	parameters, err := flattenArmPolicyJson(resp.Parameters)
+	if err != nil {
+		return fmt.Errorf("Error flattening the `parameters` of Policy Assignment %q: %s", name, err)
+	}
+	d.Set("parameters", parameters)
+
+	return nil
+}
+
+func resourceArmPolicyAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deletePolicyAssignment{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Policy Assignment %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting Policy Assignment %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmPolicyAssignmentNotScopes(d *schema.ResourceData) []string {
+	scopes := make([]string, 0)
+	for _, scope := range d.Get("not_scopes").([]interface{}) {
+		scopes = append(scopes, scope.(string))
+	}
+	return scopes
+}
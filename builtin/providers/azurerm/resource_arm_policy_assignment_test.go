@@ -0,0 +1,118 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMPolicyAssignment_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := testAccAzureRMPolicyAssignment_basic(ri, true)
+	postConfig := testAccAzureRMPolicyAssignment_basic(ri, false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyAssignmentExists("azurerm_policy_assignment.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_policy_assignment.test", "enforce", "true"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyAssignmentExists("azurerm_policy_assignment.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_policy_assignment.test", "enforce", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPolicyAssignmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getPolicyAssignment{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Policy Assignment: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Policy Assignment: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPolicyAssignmentDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_policy_assignment" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getPolicyAssignment{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Policy Assignment: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Policy Assignment still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMPolicyAssignment_basic(rInt int, enforce bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_policy_assignment" "test" {
+    name = "acctestpa-%d"
+    scope = "${azurerm_resource_group.test.id}"
+    policy_definition_id = "${azurerm_policy_definition.test.id}"
+    display_name = "Allowed locations"
+    enforce = %t
+
+    parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": ["westus"]
+  }
+}
+PARAMETERS
+}
+`, testAccAzureRMPolicyDefinition_basic(rInt, "Allowed locations"), rInt, rInt, enforce)
+}
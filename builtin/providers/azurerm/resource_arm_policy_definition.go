@@ -0,0 +1,238 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmPolicyDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPolicyDefinitionCreate,
+		Read:   resourceArmPolicyDefinitionRead,
+		Update: resourceArmPolicyDefinitionCreate,
+		Delete: resourceArmPolicyDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"BuiltIn", "Custom", "NotSpecified"}, false),
+			},
+
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"All", "Indexed"}, false),
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"policy_rule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArmPolicyJson,
+				StateFunc:    normalizeJson,
+			},
+
+			"parameters": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArmPolicyJson,
+				StateFunc:    normalizeJson,
+			},
+
+			// Azure adds its own values, such as the creation time, to the
+			// metadata of a Policy Definition.
+			"metadata": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArmPolicyJson,
+				StateFunc:    normalizeJson,
+			},
+		},
+	}
+}
+
+func resourceArmPolicyDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Policy Definition creation.")
+
+	name := d.Get("name").(string)
+	scope := fmt.Sprintf("/subscriptions/%s", client.subscriptionId)
+
+	command := &createOrUpdatePolicyDefinition{
+		PolicyType:  azure.String(d.Get("policy_type").(string)),
+		Mode:        azure.String(d.Get("mode").(string)),
+		DisplayName: azure.String(d.Get("display_name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		command.Description = azure.String(v.(string))
+	}
+
+	var err error
+	if v, ok := d.GetOk("policy_rule"); ok {
+		if command.PolicyRule, err = expandArmPolicyJson(v.(string)); err != nil {
+			return fmt.Errorf("Error parsing the `policy_rule` of Policy Definition %q: %s", name, err)
+		}
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		if command.Parameters, err = expandArmPolicyJson(v.(string)); err != nil {
+			return fmt.Errorf("Error parsing the `parameters` of Policy Definition %q: %s", name, err)
+		}
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		if command.Metadata, err = expandArmPolicyJson(v.(string)); err != nil {
+			return fmt.Errorf("Error parsing the `metadata` of Policy Definition %q: %s", name, err)
+		}
+	}
+
+	createRequest := rivieraClient.NewRequestForURI(authorizationResourceURI(scope, policyDefinitionsURLSegment, name))
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Policy Definition %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Policy Definition %q: %s", name, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getPolicyDefinitionResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Policy Definition %s ID", name)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPolicyDefinitionRead(d, meta)
+}
+
+func resourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	_, name, err := parseAuthorizationResourceID(d.Id(), policyDefinitionsURLSegment)
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getPolicyDefinition{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Policy Definition %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Policy Definition %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Policy Definition %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getPolicyDefinitionResponse)
+
+	d.Set("name", name)
+	d.Set("policy_type", resp.PolicyType)
+	d.Set("mode", resp.Mode)
+	d.Set("display_name", resp.DisplayName)
+	d.Set("description", resp.Description)
+
+	policyRule, err := flattenArmPolicyJson(resp.PolicyRule)
+	if err != nil {
+		return fmt.Errorf("Error flattening the `policy_rule` of Policy Definition %q: %s", name, err)
+	}
+	d.Set("policy_rule", policyRule)
+
+	parameters, err := flattenArmPolicyJson(resp.Parameters)
+	if err != nil {
+		return fmt.Errorf("Error flattening the `parameters` of Policy Definition %q: %s", name, err)
+	}
+	d.Set("parameters", parameters)
+
+	metadata, err := flattenArmPolicyJson(resp.Metadata)
+	if err != nil {
+		return fmt.Errorf("Error flattening the `metadata` of Policy Definition %q: %s", name, err)
+	}
+	d.Set("metadata", metadata)
+
+	return nil
+}
+
+func resourceArmPolicyDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deletePolicyDefinition{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Policy Definition %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting Policy Definition %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+// expandArmPolicyJson parses the JSON objects used for the rules, parameters
+// and metadata of Policy Definitions and Assignments.
+func expandArmPolicyJson(input string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func flattenArmPolicyJson(input map[string]interface{}) (string, error) {
+	if len(input) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func validateArmPolicyJson(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandArmPolicyJson(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
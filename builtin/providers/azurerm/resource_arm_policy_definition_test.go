@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMPolicyDefinitionMode_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "All",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Indexed",
+			ErrCount: 0,
+		},
+		{
+			Value:    "all",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmPolicyDefinition().Schema["mode"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "mode")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Policy Definition mode %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMPolicyJson_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    `{"if": {"field": "location", "notIn": ["westeurope"]}, "then": {"effect": "deny"}}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `["westeurope"]`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"if": `,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmPolicyJson(tc.Value, "policy_rule")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMPolicyDefinition_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := testAccAzureRMPolicyDefinition_basic(ri, "Allowed locations")
+	postConfig := testAccAzureRMPolicyDefinition_basic(ri, "Permitted locations")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists("azurerm_policy_definition.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_policy_definition.test", "display_name", "Allowed locations"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists("azurerm_policy_definition.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_policy_definition.test", "display_name", "Permitted locations"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPolicyDefinitionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getPolicyDefinition{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Policy Definition: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Policy Definition: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPolicyDefinitionDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_policy_definition" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getPolicyDefinition{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Policy Definition: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Policy Definition still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMPolicyDefinition_basic(rInt int, displayName string) string {
+	return fmt.Sprintf(`
+resource "azurerm_policy_definition" "test" {
+    name = "acctestpol-%d"
+    policy_type = "Custom"
+    mode = "All"
+    display_name = "%s"
+
+    policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "not": {
+      "field": "location",
+      "in": "[parameters('allowedLocations')]"
+    }
+  },
+  "then": {
+    "effect": "audit"
+  }
+}
+POLICY_RULE
+
+    parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "type": "Array",
+    "metadata": {
+      "description": "The list of allowed locations for resources.",
+      "displayName": "Allowed locations",
+      "strongType": "location"
+    }
+  }
+}
+PARAMETERS
+}
+`, rInt, displayName)
+}
@@ -7,8 +7,9 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
 )
 
 func resourceArmPublicIp() *schema.Resource {
@@ -41,10 +42,25 @@ func resourceArmPublicIp() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"sku": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Basic",
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard"}, false),
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"public_ip_address_allocation": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validatePublicIpAllocation,
+				ValidateFunc: validation.StringInSlice([]string{"Static", "Dynamic"}, true),
 				StateFunc: func(val interface{}) string {
 					return strings.ToLower(val.(string))
 				},
@@ -91,71 +107,75 @@ func resourceArmPublicIp() *schema.Resource {
 
 func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	publicIPClient := client.publicIPClient
+	rivieraClient := client.rivieraClient
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Public IP creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
+	sku := d.Get("sku").(string)
+	allocationMethod := d.Get("public_ip_address_allocation").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
-	properties := network.PublicIPAddressPropertiesFormat{
-		PublicIPAllocationMethod: network.IPAllocationMethod(d.Get("public_ip_address_allocation").(string)),
+	if strings.EqualFold(sku, "Standard") && !strings.EqualFold(allocationMethod, "Static") {
+		return fmt.Errorf("Public IP %q: `public_ip_address_allocation` must be `static` when `sku` is `Standard`", name)
+	}
+
+	command := &createOrUpdatePublicIPAddress{
+		Name:                     name,
+		ResourceGroupName:        resGroup,
+		Location:                 location,
+		Tags:                     *expandTags(tags),
+		Sku:                      &publicIPAddressSku{Name: azure.String(sku)},
+		Zones:                    expandArmPublicIpZones(d),
+		PublicIPAllocationMethod: azure.String(allocationMethod),
 	}
 
 	dnl, hasDnl := d.GetOk("domain_name_label")
 	rfqdn, hasRfqdn := d.GetOk("reverse_fqdn")
 
 	if hasDnl || hasRfqdn {
-		dnsSettings := network.PublicIPAddressDNSSettings{}
+		dnsSettings := publicIPAddressDNSSettings{}
 
 		if hasRfqdn {
-			reverse_fqdn := rfqdn.(string)
-			dnsSettings.ReverseFqdn = &reverse_fqdn
+			dnsSettings.ReverseFqdn = azure.String(rfqdn.(string))
 		}
 
 		if hasDnl {
-			domain_name_label := dnl.(string)
-			dnsSettings.DomainNameLabel = &domain_name_label
-
+			dnsSettings.DomainNameLabel = azure.String(dnl.(string))
 		}
 
-		properties.DNSSettings = &dnsSettings
+		command.DNSSettings = &dnsSettings
 	}
 
 	if v, ok := d.GetOk("idle_timeout_in_minutes"); ok {
-		idle_timeout := v.(int32)
-		properties.IdleTimeoutInMinutes = &idle_timeout
+		command.IdleTimeoutInMinutes = azure.Int32(int32(v.(int)))
 	}
 
-	publicIp := network.PublicIPAddress{
-		Name:       &name,
-		Location:   &location,
-		Properties: &properties,
-		Tags:       expandTags(tags),
-	}
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
 
-	_, err := publicIPClient.CreateOrUpdate(resGroup, name, publicIp, make(chan struct{}))
+	createResponse, err := createRequest.Execute()
 	if err != nil {
-		return err
+		return fmt.Errorf("Error creating Public IP %q: %s", name, err)
 	}
-
-	read, err := publicIPClient.Get(resGroup, name, "")
-	if err != nil {
-		return err
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Public IP %q: %s", name, createResponse.Error)
 	}
-	if read.ID == nil {
+
+	resp := createResponse.Parsed.(*getPublicIPAddressResponse)
+	if resp.ID == nil {
 		return fmt.Errorf("Cannot read Public IP %s (resource group %s) ID", name, resGroup)
 	}
 
-	d.SetId(*read.ID)
+	d.SetId(*resp.ID)
 
 	return resourceArmPublicIpRead(d, meta)
 }
 
 func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
-	publicIPClient := meta.(*ArmClient).publicIPClient
+	rivieraClient := meta.(*ArmClient).rivieraClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -164,25 +184,54 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 	resGroup := id.ResourceGroup
 	name := id.Path["publicIPAddresses"]
 
-	resp, err := publicIPClient.Get(resGroup, name, "")
-	if resp.StatusCode == http.StatusNotFound {
-		d.SetId("")
-		return nil
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getPublicIPAddress{
+		Name:              name,
+		ResourceGroupName: resGroup,
 	}
+
+	readResponse, err := readRequest.Execute()
 	if err != nil {
 		return fmt.Errorf("Error making Read request on Azure public ip %s: %s", name, err)
 	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure public ip %s: %s", name, readResponse.Error)
+	}
 
-	d.Set("location", resp.Location)
+	resp := readResponse.Parsed.(*getPublicIPAddressResponse)
+
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
 	d.Set("name", resp.Name)
-	d.Set("public_ip_address_allocation", strings.ToLower(string(resp.Properties.PublicIPAllocationMethod)))
+	d.Set("zones", resp.Zones)
+
+	if resp.Sku != nil && resp.Sku.Name != nil {
+		d.Set("sku", resp.Sku.Name)
+	}
+
+	if resp.PublicIPAllocationMethod != nil {
+		d.Set("public_ip_address_allocation", strings.ToLower(*resp.PublicIPAllocationMethod))
+	}
+
+	if resp.IdleTimeoutInMinutes != nil {
+		d.Set("idle_timeout_in_minutes", resp.IdleTimeoutInMinutes)
+	}
+
+	if dns := resp.DNSSettings; dns != nil {
+		d.Set("domain_name_label", dns.DomainNameLabel)
+		d.Set("reverse_fqdn", dns.ReverseFqdn)
 
-	if resp.Properties.DNSSettings != nil && resp.Properties.DNSSettings.Fqdn != nil && *resp.Properties.DNSSettings.Fqdn != "" {
-		d.Set("fqdn", resp.Properties.DNSSettings.Fqdn)
+		if dns.Fqdn != nil && *dns.Fqdn != "" {
+			d.Set("fqdn", dns.Fqdn)
+		}
 	}
 
-	if resp.Properties.IPAddress != nil && *resp.Properties.IPAddress != "" {
-		d.Set("ip_address", resp.Properties.IPAddress)
+	if resp.IPAddress != nil && *resp.IPAddress != "" {
+		d.Set("ip_address", resp.IPAddress)
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -205,17 +254,12 @@ func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
-func validatePublicIpAllocation(v interface{}, k string) (ws []string, errors []error) {
-	value := strings.ToLower(v.(string))
-	allocations := map[string]bool{
-		"static":  true,
-		"dynamic": true,
-	}
-
-	if !allocations[value] {
-		errors = append(errors, fmt.Errorf("Public IP Allocation can only be Static of Dynamic"))
+func expandArmPublicIpZones(d *schema.ResourceData) []string {
+	zones := make([]string, 0)
+	for _, zone := range d.Get("zones").([]interface{}) {
+		zones = append(zones, zone.(string))
 	}
-	return
+	return zones
 }
 
 func validatePublicIpDomainNameLabel(v interface{}, k string) (ws []string, errors []error) {
@@ -37,8 +37,9 @@ func TestResourceAzureRMPublicIpAllocation_validation(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceArmPublicIp().Schema["public_ip_address_allocation"].ValidateFunc
 	for _, tc := range cases {
-		_, errors := validatePublicIpAllocation(tc.Value, "azurerm_public_ip")
+		_, errors := validateFunc(tc.Value, "azurerm_public_ip")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Azure RM Public IP allocation to trigger a validation error")
@@ -46,6 +47,35 @@ func TestResourceAzureRMPublicIpAllocation_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAzureRMPublicIpSku_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Basic",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Standard",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Premium",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmPublicIp().Schema["sku"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "azurerm_public_ip")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Public IP sku %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
 func TestResourceAzureRMPublicIpDomainNameLabel_validation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -186,6 +216,28 @@ func TestAccAzureRMPublicIpDynamic_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPublicIpStatic_standard(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMVPublicIpStatic_standard, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists("azurerm_public_ip.test"),
+					resource.TestCheckResourceAttr("azurerm_public_ip.test", "sku", "Standard"),
+					resource.TestCheckResourceAttr("azurerm_public_ip.test", "zones.#", "1"),
+					resource.TestCheckResourceAttr("azurerm_public_ip.test", "idle_timeout_in_minutes", "15"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMPublicIpExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -314,3 +366,19 @@ resource "azurerm_public_ip" "test" {
     }
 }
 `
+
+var testAccAzureRMVPublicIpStatic_standard = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West Europe"
+}
+resource "azurerm_public_ip" "test" {
+    name = "acctestpublicip-%d"
+    location = "West Europe"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    public_ip_address_allocation = "static"
+    sku = "Standard"
+    zones = ["1"]
+    idle_timeout_in_minutes = 15
+}
+`
@@ -0,0 +1,544 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmRedisCache() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRedisCacheCreate,
+		Read:   resourceArmRedisCacheRead,
+		Update: resourceArmRedisCacheCreate,
+		Delete: resourceArmRedisCacheDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"capacity": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"family": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"C", "P"}, false),
+			},
+
+			"sku_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium"}, false),
+			},
+
+			"enable_non_ssl_port": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"shard_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"private_static_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"redis_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maxclients": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"maxmemory_delta": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+
+						"maxmemory_reserved": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+
+						"maxmemory_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "volatile-lru",
+							ValidateFunc: validation.StringInSlice([]string{"noeviction", "allkeys-lru", "volatile-lru", "allkeys-random", "volatile-random", "volatile-ttl"}, false),
+						},
+
+						"rdb_backup_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"rdb_backup_frequency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateArmRedisCacheBackupFrequency,
+						},
+
+						"rdb_backup_max_snapshot_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"rdb_storage_connection_string": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"patch_schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day_of_week": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday", "Everyday", "Weekend"}, false),
+						},
+
+						"start_hour_utc": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+					},
+				},
+			},
+
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"ssl_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Redis Cache creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateRedisCache{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		Sku: &redisCacheSku{
+			Name:     azure.String(d.Get("sku_name").(string)),
+			Family:   azure.String(d.Get("family").(string)),
+			Capacity: azure.Int32(int32(d.Get("capacity").(int))),
+		},
+		RedisConfiguration: expandArmRedisCacheConfiguration(d),
+		EnableNonSslPort:   azure.Bool(d.Get("enable_non_ssl_port").(bool)),
+	}
+
+	if v, ok := d.GetOk("shard_count"); ok {
+		command.ShardCount = azure.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		command.SubnetID = azure.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("private_static_ip_address"); ok {
+		command.StaticIP = azure.String(v.(string))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Redis Cache %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Redis Cache %q: %s", name, createResponse.Error)
+	}
+
+	getRedisCacheCommand := &getRedisCache{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = getRedisCacheCommand
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Redis Cache %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Redis Cache %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getRedisCacheResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Redis Cache %s (resource group %s) ID", name, resGroup)
+	}
+
+	log.Printf("[DEBUG] Waiting for Redis Cache (%s) to become available", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Creating", "Updating", "Scaling"},
+		Target:     []string{"Succeeded"},
+		Refresh:    azureStateRefreshFunc(*resp.ID, client, getRedisCacheCommand),
+		Timeout:    60 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Redis Cache (%s) to become available: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	if d.HasChange("patch_schedule") {
+		if err := updateArmRedisCachePatchSchedules(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmRedisCacheRead(d, meta)
+}
+
+func resourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["Redis"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getRedisCache{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Redis Cache %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Redis Cache %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Redis Cache %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getRedisCacheResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("enable_non_ssl_port", resp.EnableNonSslPort)
+	d.Set("shard_count", resp.ShardCount)
+	d.Set("subnet_id", resp.SubnetID)
+	d.Set("private_static_ip_address", resp.StaticIP)
+	d.Set("hostname", resp.HostName)
+	d.Set("port", resp.Port)
+	d.Set("ssl_port", resp.SslPort)
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", sku.Name)
+		d.Set("family", sku.Family)
+		d.Set("capacity", sku.Capacity)
+	}
+
+	if err := d.Set("redis_configuration", flattenArmRedisCacheConfiguration(d, resp.RedisConfiguration)); err != nil {
+		return fmt.Errorf("Error flattening `redis_configuration` for Redis Cache %q: %s", name, err)
+	}
+
+	keysRequest := rivieraClient.NewRequest()
+	keysRequest.Command = &listRedisCacheKeys{
+		Name:              name,
+		ResourceGroupName: id.ResourceGroup,
+	}
+
+	keysResponse, err := keysRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error listing access keys for Redis Cache %q: %s", name, err)
+	}
+	if !keysResponse.IsSuccessful() {
+		return fmt.Errorf("Error listing access keys for Redis Cache %q: %s", name, keysResponse.Error)
+	}
+
+	keys := keysResponse.Parsed.(*listRedisCacheKeysResponse)
+	d.Set("primary_access_key", keys.PrimaryKey)
+	d.Set("secondary_access_key", keys.SecondaryKey)
+
+	schedulesRequest := rivieraClient.NewRequest()
+	schedulesRequest.Command = &getRedisCachePatchSchedules{
+		Name:              name,
+		ResourceGroupName: id.ResourceGroup,
+	}
+
+	schedulesResponse, err := schedulesRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading patch schedules for Redis Cache %q: %s", name, err)
+	}
+	if schedulesResponse.IsSuccessful() {
+		schedules := schedulesResponse.Parsed.(*getRedisCachePatchSchedulesResponse)
+		d.Set("patch_schedule", flattenArmRedisCachePatchSchedules(schedules.ScheduleEntries))
+	} else if schedulesResponse.HTTP.StatusCode == http.StatusNotFound {
+		d.Set("patch_schedule", []interface{}{})
+	} else {
+		return fmt.Errorf("Error reading patch schedules for Redis Cache %q: %s", name, schedulesResponse.Error)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmRedisCacheDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteRedisCache{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Redis Cache %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Redis Cache %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func updateArmRedisCachePatchSchedules(d *schema.ResourceData, meta interface{}) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	entries := expandArmRedisCachePatchSchedules(d)
+
+	request := rivieraClient.NewRequest()
+	if len(entries) == 0 {
+		request.Command = &deleteRedisCachePatchSchedules{
+			Name:              name,
+			ResourceGroupName: resGroup,
+		}
+	} else {
+		request.Command = &createOrUpdateRedisCachePatchSchedules{
+			Name:              name,
+			ResourceGroupName: resGroup,
+			ScheduleEntries:   entries,
+		}
+	}
+
+	response, err := request.Execute()
+	if err != nil {
+		return fmt.Errorf("Error updating patch schedules for Redis Cache %q: %s", name, err)
+	}
+	if !response.IsSuccessful() && response.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error updating patch schedules for Redis Cache %q: %s", name, response.Error)
+	}
+
+	return nil
+}
+
+func expandArmRedisCacheConfiguration(d *schema.ResourceData) map[string]string {
+	output := make(map[string]string)
+
+	configs := d.Get("redis_configuration").([]interface{})
+	if len(configs) == 0 {
+		return output
+	}
+	config := configs[0].(map[string]interface{})
+
+	if v := config["maxmemory_delta"].(int); v > 0 {
+		output["maxmemory-delta"] = strconv.Itoa(v)
+	}
+	if v := config["maxmemory_reserved"].(int); v > 0 {
+		output["maxmemory-reserved"] = strconv.Itoa(v)
+	}
+	if v := config["maxmemory_policy"].(string); v != "" {
+		output["maxmemory-policy"] = v
+	}
+
+	// Persistence is only available on Premium caches
+	if v := config["rdb_backup_enabled"].(bool); v {
+		output["rdb-backup-enabled"] = strconv.FormatBool(v)
+	}
+	if v := config["rdb_backup_frequency"].(int); v > 0 {
+		output["rdb-backup-frequency"] = strconv.Itoa(v)
+	}
+	if v := config["rdb_backup_max_snapshot_count"].(int); v > 0 {
+		output["rdb-backup-max-snapshot-count"] = strconv.Itoa(v)
+	}
+	if v := config["rdb_storage_connection_string"].(string); v != "" {
+		output["rdb-storage-connection-string"] = v
+	}
+
+	return output
+}
+
+func flattenArmRedisCacheConfiguration(d *schema.ResourceData, configuration map[string]string) []interface{} {
+	result := map[string]interface{}{}
+
+	if v, err := strconv.Atoi(configuration["maxclients"]); err == nil {
+		result["maxclients"] = v
+	}
+	if v, err := strconv.Atoi(configuration["maxmemory-delta"]); err == nil {
+		result["maxmemory_delta"] = v
+	}
+	if v, err := strconv.Atoi(configuration["maxmemory-reserved"]); err == nil {
+		result["maxmemory_reserved"] = v
+	}
+	if v, ok := configuration["maxmemory-policy"]; ok {
+		result["maxmemory_policy"] = v
+	}
+	if v, err := strconv.ParseBool(configuration["rdb-backup-enabled"]); err == nil {
+		result["rdb_backup_enabled"] = v
+	}
+	if v, err := strconv.Atoi(configuration["rdb-backup-frequency"]); err == nil {
+		result["rdb_backup_frequency"] = v
+	}
+	if v, err := strconv.Atoi(configuration["rdb-backup-max-snapshot-count"]); err == nil {
+		result["rdb_backup_max_snapshot_count"] = v
+	}
+
+	// The storage connection string is never returned by the API
+	if v, ok := d.GetOk("redis_configuration.0.rdb_storage_connection_string"); ok {
+		result["rdb_storage_connection_string"] = v.(string)
+	}
+
+	return []interface{}{result}
+}
+
+func expandArmRedisCachePatchSchedules(d *schema.ResourceData) []redisCacheScheduleEntry {
+	configs := d.Get("patch_schedule").([]interface{})
+	entries := make([]redisCacheScheduleEntry, 0, len(configs))
+
+	for _, raw := range configs {
+		config := raw.(map[string]interface{})
+
+		entries = append(entries, redisCacheScheduleEntry{
+			DayOfWeek:    azure.String(config["day_of_week"].(string)),
+			StartHourUtc: azure.Int32(int32(config["start_hour_utc"].(int))),
+		})
+	}
+
+	return entries
+}
+
+func flattenArmRedisCachePatchSchedules(entries []redisCacheScheduleEntry) []interface{} {
+	result := make([]interface{}, 0, len(entries))
+
+	for _, entry := range entries {
+		flattened := map[string]interface{}{}
+		if entry.DayOfWeek != nil {
+			flattened["day_of_week"] = *entry.DayOfWeek
+		}
+		if entry.StartHourUtc != nil {
+			flattened["start_hour_utc"] = int(*entry.StartHourUtc)
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result
+}
+
+func validateArmRedisCacheBackupFrequency(v interface{}, k string) (ws []string, errors []error) {
+	frequencies := map[int]bool{
+		15:   true,
+		30:   true,
+		60:   true,
+		360:  true,
+		720:  true,
+		1440: true,
+	}
+
+	if !frequencies[v.(int)] {
+		errors = append(errors, fmt.Errorf("Redis Cache rdb_backup_frequency can only be 15, 30, 60, 360, 720 or 1440"))
+	}
+	return
+}
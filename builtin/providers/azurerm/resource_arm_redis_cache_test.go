@@ -0,0 +1,284 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMRedisCacheFamily_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "C",
+			ErrCount: 0,
+		},
+		{
+			Value:    "P",
+			ErrCount: 0,
+		},
+		{
+			Value:    "c",
+			ErrCount: 1,
+		},
+		{
+			Value:    "X",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmRedisCache().Schema["family"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "family")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Redis Cache Family %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMRedisCacheMaxMemoryPolicy_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "noeviction",
+			ErrCount: 0,
+		},
+		{
+			Value:    "allkeys-lru",
+			ErrCount: 0,
+		},
+		{
+			Value:    "volatile-ttl",
+			ErrCount: 0,
+		},
+		{
+			Value:    "lru",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmRedisCache().Schema["redis_configuration"].Elem.(*schema.Resource).Schema["maxmemory_policy"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "maxmemory_policy")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Redis Cache Max Memory Policy %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMRedisCacheBackupFrequency_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    1,
+			ErrCount: 1,
+		},
+		{
+			Value:    15,
+			ErrCount: 0,
+		},
+		{
+			Value:    1440,
+			ErrCount: 0,
+		},
+		{
+			Value:    1441,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmRedisCacheBackupFrequency(tc.Value, "rdb_backup_frequency")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Redis Cache Backup Frequency %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMRedisCacheDayOfWeek_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Monday",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Weekend",
+			ErrCount: 0,
+		},
+		{
+			Value:    "monday",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Someday",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmRedisCache().Schema["patch_schedule"].Elem.(*schema.Resource).Schema["day_of_week"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "day_of_week")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Redis Cache Day Of Week %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestAccAzureRMRedisCache_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMRedisCache_basic, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRedisCacheExists("azurerm_redis_cache.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_redis_cache.test", "redis_configuration.0.maxmemory_policy", "volatile-lru"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRedisCache_premiumPatchSchedule(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMRedisCache_premiumPatchSchedule, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRedisCacheDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRedisCacheExists("azurerm_redis_cache.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_redis_cache.test", "patch_schedule.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_redis_cache.test", "patch_schedule.0.day_of_week", "Tuesday"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRedisCacheExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getRedisCache{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Redis Cache: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Redis Cache: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRedisCacheDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_redis_cache" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getRedisCache{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Redis Cache: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Redis Cache still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMRedisCache_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_redis_cache" "test" {
+    name = "acctestRedis-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    capacity = 1
+    family = "C"
+    sku_name = "Basic"
+    enable_non_ssl_port = false
+
+    redis_configuration {
+        maxmemory_reserved = 2
+        maxmemory_delta = 2
+    }
+}
+`
+
+var testAccAzureRMRedisCache_premiumPatchSchedule = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_redis_cache" "test" {
+    name = "acctestRedis-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    capacity = 1
+    family = "P"
+    sku_name = "Premium"
+    enable_non_ssl_port = false
+
+    redis_configuration {
+        maxmemory_policy = "allkeys-lru"
+    }
+
+    patch_schedule {
+        day_of_week = "Tuesday"
+        start_hour_utc = 8
+    }
+}
+`
@@ -2,6 +2,8 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/core/http"
@@ -10,6 +12,68 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_resource_group", &resource.Sweeper{
+		Name: "azurerm_resource_group",
+		F:    testSweepResourceGroups,
+	})
+}
+
+// testSweepResourceGroups deletes the resource groups created by the
+// acceptance tests in the given location, which deletes all the resources
+// they contain as well.
+func testSweepResourceGroups(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+	groupsClient := client.resourceGroupClient
+	location := azureRMNormalizeLocation(region)
+
+	result, err := groupsClient.List("", nil)
+	if err != nil {
+		return fmt.Errorf("Error listing Resource Groups: %s", err)
+	}
+
+	for {
+		if result.Value != nil {
+			for _, group := range *result.Value {
+				if group.Name == nil || group.Location == nil {
+					continue
+				}
+				name := *group.Name
+
+				if azureRMNormalizeLocation(*group.Location) != location || !isArmAcceptanceTestResourceGroup(name) {
+					continue
+				}
+
+				log.Printf("[INFO] Deleting Resource Group %q", name)
+				if _, err := groupsClient.Delete(name, nil); err != nil {
+					return fmt.Errorf("Error deleting Resource Group %q: %s", name, err)
+				}
+			}
+		}
+
+		if result.NextLink == nil || *result.NextLink == "" {
+			break
+		}
+
+		result, err = groupsClient.ListNextResults(result)
+		if err != nil {
+			return fmt.Errorf("Error listing Resource Groups: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// isArmAcceptanceTestResourceGroup returns whether the resource group was
+// created by an acceptance test, judging by the name prefixes they use.
+func isArmAcceptanceTestResourceGroup(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasPrefix(name, "acctestrg") || strings.HasPrefix(name, "acctest_rg")
+}
+
 func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := fmt.Sprintf(testAccAzureRMResourceGroup_basic, ri)
@@ -0,0 +1,147 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRoleAssignmentCreate,
+		Read:   resourceArmRoleAssignmentRead,
+		Delete: resourceArmRoleAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmUUID,
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"role_definition_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmRoleDefinitionID,
+			},
+
+			"principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmUUID,
+			},
+		},
+	}
+}
+
+func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Role Assignment creation.")
+
+	scope := d.Get("scope").(string)
+	roleDefinitionID := d.Get("role_definition_id").(string)
+	principalID := d.Get("principal_id").(string)
+
+	name := d.Get("name").(string)
+	if name == "" {
+		name = generateAuthorizationResourceName(scope, roleDefinitionID, principalID)
+	}
+
+	createRequest := rivieraClient.NewRequestForURI(authorizationResourceURI(scope, roleAssignmentsURLSegment, name))
+	createRequest.Command = &createRoleAssignment{
+		RoleDefinitionID: azure.String(roleDefinitionID),
+		PrincipalID:      azure.String(principalID),
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Role Assignment %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Role Assignment %q: %s", name, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getRoleAssignmentResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Role Assignment %s (scope %s) ID", name, scope)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmRoleAssignmentRead(d, meta)
+}
+
+func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	scope, name, err := parseAuthorizationResourceID(d.Id(), roleAssignmentsURLSegment)
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getRoleAssignment{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Role Assignment %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Role Assignment %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Role Assignment %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getRoleAssignmentResponse)
+
+	d.Set("name", name)
+	if resp.Scope != nil {
+		d.Set("scope", resp.Scope)
+	} else {
+		d.Set("scope", scope)
+	}
+	d.Set("role_definition_id", resp.RoleDefinitionID)
+	d.Set("principal_id", resp.PrincipalID)
+
+	return nil
+}
+
+func resourceArmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteRoleAssignment{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Role Assignment %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting Role Assignment %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
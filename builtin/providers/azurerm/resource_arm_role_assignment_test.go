@@ -0,0 +1,155 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// readerRoleDefinitionName is the name of the built-in Reader role, which is
+// the same in every subscription.
+const readerRoleDefinitionName = "acdd72a7-3385-48ef-bd42-f606fba81ae7"
+
+// The object ID of a principal can't be derived from the credentials used to
+// run the tests, so these tests need one to be provided.
+func TestAccAzureRMRoleAssignment_basic(t *testing.T) {
+	principalID := os.Getenv("ARM_TEST_PRINCIPAL_ID")
+	if principalID == "" {
+		t.Skip("ARM_TEST_PRINCIPAL_ID must be set to the object ID of a user, group or service principal")
+	}
+
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMRoleAssignment_basic, ri, os.Getenv("ARM_SUBSCRIPTION_ID"), readerRoleDefinitionName, principalID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRoleAssignmentExists("azurerm_role_assignment.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_role_assignment.test", "principal_id", principalID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRoleAssignment_customRole(t *testing.T) {
+	principalID := os.Getenv("ARM_TEST_PRINCIPAL_ID")
+	if principalID == "" {
+		t.Skip("ARM_TEST_PRINCIPAL_ID must be set to the object ID of a user, group or service principal")
+	}
+
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMRoleAssignment_customRole, ri, ri, principalID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRoleDefinitionExists("azurerm_role_definition.test"),
+					testCheckAzureRMRoleAssignmentExists("azurerm_role_assignment.test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRoleAssignmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getRoleAssignment{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Role Assignment: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Role Assignment: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRoleAssignmentDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_role_assignment" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getRoleAssignment{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Role Assignment: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Role Assignment still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMRoleAssignment_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_role_assignment" "test" {
+    scope = "${azurerm_resource_group.test.id}"
+    role_definition_id = "/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s"
+    principal_id = "%s"
+}
+`
+
+var testAccAzureRMRoleAssignment_customRole = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_role_definition" "test" {
+    name = "acctestrd-%d"
+    scope = "${azurerm_resource_group.test.id}"
+
+    permissions {
+        actions = ["Microsoft.Resources/subscriptions/resourceGroups/read"]
+    }
+
+    assignable_scopes = ["${azurerm_resource_group.test.id}"]
+}
+
+resource "azurerm_role_assignment" "test" {
+    scope = "${azurerm_resource_group.test.id}"
+    role_definition_id = "${azurerm_role_definition.test.id}"
+    principal_id = "%s"
+}
+`
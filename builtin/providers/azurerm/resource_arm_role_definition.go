@@ -0,0 +1,228 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmRoleDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRoleDefinitionCreate,
+		Read:   resourceArmRoleDefinitionRead,
+		Update: resourceArmRoleDefinitionCreate,
+		Delete: resourceArmRoleDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_definition_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmUUID,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"permissions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"not_actions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"assignable_scopes": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceArmRoleDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Role Definition creation.")
+
+	roleName := d.Get("name").(string)
+	scope := d.Get("scope").(string)
+
+	name := d.Get("role_definition_id").(string)
+	if name == "" {
+		name = generateAuthorizationResourceName(scope, roleName)
+	}
+
+	command := &createOrUpdateRoleDefinition{
+		RoleName:         azure.String(roleName),
+		Type:             azure.String("CustomRole"),
+		Permissions:      expandArmRoleDefinitionPermissions(d),
+		AssignableScopes: expandArmRoleDefinitionAssignableScopes(d),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		command.Description = azure.String(v.(string))
+	}
+
+	createRequest := rivieraClient.NewRequestForURI(authorizationResourceURI(scope, roleDefinitionsURLSegment, name))
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Role Definition %q: %s", roleName, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Role Definition %q: %s", roleName, createResponse.Error)
+	}
+
+	resp := createResponse.Parsed.(*getRoleDefinitionResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Role Definition %s (scope %s) ID", roleName, scope)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmRoleDefinitionRead(d, meta)
+}
+
+func resourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	scope, name, err := parseAuthorizationResourceID(d.Id(), roleDefinitionsURLSegment)
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getRoleDefinition{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Role Definition %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Role Definition %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Role Definition %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getRoleDefinitionResponse)
+
+	d.Set("role_definition_id", name)
+	d.Set("name", resp.RoleName)
+	d.Set("description", resp.Description)
+	d.Set("assignable_scopes", resp.AssignableScopes)
+
+	// Azure returns the ID of a Role Definition beneath the subscription
+	// regardless of the scope it was created at, so the scope is only taken
+	// from the ID when importing.
+	if _, ok := d.GetOk("scope"); !ok {
+		d.Set("scope", scope)
+	}
+
+	if err := d.Set("permissions", flattenArmRoleDefinitionPermissions(resp.Permissions)); err != nil {
+		return fmt.Errorf("Error flattening `permissions` for Role Definition %q: %s", *resp.RoleName, err)
+	}
+
+	return nil
+}
+
+func resourceArmRoleDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteRoleDefinition{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Role Definition %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting Role Definition %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+func expandArmRoleDefinitionPermissions(d *schema.ResourceData) []roleDefinitionPermission {
+	configs := d.Get("permissions").([]interface{})
+	permissions := make([]roleDefinitionPermission, 0, len(configs))
+
+	for _, raw := range configs {
+		config := raw.(map[string]interface{})
+
+		actions := make([]string, 0)
+		for _, action := range config["actions"].([]interface{}) {
+			actions = append(actions, action.(string))
+		}
+		notActions := make([]string, 0)
+		for _, action := range config["not_actions"].([]interface{}) {
+			notActions = append(notActions, action.(string))
+		}
+
+		permissions = append(permissions, roleDefinitionPermission{
+			Actions:    actions,
+			NotActions: notActions,
+		})
+	}
+
+	return permissions
+}
+
+func expandArmRoleDefinitionAssignableScopes(d *schema.ResourceData) []string {
+	scopes := make([]string, 0)
+	for _, scope := range d.Get("assignable_scopes").([]interface{}) {
+		scopes = append(scopes, scope.(string))
+	}
+	return scopes
+}
+
+func flattenArmRoleDefinitionPermissions(permissions []roleDefinitionPermission) []interface{} {
+	result := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		result = append(result, map[string]interface{}{
+			"actions":     permission.Actions,
+			"not_actions": permission.NotActions,
+		})
+	}
+	return result
+}
@@ -0,0 +1,138 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMRoleDefinition_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMRoleDefinition_basic, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMRoleDefinition_updated, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRoleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRoleDefinitionExists("azurerm_role_definition.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_role_definition.test", "permissions.0.actions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"azurerm_role_definition.test", "assignable_scopes.#", "1"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRoleDefinitionExists("azurerm_role_definition.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_role_definition.test", "description", "Reads and restarts virtual machines"),
+					resource.TestCheckResourceAttr(
+						"azurerm_role_definition.test", "permissions.0.actions.#", "2"),
+					resource.TestCheckResourceAttr(
+						"azurerm_role_definition.test", "permissions.0.not_actions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRoleDefinitionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getRoleDefinition{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Role Definition: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get Role Definition: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRoleDefinitionDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_role_definition" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getRoleDefinition{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get Role Definition: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Role Definition still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMRoleDefinition_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_role_definition" "test" {
+    name = "acctestrd-%d"
+    scope = "${azurerm_resource_group.test.id}"
+
+    permissions {
+        actions = ["Microsoft.Compute/virtualMachines/read"]
+    }
+
+    assignable_scopes = ["${azurerm_resource_group.test.id}"]
+}
+`
+
+var testAccAzureRMRoleDefinition_updated = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_role_definition" "test" {
+    name = "acctestrd-%d"
+    scope = "${azurerm_resource_group.test.id}"
+    description = "Reads and restarts virtual machines"
+
+    permissions {
+        actions = [
+            "Microsoft.Compute/virtualMachines/read",
+            "Microsoft.Compute/virtualMachines/restart/action",
+        ]
+        not_actions = ["Microsoft.Compute/virtualMachines/delete"]
+    }
+
+    assignable_scopes = ["${azurerm_resource_group.test.id}"]
+}
+`
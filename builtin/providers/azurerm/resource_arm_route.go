@@ -2,10 +2,12 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmRoute() *schema.Resource {
@@ -14,6 +16,9 @@ func resourceArmRoute() *schema.Resource {
 		Read:   resourceArmRouteRead,
 		Update: resourceArmRouteCreate,
 		Delete: resourceArmRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -42,7 +47,7 @@ func resourceArmRoute() *schema.Resource {
 			"next_hop_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateRouteTableNextHopType,
+				ValidateFunc: validation.StringInSlice([]string{"VirtualNetworkGateway", "VnetLocal", "Internet", "VirtualAppliance", "None"}, true),
 			},
 
 			"next_hop_in_ip_address": {
@@ -113,6 +118,7 @@ func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, err := routesClient.Get(resGroup, rtName, routeName)
 	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Route %q (Route Table %q) not found - removing from state", routeName, rtName)
 		d.SetId("")
 		return nil
 	}
@@ -120,6 +126,16 @@ func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error making Read request on Azure Route %s: %s", routeName, err)
 	}
 
+	d.Set("name", routeName)
+	d.Set("resource_group_name", resGroup)
+	d.Set("route_table_name", rtName)
+	d.Set("address_prefix", resp.Properties.AddressPrefix)
+	d.Set("next_hop_type", string(resp.Properties.NextHopType))
+
+	if resp.Properties.NextHopIPAddress != nil {
+		d.Set("next_hop_in_ip_address", resp.Properties.NextHopIPAddress)
+	}
+
 	return nil
 }
 
@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmRouteTable() *schema.Resource {
@@ -18,6 +18,9 @@ func resourceArmRouteTable() *schema.Resource {
 		Read:   resourceArmRouteTableRead,
 		Update: resourceArmRouteTableCreate,
 		Delete: resourceArmRouteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,7 +61,7 @@ func resourceArmRouteTable() *schema.Resource {
 						"next_hop_type": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateRouteTableNextHopType,
+							ValidateFunc: validation.StringInSlice([]string{"VirtualNetworkGateway", "VnetLocal", "Internet", "VirtualAppliance", "None"}, true),
 						},
 
 						"next_hop_in_ip_address": {
@@ -101,16 +104,34 @@ func resourceArmRouteTableCreate(d *schema.ResourceData, meta interface{}) error
 		Tags:     expandTags(tags),
 	}
 
-	if _, ok := d.GetOk("route"); ok {
+	// Routes may also be managed by azurerm_route resources, which update
+	// this table independently; serialise both against the table name.
+	armMutexKV.Lock(name)
+	defer armMutexKV.Unlock(name)
+
+	if !d.IsNewResource() && !d.HasChange("route") {
+		// The table is replaced as a whole, so when the inline routes haven't
+		// changed send the routes which currently exist, rather than those
+		// last refreshed, so that routes added through azurerm_route are kept.
+		existing, err := routeTablesClient.Get(resGroup, name, "")
+		if err != nil && existing.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Error retrieving Route Table %q (Resource Group %q): %s", name, resGroup, err)
+		}
+		if existing.Properties != nil && existing.Properties.Routes != nil {
+			routeSet.Properties = &network.RouteTablePropertiesFormat{
+				Routes: existing.Properties.Routes,
+			}
+		}
+	} else if _, ok := d.GetOk("route"); ok {
 		properties := network.RouteTablePropertiesFormat{}
 		routes, routeErr := expandAzureRmRouteTableRoutes(d)
 		if routeErr != nil {
 			return fmt.Errorf("Error Building list of Route Table Routes: %s", routeErr)
 		}
 		if len(routes) > 0 {
+			properties.Routes = &routes
 			routeSet.Properties = &properties
 		}
-
 	}
 
 	_, err := routeTablesClient.CreateOrUpdate(resGroup, name, routeSet, make(chan struct{}))
@@ -143,6 +164,7 @@ func resourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, err := routeTablesClient.Get(resGroup, name, "")
 	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Route Table %q not found - removing from state", name)
 		d.SetId("")
 		return nil
 	}
@@ -150,6 +172,14 @@ func resourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error making Read request on Azure Route Table %s: %s", name, err)
 	}
 
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", resp.Location)
+
+	if resp.Properties.Routes != nil {
+		d.Set("route", schema.NewSet(resourceArmRouteTableRouteHash, flattenAzureRmRouteTableRoutes(resp.Properties.Routes)))
+	}
+
 	if resp.Properties.Subnets != nil {
 		if len(*resp.Properties.Subnets) > 0 {
 			subnets := make([]string, 0, len(*resp.Properties.Subnets))
@@ -179,6 +209,9 @@ func resourceArmRouteTableDelete(d *schema.ResourceData, meta interface{}) error
 	resGroup := id.ResourceGroup
 	name := id.Path["routeTables"]
 
+	armMutexKV.Lock(name)
+	defer armMutexKV.Unlock(name)
+
 	_, err = routeTablesClient.Delete(resGroup, name, make(chan struct{}))
 
 	return err
@@ -215,6 +248,23 @@ func expandAzureRmRouteTableRoutes(d *schema.ResourceData) ([]network.Route, err
 	return routes, nil
 }
 
+func flattenAzureRmRouteTableRoutes(routes *[]network.Route) []interface{} {
+	results := make([]interface{}, 0, len(*routes))
+
+	for _, route := range *routes {
+		r := make(map[string]interface{})
+		r["name"] = *route.Name
+		r["address_prefix"] = *route.Properties.AddressPrefix
+		r["next_hop_type"] = string(route.Properties.NextHopType)
+		if route.Properties.NextHopIPAddress != nil {
+			r["next_hop_in_ip_address"] = *route.Properties.NextHopIPAddress
+		}
+		results = append(results, r)
+	}
+
+	return results
+}
+
 func resourceArmRouteTableRouteHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -224,19 +274,3 @@ func resourceArmRouteTableRouteHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
-
-func validateRouteTableNextHopType(v interface{}, k string) (ws []string, errors []error) {
-	value := strings.ToLower(v.(string))
-	hopTypes := map[string]bool{
-		"virtualnetworkgateway": true,
-		"vnetlocal":             true,
-		"internet":              true,
-		"virtualappliance":      true,
-		"none":                  true,
-	}
-
-	if !hopTypes[value] {
-		errors = append(errors, fmt.Errorf("Route Table NextHopType Protocol can only be VirtualNetworkGateway, VnetLocal, Internet or VirtualAppliance"))
-	}
-	return
-}
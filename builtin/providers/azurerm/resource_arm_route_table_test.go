@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -49,8 +50,9 @@ func TestResourceAzureRMRouteTableNextHopType_validation(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceArmRouteTable().Schema["route"].Elem.(*schema.Resource).Schema["next_hop_type"].ValidateFunc
 	for _, tc := range cases {
-		_, errors := validateRouteTableNextHopType(tc.Value, "azurerm_route_table")
+		_, errors := validateFunc(tc.Value, "azurerm_route_table")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Azure RM Route Table nextHopType to trigger a validation error")
@@ -0,0 +1,246 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+// serviceBusNamespaceDefaultAuthorizationRuleName is the name of the rule
+// which Azure creates on every namespace, granting full access to it.
+const serviceBusNamespaceDefaultAuthorizationRuleName = "RootManageSharedAccessKey"
+
+func resourceArmServiceBusNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceBusNamespaceCreate,
+		Read:   resourceArmServiceBusNamespaceRead,
+		Update: resourceArmServiceBusNamespaceCreate,
+		Delete: resourceArmServiceBusNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium"}, false),
+			},
+
+			"capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArmServiceBusNamespaceCapacity,
+			},
+
+			"default_primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"default_secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmServiceBusNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Namespace creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	sku := d.Get("sku").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateServiceBusNamespace{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		Sku: &serviceBusSku{
+			Name: azure.String(sku),
+			Tier: azure.String(sku),
+		},
+	}
+
+	if v, ok := d.GetOk("capacity"); ok {
+		command.Sku.Capacity = azure.Int32(int32(v.(int)))
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating ServiceBus Namespace %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating ServiceBus Namespace %q: %s", name, createResponse.Error)
+	}
+
+	getNamespaceCommand := &getServiceBusNamespace{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = getNamespaceCommand
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading ServiceBus Namespace %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading ServiceBus Namespace %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getServiceBusNamespaceResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus Namespace %s (resource group %s) ID", name, resGroup)
+	}
+
+	log.Printf("[DEBUG] Waiting for ServiceBus Namespace (%s) to become available", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Created", "Activating", "Updating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    azureStateRefreshFunc(*resp.ID, client, getNamespaceCommand),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for ServiceBus Namespace (%s) to become available: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmServiceBusNamespaceRead(d, meta)
+}
+
+func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["namespaces"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getServiceBusNamespace{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] ServiceBus Namespace %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getServiceBusNamespaceResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+		d.Set("capacity", sku.Capacity)
+	}
+
+	keysRequest := rivieraClient.NewRequest()
+	keysRequest.Command = &listServiceBusAuthorizationRuleKeys{
+		Name:       serviceBusNamespaceDefaultAuthorizationRuleName,
+		ParentPath: serviceBusNamespaceURLPath(id.ResourceGroup, name),
+	}
+
+	keysResponse, err := keysRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error listing default keys for ServiceBus Namespace %q: %s", name, err)
+	}
+	if keysResponse.IsSuccessful() {
+		keys := keysResponse.Parsed.(*listServiceBusAuthorizationRuleKeysResponse)
+		d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
+		d.Set("default_secondary_connection_string", keys.SecondaryConnectionString)
+		d.Set("default_primary_key", keys.PrimaryKey)
+		d.Set("default_secondary_key", keys.SecondaryKey)
+	} else {
+		// The default rule can be removed from a namespace, which leaves
+		// nothing to export.
+		log.Printf("[WARN] Unable to list default keys for ServiceBus Namespace %q: %s", name, keysResponse.Error)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmServiceBusNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmServiceBusResource(d, meta, "Namespace")
+}
+
+func validateArmServiceBusNamespaceCapacity(v interface{}, k string) (ws []string, errors []error) {
+	capacities := map[int]bool{
+		1: true,
+		2: true,
+		4: true,
+	}
+
+	if !capacities[v.(int)] {
+		errors = append(errors, fmt.Errorf("ServiceBus Namespace capacity can only be 1, 2 or 4"))
+	}
+	return
+}
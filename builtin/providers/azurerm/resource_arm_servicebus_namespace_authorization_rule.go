@@ -0,0 +1,38 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmServiceBusNamespaceAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceBusNamespaceAuthorizationRuleCreate,
+		Read:   resourceArmServiceBusNamespaceAuthorizationRuleRead,
+		Update: resourceArmServiceBusNamespaceAuthorizationRuleCreate,
+		Delete: resourceArmServiceBusNamespaceAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: serviceBusAuthorizationRuleSchema(),
+	}
+}
+
+func resourceArmServiceBusNamespaceAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	parentPath := serviceBusNamespaceURLPath(d.Get("resource_group_name").(string), d.Get("namespace_name").(string))
+
+	if err := createArmServiceBusAuthorizationRule(d, meta, parentPath); err != nil {
+		return err
+	}
+
+	return resourceArmServiceBusNamespaceAuthorizationRuleRead(d, meta)
+}
+
+func resourceArmServiceBusNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := readArmServiceBusAuthorizationRule(d, meta)
+	return err
+}
+
+func resourceArmServiceBusNamespaceAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmServiceBusResource(d, meta, "Authorization Rule")
+}
@@ -0,0 +1,199 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmServiceBusQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceBusQueueCreate,
+		Read:   resourceArmServiceBusQueueRead,
+		Update: resourceArmServiceBusQueueCreate,
+		Delete: resourceArmServiceBusQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"auto_delete_on_idle": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"default_message_ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"duplicate_detection_history_time_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"lock_duration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"max_size_in_megabytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"max_delivery_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"dead_lettering_on_message_expiration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_batched_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"enable_express": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_partitioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"requires_duplicate_detection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"requires_session": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmServiceBusQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Queue creation.")
+
+	name := d.Get("name").(string)
+
+	command := &createOrUpdateServiceBusEntity{
+		ResourceGroupName: d.Get("resource_group_name").(string),
+		NamespaceName:     d.Get("namespace_name").(string),
+		EntityPath:        fmt.Sprintf("queues/%s", name),
+		Location:          d.Get("location").(string),
+		serviceBusEntityProperties: serviceBusEntityProperties{
+			DeadLetteringOnMessageExpiration: azure.Bool(d.Get("dead_lettering_on_message_expiration").(bool)),
+			EnableBatchedOperations:          azure.Bool(d.Get("enable_batched_operations").(bool)),
+			EnableExpress:                    azure.Bool(d.Get("enable_express").(bool)),
+			EnablePartitioning:               azure.Bool(d.Get("enable_partitioning").(bool)),
+			RequiresDuplicateDetection:       azure.Bool(d.Get("requires_duplicate_detection").(bool)),
+			RequiresSession:                  azure.Bool(d.Get("requires_session").(bool)),
+		},
+	}
+
+	if v, ok := d.GetOk("auto_delete_on_idle"); ok {
+		command.AutoDeleteOnIdle = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_message_ttl"); ok {
+		command.DefaultMessageTimeToLive = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("duplicate_detection_history_time_window"); ok {
+		command.DuplicateDetectionHistoryTimeWindow = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("lock_duration"); ok {
+		command.LockDuration = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("max_size_in_megabytes"); ok {
+		maxSize := int64(v.(int))
+		command.MaxSizeInMegabytes = &maxSize
+	}
+	if v, ok := d.GetOk("max_delivery_count"); ok {
+		command.MaxDeliveryCount = azure.Int32(int32(v.(int)))
+	}
+
+	if err := createArmServiceBusEntity(d, meta, "Queue", command); err != nil {
+		return err
+	}
+
+	return resourceArmServiceBusQueueRead(d, meta)
+}
+
+func resourceArmServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error {
+	resp, _, err := readArmServiceBusEntity(d, meta, "Queue")
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+
+	d.Set("auto_delete_on_idle", resp.AutoDeleteOnIdle)
+	d.Set("default_message_ttl", resp.DefaultMessageTimeToLive)
+	d.Set("duplicate_detection_history_time_window", resp.DuplicateDetectionHistoryTimeWindow)
+	d.Set("lock_duration", resp.LockDuration)
+	d.Set("max_size_in_megabytes", resp.MaxSizeInMegabytes)
+	d.Set("max_delivery_count", resp.MaxDeliveryCount)
+	d.Set("dead_lettering_on_message_expiration", resp.DeadLetteringOnMessageExpiration)
+	d.Set("enable_batched_operations", resp.EnableBatchedOperations)
+	d.Set("enable_express", resp.EnableExpress)
+	d.Set("enable_partitioning", resp.EnablePartitioning)
+	d.Set("requires_duplicate_detection", resp.RequiresDuplicateDetection)
+	d.Set("requires_session", resp.RequiresSession)
+
+	return nil
+}
+
+func resourceArmServiceBusQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmServiceBusResource(d, meta, "Queue")
+}
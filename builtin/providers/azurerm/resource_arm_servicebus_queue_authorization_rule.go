@@ -0,0 +1,57 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmServiceBusQueueAuthorizationRule() *schema.Resource {
+	ruleSchema := serviceBusAuthorizationRuleSchema()
+	ruleSchema["queue_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmServiceBusQueueAuthorizationRuleCreate,
+		Read:   resourceArmServiceBusQueueAuthorizationRuleRead,
+		Update: resourceArmServiceBusQueueAuthorizationRuleCreate,
+		Delete: resourceArmServiceBusQueueAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: ruleSchema,
+	}
+}
+
+func resourceArmServiceBusQueueAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	namespacePath := serviceBusNamespaceURLPath(d.Get("resource_group_name").(string), d.Get("namespace_name").(string))
+	parentPath := fmt.Sprintf("%s/queues/%s", namespacePath, d.Get("queue_name").(string))
+
+	if err := createArmServiceBusAuthorizationRule(d, meta, parentPath); err != nil {
+		return err
+	}
+
+	return resourceArmServiceBusQueueAuthorizationRuleRead(d, meta)
+}
+
+func resourceArmServiceBusQueueAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := readArmServiceBusAuthorizationRule(d, meta)
+	if err != nil {
+		return err
+	}
+	if id == nil {
+		return nil
+	}
+
+	d.Set("queue_name", id.Path["queues"])
+
+	return nil
+}
+
+func resourceArmServiceBusQueueAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmServiceBusResource(d, meta, "Authorization Rule")
+}
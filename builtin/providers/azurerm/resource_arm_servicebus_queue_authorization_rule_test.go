@@ -0,0 +1,115 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMServiceBusQueueAuthorizationRule_listenSend(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMServiceBusQueueAuthorizationRule_listenSend, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusQueueAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusQueueAuthorizationRuleExists("azurerm_servicebus_queue_authorization_rule.test"),
+					resource.TestCheckResourceAttr(
+						"azurerm_servicebus_queue_authorization_rule.test", "listen", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_servicebus_queue_authorization_rule.test", "send", "true"),
+					resource.TestCheckResourceAttr(
+						"azurerm_servicebus_queue_authorization_rule.test", "manage", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMServiceBusQueueAuthorizationRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getServiceBusAuthorizationRule{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get ServiceBus Queue Authorization Rule: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: Get ServiceBus Queue Authorization Rule: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMServiceBusQueueAuthorizationRuleDestroy(s *terraform.State) error {
+	rivieraClient := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_servicebus_queue_authorization_rule" {
+			continue
+		}
+
+		readRequest := rivieraClient.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getServiceBusAuthorizationRule{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: Get ServiceBus Queue Authorization Rule: %s", err)
+		}
+
+		if readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: ServiceBus Queue Authorization Rule still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMServiceBusQueueAuthorizationRule_listenSend = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+    name = "acctestservicebusnamespace-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+}
+
+resource "azurerm_servicebus_queue" "test" {
+    name = "acctestservicebusqueue-%d"
+    location = "${azurerm_resource_group.test.location}"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_servicebus_queue_authorization_rule" "test" {
+    name = "acctestrule-%d"
+    namespace_name = "${azurerm_servicebus_namespace.test.name}"
+    queue_name = "${azurerm_servicebus_queue.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    listen = true
+    send = true
+}
+`
@@ -0,0 +1,165 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmServiceBusSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceBusSubscriptionCreate,
+		Read:   resourceArmServiceBusSubscriptionRead,
+		Update: resourceArmServiceBusSubscriptionCreate,
+		Delete: resourceArmServiceBusSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"topic_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"auto_delete_on_idle": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"default_message_ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"lock_duration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"max_delivery_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"dead_lettering_on_message_expiration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"dead_lettering_on_filter_evaluation_exceptions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"enable_batched_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"requires_session": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmServiceBusSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Subscription creation.")
+
+	name := d.Get("name").(string)
+	topicName := d.Get("topic_name").(string)
+
+	command := &createOrUpdateServiceBusEntity{
+		ResourceGroupName: d.Get("resource_group_name").(string),
+		NamespaceName:     d.Get("namespace_name").(string),
+		EntityPath:        fmt.Sprintf("topics/%s/subscriptions/%s", topicName, name),
+		Location:          d.Get("location").(string),
+		serviceBusEntityProperties: serviceBusEntityProperties{
+			MaxDeliveryCount:                          azure.Int32(int32(d.Get("max_delivery_count").(int))),
+			DeadLetteringOnMessageExpiration:          azure.Bool(d.Get("dead_lettering_on_message_expiration").(bool)),
+			DeadLetteringOnFilterEvaluationExceptions: azure.Bool(d.Get("dead_lettering_on_filter_evaluation_exceptions").(bool)),
+			EnableBatchedOperations:                   azure.Bool(d.Get("enable_batched_operations").(bool)),
+			RequiresSession:                           azure.Bool(d.Get("requires_session").(bool)),
+		},
+	}
+
+	if v, ok := d.GetOk("auto_delete_on_idle"); ok {
+		command.AutoDeleteOnIdle = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_message_ttl"); ok {
+		command.DefaultMessageTimeToLive = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("lock_duration"); ok {
+		command.LockDuration = azure.String(v.(string))
+	}
+
+	if err := createArmServiceBusEntity(d, meta, "Subscription", command); err != nil {
+		return err
+	}
+
+	return resourceArmServiceBusSubscriptionRead(d, meta)
+}
+
+func resourceArmServiceBusSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	resp, id, err := readArmServiceBusEntity(d, meta, "Subscription")
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+
+	d.Set("topic_name", id.Path["topics"])
+	d.Set("auto_delete_on_idle", resp.AutoDeleteOnIdle)
+	d.Set("default_message_ttl", resp.DefaultMessageTimeToLive)
+	d.Set("lock_duration", resp.LockDuration)
+	d.Set("max_delivery_count", resp.MaxDeliveryCount)
+	d.Set("dead_lettering_on_message_expiration", resp.DeadLetteringOnMessageExpiration)
+	d.Set("dead_lettering_on_filter_evaluation_exceptions", resp.DeadLetteringOnFilterEvaluationExceptions)
+	d.Set("enable_batched_operations", resp.EnableBatchedOperations)
+	d.Set("requires_session", resp.RequiresSession)
+
+	return nil
+}
+
+func resourceArmServiceBusSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmServiceBusResource(d, meta, "Subscription")
+}
@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmServiceBusTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceBusTopicCreate,
+		Read:   resourceArmServiceBusTopicRead,
+		Update: resourceArmServiceBusTopicCreate,
+		Delete: resourceArmServiceBusTopicDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"auto_delete_on_idle": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"default_message_ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"duplicate_detection_history_time_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"max_size_in_megabytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"enable_batched_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"enable_express": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_partitioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"requires_duplicate_detection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"support_ordering": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmServiceBusTopicCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Topic creation.")
+
+	name := d.Get("name").(string)
+
+	command := &createOrUpdateServiceBusEntity{
+		ResourceGroupName: d.Get("resource_group_name").(string),
+		NamespaceName:     d.Get("namespace_name").(string),
+		EntityPath:        fmt.Sprintf("topics/%s", name),
+		Location:          d.Get("location").(string),
+		serviceBusEntityProperties: serviceBusEntityProperties{
+			EnableBatchedOperations:    azure.Bool(d.Get("enable_batched_operations").(bool)),
+			EnableExpress:              azure.Bool(d.Get("enable_express").(bool)),
+			EnablePartitioning:         azure.Bool(d.Get("enable_partitioning").(bool)),
+			RequiresDuplicateDetection: azure.Bool(d.Get("requires_duplicate_detection").(bool)),
+			SupportOrdering:            azure.Bool(d.Get("support_ordering").(bool)),
+		},
+	}
+
+	if v, ok := d.GetOk("auto_delete_on_idle"); ok {
+		command.AutoDeleteOnIdle = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_message_ttl"); ok {
+		command.DefaultMessageTimeToLive = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("duplicate_detection_history_time_window"); ok {
+		command.DuplicateDetectionHistoryTimeWindow = azure.String(v.(string))
+	}
+	if v, ok := d.GetOk("max_size_in_megabytes"); ok {
+		maxSize := int64(v.(int))
+		command.MaxSizeInMegabytes = &maxSize
+	}
+
+	if err := createArmServiceBusEntity(d, meta, "Topic", command); err != nil {
+		return err
+	}
+
+	return resourceArmServiceBusTopicRead(d, meta)
+}
+
+func resourceArmServiceBusTopicRead(d *schema.ResourceData, meta interface{}) error {
+	resp, _, err := readArmServiceBusEntity(d, meta, "Topic")
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+
+	d.Set("auto_delete_on_idle", resp.AutoDeleteOnIdle)
+	d.Set("default_message_ttl", resp.DefaultMessageTimeToLive)
+	d.Set("duplicate_detection_history_time_window", resp.DuplicateDetectionHistoryTimeWindow)
+	d.Set("max_size_in_megabytes", resp.MaxSizeInMegabytes)
+	d.Set("enable_batched_operations", resp.EnableBatchedOperations)
+	d.Set("enable_express", resp.EnableExpress)
+	d.Set("enable_partitioning", resp.EnablePartitioning)
+	d.Set("requires_duplicate_detection", resp.RequiresDuplicateDetection)
+	d.Set("support_ordering", resp.SupportOrdering)
+
+	return nil
+}
+
+func resourceArmServiceBusTopicDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmServiceBusResource(d, meta, "Topic")
+}
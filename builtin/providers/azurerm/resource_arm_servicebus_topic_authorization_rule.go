@@ -0,0 +1,57 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmServiceBusTopicAuthorizationRule() *schema.Resource {
+	ruleSchema := serviceBusAuthorizationRuleSchema()
+	ruleSchema["topic_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmServiceBusTopicAuthorizationRuleCreate,
+		Read:   resourceArmServiceBusTopicAuthorizationRuleRead,
+		Update: resourceArmServiceBusTopicAuthorizationRuleCreate,
+		Delete: resourceArmServiceBusTopicAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: ruleSchema,
+	}
+}
+
+func resourceArmServiceBusTopicAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	namespacePath := serviceBusNamespaceURLPath(d.Get("resource_group_name").(string), d.Get("namespace_name").(string))
+	parentPath := fmt.Sprintf("%s/topics/%s", namespacePath, d.Get("topic_name").(string))
+
+	if err := createArmServiceBusAuthorizationRule(d, meta, parentPath); err != nil {
+		return err
+	}
+
+	return resourceArmServiceBusTopicAuthorizationRuleRead(d, meta)
+}
+
+func resourceArmServiceBusTopicAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := readArmServiceBusAuthorizationRule(d, meta)
+	if err != nil {
+		return err
+	}
+	if id == nil {
+		return nil
+	}
+
+	d.Set("topic_name", id.Path["topics"])
+
+	return nil
+}
+
+func resourceArmServiceBusTopicAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteArmServiceBusResource(d, meta, "Authorization Rule")
+}
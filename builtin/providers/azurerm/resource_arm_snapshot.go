@@ -0,0 +1,220 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSnapshotCreate,
+		Read:   resourceArmSnapshotRead,
+		Update: resourceArmSnapshotCreate,
+		Delete: resourceArmSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"create_option": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Copy", "Import"}, false),
+			},
+
+			"source_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"storage_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"disk_size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1023),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Snapshot creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	createOption := d.Get("create_option").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandTags(tags)
+
+	command := &createOrUpdateSnapshot{
+		Name:              name,
+		ResourceGroupName: resGroup,
+		Location:          d.Get("location").(string),
+		Tags:              *expandedTags,
+		CreationData: &managedDiskCreationData{
+			CreateOption: azure.String(createOption),
+		},
+	}
+
+	if v, ok := d.GetOk("disk_size_gb"); ok {
+		command.DiskSizeGB = azure.Int32(int32(v.(int)))
+	}
+
+	switch createOption {
+	case "Import":
+		sourceURI := d.Get("source_uri").(string)
+		if sourceURI == "" {
+			return fmt.Errorf("[ERROR] source_uri must be specified when create_option is `Import`")
+		}
+		command.CreationData.SourceURI = azure.String(sourceURI)
+
+		if v, ok := d.GetOk("storage_account_id"); ok {
+			command.CreationData.StorageAccountID = azure.String(v.(string))
+		}
+	case "Copy":
+		sourceResourceID := d.Get("source_resource_id").(string)
+		if sourceResourceID == "" {
+			return fmt.Errorf("[ERROR] source_resource_id must be specified when create_option is `Copy`")
+		}
+		command.CreationData.SourceResourceID = azure.String(sourceResourceID)
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating Snapshot %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating Snapshot %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getSnapshot{
+		Name:              name,
+		ResourceGroupName: resGroup,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Snapshot %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Snapshot %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getSnapshotResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Snapshot %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSnapshotRead(d, meta)
+}
+
+func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getSnapshot{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Snapshot %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Snapshot %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Snapshot %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getSnapshotResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("disk_size_gb", resp.DiskSizeGB)
+
+	if data := resp.CreationData; data != nil {
+		d.Set("create_option", data.CreateOption)
+		d.Set("source_uri", data.SourceURI)
+		d.Set("source_resource_id", data.SourceResourceID)
+		d.Set("storage_account_id", data.StorageAccountID)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteSnapshot{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Snapshot %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() {
+		return fmt.Errorf("Error deleting Snapshot %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
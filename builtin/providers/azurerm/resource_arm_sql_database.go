@@ -3,8 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/jen20/riviera/azure"
 	"github.com/jen20/riviera/sql"
 )
@@ -15,6 +17,9 @@ func resourceArmSqlDatabase() *schema.Resource {
 		Read:   resourceArmSqlDatabaseRead,
 		Update: resourceArmSqlDatabaseCreate,
 		Delete: resourceArmSqlDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -43,9 +48,10 @@ func resourceArmSqlDatabase() *schema.Resource {
 			},
 
 			"create_mode": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "Default",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Default",
+				ValidateFunc: validation.StringInSlice([]string{"Copy", "Default", "NonReadableSecondary", "OnlineSecondary", "PointInTimeRestore", "Recovery", "Restore"}, false),
 			},
 
 			"source_database_id": &schema.Schema{
@@ -64,7 +70,7 @@ func resourceArmSqlDatabase() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validateArmSqlDatabaseEdition,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium"}, false),
 			},
 
 			"collation": &schema.Schema{
@@ -167,6 +173,14 @@ func resourceArmSqlDatabaseCreate(d *schema.ResourceData, meta interface{}) erro
 		command.RequestedServiceObjectiveName = azure.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("restore_point_in_time"); ok {
+		command.RestorePointInTime = azure.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("elastic_pool_name"); ok {
+		command.ElasticPoolName = azure.String(v.(string))
+	}
+
 	createRequest := rivieraClient.NewRequest()
 	createRequest.Command = command
 
@@ -203,6 +217,11 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	client := meta.(*ArmClient)
 	rivieraClient := client.rivieraClient
 
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	readRequest := rivieraClient.NewRequestForURI(d.Id())
 	readRequest.Command = &sql.GetDatabase{}
 
@@ -211,16 +230,40 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error reading SQL Database: %s", err)
 	}
 	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading SQL Database %q - removing from state", d.Id())
-		d.SetId("")
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] SQL Database %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error reading SQL Database: %s", readResponse.Error)
 	}
 
 	resp := readResponse.Parsed.(*sql.GetDatabaseResponse)
 
 	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("server_name", id.Path["servers"])
+	if resp.Location != nil {
+		d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	}
 	d.Set("creation_date", resp.CreationDate)
 	d.Set("default_secondary_location", resp.DefaultSecondaryLocation)
+	d.Set("edition", resp.Edition)
+	d.Set("collation", resp.Collation)
+	d.Set("max_size_bytes", resp.MaxSizeInBytes)
+	d.Set("requested_service_objective_id", resp.RequestedServiceObjectiveID)
+	d.Set("requested_service_objective_name", resp.RequestedServiceObjectiveName)
+	d.Set("elastic_pool_name", resp.ElasticPoolName)
+	d.Set("encryption", resp.Encryption)
+
+	if resp.Tags != nil {
+		tags := make(map[string]*string, len(*resp.Tags))
+		for k, v := range *resp.Tags {
+			value := v
+			tags[k] = &value
+		}
+		flattenAndSetTags(d, &tags)
+	}
 
 	return nil
 }
@@ -242,16 +285,3 @@ func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
-
-func validateArmSqlDatabaseEdition(v interface{}, k string) (ws []string, errors []error) {
-	editions := map[string]bool{
-		"Basic":    true,
-		"Standard": true,
-		"Premium":  true,
-	}
-
-	if !editions[v.(string)] {
-		errors = append(errors, fmt.Errorf("SQL Database Edition can only be Basic, Standard or Premium"))
-	}
-	return
-}
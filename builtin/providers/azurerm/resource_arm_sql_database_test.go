@@ -33,8 +33,9 @@ func TestResourceAzureRMSqlDatabaseEdition_validation(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceArmSqlDatabase().Schema["edition"].ValidateFunc
 	for _, tc := range cases {
-		_, errors := validateArmSqlDatabaseEdition(tc.Value, "azurerm_sql_database")
+		_, errors := validateFunc(tc.Value, "azurerm_sql_database")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Azure RM SQL Database edition to trigger a validation error")
@@ -42,6 +43,39 @@ func TestResourceAzureRMSqlDatabaseEdition_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAzureRMSqlDatabaseCreateMode_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Default",
+			ErrCount: 0,
+		},
+		{
+			Value:    "PointInTimeRestore",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Copy",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmSqlDatabase().Schema["create_mode"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "create_mode")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM SQL Database create mode %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
 func TestAccAzureRMSqlDatabase_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := fmt.Sprintf(testAccAzureRMSqlDatabase_basic, ri, ri, ri)
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/riviera/azure"
@@ -97,6 +98,11 @@ func resourceArmSqlFirewallRuleRead(d *schema.ResourceData, meta interface{}) er
 	client := meta.(*ArmClient)
 	rivieraClient := client.rivieraClient
 
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	readRequest := rivieraClient.NewRequestForURI(d.Id())
 	readRequest.Command = &sql.GetFirewallRule{}
 
@@ -105,14 +111,19 @@ func resourceArmSqlFirewallRuleRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error reading SQL Server Firewall Rule: %s", err)
 	}
 	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading SQL Server Firewall Rule %q - removing from state", d.Id())
-		d.SetId("")
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] SQL Server Firewall Rule %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error reading SQL Server Firewall Rule: %s", readResponse.Error)
 	}
 
 	resp := readResponse.Parsed.(*sql.GetFirewallRuleResponse)
 
 	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("server_name", id.Path["servers"])
 	d.Set("start_ip_address", resp.StartIPAddress)
 	d.Set("end_ip_address", resp.EndIPAddress)
 
@@ -3,8 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/jen20/riviera/azure"
 	"github.com/jen20/riviera/sql"
 )
@@ -15,6 +17,9 @@ func resourceArmSqlServer() *schema.Resource {
 		Read:   resourceArmSqlServerRead,
 		Update: resourceArmSqlServerCreate,
 		Delete: resourceArmSqlServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -37,8 +42,9 @@ func resourceArmSqlServer() *schema.Resource {
 			},
 
 			"version": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"2.0", "12.0"}, false),
 			},
 
 			"administrator_login": &schema.Schema{
@@ -47,8 +53,9 @@ func resourceArmSqlServer() *schema.Resource {
 			},
 
 			"administrator_login_password": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
 			},
 
 			"fully_qualified_domain_name": &schema.Schema{
@@ -111,6 +118,11 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	rivieraClient := client.rivieraClient
 
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	readRequest := rivieraClient.NewRequestForURI(d.Id())
 	readRequest.Command = &sql.GetServer{}
 
@@ -119,13 +131,22 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading SQL Server: %s", err)
 	}
 	if !readResponse.IsSuccessful() {
-		log.Printf("[INFO] Error reading SQL Server %q - removing from state", d.Id())
-		d.SetId("")
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] SQL Server %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error reading SQL Server: %s", readResponse.Error)
 	}
 
 	resp := readResponse.Parsed.(*sql.GetServerResponse)
 
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if resp.Location != nil {
+		d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	}
+
 	d.Set("fully_qualified_domain_name", resp.FullyQualifiedDomainName)
 	d.Set("administrator_login", resp.AdministratorLogin)
 	d.Set("version", resp.Version)
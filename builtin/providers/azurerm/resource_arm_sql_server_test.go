@@ -10,6 +10,35 @@ import (
 	"github.com/jen20/riviera/sql"
 )
 
+func TestResourceAzureRMSqlServerVersion_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "11.0",
+			ErrCount: 1,
+		},
+		{
+			Value:    "2.0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "12.0",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmSqlServer().Schema["version"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "version")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM SQL Server version %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
 func TestAccAzureRMSqlServer_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := fmt.Sprintf(testAccAzureRMSqlServer_basic, ri, ri)
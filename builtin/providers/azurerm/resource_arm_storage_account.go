@@ -5,12 +5,12 @@ import (
 	"log"
 	"net/http"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/storage"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmStorageAccount() *schema.Resource {
@@ -47,7 +47,7 @@ func resourceArmStorageAccount() *schema.Resource {
 			"account_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateArmStorageAccountType,
+				ValidateFunc: validation.StringInSlice([]string{"Standard_LRS", "Standard_ZRS", "Standard_GRS", "Standard_RAGRS", "Premium_LRS"}, true),
 			},
 
 			"primary_location": {
@@ -97,13 +97,15 @@ func resourceArmStorageAccount() *schema.Resource {
 			},
 
 			"primary_access_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
 			"secondary_access_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
 			"tags": tagsSchema(),
@@ -306,22 +308,6 @@ func validateArmStorageAccountName(v interface{}, k string) (ws []string, es []e
 	return
 }
 
-func validateArmStorageAccountType(v interface{}, k string) (ws []string, es []error) {
-	validAccountTypes := []string{"standard_lrs", "standard_zrs",
-		"standard_grs", "standard_ragrs", "premium_lrs"}
-
-	input := strings.ToLower(v.(string))
-
-	for _, valid := range validAccountTypes {
-		if valid == input {
-			return
-		}
-	}
-
-	es = append(es, fmt.Errorf("Invalid storage account type %q", input))
-	return
-}
-
 func storageAccountStateRefreshFunc(client *ArmClient, resourceGroupName string, storageAccountName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.storageServiceClient.GetProperties(resourceGroupName, storageAccountName)
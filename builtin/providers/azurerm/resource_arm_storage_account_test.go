@@ -20,7 +20,7 @@ func TestValidateArmStorageAccountType(t *testing.T) {
 	}
 
 	for _, test := range testCases {
-		_, es := validateArmStorageAccountType(test.input, "account_type")
+		_, es := resourceArmStorageAccount().Schema["account_type"].ValidateFunc(test.input, "account_type")
 
 		if test.shouldError && len(es) == 0 {
 			t.Fatalf("Expected validating account_type %q to fail", test.input)
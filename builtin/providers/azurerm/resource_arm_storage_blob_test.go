@@ -42,8 +42,9 @@ func TestResourceAzureRMStorageBlobType_validation(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceArmStorageBlob().Schema["type"].ValidateFunc
 	for _, tc := range cases {
-		_, errors := validateArmStorageBlobType(tc.Value, "azurerm_storage_blob")
+		_, errors := validateFunc(tc.Value, "azurerm_storage_blob")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Azure RM Storage Blob type to trigger a validation error")
@@ -106,8 +107,9 @@ func TestResourceAzureRMStorageBlobParallelism_validation(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceArmStorageBlob().Schema["parallelism"].ValidateFunc
 	for _, tc := range cases {
-		_, errors := validateArmStorageBlobParallelism(tc.Value, "azurerm_storage_blob")
+		_, errors := validateFunc(tc.Value, "azurerm_storage_blob")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Azure RM Storage Blob parallelism to trigger a validation error")
@@ -134,8 +136,9 @@ func TestResourceAzureRMStorageBlobAttempts_validation(t *testing.T) {
 		},
 	}
 
+	validateFunc := resourceArmStorageBlob().Schema["attempts"].ValidateFunc
 	for _, tc := range cases {
-		_, errors := validateArmStorageBlobAttempts(tc.Value, "azurerm_storage_blob")
+		_, errors := validateFunc(tc.Value, "azurerm_storage_blob")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Azure RM Storage Blob attempts to trigger a validation error")
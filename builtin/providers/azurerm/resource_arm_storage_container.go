@@ -3,12 +3,12 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"regexp"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmStorageContainer() *schema.Resource {
@@ -40,7 +40,7 @@ func resourceArmStorageContainer() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Default:      "private",
-				ValidateFunc: validateArmStorageContainerAccessType,
+				ValidateFunc: validation.StringInSlice([]string{"private", "blob", "container"}, true),
 			},
 			"properties": {
 				Type:     schema.TypeMap,
@@ -69,20 +69,6 @@ func validateArmStorageContainerName(v interface{}, k string) (ws []string, erro
 	return
 }
 
-func validateArmStorageContainerAccessType(v interface{}, k string) (ws []string, errors []error) {
-	value := strings.ToLower(v.(string))
-	validTypes := map[string]struct{}{
-		"private":   struct{}{},
-		"blob":      struct{}{},
-		"container": struct{}{},
-	}
-
-	if _, ok := validTypes[value]; !ok {
-		errors = append(errors, fmt.Errorf("Storage container access type %q is invalid, must be %q, %q or %q", value, "private", "blob", "page"))
-	}
-	return
-}
-
 func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
@@ -0,0 +1,177 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmStorageShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageShareCreate,
+		Read:   resourceArmStorageShareRead,
+		Exists: resourceArmStorageShareExists,
+		Delete: resourceArmStorageShareDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateArmStorageShareName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-z0-9-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only lowercase alphanumeric characters and hyphens allowed in %q", k))
+	}
+
+	if regexp.MustCompile(`^-`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot start with a hyphen", k))
+	}
+
+	if regexp.MustCompile(`-$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot end with a hyphen", k))
+	}
+
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain consecutive hyphens", k))
+	}
+
+	if len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 63 characters", k))
+	}
+
+	if len(value) < 3 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be at least 3 characters", k))
+	}
+
+	return
+}
+
+func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Creating share %q in storage account %q", name, storageAccountName)
+	err = fileClient.CreateShare(name)
+	if err != nil {
+		return fmt.Errorf("Error creating storage share on Azure: %s", err)
+	}
+
+	d.SetId(name)
+	return resourceArmStorageShareRead(d, meta)
+}
+
+func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	exists, err := resourceArmStorageShareExists(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		// Exists already removed this from state
+		return nil
+	}
+
+	storageAccountName := d.Get("storage_account_name").(string)
+	d.Set("url", fmt.Sprintf("https://%s.file.%s/%s", storageAccountName, armClient.environment.StorageEndpointSuffix, d.Get("name").(string)))
+
+	return nil
+}
+
+func resourceArmStorageShareExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return false, err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing share %q from state", storageAccountName, d.Id())
+		d.SetId("")
+		return false, nil
+	}
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Checking for existence of storage share %q.", name)
+	exists, err := fileClient.ShareExists(name)
+	if err != nil {
+		return false, fmt.Errorf("error testing existence of storage share %q: %s", name, err)
+	}
+
+	if !exists {
+		log.Printf("[INFO] Storage share %q no longer exists, removing from state...", name)
+		d.SetId("")
+	}
+
+	return exists, nil
+}
+
+func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the share won't exist", storageAccountName)
+		return nil
+	}
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Deleting storage share %q", name)
+	if _, err = fileClient.DeleteShareIfExists(name); err != nil {
+		return fmt.Errorf("Error deleting storage share %q: %s", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
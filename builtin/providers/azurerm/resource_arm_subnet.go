@@ -16,6 +16,9 @@ func resourceArmSubnet() *schema.Resource {
 		Read:   resourceArmSubnetRead,
 		Update: resourceArmSubnetCreate,
 		Delete: resourceArmSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -37,20 +40,23 @@ func resourceArmSubnet() *schema.Resource {
 			},
 
 			"address_prefix": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentCidrDiffs,
 			},
 
 			"network_security_group_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
 			"route_table_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
 			"ip_configurations": {
@@ -139,6 +145,19 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error making Read request on Azure Subnet %s: %s", name, err)
 	}
 
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("virtual_network_name", vnetName)
+	d.Set("address_prefix", resp.Properties.AddressPrefix)
+
+	if resp.Properties.NetworkSecurityGroup != nil {
+		d.Set("network_security_group_id", resp.Properties.NetworkSecurityGroup.ID)
+	}
+
+	if resp.Properties.RouteTable != nil {
+		d.Set("route_table_id", resp.Properties.RouteTable.ID)
+	}
+
 	if resp.Properties.IPConfigurations != nil && len(*resp.Properties.IPConfigurations) > 0 {
 		ips := make([]string, 0, len(*resp.Properties.IPConfigurations))
 		for _, ip := range *resp.Properties.IPConfigurations {
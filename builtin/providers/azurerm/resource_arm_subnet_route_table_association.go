@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmSubnetRouteTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetRouteTableAssociationCreate,
+		Read:   resourceArmSubnetRouteTableAssociationRead,
+		Delete: resourceArmSubnetRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmSubnetRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	subnetClient := meta.(*ArmClient).subnetClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Subnet Route Table Association creation.")
+
+	subnetID := d.Get("subnet_id").(string)
+	routeTableID := d.Get("route_table_id").(string)
+
+	id, err := parseAzureResourceID(subnetID)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	rtID, err := parseAzureResourceID(routeTableID)
+	if err != nil {
+		return err
+	}
+	rtName := rtID.Path["routeTables"]
+
+	armMutexKV.Lock(rtName)
+	defer armMutexKV.Unlock(rtName)
+
+	armMutexKV.Lock(vnetName)
+	defer armMutexKV.Unlock(vnetName)
+
+	subnet, err := subnetClient.Get(resGroup, vnetName, subnetName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, vnetName, resGroup, err)
+	}
+	if subnet.Properties == nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): `properties` was nil", subnetName, vnetName, resGroup)
+	}
+
+	if rt := subnet.Properties.RouteTable; rt != nil && rt.ID != nil && !strings.EqualFold(*rt.ID, routeTableID) {
+		return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) is already associated with Route Table %q", subnetName, vnetName, resGroup, *rt.ID)
+	}
+
+	subnet.Properties.RouteTable = &network.RouteTable{
+		ID: &routeTableID,
+	}
+
+	_, err = subnetClient.CreateOrUpdate(resGroup, vnetName, subnetName, subnet, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error associating Route Table %q with Subnet %q (Virtual Network %q / Resource Group %q): %s", routeTableID, subnetName, vnetName, resGroup, err)
+	}
+
+	read, err := subnetClient.Get(resGroup, vnetName, subnetName, "")
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Subnet %s/%s (resource group %s) ID", vnetName, subnetName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubnetRouteTableAssociationRead(d, meta)
+}
+
+func resourceArmSubnetRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	subnetClient := meta.(*ArmClient).subnetClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	resp, err := subnetClient.Get(resGroup, vnetName, subnetName, "")
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Subnet %q (Virtual Network %q / Resource Group %q) not found - removing Route Table Association from state", subnetName, vnetName, resGroup)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Subnet %s: %s", subnetName, err)
+	}
+
+	if resp.Properties == nil || resp.Properties.RouteTable == nil || resp.Properties.RouteTable.ID == nil {
+		log.Printf("[INFO] Subnet %q (Virtual Network %q / Resource Group %q) has no Route Table - removing Route Table Association from state", subnetName, vnetName, resGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", resp.ID)
+	d.Set("route_table_id", resp.Properties.RouteTable.ID)
+
+	return nil
+}
+
+func resourceArmSubnetRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	subnetClient := meta.(*ArmClient).subnetClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	rtID, err := parseAzureResourceID(d.Get("route_table_id").(string))
+	if err != nil {
+		return err
+	}
+	rtName := rtID.Path["routeTables"]
+
+	armMutexKV.Lock(rtName)
+	defer armMutexKV.Unlock(rtName)
+
+	armMutexKV.Lock(vnetName)
+	defer armMutexKV.Unlock(vnetName)
+
+	subnet, err := subnetClient.Get(resGroup, vnetName, subnetName, "")
+	if subnet.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, vnetName, resGroup, err)
+	}
+	if subnet.Properties == nil || subnet.Properties.RouteTable == nil {
+		return nil
+	}
+
+	subnet.Properties.RouteTable = nil
+
+	_, err = subnetClient.CreateOrUpdate(resGroup, vnetName, subnetName, subnet, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error removing Route Table from Subnet %q (Virtual Network %q / Resource Group %q): %s", subnetName, vnetName, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMSubnetRouteTableAssociation_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMSubnetRouteTableAssociation_basic, ri, ri, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetRouteTableAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetRouteTableAssociationExists("azurerm_subnet_route_table_association.test"),
+					testCheckAzureRMRouteExists("azurerm_route.test1"),
+					testCheckAzureRMRouteExists("azurerm_route.test2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetRouteTableAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		conn := testAccProvider.Meta().(*ArmClient).subnetClient
+
+		resp, err := conn.Get(resourceGroup, vnetName, subnetName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on subnetClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Subnet %q (resource group: %q) does not exist", subnetName, resourceGroup)
+		}
+
+		if resp.Properties.RouteTable == nil || resp.Properties.RouteTable.ID == nil {
+			return fmt.Errorf("Bad: Subnet %q (resource group: %q) has no Route Table", subnetName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubnetRouteTableAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).subnetClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subnet_route_table_association" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.Get(id.ResourceGroup, id.Path["virtualNetworks"], id.Path["subnets"], "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound && resp.Properties.RouteTable != nil {
+			return fmt.Errorf("Subnet Route Table Association still exists:\n%#v", resp.Properties.RouteTable)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMSubnetRouteTableAssociation_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctestvirtnet%d"
+    address_space = ["10.0.0.0/16"]
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+    name = "acctestsubnet%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.2.0/24"
+}
+
+resource "azurerm_route_table" "test" {
+    name = "acctestrt%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_route" "test1" {
+    name = "acctestroute%d-1"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    route_table_name = "${azurerm_route_table.test.name}"
+
+    address_prefix = "0.0.0.0/0"
+    next_hop_type = "VirtualAppliance"
+    next_hop_in_ip_address = "10.0.1.4"
+}
+
+resource "azurerm_route" "test2" {
+    name = "acctestroute%d-2"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    route_table_name = "${azurerm_route_table.test.name}"
+
+    address_prefix = "10.1.0.0/16"
+    next_hop_type = "vnetlocal"
+}
+
+resource "azurerm_subnet_route_table_association" "test" {
+    subnet_id = "${azurerm_subnet.test.id}"
+    route_table_id = "${azurerm_route_table.test.id}"
+}
+`
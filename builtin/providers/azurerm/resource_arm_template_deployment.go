@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmTemplateDeployment() *schema.Resource {
@@ -51,8 +53,9 @@ func resourceArmTemplateDeployment() *schema.Resource {
 			},
 
 			"deployment_mode": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(resources.Complete), string(resources.Incremental)}, false),
 			},
 		},
 	}
@@ -150,18 +153,11 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %s: %s", name, err)
 	}
-	var outputs map[string]string
-	if resp.Properties.Outputs != nil && len(*resp.Properties.Outputs) > 0 {
-		outputs = make(map[string]string)
-		for key, output := range *resp.Properties.Outputs {
-			outputMap := output.(map[string]interface{})
-			outputValue, ok := outputMap["value"]
-			if !ok {
-				// No value
-				continue
-			}
-
-			outputs[key] = outputValue.(string)
+	outputs := make(map[string]string)
+	if resp.Properties != nil && resp.Properties.Outputs != nil {
+		outputs, err = flattenArmTemplateDeploymentOutputs(*resp.Properties.Outputs)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -185,9 +181,49 @@ func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{
 	}
 
 	_, err = deployClient.Delete(resGroup, name, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error deleting Template Deployment %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
 	return nil
 }
 
+// flattenArmTemplateDeploymentOutputs converts the outputs of a deployment,
+// each of which has a type and a value, into a map of strings. Values which
+// aren't strings are formatted as they would be in a template, with objects
+// and arrays encoded as JSON.
+func flattenArmTemplateDeploymentOutputs(outputs map[string]interface{}) (map[string]string, error) {
+	result := make(map[string]string, len(outputs))
+	for key, output := range outputs {
+		outputMap, ok := output.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value, ok := outputMap["value"]
+		if !ok {
+			// No value
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			result[key] = v
+		case bool:
+			result[key] = strconv.FormatBool(v)
+		case float64:
+			result[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("Error encoding the value of output %q: %s", key, err)
+			}
+			result[key] = string(b)
+		}
+	}
+
+	return result, nil
+}
+
 func expandTemplateBody(template string) (map[string]interface{}, error) {
 	var templateBody map[string]interface{}
 	err := json.Unmarshal([]byte(template), &templateBody)
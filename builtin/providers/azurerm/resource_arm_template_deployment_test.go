@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -11,6 +12,66 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestResourceAzureRMTemplateDeploymentMode_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Complete",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Incremental",
+			ErrCount: 0,
+		},
+		{
+			Value:    "incremental",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmTemplateDeployment().Schema["deployment_mode"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "deployment_mode")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Template Deployment Mode to trigger a validation error for %q", tc.Value)
+		}
+	}
+}
+
+func TestResourceAzureRMTemplateDeploymentOutputs_flatten(t *testing.T) {
+	outputs := map[string]interface{}{
+		"string": map[string]interface{}{"type": "String", "value": "Output Value"},
+		"int":    map[string]interface{}{"type": "Int", "value": float64(42)},
+		"bool":   map[string]interface{}{"type": "Bool", "value": true},
+		"object": map[string]interface{}{"type": "Object", "value": map[string]interface{}{"key": "value"}},
+		"array":  map[string]interface{}{"type": "Array", "value": []interface{}{"a", "b"}},
+		"none":   map[string]interface{}{"type": "String"},
+	}
+
+	expected := map[string]string{
+		"string": "Output Value",
+		"int":    "42",
+		"bool":   "true",
+		"object": `{"key":"value"}`,
+		"array":  `["a","b"]`,
+	}
+
+	actual, err := flattenArmTemplateDeploymentOutputs(outputs)
+	if err != nil {
+		t.Fatalf("Error flattening the Template Deployment outputs: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected the flattened outputs to be %v, got %v", expected, actual)
+	}
+}
+
 func TestAccAzureRMTemplateDeployment_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := fmt.Sprintf(testAccAzureRMTemplateDeployment_basicExample, ri, ri)
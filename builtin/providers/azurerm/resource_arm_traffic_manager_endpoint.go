@@ -8,6 +8,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/arm/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmTrafficManagerEndpoint() *schema.Resource {
@@ -31,7 +32,7 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateAzureRMTrafficManagerEndpointType,
+				ValidateFunc: validation.StringInSlice([]string{"azureEndpoints", "externalEndpoints", "nestedEndpoints"}, false),
 			},
 
 			"profile_name": {
@@ -62,14 +63,14 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validateAzureRMTrafficManagerEndpointWeight,
+				ValidateFunc: validation.IntBetween(1, 1000),
 			},
 
 			"priority": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validateAzureRMTrafficManagerEndpointPriority,
+				ValidateFunc: validation.IntBetween(1, 1000),
 			},
 
 			"endpoint_location": {
@@ -80,6 +81,11 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 				StateFunc: azureRMNormalizeLocation,
 			},
 
+			"endpoint_monitor_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"min_child_endpoints": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -137,15 +143,7 @@ func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 	resGroup := id.ResourceGroup
-
-	// lookup endpointType in Azure ID path
-	var endpointType string
-	typeRegex := regexp.MustCompile("azureEndpoints|externalEndpoints|nestedEndpoints")
-	for k := range id.Path {
-		if typeRegex.MatchString(k) {
-			endpointType = k
-		}
-	}
+	endpointType := parseArmTrafficManagerEndpointType(id)
 	profileName := id.Path["trafficManagerProfiles"]
 
 	// endpoint name is keyed by endpoint type in ARM ID
@@ -162,6 +160,7 @@ func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interfac
 	endpoint := *resp.Properties
 
 	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
 	d.Set("type", endpointType)
 	d.Set("profile_name", profileName)
 	d.Set("endpoint_status", endpoint.EndpointStatus)
@@ -184,7 +183,7 @@ func resourceArmTrafficManagerEndpointDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 	resGroup := id.ResourceGroup
-	endpointType := d.Get("type").(string)
+	endpointType := parseArmTrafficManagerEndpointType(id)
 	profileName := id.Path["trafficManagerProfiles"]
 
 	// endpoint name is keyed by endpoint type in ARM ID
@@ -195,6 +194,18 @@ func resourceArmTrafficManagerEndpointDelete(d *schema.ResourceData, meta interf
 	return err
 }
 
+// parseArmTrafficManagerEndpointType looks up the endpoint type, which is
+// the key the endpoint name is stored under in the ARM ID.
+func parseArmTrafficManagerEndpointType(id *ResourceID) string {
+	typeRegex := regexp.MustCompile("azureEndpoints|externalEndpoints|nestedEndpoints")
+	for k := range id.Path {
+		if typeRegex.MatchString(k) {
+			return k
+		}
+	}
+	return ""
+}
+
 func getArmTrafficManagerEndpointProperties(d *schema.ResourceData) *trafficmanager.EndpointProperties {
 	var endpointProps trafficmanager.EndpointProperties
 
@@ -231,32 +242,3 @@ func getArmTrafficManagerEndpointProperties(d *schema.ResourceData) *trafficmana
 
 	return &endpointProps
 }
-
-func validateAzureRMTrafficManagerEndpointType(i interface{}, k string) (s []string, errors []error) {
-	valid := map[string]struct{}{
-		"azureEndpoints":    struct{}{},
-		"externalEndpoints": struct{}{},
-		"nestedEndpoints":   struct{}{},
-	}
-
-	if _, ok := valid[i.(string)]; !ok {
-		errors = append(errors, fmt.Errorf("endpoint type invalid, got %s", i.(string)))
-	}
-	return
-}
-
-func validateAzureRMTrafficManagerEndpointWeight(i interface{}, k string) (s []string, errors []error) {
-	w := i.(int)
-	if w < 1 || w > 1000 {
-		errors = append(errors, fmt.Errorf("endpoint weight must be between 1-1000 inclusive"))
-	}
-	return
-}
-
-func validateAzureRMTrafficManagerEndpointPriority(i interface{}, k string) (s []string, errors []error) {
-	p := i.(int)
-	if p < 1 || p > 1000 {
-		errors = append(errors, fmt.Errorf("endpoint priority must be between 1-1000 inclusive"))
-	}
-	return
-}
@@ -11,6 +11,76 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestResourceAzureRMTrafficManagerEndpointType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "azureEndpoints",
+			ErrCount: 0,
+		},
+		{
+			Value:    "externalEndpoints",
+			ErrCount: 0,
+		},
+		{
+			Value:    "nestedEndpoints",
+			ErrCount: 0,
+		},
+		{
+			Value:    "AzureEndpoints",
+			ErrCount: 1,
+		},
+		{
+			Value:    "randomEndpoints",
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmTrafficManagerEndpoint().Schema["type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Traffic Manager Endpoint type %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestResourceAzureRMTrafficManagerEndpointWeight_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    1000,
+			ErrCount: 0,
+		},
+		{
+			Value:    1001,
+			ErrCount: 1,
+		},
+	}
+
+	validateFunc := resourceArmTrafficManagerEndpoint().Schema["weight"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "weight")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Traffic Manager Endpoint weight %d to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
 func TestAccAzureRMTrafficManagerEndpoint_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := fmt.Sprintf(testAccAzureRMTrafficManagerEndpoint_basic, ri, ri, ri, ri, ri, ri, ri)
@@ -1,15 +1,13 @@
 package azurerm
 
 import (
-	"bytes"
 	"fmt"
 	"log"
 	"net/http"
-	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/arm/trafficmanager"
-	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmTrafficManagerProfile() *schema.Resource {
@@ -22,6 +20,14 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Upgrade: resourceArmTrafficManagerProfileStateUpgradeV0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,18 +39,19 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validateAzureRMTrafficManagerStatus,
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, true),
 			},
 
 			"traffic_routing_method": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateAzureRMTrafficManagerRoutingMethod,
+				ValidateFunc: validation.StringInSlice([]string{"Performance", "Weighted", "Priority"}, false),
 			},
 
 			"dns_config": {
-				Type:     schema.TypeSet,
+				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"relative_name": {
@@ -55,11 +62,10 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 						"ttl": {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ValidateFunc: validateAzureRMTrafficManagerTTL,
+							ValidateFunc: validation.IntBetween(30, 999999),
 						},
 					},
 				},
-				Set: resourceAzureRMTrafficManagerDNSConfigHash,
 			},
 
 			// inlined from dns_config for ease of use
@@ -69,19 +75,21 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 			},
 
 			"monitor_config": {
-				Type:     schema.TypeSet,
+				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"protocol": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validateAzureRMTrafficManagerMonitorProtocol,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringInSlice([]string{"http", "https"}, false),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 						},
 						"port": {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ValidateFunc: validateAzureRMTrafficManagerMonitorPort,
+							ValidateFunc: validation.IsPortNumber(),
 						},
 						"path": {
 							Type:     schema.TypeString,
@@ -89,7 +97,6 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 						},
 					},
 				},
-				Set: resourceAzureRMTrafficManagerMonitorConfigHash,
 			},
 
 			"resource_group_name": {
@@ -161,17 +168,20 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 
 	// update appropriate values
 	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
 	d.Set("profile_status", profile.ProfileStatus)
 	d.Set("traffic_routing_method", profile.TrafficRoutingMethod)
 
-	dnsFlat := flattenAzureRMTrafficManagerProfileDNSConfig(profile.DNSConfig)
-	d.Set("dns_config", schema.NewSet(resourceAzureRMTrafficManagerDNSConfigHash, dnsFlat))
+	if err := d.Set("dns_config", flattenAzureRMTrafficManagerProfileDNSConfig(profile.DNSConfig)); err != nil {
+		return fmt.Errorf("Error flattening `dns_config` for Traffic Manager Profile %q: %s", name, err)
+	}
 
 	// fqdn is actually inside DNSConfig, inlined for simpler reference
 	d.Set("fqdn", profile.DNSConfig.Fqdn)
 
-	monitorFlat := flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig)
-	d.Set("monitor_config", schema.NewSet(resourceAzureRMTrafficManagerMonitorConfigHash, monitorFlat))
+	if err := d.Set("monitor_config", flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig)); err != nil {
+		return fmt.Errorf("Error flattening `monitor_config` for Traffic Manager Profile %q: %s", name, err)
+	}
 
 	flattenAndSetTags(d, resp.Tags)
 
@@ -210,8 +220,7 @@ func getArmTrafficManagerProfileProperties(d *schema.ResourceData) *trafficmanag
 }
 
 func expandArmTrafficManagerMonitorConfig(d *schema.ResourceData) *trafficmanager.MonitorConfig {
-	monitorSets := d.Get("monitor_config").(*schema.Set).List()
-	monitor := monitorSets[0].(map[string]interface{})
+	monitor := d.Get("monitor_config").([]interface{})[0].(map[string]interface{})
 
 	proto := monitor["protocol"].(string)
 	port := int64(monitor["port"].(int))
@@ -225,8 +234,7 @@ func expandArmTrafficManagerMonitorConfig(d *schema.ResourceData) *trafficmanage
 }
 
 func expandArmTrafficManagerDNSConfig(d *schema.ResourceData) *trafficmanager.DNSConfig {
-	dnsSets := d.Get("dns_config").(*schema.Set).List()
-	dns := dnsSets[0].(map[string]interface{})
+	dns := d.Get("dns_config").([]interface{})[0].(map[string]interface{})
 
 	name := dns["relative_name"].(string)
 	ttl := int64(dns["ttl"].(int))
@@ -255,69 +263,3 @@ func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficmanager.Monito
 
 	return []interface{}{result}
 }
-
-func resourceAzureRMTrafficManagerDNSConfigHash(v interface{}) int {
-	var buf bytes.Buffer
-	m := v.(map[string]interface{})
-
-	buf.WriteString(fmt.Sprintf("%s-", m["relative_name"].(string)))
-	buf.WriteString(fmt.Sprintf("%d-", m["ttl"].(int)))
-
-	return hashcode.String(buf.String())
-}
-
-func resourceAzureRMTrafficManagerMonitorConfigHash(v interface{}) int {
-	var buf bytes.Buffer
-	m := v.(map[string]interface{})
-
-	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["protocol"].(string))))
-	buf.WriteString(fmt.Sprintf("%d-", m["port"].(int)))
-	buf.WriteString(fmt.Sprintf("%s-", m["path"].(string)))
-
-	return hashcode.String(buf.String())
-}
-
-func validateAzureRMTrafficManagerStatus(i interface{}, k string) (s []string, errors []error) {
-	status := strings.ToLower(i.(string))
-	if status != "enabled" && status != "disabled" {
-		errors = append(errors, fmt.Errorf("%s must be one of: Enabled, Disabled", k))
-	}
-	return
-}
-
-func validateAzureRMTrafficManagerRoutingMethod(i interface{}, k string) (s []string, errors []error) {
-	valid := map[string]struct{}{
-		"Performance": struct{}{},
-		"Weighted":    struct{}{},
-		"Priority":    struct{}{},
-	}
-
-	if _, ok := valid[i.(string)]; !ok {
-		errors = append(errors, fmt.Errorf("traffic_routing_method must be one of (Performance, Weighted, Priority), got %s", i.(string)))
-	}
-	return
-}
-
-func validateAzureRMTrafficManagerTTL(i interface{}, k string) (s []string, errors []error) {
-	ttl := i.(int)
-	if ttl < 30 || ttl > 999999 {
-		errors = append(errors, fmt.Errorf("ttl must be between 30 and 999,999 inclusive"))
-	}
-	return
-}
-
-func validateAzureRMTrafficManagerMonitorProtocol(i interface{}, k string) (s []string, errors []error) {
-	p := i.(string)
-	if p != "http" && p != "https" {
-		errors = append(errors, fmt.Errorf("monitor_config.protocol must be one of: http, https"))
-	}
-	return
-}
-
-func validateAzureRMTrafficManagerMonitorPort(i interface{}, k string) (s []string, errors []error) {
-	p := i.(int)
-	if p < 1 || p > 65535 {
-		errors = append(errors, fmt.Errorf("monitor_config.port must be between 1 - 65535 inclusive"))
-	}
-	return
-}
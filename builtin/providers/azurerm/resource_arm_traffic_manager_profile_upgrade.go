@@ -0,0 +1,57 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// resourceArmTrafficManagerProfileStateUpgradeV0 migrates `dns_config` and
+// `monitor_config` from sets, whose elements are keyed by their hash, to
+// lists whose elements are keyed by their index.
+func resourceArmTrafficManagerProfileStateUpgradeV0(
+	is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Println("[INFO] Found AzureRM Traffic Manager Profile State v0; migrating to v1")
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	for _, block := range []string{"dns_config", "monitor_config"} {
+		prefix := block + "."
+
+		hashes := make([]string, 0)
+		seen := make(map[string]bool)
+		for k := range is.Attributes {
+			if !strings.HasPrefix(k, prefix) || k == prefix+"#" {
+				continue
+			}
+
+			hash := strings.SplitN(strings.TrimPrefix(k, prefix), ".", 2)[0]
+			if !seen[hash] {
+				seen[hash] = true
+				hashes = append(hashes, hash)
+			}
+		}
+		sort.Strings(hashes)
+
+		for i, hash := range hashes {
+			oldPrefix := prefix + hash + "."
+			newPrefix := fmt.Sprintf("%s%d.", prefix, i)
+			for k, v := range is.Attributes {
+				if strings.HasPrefix(k, oldPrefix) {
+					delete(is.Attributes, k)
+					is.Attributes[newPrefix+strings.TrimPrefix(k, oldPrefix)] = v
+				}
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
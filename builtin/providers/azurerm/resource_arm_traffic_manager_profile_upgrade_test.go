@@ -0,0 +1,80 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMTrafficManagerProfileStateUpgradeV0(t *testing.T) {
+	cases := map[string]struct {
+		Attributes map[string]string
+		Expected   map[string]string
+		Meta       interface{}
+	}{
+		"v0_1": {
+			Attributes: map[string]string{
+				"name":                                "acctesttmp",
+				"dns_config.#":                        "1",
+				"dns_config.2867421014.relative_name": "acctesttmp",
+				"dns_config.2867421014.ttl":           "30",
+				"monitor_config.#":                    "1",
+				"monitor_config.1085712306.protocol":  "https",
+				"monitor_config.1085712306.port":      "443",
+				"monitor_config.1085712306.path":      "/",
+				"traffic_routing_method":              "Weighted",
+			},
+			Expected: map[string]string{
+				"name":                       "acctesttmp",
+				"dns_config.#":               "1",
+				"dns_config.0.relative_name": "acctesttmp",
+				"dns_config.0.ttl":           "30",
+				"monitor_config.#":           "1",
+				"monitor_config.0.protocol":  "https",
+				"monitor_config.0.port":      "443",
+				"monitor_config.0.path":      "/",
+				"traffic_routing_method":     "Weighted",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "some_id",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceArmTrafficManagerProfileStateUpgradeV0(is, tc.Meta)
+
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Fatalf("bad: %s\n\nexpected: %#v\n\ngot: %#v", tn, tc.Expected, is.Attributes)
+		}
+	}
+}
+
+func TestAzureRMTrafficManagerProfileStateUpgradeV0_empty(t *testing.T) {
+	var is *terraform.InstanceState
+	var meta interface{}
+
+	// should handle nil
+	is, err := resourceArmTrafficManagerProfileStateUpgradeV0(is, meta)
+
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil instancestate, got: %#v", is)
+	}
+
+	// should handle non-nil but empty
+	is = &terraform.InstanceState{}
+	is, err = resourceArmTrafficManagerProfileStateUpgradeV0(is, meta)
+
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+}
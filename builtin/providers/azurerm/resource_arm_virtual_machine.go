@@ -67,13 +67,11 @@ func resourceArmVirtualMachine() *schema.Resource {
 			},
 
 			"availability_set_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-				StateFunc: func(id interface{}) string {
-					return strings.ToLower(id.(string))
-				},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
 			"license_type": {
@@ -500,7 +498,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if resp.Properties.AvailabilitySet != nil {
-		d.Set("availability_set_id", strings.ToLower(*resp.Properties.AvailabilitySet.ID))
+		d.Set("availability_set_id", resp.Properties.AvailabilitySet.ID)
 	}
 
 	d.Set("vm_size", resp.Properties.HardwareProfile.VMSize)
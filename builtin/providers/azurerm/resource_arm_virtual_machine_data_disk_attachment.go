@@ -0,0 +1,265 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmVirtualMachineDataDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineDataDiskAttachmentCreate,
+		Read:   resourceArmVirtualMachineDataDiskAttachmentRead,
+		Delete: resourceArmVirtualMachineDataDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"managed_disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"lun": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"caching": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "None",
+				ValidateFunc: validation.StringInSlice([]string{"None", "ReadOnly", "ReadWrite"}, false),
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	virtualMachineID := d.Get("virtual_machine_id").(string)
+	managedDiskID := d.Get("managed_disk_id").(string)
+	lun := d.Get("lun").(int)
+
+	diskID, err := parseAzureResourceID(managedDiskID)
+	if err != nil {
+		return err
+	}
+	diskName := diskID.Path["disks"]
+
+	armMutexKV.Lock(virtualMachineID)
+	defer armMutexKV.Unlock(virtualMachineID)
+
+	vm, err := getArmVirtualMachineDisks(meta, virtualMachineID)
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		return fmt.Errorf("Virtual Machine %q was not found", virtualMachineID)
+	}
+
+	dataDisks := flattenArmVirtualMachineDataDisks(vm)
+	for _, disk := range dataDisks {
+		if strings.EqualFold(disk["name"].(string), diskName) {
+			return fmt.Errorf("Managed Disk %q is already attached to Virtual Machine %q", diskName, virtualMachineID)
+		}
+		if disk["lun"].(int) == lun {
+			return fmt.Errorf("LUN %d is already in use on Virtual Machine %q", lun, virtualMachineID)
+		}
+	}
+
+	attachment := map[string]interface{}{
+		"lun":          lun,
+		"name":         diskName,
+		"caching":      d.Get("caching").(string),
+		"createOption": "Attach",
+		"managedDisk": map[string]interface{}{
+			"id": managedDiskID,
+		},
+	}
+
+	disks := virtualMachineDataDisks(vm)
+	if err := updateArmVirtualMachineDataDisks(meta, vm, append(disks, attachment)); err != nil {
+		return fmt.Errorf("Error attaching Managed Disk %q to Virtual Machine %q: %s", diskName, virtualMachineID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/dataDisks/%s", virtualMachineID, diskName))
+
+	return resourceArmVirtualMachineDataDiskAttachmentRead(d, meta)
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	virtualMachineID, diskName := parseArmVirtualMachineDataDiskAttachmentID(d.Id())
+
+	vm, err := getArmVirtualMachineDisks(meta, virtualMachineID)
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		log.Printf("[INFO] Virtual Machine %q not found - removing Data Disk Attachment %q from state", virtualMachineID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	for _, disk := range flattenArmVirtualMachineDataDisks(vm) {
+		if !strings.EqualFold(disk["name"].(string), diskName) {
+			continue
+		}
+
+		d.Set("virtual_machine_id", virtualMachineID)
+		d.Set("managed_disk_id", disk["managed_disk_id"])
+		d.Set("lun", disk["lun"])
+		d.Set("caching", disk["caching"])
+		return nil
+	}
+
+	log.Printf("[INFO] Data Disk %q is no longer attached to Virtual Machine %q - removing from state", diskName, virtualMachineID)
+	d.SetId("")
+	return nil
+}
+
+func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	virtualMachineID, diskName := parseArmVirtualMachineDataDiskAttachmentID(d.Id())
+
+	armMutexKV.Lock(virtualMachineID)
+	defer armMutexKV.Unlock(virtualMachineID)
+
+	vm, err := getArmVirtualMachineDisks(meta, virtualMachineID)
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		return nil
+	}
+
+	disks := make([]interface{}, 0)
+	for _, raw := range virtualMachineDataDisks(vm) {
+		disk := raw.(map[string]interface{})
+		if name, ok := disk["name"].(string); ok && strings.EqualFold(name, diskName) {
+			continue
+		}
+		disks = append(disks, disk)
+	}
+
+	if err := updateArmVirtualMachineDataDisks(meta, vm, disks); err != nil {
+		return fmt.Errorf("Error detaching Managed Disk %q from Virtual Machine %q: %s", diskName, virtualMachineID, err)
+	}
+
+	return nil
+}
+
+// parseArmVirtualMachineDataDiskAttachmentID splits the ID of an attachment
+// into the ID of the Virtual Machine and the name of the attached disk.
+func parseArmVirtualMachineDataDiskAttachmentID(id string) (string, string) {
+	i := strings.LastIndex(id, "/dataDisks/")
+	if i == -1 {
+		return id, ""
+	}
+	return id[:i], id[i+len("/dataDisks/"):]
+}
+
+// getArmVirtualMachineDisks reads the Virtual Machine with the given ID,
+// returning nil if it does not exist.
+func getArmVirtualMachineDisks(meta interface{}, virtualMachineID string) (*getVirtualMachineDisksResponse, error) {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	readRequest := rivieraClient.NewRequestForURI(virtualMachineID)
+	readRequest.Command = &getVirtualMachineDisks{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Virtual Machine %q: %s", virtualMachineID, err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error reading Virtual Machine %q: %s", virtualMachineID, readResponse.Error)
+	}
+
+	return readResponse.Parsed.(*getVirtualMachineDisksResponse), nil
+}
+
+func updateArmVirtualMachineDataDisks(meta interface{}, vm *getVirtualMachineDisksResponse, disks []interface{}) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	storageProfile, ok := vm.Properties["storageProfile"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Virtual Machine has no storage profile")
+	}
+	storageProfile["dataDisks"] = disks
+
+	updateRequest := rivieraClient.NewRequestForURI(*vm.ID)
+	updateRequest.Command = &updateVirtualMachineDisks{
+		Location:   *vm.Location,
+		Properties: vm.Properties,
+	}
+
+	updateResponse, err := updateRequest.Execute()
+	if err != nil {
+		return err
+	}
+	if !updateResponse.IsSuccessful() {
+		return updateResponse.Error
+	}
+
+	return nil
+}
+
+func virtualMachineDataDisks(vm *getVirtualMachineDisksResponse) []interface{} {
+	storageProfile, ok := vm.Properties["storageProfile"].(map[string]interface{})
+	if !ok {
+		return []interface{}{}
+	}
+	disks, ok := storageProfile["dataDisks"].([]interface{})
+	if !ok {
+		return []interface{}{}
+	}
+	return disks
+}
+
+func flattenArmVirtualMachineDataDisks(vm *getVirtualMachineDisksResponse) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+
+	for _, raw := range virtualMachineDataDisks(vm) {
+		disk := raw.(map[string]interface{})
+
+		flattened := map[string]interface{}{
+			"name":            "",
+			"lun":             -1,
+			"caching":         "",
+			"managed_disk_id": "",
+		}
+		if v, ok := disk["name"].(string); ok {
+			flattened["name"] = v
+		}
+		if v, ok := disk["lun"].(float64); ok {
+			flattened["lun"] = int(v)
+		}
+		if v, ok := disk["caching"].(string); ok {
+			flattened["caching"] = v
+		}
+		if managedDisk, ok := disk["managedDisk"].(map[string]interface{}); ok {
+			if v, ok := managedDisk["id"].(string); ok {
+				flattened["managed_disk_id"] = v
+			}
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result
+}
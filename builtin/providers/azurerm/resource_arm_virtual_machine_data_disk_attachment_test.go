@@ -0,0 +1,70 @@
+package azurerm
+
+import (
+	"testing"
+)
+
+func TestResourceAzureRMVirtualMachineDataDiskAttachmentCaching_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "None",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ReadOnly",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ReadWrite",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmVirtualMachineDataDiskAttachment().Schema["caching"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "caching")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Data Disk Attachment Caching %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
+func TestParseArmVirtualMachineDataDiskAttachmentID(t *testing.T) {
+	vmID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1"
+
+	cases := []struct {
+		ID       string
+		VMID     string
+		DiskName string
+	}{
+		{
+			ID:       vmID + "/dataDisks/disk1",
+			VMID:     vmID,
+			DiskName: "disk1",
+		},
+		{
+			ID:       vmID,
+			VMID:     vmID,
+			DiskName: "",
+		},
+	}
+
+	for _, tc := range cases {
+		virtualMachineID, diskName := parseArmVirtualMachineDataDiskAttachmentID(tc.ID)
+
+		if virtualMachineID != tc.VMID {
+			t.Fatalf("Expected Virtual Machine ID %q for %q, got %q", tc.VMID, tc.ID, virtualMachineID)
+		}
+		if diskName != tc.DiskName {
+			t.Fatalf("Expected disk name %q for %q, got %q", tc.DiskName, tc.ID, diskName)
+		}
+	}
+}
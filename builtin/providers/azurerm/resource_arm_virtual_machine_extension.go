@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceArmVirtualMachineExtension() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineExtensionCreate,
+		Read:   resourceArmVirtualMachineExtensionRead,
+		Update: resourceArmVirtualMachineExtensionCreate,
+		Delete: resourceArmVirtualMachineExtensionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"virtual_machine_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"publisher": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type_handler_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"auto_upgrade_minor_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"settings": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArmVirtualMachineExtensionSettings,
+				StateFunc:    normalizeJson,
+			},
+
+			// The protected settings are never returned by the API, so
+			// changes made outside of Terraform cannot be detected.
+			"protected_settings": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validateArmVirtualMachineExtensionSettings,
+				StateFunc:    normalizeJson,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualMachineExtensionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	vmExtensionClient := client.vmExtensionClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Extension creation.")
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	vmName := d.Get("virtual_machine_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	publisher := d.Get("publisher").(string)
+	extensionType := d.Get("type").(string)
+	typeHandlerVersion := d.Get("type_handler_version").(string)
+	autoUpgradeMinor := d.Get("auto_upgrade_minor_version").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := compute.VirtualMachineExtensionProperties{
+		Publisher:               &publisher,
+		Type:                    &extensionType,
+		TypeHandlerVersion:      &typeHandlerVersion,
+		AutoUpgradeMinorVersion: &autoUpgradeMinor,
+	}
+
+	if v, ok := d.GetOk("settings"); ok {
+		settings, err := expandArmVirtualMachineExtensionSettings(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing the settings of Virtual Machine Extension %q: %s", name, err)
+		}
+		properties.Settings = &settings
+	}
+
+	if v, ok := d.GetOk("protected_settings"); ok {
+		protectedSettings, err := expandArmVirtualMachineExtensionSettings(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing the protected settings of Virtual Machine Extension %q: %s", name, err)
+		}
+		properties.ProtectedSettings = &protectedSettings
+	}
+
+	extension := compute.VirtualMachineExtension{
+		Location:   &location,
+		Properties: &properties,
+		Tags:       expandTags(tags),
+	}
+
+	// A Virtual Machine can only process one extension operation at a time.
+	armMutexKV.Lock(vmName)
+	defer armMutexKV.Unlock(vmName)
+
+	_, err := vmExtensionClient.CreateOrUpdate(resGroup, vmName, name, extension, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error creating Virtual Machine Extension %q (Virtual Machine %q): %s", name, vmName, err)
+	}
+
+	read, err := vmExtensionClient.Get(resGroup, vmName, name, "")
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Machine Extension %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineExtensionRead(d, meta)
+}
+
+func resourceArmVirtualMachineExtensionRead(d *schema.ResourceData, meta interface{}) error {
+	vmExtensionClient := meta.(*ArmClient).vmExtensionClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+	name := id.Path["extensions"]
+
+	resp, err := vmExtensionClient.Get(resGroup, vmName, name, "")
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Virtual Machine Extension %q (Virtual Machine %q) not found - removing from state", name, vmName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Virtual Machine Extension %s: %s", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	d.Set("virtual_machine_name", vmName)
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.Properties; props != nil {
+		d.Set("publisher", props.Publisher)
+		d.Set("type", props.Type)
+		d.Set("type_handler_version", props.TypeHandlerVersion)
+		d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
+
+		if props.Settings != nil {
+			settings, err := flattenArmVirtualMachineExtensionSettings(*props.Settings)
+			if err != nil {
+				return fmt.Errorf("Error flattening the settings of Virtual Machine Extension %q: %s", name, err)
+			}
+			d.Set("settings", settings)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualMachineExtensionDelete(d *schema.ResourceData, meta interface{}) error {
+	vmExtensionClient := meta.(*ArmClient).vmExtensionClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+	name := id.Path["extensions"]
+
+	armMutexKV.Lock(vmName)
+	defer armMutexKV.Unlock(vmName)
+
+	_, err = vmExtensionClient.Delete(resGroup, vmName, name, make(chan struct{}))
+
+	return err
+}
+
+func expandArmVirtualMachineExtensionSettings(settings string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(settings), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func flattenArmVirtualMachineExtensionSettings(settings map[string]interface{}) (string, error) {
+	b, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func validateArmVirtualMachineExtensionSettings(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandArmVirtualMachineExtensionSettings(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmVirtualMachineScaleSet() *schema.Resource {
@@ -66,8 +68,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			},
 
 			"upgrade_policy_mode": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringInSlice([]string{string(compute.Automatic), string(compute.Manual)}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
 			"os_profile": &schema.Schema{
@@ -260,6 +264,14 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 										Elem:     &schema.Schema{Type: schema.TypeString},
 										Set:      schema.HashString,
 									},
+
+									"load_balancer_inbound_nat_pool_ids": &schema.Schema{
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
 								},
 							},
 						},
@@ -407,6 +419,32 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		return vmErr
 	}
 
+	log.Printf("[DEBUG] Waiting for Virtual Machine Scale Set (%s) to become available", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Updating", "Creating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    virtualMachineScaleSetStateRefreshFunc(client, resGroup, name),
+		Timeout:    60 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine Scale Set (%s) to become available: %s", name, err)
+	}
+
+	// A change in capacity is reported as succeeded before the instances
+	// have been added or removed, so wait for the instance count to match.
+	log.Printf("[DEBUG] Waiting for Virtual Machine Scale Set (%s) to reach a capacity of %d", name, *sku.Capacity)
+	capacityConf := &resource.StateChangeConf{
+		Pending:    []string{"Scaling"},
+		Target:     []string{"Scaled"},
+		Refresh:    virtualMachineScaleSetCapacityRefreshFunc(client, resGroup, name, *sku.Capacity),
+		Timeout:    60 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := capacityConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine Scale Set (%s) to reach a capacity of %d: %s", name, *sku.Capacity, err)
+	}
+
 	read, err := vmScaleSetClient.Get(resGroup, name)
 	if err != nil {
 		return err
@@ -440,6 +478,7 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error making Read request on Azure Virtual Machine Scale Set %s: %s", name, err)
 	}
 
+	d.Set("resource_group_name", resGroup)
 	d.Set("location", resp.Location)
 	d.Set("name", resp.Name)
 
@@ -627,6 +666,16 @@ func flattenAzureRmVirtualMachineScaleSetNetworkProfile(profile *compute.Virtual
 					}
 					config["load_balancer_backend_address_pool_ids"] = addressPools
 				}
+
+				if ipConfig.Properties.LoadBalancerInboundNatPools != nil {
+					natPools := make([]string, 0, len(*ipConfig.Properties.LoadBalancerInboundNatPools))
+					for _, pool := range *ipConfig.Properties.LoadBalancerInboundNatPools {
+						natPools = append(natPools, *pool.ID)
+					}
+					config["load_balancer_inbound_nat_pool_ids"] = natPools
+				}
+
+				ipConfigs = append(ipConfigs, config)
 			}
 
 			s["ip_configuration"] = ipConfigs
@@ -703,6 +752,40 @@ func virtualMachineScaleSetStateRefreshFunc(client *ArmClient, resourceGroupName
 	}
 }
 
+// virtualMachineScaleSetCapacityRefreshFunc reports "Scaled" once the number
+// of instances in the scale set matches the requested capacity.
+func virtualMachineScaleSetCapacityRefreshFunc(client *ArmClient, resourceGroupName string, scaleSetName string, capacity int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.vmScaleSetVMsClient.List(resourceGroupName, scaleSetName, "", "", "")
+		if err != nil {
+			return nil, "", fmt.Errorf("Error listing instances in virtualMachineScaleSetCapacityRefreshFunc to Azure ARM for Virtual Machine Scale Set '%s' (RG: '%s'): %s", scaleSetName, resourceGroupName, err)
+		}
+
+		var count int64
+		for {
+			if res.Value != nil {
+				count += int64(len(*res.Value))
+			}
+
+			if res.NextLink == nil || *res.NextLink == "" {
+				break
+			}
+
+			res, err = client.vmScaleSetVMsClient.ListNextResults(res)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error listing instances in virtualMachineScaleSetCapacityRefreshFunc to Azure ARM for Virtual Machine Scale Set '%s' (RG: '%s'): %s", scaleSetName, resourceGroupName, err)
+			}
+		}
+
+		log.Printf("[DEBUG] Virtual Machine Scale Set (%s) has %d of %d instances", scaleSetName, count, capacity)
+		if count != capacity {
+			return res, "Scaling", nil
+		}
+
+		return res, "Scaled", nil
+	}
+}
+
 func resourceArmVirtualMachineScaleSetStorageProfileImageReferenceHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -826,10 +909,30 @@ func expandAzureRmVirtualMachineScaleSetNetworkProfile(d *schema.ResourceData) *
 					},
 				},
 			}
-			//TODO: Add the support for the load balancers when it drops
-			//if v := ipconfig["load_balancer_backend_address_pool_ids"]; v != nil {
-			//
-			//}
+
+			if v := ipconfig["load_balancer_backend_address_pool_ids"]; v != nil {
+				pools := v.(*schema.Set).List()
+				resources := make([]compute.SubResource, 0, len(pools))
+				for _, p := range pools {
+					id := p.(string)
+					resources = append(resources, compute.SubResource{
+						ID: &id,
+					})
+				}
+				ipConfiguration.Properties.LoadBalancerBackendAddressPools = &resources
+			}
+
+			if v := ipconfig["load_balancer_inbound_nat_pool_ids"]; v != nil {
+				pools := v.(*schema.Set).List()
+				resources := make([]compute.SubResource, 0, len(pools))
+				for _, p := range pools {
+					id := p.(string)
+					resources = append(resources, compute.SubResource{
+						ID: &id,
+					})
+				}
+				ipConfiguration.Properties.LoadBalancerInboundNatPools = &resources
+			}
 
 			ipConfigurations = append(ipConfigurations, ipConfiguration)
 		}
@@ -10,6 +10,39 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestResourceAzureRMVirtualMachineScaleSetUpgradePolicyMode_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Random",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Manual",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Automatic",
+			ErrCount: 0,
+		},
+		{
+			Value:    "manual",
+			ErrCount: 0,
+		},
+	}
+
+	validateFunc := resourceArmVirtualMachineScaleSet().Schema["upgrade_policy_mode"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "upgrade_policy_mode")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Virtual Machine Scale Set upgrade policy mode %q to trigger %d validation errors", tc.Value, tc.ErrCount)
+		}
+	}
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_basicLinux(t *testing.T) {
 	ri := acctest.RandInt()
 	config := fmt.Sprintf(testAccAzureRMVirtualMachineScaleSet_basicLinux, ri, ri, ri, ri, ri, ri, ri, ri)
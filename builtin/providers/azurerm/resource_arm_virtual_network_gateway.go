@@ -0,0 +1,631 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmVirtualNetworkGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualNetworkGatewayCreate,
+		Read:   resourceArmVirtualNetworkGatewayRead,
+		Update: resourceArmVirtualNetworkGatewayCreate,
+		Delete: resourceArmVirtualNetworkGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(network.VirtualNetworkGatewayTypeVpn), string(network.VirtualNetworkGatewayTypeExpressRoute)}, false),
+			},
+
+			"vpn_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(network.RouteBased),
+				ValidateFunc: validation.StringInSlice([]string{string(network.RouteBased), string(network.PolicyBased)}, false),
+			},
+
+			"enable_bgp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(network.VirtualNetworkGatewaySkuNameBasic), string(network.VirtualNetworkGatewaySkuNameStandard), string(network.VirtualNetworkGatewaySkuNameHighPerformance)}, false),
+			},
+
+			"ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "vnetGatewayConfig",
+						},
+
+						"private_ip_address_allocation": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.Dynamic),
+						},
+
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArmVirtualNetworkGatewaySubnetID,
+						},
+
+						"public_ip_address_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"vpn_client_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_space": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"root_certificate": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"public_cert_data": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+							Set: hashArmVirtualNetworkGatewayRootCert,
+						},
+
+						"revoked_certificate": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"thumbprint": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+							Set: hashArmVirtualNetworkGatewayRevokedCert,
+						},
+					},
+				},
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+
+						"peering_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"peer_weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"default_local_network_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	vnetGatewayClient := client.vnetGatewayClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Network Gateway creation.")
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties, err := expandArmVirtualNetworkGatewayProperties(d)
+	if err != nil {
+		return err
+	}
+
+	gateway := network.VirtualNetworkGateway{
+		Name:       &name,
+		Location:   &location,
+		Tags:       expandTags(tags),
+		Properties: properties,
+	}
+
+	_, err = vnetGatewayClient.CreateOrUpdate(resGroup, name, gateway, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error creating Azure ARM Virtual Network Gateway %q: %s", name, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	// Provisioning a gateway can take upwards of 45 minutes, so rather than
+	// relying on the request polling alone wait for it to finish provisioning.
+	log.Printf("[DEBUG] Waiting for Virtual Network Gateway %q to become available", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Updating", "Creating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    virtualNetworkGatewayStateRefreshFunc(client, resGroup, name),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Network Gateway %q to become available: %s", name, err)
+	}
+
+	read, err := vnetGatewayClient.Get(resGroup, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Gateway %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualNetworkGatewayRead(d, meta)
+}
+
+func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	vnetGatewayClient := meta.(*ArmClient).vnetGatewayClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["virtualNetworkGateways"]
+
+	resp, err := vnetGatewayClient.Get(resGroup, name)
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Virtual Network Gateway %q not found - removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Virtual Network Gateway %s: %s", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.Properties; props != nil {
+		d.Set("type", string(props.GatewayType))
+		d.Set("enable_bgp", props.EnableBgp)
+
+		if string(props.VpnType) != "" {
+			d.Set("vpn_type", string(props.VpnType))
+		}
+
+		if props.Sku != nil {
+			d.Set("sku", string(props.Sku.Name))
+		}
+
+		if props.GatewayDefaultSite != nil && props.GatewayDefaultSite.ID != nil {
+			d.Set("default_local_network_gateway_id", props.GatewayDefaultSite.ID)
+		} else {
+			d.Set("default_local_network_gateway_id", "")
+		}
+
+		if err := d.Set("ip_configuration", flattenArmVirtualNetworkGatewayIPConfigurations(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `ip_configuration`: %s", err)
+		}
+
+		if err := d.Set("vpn_client_configuration", flattenArmVirtualNetworkGatewayVpnClientConfig(props.VpnClientConfiguration)); err != nil {
+			return fmt.Errorf("Error setting `vpn_client_configuration`: %s", err)
+		}
+
+		if err := d.Set("bgp_settings", flattenArmVirtualNetworkGatewayBgpSettings(props.BgpSettings)); err != nil {
+			return fmt.Errorf("Error setting `bgp_settings`: %s", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	vnetGatewayClient := meta.(*ArmClient).vnetGatewayClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["virtualNetworkGateways"]
+
+	// Polling the deletion stops when the cancel channel is closed.
+	cancel := make(chan struct{})
+	timer := time.AfterFunc(d.Timeout(schema.TimeoutDelete), func() {
+		close(cancel)
+	})
+	defer timer.Stop()
+
+	_, err = vnetGatewayClient.Delete(resGroup, name, cancel)
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Network Gateway %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func virtualNetworkGatewayStateRefreshFunc(client *ArmClient, resourceGroupName string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.vnetGatewayClient.Get(resourceGroupName, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error issuing read request in virtualNetworkGatewayStateRefreshFunc to Azure ARM for Virtual Network Gateway '%s' (RG: '%s'): %s", name, resourceGroupName, err)
+		}
+
+		return res, *res.Properties.ProvisioningState, nil
+	}
+}
+
+func expandArmVirtualNetworkGatewayProperties(d *schema.ResourceData) (*network.VirtualNetworkGatewayPropertiesFormat, error) {
+	gatewayType := network.VirtualNetworkGatewayType(d.Get("type").(string))
+	vpnType := network.VpnType(d.Get("vpn_type").(string))
+	enableBgp := d.Get("enable_bgp").(bool)
+	sku := d.Get("sku").(string)
+
+	props := &network.VirtualNetworkGatewayPropertiesFormat{
+		GatewayType: gatewayType,
+		VpnType:     vpnType,
+		EnableBgp:   &enableBgp,
+		Sku: &network.VirtualNetworkGatewaySku{
+			Name: network.VirtualNetworkGatewaySkuName(sku),
+			Tier: network.VirtualNetworkGatewaySkuTier(sku),
+		},
+		IPConfigurations: expandArmVirtualNetworkGatewayIPConfigurations(d),
+	}
+
+	if v, ok := d.GetOk("default_local_network_gateway_id"); ok {
+		gatewayDefaultSite := v.(string)
+		props.GatewayDefaultSite = &network.SubResource{
+			ID: &gatewayDefaultSite,
+		}
+	}
+
+	if _, ok := d.GetOk("vpn_client_configuration"); ok {
+		props.VpnClientConfiguration = expandArmVirtualNetworkGatewayVpnClientConfig(d)
+	}
+
+	if _, ok := d.GetOk("bgp_settings"); ok {
+		if !enableBgp {
+			return nil, fmt.Errorf("`bgp_settings` can only be specified when `enable_bgp` is set to `true`")
+		}
+		props.BgpSettings = expandArmVirtualNetworkGatewayBgpSettings(d)
+	}
+
+	if gatewayType == network.VirtualNetworkGatewayTypeVpn && strings.EqualFold(string(vpnType), string(network.PolicyBased)) && sku != string(network.VirtualNetworkGatewaySkuNameBasic) {
+		return nil, fmt.Errorf("A Virtual Network Gateway with a `vpn_type` of `PolicyBased` must use the `Basic` SKU")
+	}
+
+	return props, nil
+}
+
+func expandArmVirtualNetworkGatewayIPConfigurations(d *schema.ResourceData) *[]network.VirtualNetworkGatewayIPConfiguration {
+	configs := d.Get("ip_configuration").([]interface{})
+	ipConfigs := make([]network.VirtualNetworkGatewayIPConfiguration, 0, len(configs))
+
+	for _, c := range configs {
+		conf := c.(map[string]interface{})
+
+		name := conf["name"].(string)
+		subnetID := conf["subnet_id"].(string)
+		allocationMethod := network.IPAllocationMethod(conf["private_ip_address_allocation"].(string))
+
+		props := &network.VirtualNetworkGatewayIPConfigurationPropertiesFormat{
+			PrivateIPAllocationMethod: allocationMethod,
+			Subnet: &network.SubResource{
+				ID: &subnetID,
+			},
+		}
+
+		if publicIPID := conf["public_ip_address_id"].(string); publicIPID != "" {
+			props.PublicIPAddress = &network.SubResource{
+				ID: &publicIPID,
+			}
+		}
+
+		ipConfigs = append(ipConfigs, network.VirtualNetworkGatewayIPConfiguration{
+			Name:       &name,
+			Properties: props,
+		})
+	}
+
+	return &ipConfigs
+}
+
+func expandArmVirtualNetworkGatewayVpnClientConfig(d *schema.ResourceData) *network.VpnClientConfiguration {
+	configSets := d.Get("vpn_client_configuration").([]interface{})
+	conf := configSets[0].(map[string]interface{})
+
+	addresses := make([]string, 0)
+	for _, addr := range conf["address_space"].([]interface{}) {
+		addresses = append(addresses, addr.(string))
+	}
+
+	rootCerts := make([]network.VpnClientRootCertificate, 0)
+	for _, rootCertSet := range conf["root_certificate"].(*schema.Set).List() {
+		rootCert := rootCertSet.(map[string]interface{})
+		name := rootCert["name"].(string)
+		publicCertData := rootCert["public_cert_data"].(string)
+		rootCerts = append(rootCerts, network.VpnClientRootCertificate{
+			Name: &name,
+			Properties: &network.VpnClientRootCertificatePropertiesFormat{
+				PublicCertData: &publicCertData,
+			},
+		})
+	}
+
+	revokedCerts := make([]network.VpnClientRevokedCertificate, 0)
+	for _, revokedCertSet := range conf["revoked_certificate"].(*schema.Set).List() {
+		revokedCert := revokedCertSet.(map[string]interface{})
+		name := revokedCert["name"].(string)
+		thumbprint := revokedCert["thumbprint"].(string)
+		revokedCerts = append(revokedCerts, network.VpnClientRevokedCertificate{
+			Name: &name,
+			Properties: &network.VpnClientRevokedCertificatePropertiesFormat{
+				Thumbprint: &thumbprint,
+			},
+		})
+	}
+
+	return &network.VpnClientConfiguration{
+		VpnClientAddressPool: &network.AddressSpace{
+			AddressPrefixes: &addresses,
+		},
+		VpnClientRootCertificates:    &rootCerts,
+		VpnClientRevokedCertificates: &revokedCerts,
+	}
+}
+
+func expandArmVirtualNetworkGatewayBgpSettings(d *schema.ResourceData) *network.BgpSettings {
+	bgpSets := d.Get("bgp_settings").([]interface{})
+	bgp := bgpSets[0].(map[string]interface{})
+
+	settings := &network.BgpSettings{}
+
+	if v := bgp["asn"].(int); v != 0 {
+		asn := int64(v)
+		settings.Asn = &asn
+	}
+
+	if v := bgp["peering_address"].(string); v != "" {
+		settings.BgpPeeringAddress = &v
+	}
+
+	if v := bgp["peer_weight"].(int); v != 0 {
+		peerWeight := int32(v)
+		settings.PeerWeight = &peerWeight
+	}
+
+	return settings
+}
+
+func flattenArmVirtualNetworkGatewayIPConfigurations(ipConfigs *[]network.VirtualNetworkGatewayIPConfiguration) []interface{} {
+	flat := make([]interface{}, 0)
+	if ipConfigs == nil {
+		return flat
+	}
+
+	for _, cfg := range *ipConfigs {
+		v := make(map[string]interface{})
+
+		if cfg.Name != nil {
+			v["name"] = *cfg.Name
+		}
+
+		if props := cfg.Properties; props != nil {
+			v["private_ip_address_allocation"] = string(props.PrivateIPAllocationMethod)
+
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				v["subnet_id"] = *props.Subnet.ID
+			}
+
+			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+				v["public_ip_address_id"] = *props.PublicIPAddress.ID
+			}
+		}
+
+		flat = append(flat, v)
+	}
+
+	return flat
+}
+
+func flattenArmVirtualNetworkGatewayVpnClientConfig(cfg *network.VpnClientConfiguration) []interface{} {
+	if cfg == nil {
+		return []interface{}{}
+	}
+
+	flat := make(map[string]interface{})
+
+	addressSpace := make([]interface{}, 0)
+	if cfg.VpnClientAddressPool != nil && cfg.VpnClientAddressPool.AddressPrefixes != nil {
+		for _, addr := range *cfg.VpnClientAddressPool.AddressPrefixes {
+			addressSpace = append(addressSpace, addr)
+		}
+	}
+	flat["address_space"] = addressSpace
+
+	rootCerts := make([]interface{}, 0)
+	if cfg.VpnClientRootCertificates != nil {
+		for _, cert := range *cfg.VpnClientRootCertificates {
+			v := map[string]interface{}{
+				"name": *cert.Name,
+			}
+			if cert.Properties != nil && cert.Properties.PublicCertData != nil {
+				v["public_cert_data"] = *cert.Properties.PublicCertData
+			}
+			rootCerts = append(rootCerts, v)
+		}
+	}
+	flat["root_certificate"] = schema.NewSet(hashArmVirtualNetworkGatewayRootCert, rootCerts)
+
+	revokedCerts := make([]interface{}, 0)
+	if cfg.VpnClientRevokedCertificates != nil {
+		for _, cert := range *cfg.VpnClientRevokedCertificates {
+			v := map[string]interface{}{
+				"name": *cert.Name,
+			}
+			if cert.Properties != nil && cert.Properties.Thumbprint != nil {
+				v["thumbprint"] = *cert.Properties.Thumbprint
+			}
+			revokedCerts = append(revokedCerts, v)
+		}
+	}
+	flat["revoked_certificate"] = schema.NewSet(hashArmVirtualNetworkGatewayRevokedCert, revokedCerts)
+
+	return []interface{}{flat}
+}
+
+func flattenArmVirtualNetworkGatewayBgpSettings(settings *network.BgpSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	flat := make(map[string]interface{})
+
+	if settings.Asn != nil {
+		flat["asn"] = int(*settings.Asn)
+	}
+	if settings.BgpPeeringAddress != nil {
+		flat["peering_address"] = *settings.BgpPeeringAddress
+	}
+	if settings.PeerWeight != nil {
+		flat["peer_weight"] = int(*settings.PeerWeight)
+	}
+
+	return []interface{}{flat}
+}
+
+func hashArmVirtualNetworkGatewayRootCert(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["public_cert_data"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func hashArmVirtualNetworkGatewayRevokedCert(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["thumbprint"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func validateArmVirtualNetworkGatewaySubnetID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	id, err := parseAzureResourceID(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Subnet ID: %s", k, err))
+		return
+	}
+
+	// Azure requires the gateway be deployed into a subnet named GatewaySubnet.
+	if subnet, ok := id.Path["subnets"]; !ok || !strings.EqualFold(subnet, "GatewaySubnet") {
+		errors = append(errors, fmt.Errorf("%q must reference a subnet named GatewaySubnet", k))
+	}
+	return
+}
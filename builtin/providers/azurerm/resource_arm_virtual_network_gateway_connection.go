@@ -0,0 +1,320 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualNetworkGatewayConnectionCreate,
+		Read:   resourceArmVirtualNetworkGatewayConnectionRead,
+		Update: resourceArmVirtualNetworkGatewayConnectionCreate,
+		Delete: resourceArmVirtualNetworkGatewayConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(network.IPsec), string(network.Vnet2Vnet), string(network.ExpressRoute)}, false),
+			},
+
+			"virtual_network_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"local_network_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"peer_virtual_network_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"express_route_circuit_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"authorization_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"shared_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"routing_weight": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"enable_bgp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualNetworkGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	connClient := client.vnetGatewayConnectionsClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Network Gateway Connection creation.")
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties, err := expandArmVirtualNetworkGatewayConnectionProperties(d)
+	if err != nil {
+		return err
+	}
+
+	connection := network.VirtualNetworkGatewayConnection{
+		Name:       &name,
+		Location:   &location,
+		Tags:       expandTags(tags),
+		Properties: properties,
+	}
+
+	_, err = connClient.CreateOrUpdate(resGroup, name, connection, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error creating Azure ARM Virtual Network Gateway Connection %q: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Virtual Network Gateway Connection %q to become available", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Updating", "Creating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    virtualNetworkGatewayConnectionStateRefreshFunc(client, resGroup, name),
+		Timeout:    60 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Network Gateway Connection %q to become available: %s", name, err)
+	}
+
+	read, err := connClient.Get(resGroup, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Gateway Connection %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualNetworkGatewayConnectionRead(d, meta)
+}
+
+func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	connClient := meta.(*ArmClient).vnetGatewayConnectionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["connections"]
+
+	resp, err := connClient.Get(resGroup, name)
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] Virtual Network Gateway Connection %q not found - removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Virtual Network Gateway Connection %s: %s", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+
+	if props := resp.Properties; props != nil {
+		d.Set("type", string(props.ConnectionType))
+
+		if props.VirtualNetworkGateway1 != nil {
+			d.Set("virtual_network_gateway_id", props.VirtualNetworkGateway1.ID)
+		}
+
+		if props.LocalNetworkGateway2 != nil {
+			d.Set("local_network_gateway_id", props.LocalNetworkGateway2.ID)
+		}
+
+		if props.VirtualNetworkGateway2 != nil {
+			d.Set("peer_virtual_network_gateway_id", props.VirtualNetworkGateway2.ID)
+		}
+
+		if props.Peer != nil {
+			d.Set("express_route_circuit_id", props.Peer.ID)
+		}
+
+		if props.RoutingWeight != nil {
+			d.Set("routing_weight", int(*props.RoutingWeight))
+		}
+
+		if props.EnableBgp != nil {
+			d.Set("enable_bgp", *props.EnableBgp)
+		}
+	}
+
+	// The shared key isn't returned with the connection, so it's read
+	// separately for connections which use one.
+	if _, ok := d.GetOk("shared_key"); ok {
+		sharedKey, err := connClient.GetSharedKey(resGroup, name)
+		if err != nil && sharedKey.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Error retrieving the Shared Key for Virtual Network Gateway Connection %q: %s", name, err)
+		}
+		if sharedKey.Value != nil {
+			d.Set("shared_key", sharedKey.Value)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualNetworkGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	connClient := meta.(*ArmClient).vnetGatewayConnectionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["connections"]
+
+	_, err = connClient.Delete(resGroup, name, make(chan struct{}))
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Network Gateway Connection %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func virtualNetworkGatewayConnectionStateRefreshFunc(client *ArmClient, resourceGroupName string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.vnetGatewayConnectionsClient.Get(resourceGroupName, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error issuing read request in virtualNetworkGatewayConnectionStateRefreshFunc to Azure ARM for Virtual Network Gateway Connection '%s' (RG: '%s'): %s", name, resourceGroupName, err)
+		}
+
+		return res, *res.Properties.ProvisioningState, nil
+	}
+}
+
+func expandArmVirtualNetworkGatewayConnectionProperties(d *schema.ResourceData) (*network.VirtualNetworkGatewayConnectionPropertiesFormat, error) {
+	connectionType := network.VirtualNetworkGatewayConnectionType(d.Get("type").(string))
+	virtualNetworkGatewayID := d.Get("virtual_network_gateway_id").(string)
+
+	props := &network.VirtualNetworkGatewayConnectionPropertiesFormat{
+		ConnectionType: connectionType,
+		VirtualNetworkGateway1: &network.VirtualNetworkGateway{
+			ID: &virtualNetworkGatewayID,
+		},
+	}
+
+	switch connectionType {
+	case network.IPsec:
+		v, ok := d.GetOk("local_network_gateway_id")
+		if !ok {
+			return nil, fmt.Errorf("`local_network_gateway_id` must be specified when `type` is `IPsec`")
+		}
+		localNetworkGatewayID := v.(string)
+		props.LocalNetworkGateway2 = &network.LocalNetworkGateway{
+			ID: &localNetworkGatewayID,
+		}
+
+	case network.Vnet2Vnet:
+		v, ok := d.GetOk("peer_virtual_network_gateway_id")
+		if !ok {
+			return nil, fmt.Errorf("`peer_virtual_network_gateway_id` must be specified when `type` is `Vnet2Vnet`")
+		}
+		peerGatewayID := v.(string)
+		props.VirtualNetworkGateway2 = &network.VirtualNetworkGateway{
+			ID: &peerGatewayID,
+		}
+
+	case network.ExpressRoute:
+		v, ok := d.GetOk("express_route_circuit_id")
+		if !ok {
+			return nil, fmt.Errorf("`express_route_circuit_id` must be specified when `type` is `ExpressRoute`")
+		}
+		circuitID := v.(string)
+		props.Peer = &network.SubResource{
+			ID: &circuitID,
+		}
+	}
+
+	if v, ok := d.GetOk("authorization_key"); ok {
+		authorizationKey := v.(string)
+		props.AuthorizationKey = &authorizationKey
+	}
+
+	if v, ok := d.GetOk("shared_key"); ok {
+		sharedKey := v.(string)
+		props.SharedKey = &sharedKey
+	}
+
+	if v, ok := d.GetOk("routing_weight"); ok {
+		routingWeight := int32(v.(int))
+		props.RoutingWeight = &routingWeight
+	}
+
+	if v, ok := d.GetOk("enable_bgp"); ok {
+		enableBgp := v.(bool)
+		props.EnableBgp = &enableBgp
+	}
+
+	return props, nil
+}
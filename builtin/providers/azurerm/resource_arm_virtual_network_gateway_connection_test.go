@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMVirtualNetworkGatewayConnectionType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "IPsec", ErrCount: 0},
+		{Value: "Vnet2Vnet", ErrCount: 0},
+		{Value: "ExpressRoute", ErrCount: 0},
+		{Value: "VPNClient", ErrCount: 1},
+		{Value: "ipsec", ErrCount: 1},
+	}
+
+	validateFunc := resourceArmVirtualNetworkGatewayConnection().Schema["type"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Virtual Network Gateway Connection type to trigger a validation error for %q", tc.Value)
+		}
+	}
+}
+
+func TestAccAzureRMVirtualNetworkGatewayConnection_siteToSite(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMVirtualNetworkGatewayConnection_siteToSite, ri, ri, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayConnectionExists("azurerm_virtual_network_gateway_connection.test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualNetworkGatewayConnectionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		connectionName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Virtual Network Gateway Connection: %s", connectionName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).vnetGatewayConnectionsClient
+
+		resp, err := conn.Get(resourceGroup, connectionName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on vnetGatewayConnectionsClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Virtual Network Gateway Connection %q (resource group: %q) does not exist", connectionName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualNetworkGatewayConnectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).vnetGatewayConnectionsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_network_gateway_connection" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Virtual Network Gateway Connection still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMVirtualNetworkGatewayConnection_siteToSite = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctestvn-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    address_space = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+    name = "GatewaySubnet"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+    name = "acctestpip-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    public_ip_address_allocation = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+    name = "acctestvng-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    type = "Vpn"
+    vpn_type = "RouteBased"
+    sku = "Basic"
+
+    ip_configuration {
+        public_ip_address_id = "${azurerm_public_ip.test.id}"
+        private_ip_address_allocation = "Dynamic"
+        subnet_id = "${azurerm_subnet.test.id}"
+    }
+}
+
+resource "azurerm_local_network_gateway" "test" {
+    name = "acctestlng-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    gateway_address = "168.62.225.23"
+    address_space = ["10.1.1.0/24"]
+}
+
+resource "azurerm_virtual_network_gateway_connection" "test" {
+    name = "acctestvngc-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    type = "IPsec"
+    virtual_network_gateway_id = "${azurerm_virtual_network_gateway.test.id}"
+    local_network_gateway_id = "${azurerm_local_network_gateway.test.id}"
+
+    shared_key = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+}
+`
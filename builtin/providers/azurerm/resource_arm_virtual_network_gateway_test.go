@@ -0,0 +1,257 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAzureRMVirtualNetworkGatewaySku_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "Basic", ErrCount: 0},
+		{Value: "Standard", ErrCount: 0},
+		{Value: "HighPerformance", ErrCount: 0},
+		{Value: "UltraPerformance", ErrCount: 1},
+		{Value: "basic", ErrCount: 1},
+	}
+
+	validateFunc := resourceArmVirtualNetworkGateway().Schema["sku"].ValidateFunc
+	for _, tc := range cases {
+		_, errors := validateFunc(tc.Value, "sku")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Virtual Network Gateway sku to trigger a validation error for %q", tc.Value)
+		}
+	}
+}
+
+func TestResourceAzureRMVirtualNetworkGatewaySubnetID_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/GatewaySubnet",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/gatewaysubnet",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "GatewaySubnet",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmVirtualNetworkGatewaySubnetID(tc.Value, "subnet_id")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Virtual Network Gateway subnet_id to trigger a validation error for %q", tc.Value)
+		}
+	}
+}
+
+func TestAccAzureRMVirtualNetworkGateway_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMVirtualNetworkGateway_basic, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists("azurerm_virtual_network_gateway.test"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_gateway.test", "sku", "Basic"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkGateway_bgp(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMVirtualNetworkGateway_bgp, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists("azurerm_virtual_network_gateway.test"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_gateway.test", "enable_bgp", "true"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_gateway.test", "bgp_settings.0.asn", "65010"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualNetworkGatewayExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		gatewayName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Virtual Network Gateway: %s", gatewayName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).vnetGatewayClient
+
+		resp, err := conn.Get(resourceGroup, gatewayName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on vnetGatewayClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Virtual Network Gateway %q (resource group: %q) does not exist", gatewayName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualNetworkGatewayDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).vnetGatewayClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_network_gateway" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Virtual Network Gateway still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMVirtualNetworkGateway_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctestvn-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    address_space = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+    name = "GatewaySubnet"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+    name = "acctestpip-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    public_ip_address_allocation = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+    name = "acctestvng-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    type = "Vpn"
+    vpn_type = "RouteBased"
+    sku = "Basic"
+
+    ip_configuration {
+        public_ip_address_id = "${azurerm_public_ip.test.id}"
+        private_ip_address_allocation = "Dynamic"
+        subnet_id = "${azurerm_subnet.test.id}"
+    }
+}
+`
+
+var testAccAzureRMVirtualNetworkGateway_bgp = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctestvn-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    address_space = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+    name = "GatewaySubnet"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+    name = "acctestpip-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    public_ip_address_allocation = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+    name = "acctestvng-%d"
+    location = "West US"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+
+    type = "Vpn"
+    vpn_type = "RouteBased"
+    sku = "Standard"
+    enable_bgp = true
+
+    ip_configuration {
+        public_ip_address_id = "${azurerm_public_ip.test.id}"
+        private_ip_address_allocation = "Dynamic"
+        subnet_id = "${azurerm_subnet.test.id}"
+    }
+
+    bgp_settings {
+        asn = 65010
+        peer_weight = 10
+    }
+}
+`
@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+// virtualNetworkPeeringMutexKey serialises changes to peerings. A peering
+// and its counterpart in the remote network can't be modified at the same
+// time, and the two directions are managed as separate resources.
+const virtualNetworkPeeringMutexKey = "azurerm_virtual_network_peering"
+
+func resourceArmVirtualNetworkPeering() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualNetworkPeeringCreate,
+		Read:   resourceArmVirtualNetworkPeeringRead,
+		Update: resourceArmVirtualNetworkPeeringCreate,
+		Delete: resourceArmVirtualNetworkPeeringDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"virtual_network_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"remote_virtual_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"allow_virtual_network_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allow_forwarded_traffic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_gateway_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"use_remote_gateways": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"peering_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Network Peering creation.")
+
+	name := d.Get("name").(string)
+	vnetName := d.Get("virtual_network_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	if d.Get("allow_gateway_transit").(bool) && d.Get("use_remote_gateways").(bool) {
+		return fmt.Errorf("`allow_gateway_transit` and `use_remote_gateways` cannot both be enabled on Virtual Network Peering %q", name)
+	}
+
+	armMutexKV.Lock(virtualNetworkPeeringMutexKey)
+	defer armMutexKV.Unlock(virtualNetworkPeeringMutexKey)
+
+	command := &createOrUpdateVirtualNetworkPeering{
+		Name:               name,
+		ResourceGroupName:  resGroup,
+		VirtualNetworkName: vnetName,
+		RemoteVirtualNetwork: &virtualNetworkPeeringRemoteNetwork{
+			ID: azure.String(d.Get("remote_virtual_network_id").(string)),
+		},
+		AllowVirtualNetworkAccess: azure.Bool(d.Get("allow_virtual_network_access").(bool)),
+		AllowForwardedTraffic:     azure.Bool(d.Get("allow_forwarded_traffic").(bool)),
+		AllowGatewayTransit:       azure.Bool(d.Get("allow_gateway_transit").(bool)),
+		UseRemoteGateways:         azure.Bool(d.Get("use_remote_gateways").(bool)),
+	}
+
+	// The remote network may still be busy with a change to its own side of
+	// the peering, which Azure rejects with a retryable error.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		createRequest := rivieraClient.NewRequest()
+		createRequest.Command = command
+
+		createResponse, err := createRequest.Execute()
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error creating Virtual Network Peering %q: %s", name, err))
+		}
+		if !createResponse.IsSuccessful() {
+			if isRetryableVirtualNetworkPeeringError(createResponse.Error) {
+				return resource.RetryableError(fmt.Errorf("Error creating Virtual Network Peering %q: %s", name, createResponse.Error))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error creating Virtual Network Peering %q: %s", name, createResponse.Error))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getVirtualNetworkPeering{
+		Name:               name,
+		ResourceGroupName:  resGroup,
+		VirtualNetworkName: vnetName,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading Virtual Network Peering %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading Virtual Network Peering %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getVirtualNetworkPeeringResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Peering %s/%s (resource group %s) ID", vnetName, name, resGroup)
+	}
+
+	log.Printf("[DEBUG] Waiting for Virtual Network Peering %q to finish provisioning", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Updating", "Creating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    azureStateRefreshFunc(*resp.ID, client, &getVirtualNetworkPeering{}),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Network Peering %q to finish provisioning: %s", name, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmVirtualNetworkPeeringRead(d, meta)
+}
+
+func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	name := id.Path["virtualNetworkPeerings"]
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getVirtualNetworkPeering{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Virtual Network Peering %s: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Virtual Network Peering %q not found - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Virtual Network Peering %s: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getVirtualNetworkPeeringResponse)
+
+	// A peering remains "Initiated" until its counterpart in the remote
+	// network has been created, which is expected whilst both directions
+	// are being applied, so it isn't treated as an error.
+	if resp.PeeringState != nil && *resp.PeeringState != "Connected" {
+		log.Printf("[DEBUG] Virtual Network Peering %q is in the %q state", name, *resp.PeeringState)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("virtual_network_name", vnetName)
+	d.Set("allow_virtual_network_access", resp.AllowVirtualNetworkAccess)
+	d.Set("allow_forwarded_traffic", resp.AllowForwardedTraffic)
+	d.Set("allow_gateway_transit", resp.AllowGatewayTransit)
+	d.Set("use_remote_gateways", resp.UseRemoteGateways)
+	d.Set("peering_state", resp.PeeringState)
+
+	if resp.RemoteVirtualNetwork != nil {
+		d.Set("remote_virtual_network_id", resp.RemoteVirtualNetwork.ID)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	rivieraClient := client.rivieraClient
+
+	armMutexKV.Lock(virtualNetworkPeeringMutexKey)
+	defer armMutexKV.Unlock(virtualNetworkPeeringMutexKey)
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteVirtualNetworkPeering{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Network Peering %s: %s", d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting Virtual Network Peering %s: %s", d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+// isRetryableVirtualNetworkPeeringError returns whether Azure rejected a
+// change to a peering because a change to the remote network is in progress.
+func isRetryableVirtualNetworkPeeringError(err *azure.Error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch err.ErrorCode {
+	case "AnotherOperationInProgress", "ReferencedResourceNotProvisioned", "RetryableError":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,211 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/jen20/riviera/azure"
+)
+
+func TestResourceAzureRMVirtualNetworkPeering_retryableError(t *testing.T) {
+	cases := []struct {
+		Error     *azure.Error
+		Retryable bool
+	}{
+		{Error: nil, Retryable: false},
+		{Error: &azure.Error{StatusCode: 400, ErrorCode: "InvalidRequestFormat"}, Retryable: false},
+		{Error: &azure.Error{StatusCode: 409, ErrorCode: "AnotherOperationInProgress"}, Retryable: true},
+		{Error: &azure.Error{StatusCode: 400, ErrorCode: "ReferencedResourceNotProvisioned"}, Retryable: true},
+		{Error: &azure.Error{StatusCode: 429, ErrorCode: "RetryableError"}, Retryable: true},
+	}
+
+	for _, tc := range cases {
+		if v := isRetryableVirtualNetworkPeeringError(tc.Error); v != tc.Retryable {
+			t.Fatalf("Expected %#v to be retryable: %t, got %t", tc.Error, tc.Retryable, v)
+		}
+	}
+}
+
+func TestAccAzureRMVirtualNetworkPeering_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(testAccAzureRMVirtualNetworkPeering_basic, ri, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkPeeringExists("azurerm_virtual_network_peering.test1"),
+					testCheckAzureRMVirtualNetworkPeeringExists("azurerm_virtual_network_peering.test2"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_peering.test1", "allow_virtual_network_access", "true"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_peering.test2", "allow_virtual_network_access", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkPeering_update(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAzureRMVirtualNetworkPeering_basic, ri, ri, ri, ri, ri)
+	postConfig := fmt.Sprintf(testAccAzureRMVirtualNetworkPeering_basicUpdate, ri, ri, ri, ri, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkPeeringExists("azurerm_virtual_network_peering.test1"),
+					testCheckAzureRMVirtualNetworkPeeringExists("azurerm_virtual_network_peering.test2"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_peering.test1", "allow_forwarded_traffic", "false"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_peering.test2", "allow_forwarded_traffic", "false"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkPeeringExists("azurerm_virtual_network_peering.test1"),
+					testCheckAzureRMVirtualNetworkPeeringExists("azurerm_virtual_network_peering.test2"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_peering.test1", "allow_forwarded_traffic", "true"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_peering.test2", "allow_forwarded_traffic", "true"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network_peering.test1", "peering_state", "Connected"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualNetworkPeeringExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+		readRequest := conn.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getVirtualNetworkPeering{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: GetVirtualNetworkPeering: %s", err)
+		}
+		if !readResponse.IsSuccessful() {
+			return fmt.Errorf("Bad: GetVirtualNetworkPeering: %s", readResponse.Error)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualNetworkPeeringDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).rivieraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_network_peering" {
+			continue
+		}
+
+		readRequest := conn.NewRequestForURI(rs.Primary.ID)
+		readRequest.Command = &getVirtualNetworkPeering{}
+
+		readResponse, err := readRequest.Execute()
+		if err != nil {
+			return fmt.Errorf("Bad: GetVirtualNetworkPeering: %s", err)
+		}
+
+		if readResponse.IsSuccessful() || readResponse.HTTP.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Bad: Virtual Network Peering still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccAzureRMVirtualNetworkPeering_basic = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_network" "test1" {
+    name = "acctestvirtnet-1-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    address_space = ["10.0.1.0/24"]
+    location = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_network" "test2" {
+    name = "acctestvirtnet-2-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    address_space = ["10.0.2.0/24"]
+    location = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_network_peering" "test1" {
+    name = "acctestpeer-1-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test1.name}"
+    remote_virtual_network_id = "${azurerm_virtual_network.test2.id}"
+    allow_virtual_network_access = true
+}
+
+resource "azurerm_virtual_network_peering" "test2" {
+    name = "acctestpeer-2-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test2.name}"
+    remote_virtual_network_id = "${azurerm_virtual_network.test1.id}"
+    allow_virtual_network_access = true
+}
+`
+
+var testAccAzureRMVirtualNetworkPeering_basicUpdate = `
+resource "azurerm_resource_group" "test" {
+    name = "acctestrg-%d"
+    location = "West US"
+}
+
+resource "azurerm_virtual_network" "test1" {
+    name = "acctestvirtnet-1-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    address_space = ["10.0.1.0/24"]
+    location = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_network" "test2" {
+    name = "acctestvirtnet-2-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    address_space = ["10.0.2.0/24"]
+    location = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_network_peering" "test1" {
+    name = "acctestpeer-1-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test1.name}"
+    remote_virtual_network_id = "${azurerm_virtual_network.test2.id}"
+    allow_forwarded_traffic = true
+    allow_virtual_network_access = true
+}
+
+resource "azurerm_virtual_network_peering" "test2" {
+    name = "acctestpeer-2-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test2.name}"
+    remote_virtual_network_id = "${azurerm_virtual_network.test1.id}"
+    allow_forwarded_traffic = true
+    allow_virtual_network_access = true
+}
+`
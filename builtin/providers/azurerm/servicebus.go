@@ -0,0 +1,346 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Queues, topics and subscriptions are all entities beneath a ServiceBus
+// namespace, and namespaces, queues and topics can all hold Shared Access
+// Signature authorization rules, so the requests which are common to them
+// are made here.
+
+// serviceBusIDSegment returns the value of the given segment of a ServiceBus
+// resource ID. The API is inconsistent in the case it uses for segments such
+// as AuthorizationRules, so they are matched case-insensitively.
+func serviceBusIDSegment(id *ResourceID, key string) string {
+	for k, v := range id.Path {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
+
+// createArmServiceBusEntity executes the create command for a queue, topic
+// or subscription and sets the ID of the resource to that of the entity.
+func createArmServiceBusEntity(d *schema.ResourceData, meta interface{}, entityType string, command *createOrUpdateServiceBusEntity) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = command
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating ServiceBus %s %q: %s", entityType, command.EntityPath, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating ServiceBus %s %q: %s", entityType, command.EntityPath, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getServiceBusEntity{
+		ResourceGroupName: command.ResourceGroupName,
+		NamespaceName:     command.NamespaceName,
+		EntityPath:        command.EntityPath,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading ServiceBus %s %q: %s", entityType, command.EntityPath, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading ServiceBus %s %q: %s", entityType, command.EntityPath, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getServiceBusEntityResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus %s %s (resource group %s) ID", entityType, command.EntityPath, command.ResourceGroupName)
+	}
+
+	d.SetId(*resp.ID)
+
+	return nil
+}
+
+// readArmServiceBusEntity reads the queue, topic or subscription identified
+// by the resource ID, setting the attributes common to every entity. If the
+// entity no longer exists the resource is removed from state and nil is
+// returned.
+func readArmServiceBusEntity(d *schema.ResourceData, meta interface{}, entityType string) (*getServiceBusEntityResponse, *ResourceID, error) {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getServiceBusEntity{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error making Read request on Azure ServiceBus %s %s: %s", entityType, d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] ServiceBus %s %q not found - removing from state", entityType, d.Id())
+			d.SetId("")
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("Error making Read request on Azure ServiceBus %s %s: %s", entityType, d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getServiceBusEntityResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("namespace_name", id.Path["namespaces"])
+	if resp.Location != nil {
+		d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	}
+
+	return resp, id, nil
+}
+
+// deleteArmServiceBusResource deletes the ServiceBus resource identified by
+// the resource ID.
+func deleteArmServiceBusResource(d *schema.ResourceData, meta interface{}, resourceType string) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	deleteRequest := rivieraClient.NewRequestForURI(d.Id())
+	deleteRequest.Command = &deleteServiceBusResource{}
+
+	deleteResponse, err := deleteRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error deleting ServiceBus %s %s: %s", resourceType, d.Id(), err)
+	}
+	if !deleteResponse.IsSuccessful() && deleteResponse.HTTP.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("Error deleting ServiceBus %s %s: %s", resourceType, d.Id(), deleteResponse.Error)
+	}
+
+	return nil
+}
+
+// serviceBusAuthorizationRuleSchema returns the schema common to the
+// authorization rule resources, which additionally identify their parent.
+func serviceBusAuthorizationRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"namespace_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"resource_group_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"listen": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"send": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"manage": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"primary_key": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"primary_connection_string": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_connection_string": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func expandServiceBusAuthorizationRuleRights(d *schema.ResourceData) ([]string, error) {
+	rights := make([]string, 0)
+
+	listen := d.Get("listen").(bool)
+	send := d.Get("send").(bool)
+	manage := d.Get("manage").(bool)
+
+	if manage && !(listen && send) {
+		return nil, fmt.Errorf("[ERROR] listen and send must both be enabled when manage is enabled")
+	}
+	if !listen && !send && !manage {
+		return nil, fmt.Errorf("[ERROR] at least one of listen, send or manage must be enabled")
+	}
+
+	if listen {
+		rights = append(rights, "Listen")
+	}
+	if send {
+		rights = append(rights, "Send")
+	}
+	if manage {
+		rights = append(rights, "Manage")
+	}
+
+	return rights, nil
+}
+
+// flattenServiceBusAuthorizationRuleRights sets the listen, send and manage
+// attributes from the rights granted by an authorization rule.
+func flattenServiceBusAuthorizationRuleRights(d *schema.ResourceData, rights []string) {
+	listen, send, manage := false, false, false
+	for _, right := range rights {
+		switch strings.ToLower(right) {
+		case "listen":
+			listen = true
+		case "send":
+			send = true
+		case "manage":
+			manage = true
+		}
+	}
+	d.Set("listen", listen)
+	d.Set("send", send)
+	d.Set("manage", manage)
+}
+
+// createArmServiceBusAuthorizationRule creates the authorization rule on the
+// namespace, queue or topic at parentPath and sets the ID of the resource to
+// that of the new rule.
+func createArmServiceBusAuthorizationRule(d *schema.ResourceData, meta interface{}, parentPath string) error {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	name := d.Get("name").(string)
+
+	rights, err := expandServiceBusAuthorizationRuleRights(d)
+	if err != nil {
+		return err
+	}
+
+	createRequest := rivieraClient.NewRequest()
+	createRequest.Command = &createOrUpdateServiceBusAuthorizationRule{
+		Name:       name,
+		ParentPath: parentPath,
+		Rights:     rights,
+	}
+
+	createResponse, err := createRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error creating ServiceBus Authorization Rule %q: %s", name, err)
+	}
+	if !createResponse.IsSuccessful() {
+		return fmt.Errorf("Error creating ServiceBus Authorization Rule %q: %s", name, createResponse.Error)
+	}
+
+	readRequest := rivieraClient.NewRequest()
+	readRequest.Command = &getServiceBusAuthorizationRule{
+		Name:       name,
+		ParentPath: parentPath,
+	}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return fmt.Errorf("Error reading ServiceBus Authorization Rule %q: %s", name, err)
+	}
+	if !readResponse.IsSuccessful() {
+		return fmt.Errorf("Error reading ServiceBus Authorization Rule %q: %s", name, readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getServiceBusAuthorizationRuleResponse)
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus Authorization Rule %s ID", name)
+	}
+
+	d.SetId(*resp.ID)
+
+	return nil
+}
+
+// readArmServiceBusAuthorizationRule reads the authorization rule identified
+// by the resource ID along with its keys. If the rule no longer exists the
+// resource is removed from state and nil is returned.
+func readArmServiceBusAuthorizationRule(d *schema.ResourceData, meta interface{}) (*ResourceID, error) {
+	rivieraClient := meta.(*ArmClient).rivieraClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	readRequest := rivieraClient.NewRequestForURI(d.Id())
+	readRequest.Command = &getServiceBusAuthorizationRule{}
+
+	readResponse, err := readRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Error making Read request on Azure ServiceBus Authorization Rule %s: %s", d.Id(), err)
+	}
+	if !readResponse.IsSuccessful() {
+		if readResponse.HTTP.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] ServiceBus Authorization Rule %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error making Read request on Azure ServiceBus Authorization Rule %s: %s", d.Id(), readResponse.Error)
+	}
+
+	resp := readResponse.Parsed.(*getServiceBusAuthorizationRuleResponse)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("namespace_name", id.Path["namespaces"])
+
+	flattenServiceBusAuthorizationRuleRights(d, resp.Rights)
+
+	keysRequest := rivieraClient.NewRequestForURI(fmt.Sprintf("%s/listKeys", d.Id()))
+	keysRequest.Command = &listServiceBusAuthorizationRuleKeys{}
+
+	keysResponse, err := keysRequest.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("Error listing keys for ServiceBus Authorization Rule %s: %s", d.Id(), err)
+	}
+	if !keysResponse.IsSuccessful() {
+		return nil, fmt.Errorf("Error listing keys for ServiceBus Authorization Rule %s: %s", d.Id(), keysResponse.Error)
+	}
+
+	keys := keysResponse.Parsed.(*listServiceBusAuthorizationRuleKeysResponse)
+	d.Set("primary_key", keys.PrimaryKey)
+	d.Set("primary_connection_string", keys.PrimaryConnectionString)
+	d.Set("secondary_key", keys.SecondaryKey)
+	d.Set("secondary_connection_string", keys.SecondaryConnectionString)
+
+	return id, nil
+}
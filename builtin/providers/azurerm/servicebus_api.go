@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/jen20/riviera/azure"
+)
+
+// The vendored Azure SDK has no client for ServiceBus, so the ARM requests
+// used by the ServiceBus resources are described here for use with the
+// Riviera client.
+
+const serviceBusAPIVersion = "2015-08-01"
+
+func serviceBusNamespaceURLPath(resourceGroupName, namespaceName string) string {
+	return fmt.Sprintf("resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s", resourceGroupName, namespaceName)
+}
+
+type serviceBusSku struct {
+	Name     *string `json:"name" mapstructure:"name"`
+	Tier     *string `json:"tier" mapstructure:"tier"`
+	Capacity *int32  `json:"capacity,omitempty" mapstructure:"capacity"`
+}
+
+type getServiceBusNamespaceResponse struct {
+	ID                 *string             `mapstructure:"id"`
+	Name               *string             `mapstructure:"name"`
+	Location           *string             `mapstructure:"location"`
+	Tags               *map[string]*string `mapstructure:"tags"`
+	Sku                *serviceBusSku      `mapstructure:"sku"`
+	ProvisioningState  *string             `mapstructure:"provisioningState"`
+	ServiceBusEndpoint *string             `mapstructure:"serviceBusEndpoint"`
+}
+
+type createOrUpdateServiceBusNamespace struct {
+	Name              string             `json:"-"`
+	ResourceGroupName string             `json:"-"`
+	Location          string             `json:"-" riviera:"location"`
+	Tags              map[string]*string `json:"-" riviera:"tags"`
+	Sku               *serviceBusSku     `json:"-" riviera:"sku"`
+}
+
+func (command createOrUpdateServiceBusNamespace) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: serviceBusAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return serviceBusNamespaceURLPath(command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getServiceBusNamespaceResponse{}
+		},
+	}
+}
+
+type getServiceBusNamespace struct {
+	Name              string `json:"-"`
+	ResourceGroupName string `json:"-"`
+}
+
+func (command getServiceBusNamespace) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: serviceBusAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return serviceBusNamespaceURLPath(command.ResourceGroupName, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getServiceBusNamespaceResponse{}
+		},
+	}
+}
+
+// serviceBusEntityProperties holds the properties of queues, topics and
+// subscriptions. Each entity only accepts a subset of them, so they are all
+// omitted when unset.
+type serviceBusEntityProperties struct {
+	AutoDeleteOnIdle                          *string `json:"autoDeleteOnIdle,omitempty" mapstructure:"autoDeleteOnIdle"`
+	DefaultMessageTimeToLive                  *string `json:"defaultMessageTimeToLive,omitempty" mapstructure:"defaultMessageTimeToLive"`
+	DuplicateDetectionHistoryTimeWindow       *string `json:"duplicateDetectionHistoryTimeWindow,omitempty" mapstructure:"duplicateDetectionHistoryTimeWindow"`
+	LockDuration                              *string `json:"lockDuration,omitempty" mapstructure:"lockDuration"`
+	MaxSizeInMegabytes                        *int64  `json:"maxSizeInMegabytes,omitempty" mapstructure:"maxSizeInMegabytes"`
+	MaxDeliveryCount                          *int32  `json:"maxDeliveryCount,omitempty" mapstructure:"maxDeliveryCount"`
+	DeadLetteringOnMessageExpiration          *bool   `json:"deadLetteringOnMessageExpiration,omitempty" mapstructure:"deadLetteringOnMessageExpiration"`
+	DeadLetteringOnFilterEvaluationExceptions *bool   `json:"deadLetteringOnFilterEvaluationExceptions,omitempty" mapstructure:"deadLetteringOnFilterEvaluationExceptions"`
+	EnableBatchedOperations                   *bool   `json:"enableBatchedOperations,omitempty" mapstructure:"enableBatchedOperations"`
+	EnableExpress                             *bool   `json:"enableExpress,omitempty" mapstructure:"enableExpress"`
+	EnablePartitioning                        *bool   `json:"enablePartitioning,omitempty" mapstructure:"enablePartitioning"`
+	RequiresDuplicateDetection                *bool   `json:"requiresDuplicateDetection,omitempty" mapstructure:"requiresDuplicateDetection"`
+	RequiresSession                           *bool   `json:"requiresSession,omitempty" mapstructure:"requiresSession"`
+	SupportOrdering                           *bool   `json:"supportOrdering,omitempty" mapstructure:"supportOrdering"`
+}
+
+type getServiceBusEntityResponse struct {
+	ID                         *string `mapstructure:"id"`
+	Name                       *string `mapstructure:"name"`
+	Location                   *string `mapstructure:"location"`
+	Status                     *string `mapstructure:"status"`
+	serviceBusEntityProperties `mapstructure:",squash"`
+}
+
+// createOrUpdateServiceBusEntity creates or updates the queue, topic or
+// subscription at the given path beneath a namespace.
+type createOrUpdateServiceBusEntity struct {
+	ResourceGroupName string `json:"-"`
+	NamespaceName     string `json:"-"`
+	EntityPath        string `json:"-"`
+	Location          string `json:"-" riviera:"location"`
+	serviceBusEntityProperties
+}
+
+func (command createOrUpdateServiceBusEntity) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: serviceBusAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/%s", serviceBusNamespaceURLPath(command.ResourceGroupName, command.NamespaceName), command.EntityPath)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getServiceBusEntityResponse{}
+		},
+	}
+}
+
+type getServiceBusEntity struct {
+	ResourceGroupName string `json:"-"`
+	NamespaceName     string `json:"-"`
+	EntityPath        string `json:"-"`
+}
+
+func (command getServiceBusEntity) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: serviceBusAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/%s", serviceBusNamespaceURLPath(command.ResourceGroupName, command.NamespaceName), command.EntityPath)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getServiceBusEntityResponse{}
+		},
+	}
+}
+
+// deleteServiceBusResource deletes any ServiceBus resource, and is used with
+// a request for the resource's URI.
+type deleteServiceBusResource struct{}
+
+func (command deleteServiceBusResource) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: serviceBusAPIVersion,
+		Method:     "DELETE",
+		ResponseTypeFunc: func() interface{} {
+			return nil
+		},
+	}
+}
+
+type getServiceBusAuthorizationRuleResponse struct {
+	ID     *string  `mapstructure:"id"`
+	Name   *string  `mapstructure:"name"`
+	Rights []string `mapstructure:"rights"`
+}
+
+// createOrUpdateServiceBusAuthorizationRule creates or updates a Shared
+// Access Signature rule on the namespace, queue or topic at ParentPath.
+type createOrUpdateServiceBusAuthorizationRule struct {
+	Name       string   `json:"-"`
+	ParentPath string   `json:"-"`
+	Rights     []string `json:"rights"`
+}
+
+func (command createOrUpdateServiceBusAuthorizationRule) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: serviceBusAPIVersion,
+		Method:     "PUT",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/AuthorizationRules/%s", command.ParentPath, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getServiceBusAuthorizationRuleResponse{}
+		},
+	}
+}
+
+type getServiceBusAuthorizationRule struct {
+	Name       string `json:"-"`
+	ParentPath string `json:"-"`
+}
+
+func (command getServiceBusAuthorizationRule) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: serviceBusAPIVersion,
+		Method:     "GET",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/AuthorizationRules/%s", command.ParentPath, command.Name)
+		},
+		ResponseTypeFunc: func() interface{} {
+			return &getServiceBusAuthorizationRuleResponse{}
+		},
+	}
+}
+
+type listServiceBusAuthorizationRuleKeysResponse struct {
+	PrimaryConnectionString   *string `mapstructure:"primaryConnectionString"`
+	SecondaryConnectionString *string `mapstructure:"secondaryConnectionString"`
+	PrimaryKey                *string `mapstructure:"primaryKey"`
+	SecondaryKey              *string `mapstructure:"secondaryKey"`
+}
+
+type listServiceBusAuthorizationRuleKeys struct {
+	Name       string `json:"-"`
+	ParentPath string `json:"-"`
+}
+
+func (command listServiceBusAuthorizationRuleKeys) APIInfo() azure.APIInfo {
+	return azure.APIInfo{
+		APIVersion: serviceBusAPIVersion,
+		Method:     "POST",
+		URLPathFunc: func() string {
+			return fmt.Sprintf("%s/AuthorizationRules/%s/listKeys", command.ParentPath, command.Name)
+		},
+		HasBodyOverride: true,
+		ResponseTypeFunc: func() interface{} {
+			return &listServiceBusAuthorizationRuleKeysResponse{}
+		},
+	}
+}
@@ -25,7 +25,8 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"cloudflare_record": resourceCloudFlareRecord(),
+			"cloudflare_page_rule": resourceCloudFlarePageRule(),
+			"cloudflare_record":    resourceCloudFlareRecord(),
 		},
 
 		ConfigureFunc: providerConfigure,
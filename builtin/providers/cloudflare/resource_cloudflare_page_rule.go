@@ -0,0 +1,390 @@
+package cloudflare
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Page rule actions which are switched "on" or "off".
+var pageRuleOnOffActions = []string{
+	"always_online",
+	"browser_check",
+	"email_obfuscation",
+	"ip_geolocation",
+	"mirage",
+	"rocket_loader",
+	"server_side_exclude",
+	"smart_errors",
+	"waf",
+}
+
+// Page rule actions which take no value, and apply just by being present.
+var pageRuleFlagActions = []string{
+	"always_use_https",
+	"disable_apps",
+	"disable_performance",
+	"disable_security",
+}
+
+// Page rule actions which take a number of seconds.
+var pageRuleTTLActions = []string{
+	"browser_cache_ttl",
+	"edge_cache_ttl",
+}
+
+// Page rule actions which take one of a set of values.
+var pageRuleEnumActions = map[string][]string{
+	"cache_level":    []string{"bypass", "basic", "simplified", "aggressive", "cache_everything"},
+	"security_level": []string{"essentially_off", "low", "medium", "high", "under_attack"},
+	"ssl":            []string{"off", "flexible", "full", "strict"},
+}
+
+func resourceCloudFlarePageRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudFlarePageRuleCreate,
+		Read:   resourceCloudFlarePageRuleRead,
+		Update: resourceCloudFlarePageRuleUpdate,
+		Delete: resourceCloudFlarePageRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"priority": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"active", "paused"}, false),
+			},
+
+			"actions": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: pageRuleActionsSchema(),
+				},
+			},
+
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func pageRuleActionsSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"forwarding_url": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": &schema.Schema{
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					"status_code": &schema.Schema{
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validatePageRuleForwardingStatusCode,
+					},
+				},
+			},
+		},
+	}
+
+	for _, name := range pageRuleOnOffActions {
+		s[name] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		}
+	}
+
+	for _, name := range pageRuleFlagActions {
+		s[name] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
+	}
+
+	for _, name := range pageRuleTTLActions {
+		s[name] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		}
+	}
+
+	for name, values := range pageRuleEnumActions {
+		s[name] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(values, false),
+		}
+	}
+
+	return s
+}
+
+func resourceCloudFlarePageRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	domain := d.Get("domain").(string)
+
+	zoneId, err := client.ZoneIDByName(domain)
+	if err != nil {
+		return fmt.Errorf("Error finding zone %q: %s", domain, err)
+	}
+
+	d.Set("zone_id", zoneId)
+
+	newPageRule := buildPageRule(d)
+
+	log.Printf("[DEBUG] CloudFlare Page Rule create configuration: %#v", newPageRule)
+
+	if err := client.CreatePageRule(zoneId, newPageRule); err != nil {
+		return fmt.Errorf("Failed to create page rule: %s", err)
+	}
+
+	// The create response isn't returned by the client, so the new page rule
+	// has to be found by its target and priority.
+	rule, err := findPageRule(client, zoneId, newPageRule)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(rule.ID)
+
+	log.Printf("[INFO] CloudFlare Page Rule ID: %s", d.Id())
+
+	return resourceCloudFlarePageRuleRead(d, meta)
+}
+
+func resourceCloudFlarePageRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	domain := d.Get("domain").(string)
+
+	zoneId, err := client.ZoneIDByName(domain)
+	if err != nil {
+		return fmt.Errorf("Error finding zone %q: %s", domain, err)
+	}
+
+	rule, err := client.PageRule(zoneId, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if len(rule.Targets) > 0 {
+		d.Set("target", rule.Targets[0].Constraint.Value)
+	}
+	d.Set("priority", rule.Priority)
+	d.Set("status", rule.Status)
+	d.Set("zone_id", zoneId)
+
+	if err := d.Set("actions", flattenPageRuleActions(rule.Actions)); err != nil {
+		return fmt.Errorf("Error setting the actions of CloudFlare Page Rule %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCloudFlarePageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	domain := d.Get("domain").(string)
+
+	zoneId, err := client.ZoneIDByName(domain)
+	if err != nil {
+		return fmt.Errorf("Error finding zone %q: %s", domain, err)
+	}
+
+	updatePageRule := buildPageRule(d)
+
+	log.Printf("[DEBUG] CloudFlare Page Rule update configuration: %#v", updatePageRule)
+
+	// UpdatePageRule doesn't send the page rule in this version of the
+	// client, but setting all of its fields with ChangePageRule replaces it.
+	err = client.ChangePageRule(zoneId, d.Id(), updatePageRule)
+	if err != nil {
+		return fmt.Errorf("Failed to update CloudFlare Page Rule: %s", err)
+	}
+
+	return resourceCloudFlarePageRuleRead(d, meta)
+}
+
+func resourceCloudFlarePageRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	domain := d.Get("domain").(string)
+
+	zoneId, err := client.ZoneIDByName(domain)
+	if err != nil {
+		return fmt.Errorf("Error finding zone %q: %s", domain, err)
+	}
+
+	log.Printf("[INFO] Deleting CloudFlare Page Rule: %s, %s", domain, d.Id())
+
+	err = client.DeletePageRule(zoneId, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error deleting CloudFlare Page Rule: %s", err)
+	}
+
+	return nil
+}
+
+func buildPageRule(d *schema.ResourceData) cloudflare.PageRule {
+	target := cloudflare.PageRuleTarget{
+		Target: "url",
+	}
+	target.Constraint.Operator = "matches"
+	target.Constraint.Value = d.Get("target").(string)
+
+	return cloudflare.PageRule{
+		Targets:  []cloudflare.PageRuleTarget{target},
+		Actions:  expandPageRuleActions(d),
+		Priority: d.Get("priority").(int),
+		Status:   d.Get("status").(string),
+	}
+}
+
+func expandPageRuleActions(d *schema.ResourceData) []cloudflare.PageRuleAction {
+	actions := make([]cloudflare.PageRuleAction, 0)
+
+	for _, name := range pageRuleOnOffActions {
+		if v, ok := d.GetOk("actions.0." + name); ok {
+			actions = append(actions, cloudflare.PageRuleAction{ID: name, Value: v.(string)})
+		}
+	}
+
+	for _, name := range pageRuleFlagActions {
+		if v, ok := d.GetOk("actions.0." + name); ok && v.(bool) {
+			actions = append(actions, cloudflare.PageRuleAction{ID: name})
+		}
+	}
+
+	for _, name := range pageRuleTTLActions {
+		if v, ok := d.GetOk("actions.0." + name); ok {
+			actions = append(actions, cloudflare.PageRuleAction{ID: name, Value: v.(int)})
+		}
+	}
+
+	for name := range pageRuleEnumActions {
+		if v, ok := d.GetOk("actions.0." + name); ok {
+			actions = append(actions, cloudflare.PageRuleAction{ID: name, Value: v.(string)})
+		}
+	}
+
+	if _, ok := d.GetOk("actions.0.forwarding_url"); ok {
+		actions = append(actions, cloudflare.PageRuleAction{
+			ID: "forwarding_url",
+			Value: map[string]interface{}{
+				"url":         d.Get("actions.0.forwarding_url.0.url").(string),
+				"status_code": d.Get("actions.0.forwarding_url.0.status_code").(int),
+			},
+		})
+	}
+
+	return actions
+}
+
+func flattenPageRuleActions(actions []cloudflare.PageRuleAction) []map[string]interface{} {
+	result := make(map[string]interface{})
+
+	for _, action := range actions {
+		switch {
+		case action.ID == "forwarding_url":
+			if value, ok := action.Value.(map[string]interface{}); ok {
+				forwardingURL := map[string]interface{}{
+					"url": value["url"],
+				}
+				// JSON numbers are decoded as float64.
+				if statusCode, ok := value["status_code"].(float64); ok {
+					forwardingURL["status_code"] = int(statusCode)
+				}
+				result[action.ID] = []map[string]interface{}{forwardingURL}
+			}
+
+		case containsPageRuleAction(pageRuleFlagActions, action.ID):
+			result[action.ID] = true
+
+		case containsPageRuleAction(pageRuleTTLActions, action.ID):
+			if value, ok := action.Value.(float64); ok {
+				result[action.ID] = int(value)
+			}
+
+		default:
+			if _, ok := pageRuleEnumActions[action.ID]; ok || containsPageRuleAction(pageRuleOnOffActions, action.ID) {
+				result[action.ID] = action.Value
+			} else {
+				log.Printf("[WARN] Ignoring unsupported CloudFlare Page Rule action %q", action.ID)
+			}
+		}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+// findPageRule returns the most recently created page rule in the zone which
+// has the same target and priority as the given page rule.
+func findPageRule(client *cloudflare.API, zoneId string, pageRule cloudflare.PageRule) (*cloudflare.PageRule, error) {
+	rules, err := client.ListPageRules(zoneId)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list page rules: %s", err)
+	}
+
+	var found *cloudflare.PageRule
+	for i, rule := range rules {
+		if len(rule.Targets) == 0 || rule.Priority != pageRule.Priority {
+			continue
+		}
+		if rule.Targets[0].Constraint.Value != pageRule.Targets[0].Constraint.Value {
+			continue
+		}
+		if found == nil || rule.CreatedOn.After(found.CreatedOn) {
+			found = &rules[i]
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("Failed to find the created page rule for %q", pageRule.Targets[0].Constraint.Value)
+	}
+
+	return found, nil
+}
+
+func containsPageRuleAction(actions []string, id string) bool {
+	for _, action := range actions {
+		if action == id {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePageRuleForwardingStatusCode(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value != 301 && value != 302 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be either 301 or 302, got %d", k, value))
+	}
+	return
+}
@@ -0,0 +1,166 @@
+package cloudflare
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudFlarePageRule_Basic(t *testing.T) {
+	var pageRule cloudflare.PageRule
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFlarePageRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccCheckCloudFlarePageRuleConfigBasic, domain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFlarePageRuleExists("cloudflare_page_rule.foobar", &pageRule),
+					resource.TestCheckResourceAttr(
+						"cloudflare_page_rule.foobar", "target", fmt.Sprintf("%s/static/*", domain)),
+					resource.TestCheckResourceAttr(
+						"cloudflare_page_rule.foobar", "actions.0.cache_level", "cache_everything"),
+					resource.TestCheckResourceAttr(
+						"cloudflare_page_rule.foobar", "actions.0.edge_cache_ttl", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFlarePageRule_Updated(t *testing.T) {
+	var pageRule cloudflare.PageRule
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFlarePageRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccCheckCloudFlarePageRuleConfigBasic, domain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFlarePageRuleExists("cloudflare_page_rule.foobar", &pageRule),
+					resource.TestCheckResourceAttr(
+						"cloudflare_page_rule.foobar", "status", "active"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccCheckCloudFlarePageRuleConfigForwarding, domain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFlarePageRuleExists("cloudflare_page_rule.foobar", &pageRule),
+					resource.TestCheckResourceAttr(
+						"cloudflare_page_rule.foobar", "status", "paused"),
+					resource.TestCheckResourceAttr(
+						"cloudflare_page_rule.foobar", "actions.0.forwarding_url.0.status_code", "301"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudFlarePageRuleForwardingStatusCode_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    301,
+			ErrCount: 0,
+		},
+		{
+			Value:    302,
+			ErrCount: 0,
+		},
+		{
+			Value:    307,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validatePageRuleForwardingStatusCode(tc.Value, "status_code")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %d, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func testAccCheckCloudFlarePageRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_page_rule" {
+			continue
+		}
+
+		_, err := client.PageRule(rs.Primary.Attributes["zone_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Page rule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFlarePageRuleExists(n string, pageRule *cloudflare.PageRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Page Rule ID is set")
+		}
+
+		client := testAccProvider.Meta().(*cloudflare.API)
+		foundPageRule, err := client.PageRule(rs.Primary.Attributes["zone_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundPageRule.ID != rs.Primary.ID {
+			return fmt.Errorf("Page rule not found")
+		}
+
+		*pageRule = foundPageRule
+
+		return nil
+	}
+}
+
+const testAccCheckCloudFlarePageRuleConfigBasic = `
+resource "cloudflare_page_rule" "foobar" {
+	domain = "%s"
+	target = "%s/static/*"
+	priority = 1
+
+	actions {
+		cache_level = "cache_everything"
+		edge_cache_ttl = 7200
+		always_use_https = true
+	}
+}`
+
+const testAccCheckCloudFlarePageRuleConfigForwarding = `
+resource "cloudflare_page_rule" "foobar" {
+	domain = "%s"
+	target = "%s/static/*"
+	priority = 1
+	status = "paused"
+
+	actions {
+		forwarding_url {
+			url = "https://www.example.com/static/$1"
+			status_code = 301
+		}
+	}
+}`
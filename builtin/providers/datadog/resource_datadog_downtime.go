@@ -0,0 +1,261 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func resourceDatadogDowntime() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogDowntimeCreate,
+		Read:   resourceDatadogDowntimeRead,
+		Update: resourceDatadogDowntimeUpdate,
+		Delete: resourceDatadogDowntimeDelete,
+		Exists: resourceDatadogDowntimeExists,
+
+		Schema: map[string]*schema.Schema{
+			"scope": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"start": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"end": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"message": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(val interface{}) string {
+					return strings.TrimSpace(val.(string))
+				},
+			},
+			"recurrence": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDatadogDowntimeRecurrenceType,
+						},
+						"period": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"week_days": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateDatadogDowntimeRecurrenceWeekDay,
+							},
+						},
+						"until_date": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"until_occurrences": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"active": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDowntimeStruct(d *schema.ResourceData) (*datadog.Downtime, error) {
+	var dt datadog.Downtime
+
+	for _, s := range d.Get("scope").([]interface{}) {
+		dt.Scope = append(dt.Scope, s.(string))
+	}
+	if attr, ok := d.GetOk("start"); ok {
+		dt.Start = attr.(int)
+	}
+	if attr, ok := d.GetOk("end"); ok {
+		dt.End = attr.(int)
+	}
+	if attr, ok := d.GetOk("message"); ok {
+		dt.Message = strings.TrimSpace(attr.(string))
+	}
+
+	if _, ok := d.GetOk("recurrence"); ok {
+		r := datadog.Recurrence{
+			Type:   d.Get("recurrence.0.type").(string),
+			Period: d.Get("recurrence.0.period").(int),
+		}
+		for _, day := range d.Get("recurrence.0.week_days").([]interface{}) {
+			r.WeekDays = append(r.WeekDays, day.(string))
+		}
+		if attr, ok := d.GetOk("recurrence.0.until_date"); ok {
+			r.UntilDate = attr.(int)
+		}
+		if attr, ok := d.GetOk("recurrence.0.until_occurrences"); ok {
+			r.UntilOccurrences = attr.(int)
+		}
+		if r.UntilDate != 0 && r.UntilOccurrences != 0 {
+			return nil, fmt.Errorf("only one of until_date and until_occurrences can be set in a downtime recurrence")
+		}
+		dt.Recurrence = &r
+	}
+
+	return &dt, nil
+}
+
+func resourceDatadogDowntimeExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	// Exists - This is called to verify a resource still exists. It is called prior to Read,
+	// and lowers the burden of Read to be able to assume the resource exists.
+	client := meta.(*datadog.Client)
+
+	i, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	dt, err := client.GetDowntime(i)
+	if err != nil {
+		if strings.Contains(err.Error(), "404 Not Found") {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Deleting a downtime only cancels it, it can still be retrieved afterwards.
+	if dt.Canceled != 0 {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func resourceDatadogDowntimeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	dt, err := buildDowntimeStruct(d)
+	if err != nil {
+		return err
+	}
+
+	dt, err = client.CreateDowntime(dt)
+	if err != nil {
+		return fmt.Errorf("error creating downtime: %s", err.Error())
+	}
+
+	d.SetId(strconv.Itoa(dt.Id))
+
+	return resourceDatadogDowntimeRead(d, meta)
+}
+
+func resourceDatadogDowntimeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	i, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dt, err := client.GetDowntime(i)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] downtime: %v", dt)
+	d.Set("scope", dt.Scope)
+	d.Set("start", dt.Start)
+	d.Set("end", dt.End)
+	d.Set("message", dt.Message)
+	d.Set("active", dt.Active)
+
+	recurrence := make([]map[string]interface{}, 0, 1)
+	if r := dt.Recurrence; r != nil {
+		recurrence = append(recurrence, map[string]interface{}{
+			"type":              r.Type,
+			"period":            r.Period,
+			"week_days":         r.WeekDays,
+			"until_date":        r.UntilDate,
+			"until_occurrences": r.UntilOccurrences,
+		})
+	}
+	d.Set("recurrence", recurrence)
+
+	return nil
+}
+
+func resourceDatadogDowntimeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	i, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dt, err := buildDowntimeStruct(d)
+	if err != nil {
+		return err
+	}
+	dt.Id = i
+
+	if err = client.UpdateDowntime(dt); err != nil {
+		return fmt.Errorf("error updating downtime: %s", err.Error())
+	}
+
+	return resourceDatadogDowntimeRead(d, meta)
+}
+
+func resourceDatadogDowntimeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	i, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err = client.DeleteDowntime(i); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateDatadogDowntimeRecurrenceType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "days", "weeks", "months", "years":
+		break
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid recurrence type parameter %q. Valid parameters are days, weeks, months, or years", k, value))
+	}
+	return
+}
+
+func validateDatadogDowntimeRecurrenceWeekDay(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun":
+		break
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid recurrence week day parameter %q. Valid parameters are Mon, Tue, Wed, Thu, Fri, Sat, or Sun", k, value))
+	}
+	return
+}
@@ -0,0 +1,193 @@
+package datadog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogDowntime_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDatadogDowntimeConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "scope.0", "env:staging"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "message", "Example Datadog downtime message."),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.type", "days"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.period", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogDowntime_Updated(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDatadogDowntimeConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "scope.0", "env:staging"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckDatadogDowntimeConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "scope.0", "env:production"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.type", "weeks"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.week_days.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.week_days.0", "Sat"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogDowntimeRecurrenceType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "days",
+			ErrCount: 0,
+		},
+		{
+			Value:    "years",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hours",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDatadogDowntimeRecurrenceType(tc.Value, "recurrence.0.type")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestDatadogDowntimeRecurrenceWeekDay_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "Mon",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Sun",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Monday",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDatadogDowntimeRecurrenceWeekDay(tc.Value, "recurrence.0.week_days.0")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func testAccCheckDatadogDowntimeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_downtime" {
+			continue
+		}
+
+		i, _ := strconv.Atoi(r.Primary.ID)
+		dt, err := client.GetDowntime(i)
+		if err != nil {
+			if strings.Contains(err.Error(), "404 Not Found") {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving downtime %s", err)
+		}
+
+		// Deleted downtimes are only canceled.
+		if dt.Canceled == 0 {
+			return fmt.Errorf("Downtime still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDatadogDowntimeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*datadog.Client)
+
+		i, _ := strconv.Atoi(rs.Primary.ID)
+		if _, err := client.GetDowntime(i); err != nil {
+			return fmt.Errorf("Received an error retrieving downtime %s", err)
+		}
+		return nil
+	}
+}
+
+const testAccCheckDatadogDowntimeConfig = `
+resource "datadog_downtime" "foo" {
+  scope = ["env:staging"]
+  start = 1893456000
+  end = 1893513600
+  message = "Example Datadog downtime message."
+
+  recurrence {
+    type = "days"
+    period = 1
+  }
+}
+`
+
+const testAccCheckDatadogDowntimeConfigUpdated = `
+resource "datadog_downtime" "foo" {
+  scope = ["env:production"]
+  start = 1893456000
+  end = 1893513600
+  message = "Example Datadog downtime message."
+
+  recurrence {
+    type = "weeks"
+    period = 1
+    week_days = ["Sat", "Sun"]
+  }
+}
+`
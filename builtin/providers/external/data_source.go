@@ -0,0 +1,106 @@
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"program": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"working_dir": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+
+			"query": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"result": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	programI := d.Get("program").([]interface{})
+	if len(programI) == 0 {
+		return fmt.Errorf("'program' must contain at least one element")
+	}
+
+	program := make([]string, len(programI))
+	for i, vI := range programI {
+		program[i] = vI.(string)
+	}
+
+	query := make(map[string]string)
+	for k, vI := range d.Get("query").(map[string]interface{}) {
+		query[k] = vI.(string)
+	}
+
+	result, err := runProgram(program, d.Get("working_dir").(string), query)
+	if err != nil {
+		return err
+	}
+
+	d.Set("result", result)
+	d.SetId("-")
+
+	return nil
+}
+
+// runProgram runs the given program with the query encoded as a JSON object
+// on its stdin, and decodes the JSON object it writes to its stdout. The
+// query and the result are both flat objects with string values.
+func runProgram(program []string, workingDir string, query map[string]string) (map[string]string, error) {
+	queryJson, err := json.Marshal(query)
+	if err != nil {
+		// Should never happen, since we know query will always be a map
+		// from string to string, as guaranteed by d.Get and our schema.
+		return nil, err
+	}
+
+	cmd := exec.Command(program[0], program[1:]...)
+	cmd.Dir = workingDir
+	cmd.Stdin = bytes.NewReader(queryJson)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	resultJson, err := cmd.Output()
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			if stderr.Len() > 0 {
+				return nil, fmt.Errorf("failed to execute %q: %s", program[0], bytes.TrimSpace(stderr.Bytes()))
+			}
+			return nil, fmt.Errorf("command %q failed with no error message", program[0])
+		}
+		return nil, fmt.Errorf("can't find external program %q: %s", program[0], err)
+	}
+
+	result := make(map[string]string)
+	if err := json.Unmarshal(resultJson, &result); err != nil {
+		return nil, fmt.Errorf("command %q produced invalid JSON, which must be an object whose values are all strings: %s", program[0], err)
+	}
+
+	return result, nil
+}
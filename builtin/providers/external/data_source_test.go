@@ -0,0 +1,160 @@
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The test binary is used as the external program, by re-running it with
+// the helper environment variable set. See TestHelperProgram.
+const testHelperEnv = "TF_EXTERNAL_TEST_HELPER"
+
+func TestDataSource_basic(t *testing.T) {
+	os.Setenv(testHelperEnv, "1")
+	defer os.Unsetenv(testHelperEnv)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testDataSourceConfig_basic, os.Args[0]),
+				Check: func(s *terraform.State) error {
+					_, ok := s.RootModule().Resources["data.external.test"]
+					if !ok {
+						return fmt.Errorf("missing data resource")
+					}
+
+					outputs := s.RootModule().Outputs
+
+					if outputs["argument"] == nil {
+						return fmt.Errorf("missing 'argument' output")
+					}
+					if outputs["query_value"] == nil {
+						return fmt.Errorf("missing 'query_value' output")
+					}
+
+					if outputs["argument"].Value != "cheese" {
+						return fmt.Errorf(
+							"'argument' output is %q; want 'cheese'",
+							outputs["argument"].Value,
+						)
+					}
+					if outputs["query_value"].Value != "pizza" {
+						return fmt.Errorf(
+							"'query_value' output is %q; want 'pizza'",
+							outputs["query_value"].Value,
+						)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestDataSource_error(t *testing.T) {
+	os.Setenv(testHelperEnv, "1")
+	defer os.Unsetenv(testHelperEnv)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      fmt.Sprintf(testDataSourceConfig_error, os.Args[0]),
+				ExpectError: regexp.MustCompile("I was asked to fail"),
+			},
+		},
+	})
+}
+
+func TestDataSource_invalidResult(t *testing.T) {
+	os.Setenv(testHelperEnv, "1")
+	defer os.Unsetenv(testHelperEnv)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      fmt.Sprintf(testDataSourceConfig_invalidResult, os.Args[0]),
+				ExpectError: regexp.MustCompile("produced invalid JSON"),
+			},
+		},
+	})
+}
+
+// TestHelperProgram isn't a real test. When the helper environment variable
+// is set it acts as the external program, echoing its query and first
+// argument back as its result.
+func TestHelperProgram(t *testing.T) {
+	if os.Getenv(testHelperEnv) != "1" || len(os.Args) < 3 || os.Args[len(os.Args)-2] != "--" {
+		return
+	}
+
+	var query map[string]string
+	if err := json.NewDecoder(os.Stdin).Decode(&query); err != nil {
+		fmt.Fprintf(os.Stderr, "error decoding query: %s", err)
+		os.Exit(1)
+	}
+
+	if query["fail"] != "" {
+		fmt.Fprintf(os.Stderr, "I was asked to fail")
+		os.Exit(1)
+	}
+
+	var result interface{} = map[string]string{
+		"result":      "yes",
+		"query_value": query["value"],
+		"argument":    os.Args[len(os.Args)-1],
+	}
+	if query["invalid"] != "" {
+		result = map[string]interface{}{"nested": map[string]string{}}
+	}
+
+	json.NewEncoder(os.Stdout).Encode(result)
+	os.Exit(0)
+}
+
+const testDataSourceConfig_basic = `
+data "external" "test" {
+  program = ["%s", "-test.run=TestHelperProgram", "--", "cheese"]
+
+  query = {
+    value = "pizza"
+  }
+}
+
+output "query_value" {
+  value = "${data.external.test.result["query_value"]}"
+}
+
+output "argument" {
+  value = "${data.external.test.result["argument"]}"
+}
+`
+
+const testDataSourceConfig_error = `
+data "external" "test" {
+  program = ["%s", "-test.run=TestHelperProgram", "--", "cheese"]
+
+  query = {
+    fail = "true"
+  }
+}
+`
+
+const testDataSourceConfig_invalidResult = `
+data "external" "test" {
+  program = ["%s", "-test.run=TestHelperProgram", "--", "cheese"]
+
+  query = {
+    invalid = "true"
+  }
+}
+`
@@ -32,6 +32,8 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"github_branch_protection":       resourceGithubBranchProtection(),
+			"github_repository":              resourceGithubRepository(),
 			"github_team":                    resourceGithubTeam(),
 			"github_team_membership":         resourceGithubTeamMembership(),
 			"github_team_repository":         resourceGithubTeamRepository(),
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"log"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubBranchProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubBranchProtectionCreate,
+		Read:   resourceGithubBranchProtectionRead,
+		Update: resourceGithubBranchProtectionUpdate,
+		Delete: resourceGithubBranchProtectionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"required_status_checks": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement_level": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "everyone",
+							ValidateFunc: validateValueFunc([]string{"non_admins", "everyone"}),
+						},
+						"contexts": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	r := d.Get("repository").(string)
+	b := d.Get("branch").(string)
+
+	log.Printf("[DEBUG] protect github branch %s/%s:%s", meta.(*Organization).name, r, b)
+	_, _, err := client.Repositories.EditBranch(meta.(*Organization).name, r, b, &github.Branch{
+		Protection: expandGithubBranchProtection(d),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&r, &b))
+
+	return resourceGithubBranchProtectionRead(d, meta)
+}
+
+func resourceGithubBranchProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	r, b := parseTwoPartID(d.Id())
+
+	branch, resp, err := client.Repositories.GetBranch(meta.(*Organization).name, r, b)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	protection := branch.Protection
+	if protection == nil || protection.Enabled == nil || !*protection.Enabled {
+		log.Printf("[WARN] removing %s from state because the branch is no longer protected", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository", r)
+	d.Set("branch", b)
+
+	checks := make([]map[string]interface{}, 0, 1)
+	if rsc := protection.RequiredStatusChecks; rsc != nil && rsc.EnforcementLevel != nil && *rsc.EnforcementLevel != "off" {
+		contexts := make([]string, 0)
+		if rsc.Contexts != nil {
+			contexts = *rsc.Contexts
+		}
+		checks = append(checks, map[string]interface{}{
+			"enforcement_level": *rsc.EnforcementLevel,
+			"contexts":          contexts,
+		})
+	}
+	d.Set("required_status_checks", checks)
+
+	return nil
+}
+
+func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	r, b := parseTwoPartID(d.Id())
+
+	log.Printf("[DEBUG] update github branch protection %s/%s:%s", meta.(*Organization).name, r, b)
+	_, _, err := client.Repositories.EditBranch(meta.(*Organization).name, r, b, &github.Branch{
+		Protection: expandGithubBranchProtection(d),
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubBranchProtectionRead(d, meta)
+}
+
+func resourceGithubBranchProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	r, b := parseTwoPartID(d.Id())
+	enabled := false
+
+	log.Printf("[DEBUG] unprotect github branch %s/%s:%s", meta.(*Organization).name, r, b)
+	_, _, err := client.Repositories.EditBranch(meta.(*Organization).name, r, b, &github.Branch{
+		Protection: &github.Protection{
+			Enabled: &enabled,
+		},
+	})
+	return err
+}
+
+func expandGithubBranchProtection(d *schema.ResourceData) *github.Protection {
+	enabled := true
+	level := "off"
+	contexts := make([]string, 0)
+
+	if _, ok := d.GetOk("required_status_checks"); ok {
+		level = d.Get("required_status_checks.0.enforcement_level").(string)
+		for _, c := range d.Get("required_status_checks.0.contexts").([]interface{}) {
+			contexts = append(contexts, c.(string))
+		}
+	}
+
+	return &github.Protection{
+		Enabled: &enabled,
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			EnforcementLevel: &level,
+			Contexts:         &contexts,
+		},
+	}
+}
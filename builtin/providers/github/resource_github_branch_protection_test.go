@@ -0,0 +1,107 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubBranchProtection_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubBranchProtectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccGithubBranchProtectionConfig(randString, "everyone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubBranchProtectionExists("github_branch_protection.master"),
+					resource.TestCheckResourceAttr("github_branch_protection.master", "required_status_checks.0.enforcement_level", "everyone"),
+					resource.TestCheckResourceAttr("github_branch_protection.master", "required_status_checks.0.contexts.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccGithubBranchProtectionConfig(randString, "non_admins"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubBranchProtectionExists("github_branch_protection.master"),
+					resource.TestCheckResourceAttr("github_branch_protection.master", "required_status_checks.0.enforcement_level", "non_admins"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubBranchProtectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No branch protection ID is set")
+		}
+
+		org := testAccProvider.Meta().(*Organization)
+		r, b := parseTwoPartID(rs.Primary.ID)
+		branch, _, err := org.client.Repositories.GetBranch(org.name, r, b)
+		if err != nil {
+			return err
+		}
+
+		if branch.Protection == nil || branch.Protection.Enabled == nil || !*branch.Protection.Enabled {
+			return fmt.Errorf("Branch %s is not protected", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGithubBranchProtectionDestroy(s *terraform.State) error {
+	org := testAccProvider.Meta().(*Organization)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_branch_protection" {
+			continue
+		}
+
+		r, b := parseTwoPartID(rs.Primary.ID)
+		branch, resp, err := org.client.Repositories.GetBranch(org.name, r, b)
+		if err != nil {
+			// The repository is destroyed alongside the protection.
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			return err
+		}
+
+		if branch.Protection != nil && branch.Protection.Enabled != nil && *branch.Protection.Enabled {
+			return fmt.Errorf("Branch %s is still protected", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGithubBranchProtectionConfig(randString, enforcementLevel string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+	name = "tf-acc-test-%s"
+	description = "Terraform acceptance tests"
+	auto_init = true
+}
+
+resource "github_branch_protection" "master" {
+	repository = "${github_repository.foo.name}"
+	branch = "master"
+
+	required_status_checks {
+		enforcement_level = "%s"
+		contexts = ["ci/travis"]
+	}
+}
+`, randString, enforcementLevel)
+}
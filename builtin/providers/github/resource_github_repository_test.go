@@ -0,0 +1,138 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubRepository_basic(t *testing.T) {
+	var repo github.Repository
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccGithubRepositoryConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists("github_repository.foo", &repo),
+					testAccCheckGithubRepositoryAttributes(&repo, name, "Terraform acceptance tests", true),
+				),
+			},
+			resource.TestStep{
+				Config: testAccGithubRepositoryUpdateConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists("github_repository.foo", &repo),
+					testAccCheckGithubRepositoryAttributes(&repo, name, "Updated Terraform acceptance tests", false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryExists(n string, repo *github.Repository) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No repository name is set")
+		}
+
+		org := testAccProvider.Meta().(*Organization)
+		conn := org.client
+		gotRepo, _, err := conn.Repositories.Get(org.name, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		*repo = *gotRepo
+		return nil
+	}
+}
+
+func testAccCheckGithubRepositoryAttributes(repo *github.Repository, name, description string, hasIssues bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *repo.Name != name {
+			return fmt.Errorf("Repository name does not match: %s, %s", *repo.Name, name)
+		}
+
+		if *repo.Description != description {
+			return fmt.Errorf("Repository description does not match: %s, %s", *repo.Description, description)
+		}
+
+		if *repo.HasIssues != hasIssues {
+			return fmt.Errorf("Repository has_issues does not match: %t, %t", *repo.HasIssues, hasIssues)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGithubRepositoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+	orgName := testAccProvider.Meta().(*Organization).name
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_repository" {
+			continue
+		}
+
+		gotRepo, resp, err := conn.Repositories.Get(orgName, rs.Primary.ID)
+		if err == nil {
+			if gotRepo != nil && *gotRepo.Name == rs.Primary.ID {
+				return fmt.Errorf("Repository %s/%s still exists", orgName, *gotRepo.Name)
+			}
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccGithubRepositoryConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+	name = "tf-acc-test-%s"
+	description = "Terraform acceptance tests"
+	homepage_url = "http://example.com/"
+
+	# So that acceptance tests can be run in a github organization
+	# with no billing
+	private = false
+
+	has_issues = true
+	has_wiki = true
+	has_downloads = true
+}
+`, randString)
+}
+
+func testAccGithubRepositoryUpdateConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+	name = "tf-acc-test-%s"
+	description = "Updated Terraform acceptance tests"
+	homepage_url = "http://example.com/"
+
+	# So that acceptance tests can be run in a github organization
+	# with no billing
+	private = false
+
+	has_issues = false
+	has_wiki = false
+	has_downloads = false
+}
+`, randString)
+}
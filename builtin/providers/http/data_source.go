@@ -0,0 +1,177 @@
+package http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"request_headers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"ca_cert_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded CA certificates used to verify the server, instead of the system roots",
+			},
+
+			"client_cert_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded client certificate presented to the server",
+			},
+
+			"client_key_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded private key of the client certificate",
+			},
+
+			"insecure": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"retry_attempts": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Number of times the request is retried after a connection error or 5xx response",
+			},
+
+			"retry_min_delay_ms": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
+			},
+
+			"retry_max_delay_ms": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30000,
+			},
+
+			"body": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"response_headers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"status_code": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	url := d.Get("url").(string)
+
+	client, err := httpClient(d)
+	if err != nil {
+		return err
+	}
+
+	req, err := retryablehttp.NewRequest("GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("Error creating request: %s", err)
+	}
+
+	for name, value := range d.Get("request_headers").(map[string]interface{}) {
+		req.Header.Set(name, value.(string))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Error making request to %s: %s", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("HTTP request to %s failed with status %s", url, resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("Error reading response body from %s: %s", url, err)
+	}
+
+	// Headers which are repeated in the response are joined, as described
+	// in RFC 7230 section 3.2.2.
+	headers := make(map[string]interface{})
+	for name, values := range resp.Header {
+		headers[name] = strings.Join(values, ", ")
+	}
+
+	d.Set("body", string(body))
+	d.Set("response_headers", headers)
+	d.Set("status_code", resp.StatusCode)
+	d.SetId(url)
+
+	return nil
+}
+
+func httpClient(d *schema.ResourceData) (*retryablehttp.Client, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: d.Get("insecure").(bool),
+	}
+
+	if v, ok := d.GetOk("ca_cert_pem"); ok {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(v.(string))) {
+			return nil, fmt.Errorf("ca_cert_pem does not contain any valid PEM-encoded certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certPEM, hasCert := d.GetOk("client_cert_pem")
+	keyPEM, hasKey := d.GetOk("client_key_pem")
+	if hasCert != hasKey {
+		return nil, fmt.Errorf("client_cert_pem and client_key_pem must be set together")
+	}
+	if hasCert {
+		cert, err := tls.X509KeyPair([]byte(certPEM.(string)), []byte(keyPEM.(string)))
+		if err != nil {
+			return nil, fmt.Errorf("Error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := cleanhttp.DefaultTransport()
+	transport.TLSClientConfig = tlsConfig
+
+	client := retryablehttp.NewClient()
+	client.HTTPClient = &http.Client{Transport: transport}
+	client.RetryMax = d.Get("retry_attempts").(int)
+	client.RetryWaitMin = time.Duration(d.Get("retry_min_delay_ms").(int)) * time.Millisecond
+	client.RetryWaitMax = time.Duration(d.Get("retry_max_delay_ms").(int)) * time.Millisecond
+
+	return client, nil
+}
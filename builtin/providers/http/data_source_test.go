@@ -0,0 +1,105 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSource_basic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("X-Single", "foo")
+		w.Header().Add("X-Double", "1")
+		w.Header().Add("X-Double", "2")
+		w.Write([]byte("10.0.0.0/8"))
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testDataSourceConfig_headers, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.http.test", "body", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("data.http.test", "status_code", "200"),
+					resource.TestCheckResourceAttr("data.http.test", "response_headers.X-Single", "foo"),
+					resource.TestCheckResourceAttr("data.http.test", "response_headers.X-Double", "1, 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSource_retry(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testDataSourceConfig_retry, server.URL),
+				Check:  resource.TestCheckResourceAttr("data.http.test", "body", "ok"),
+			},
+		},
+	})
+}
+
+func TestDataSource_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      fmt.Sprintf(testDataSourceConfig_basic, server.URL),
+				ExpectError: regexp.MustCompile("failed with status 404"),
+			},
+		},
+	})
+}
+
+const testDataSourceConfig_basic = `
+data "http" "test" {
+  url = "%s"
+}
+`
+
+const testDataSourceConfig_headers = `
+data "http" "test" {
+  url = "%s"
+
+  request_headers = {
+    "Authorization" = "Bearer token"
+  }
+}
+`
+
+const testDataSourceConfig_retry = `
+data "http" "test" {
+  url                = "%s"
+  retry_attempts     = 3
+  retry_min_delay_ms = 10
+  retry_max_delay_ms = 10
+}
+`
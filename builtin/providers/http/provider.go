@@ -0,0 +1,19 @@
+package http
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns a terraform.ResourceProvider.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"http": dataSource(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{},
+	}
+}
@@ -0,0 +1,121 @@
+package local
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLocalFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLocalFileCreate,
+		Read:   resourceLocalFileRead,
+		Delete: resourceLocalFileDelete,
+
+		Schema: map[string]*schema.Schema{
+			"content": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filename": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Path to the output file",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"file_permission": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Permissions to set for the output file, in octal",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "0644",
+				ValidateFunc: validateLocalFileMode,
+			},
+			"directory_permission": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Permissions to set for any directories created, in octal",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "0755",
+				ValidateFunc: validateLocalFileMode,
+			},
+		},
+	}
+}
+
+func resourceLocalFileCreate(d *schema.ResourceData, meta interface{}) error {
+	content := d.Get("content").(string)
+	destination := d.Get("filename").(string)
+
+	// The modes have already been validated.
+	fileMode, _ := strconv.ParseUint(d.Get("file_permission").(string), 8, 32)
+	dirMode, _ := strconv.ParseUint(d.Get("directory_permission").(string), 8, 32)
+
+	destinationDir := filepath.Dir(destination)
+	if err := os.MkdirAll(destinationDir, os.FileMode(dirMode)); err != nil {
+		return fmt.Errorf("could not create directory '%s': %s", destinationDir, err)
+	}
+
+	if err := ioutil.WriteFile(destination, []byte(content), os.FileMode(fileMode)); err != nil {
+		return fmt.Errorf("could not write file '%s': %s", destination, err)
+	}
+
+	// WriteFile only applies the mode to new files, and is subject to the
+	// umask.
+	if err := os.Chmod(destination, os.FileMode(fileMode)); err != nil {
+		return fmt.Errorf("could not set the permissions of file '%s': %s", destination, err)
+	}
+
+	checksum := sha1.Sum([]byte(content))
+	d.SetId(hex.EncodeToString(checksum[:]))
+
+	return nil
+}
+
+func resourceLocalFileRead(d *schema.ResourceData, meta interface{}) error {
+	// If the output file doesn't exist, or its content has changed, mark the
+	// resource for creation.
+	outputPath := d.Get("filename").(string)
+	outputContent, err := ioutil.ReadFile(outputPath)
+	if os.IsNotExist(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not read file '%s': %s", outputPath, err)
+	}
+
+	checksum := sha1.Sum(outputContent)
+	if d.Id() != hex.EncodeToString(checksum[:]) {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceLocalFileDelete(d *schema.ResourceData, meta interface{}) error {
+	outputPath := d.Get("filename").(string)
+	if err := os.Remove(outputPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not delete file '%s': %s", outputPath, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func validateLocalFileMode(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	mode, err := strconv.ParseUint(value, 8, 32)
+	if err != nil || mode > 0777 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be an octal file mode such as \"0644\": %q", k, value))
+	}
+	return
+}
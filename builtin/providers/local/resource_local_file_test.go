@@ -0,0 +1,97 @@
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	r "github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestLocalFile_Basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-local-file")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "sub", "local_file")
+
+	r.UnitTest(t, r.TestCase{
+		Providers:    testProviders,
+		CheckDestroy: testLocalFileMissing(filename),
+		Steps: []r.TestStep{
+			r.TestStep{
+				Config: fmt.Sprintf(testLocalFileConfig, "This is some content", filename),
+				Check:  testLocalFileContent(filename, "This is some content", 0600),
+			},
+			r.TestStep{
+				Config: fmt.Sprintf(testLocalFileConfig, "This is some other content", filename),
+				Check:  testLocalFileContent(filename, "This is some other content", 0600),
+			},
+		},
+	})
+}
+
+func TestValidateLocalFileMode(t *testing.T) {
+	validModes := []string{"0644", "0755", "600", "0"}
+	for _, v := range validModes {
+		_, errors := validateLocalFileMode(v, "file_permission")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid file mode: %q", v, errors)
+		}
+	}
+
+	invalidModes := []string{"0888", "rw-r--r--", "01777", ""}
+	for _, v := range invalidModes {
+		_, errors := validateLocalFileMode(v, "file_permission")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid file mode", v)
+		}
+	}
+}
+
+func testLocalFileContent(filename, content string, mode os.FileMode) r.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fi, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if fi.Mode().Perm() != mode {
+			return fmt.Errorf("file mode is %o; want %o", fi.Mode().Perm(), mode)
+		}
+
+		actual, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if string(actual) != content {
+			return fmt.Errorf("file content is %q; want %q", actual, content)
+		}
+		return nil
+	}
+}
+
+func testLocalFileMissing(filename string) r.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := os.Stat(filename)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("found file expected to be deleted: %s", filename)
+	}
+}
+
+var testLocalFileConfig = `
+resource "local_file" "foo" {
+  content         = "%s"
+  filename        = "%s"
+  file_permission = "0600"
+}
+`
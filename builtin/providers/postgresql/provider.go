@@ -45,6 +45,7 @@ func Provider() terraform.ResourceProvider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"postgresql_database": resourcePostgresqlDatabase(),
+			"postgresql_grant":    resourcePostgresqlGrant(),
 			"postgresql_role":     resourcePostgresqlRole(),
 		},
 
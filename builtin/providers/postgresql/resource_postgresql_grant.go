@@ -0,0 +1,148 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/lib/pq"
+)
+
+func resourcePostgresqlGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePostgresqlGrantCreate,
+		Read:   resourcePostgresqlGrantRead,
+		Delete: resourcePostgresqlGrantDelete,
+
+		Schema: map[string]*schema.Schema{
+			"database": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"privileges": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDatabasePrivilege,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourcePostgresqlGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	conn, err := client.Connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	dbName := d.Get("database").(string)
+	roleName := d.Get("role").(string)
+	privileges := getPrivilegesStr(d.Get("privileges").(*schema.Set))
+
+	query := fmt.Sprintf("GRANT %s ON DATABASE %s TO %s", privileges, pq.QuoteIdentifier(dbName), pq.QuoteIdentifier(roleName))
+	_, err = conn.Query(query)
+	if err != nil {
+		return fmt.Errorf("Error granting privileges: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", roleName, dbName))
+
+	return resourcePostgresqlGrantRead(d, meta)
+}
+
+func resourcePostgresqlGrantRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	conn, err := client.Connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	dbName := d.Get("database").(string)
+	roleName := d.Get("role").(string)
+
+	// Only the privileges explicitly granted to the role are read, rather than
+	// those it has through PUBLIC or by owning the database.
+	rows, err := conn.Query(`SELECT a.privilege_type FROM pg_database d, aclexplode(d.datacl) a
+		JOIN pg_roles r ON a.grantee = r.oid WHERE d.datname = $1 AND r.rolname = $2`, dbName, roleName)
+	if err != nil {
+		return fmt.Errorf("Error reading privileges: %s", err)
+	}
+	defer rows.Close()
+
+	privileges := make([]interface{}, 0)
+	for rows.Next() {
+		var privilege string
+		if err := rows.Scan(&privilege); err != nil {
+			return fmt.Errorf("Error reading privileges: %s", err)
+		}
+		privileges = append(privileges, privilege)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Error reading privileges: %s", err)
+	}
+
+	if len(privileges) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("privileges", schema.NewSet(schema.HashString, privileges))
+
+	return nil
+}
+
+func resourcePostgresqlGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+	conn, err := client.Connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	dbName := d.Get("database").(string)
+	roleName := d.Get("role").(string)
+	privileges := getPrivilegesStr(d.Get("privileges").(*schema.Set))
+
+	query := fmt.Sprintf("REVOKE %s ON DATABASE %s FROM %s", privileges, pq.QuoteIdentifier(dbName), pq.QuoteIdentifier(roleName))
+	_, err = conn.Query(query)
+	if err != nil {
+		return fmt.Errorf("Error revoking privileges: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getPrivilegesStr(privileges *schema.Set) string {
+	result := make([]string, 0, privileges.Len())
+	for _, privilege := range privileges.List() {
+		result = append(result, privilege.(string))
+	}
+	return strings.Join(result, ", ")
+}
+
+func validateDatabasePrivilege(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "CREATE", "CONNECT", "TEMPORARY":
+		break
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of CREATE, CONNECT or TEMPORARY, got %q", k, value))
+	}
+	return
+}
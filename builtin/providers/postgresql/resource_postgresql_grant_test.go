@@ -0,0 +1,134 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccPostgresqlGrant_Basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlGrantDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPostgresqlGrantConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlGrantExists("postgresql_grant.mygrant", "CONNECT"),
+					testAccCheckPostgresqlGrantExists("postgresql_grant.mygrant", "TEMPORARY"),
+					resource.TestCheckResourceAttr(
+						"postgresql_grant.mygrant", "database", "mydb3"),
+					resource.TestCheckResourceAttr(
+						"postgresql_grant.mygrant", "role", "myrole3"),
+					resource.TestCheckResourceAttr(
+						"postgresql_grant.mygrant", "privileges.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateDatabasePrivilege(t *testing.T) {
+	validPrivileges := []string{"CREATE", "CONNECT", "TEMPORARY"}
+	for _, v := range validPrivileges {
+		_, errors := validateDatabasePrivilege(v, "privileges")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid privilege: %q", v, errors)
+		}
+	}
+
+	invalidPrivileges := []string{"SELECT", "connect", "ALL", ""}
+	for _, v := range invalidPrivileges {
+		_, errors := validateDatabasePrivilege(v, "privileges")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid privilege", v)
+		}
+	}
+}
+
+func testAccCheckPostgresqlGrantDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_grant" {
+			continue
+		}
+
+		hasPrivilege, err := checkGrantExists(client, rs.Primary.Attributes["database"], rs.Primary.Attributes["role"], "CONNECT")
+
+		if err != nil {
+			return fmt.Errorf("Error checking grant %s", err)
+		}
+
+		if hasPrivilege {
+			return fmt.Errorf("Grant still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPostgresqlGrantExists(n string, privilege string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		hasPrivilege, err := checkGrantExists(client, rs.Primary.Attributes["database"], rs.Primary.Attributes["role"], privilege)
+
+		if err != nil {
+			return fmt.Errorf("Error checking grant %s", err)
+		}
+
+		if !hasPrivilege {
+			return fmt.Errorf("Privilege %s not granted", privilege)
+		}
+
+		return nil
+	}
+}
+
+func checkGrantExists(client *Client, dbName, roleName, privilege string) (bool, error) {
+	conn, err := client.Connect()
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	var count int
+	err = conn.QueryRow(`SELECT count(*) FROM pg_database d, aclexplode(d.datacl) a
+		JOIN pg_roles r ON a.grantee = r.oid
+		WHERE d.datname = $1 AND r.rolname = $2 AND a.privilege_type = $3`, dbName, roleName, privilege).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("Error reading info about grant: %s", err)
+	}
+
+	return count > 0, nil
+}
+
+var testAccPostgresqlGrantConfig = `
+resource "postgresql_role" "myrole3" {
+  name = "myrole3"
+  login = true
+}
+
+resource "postgresql_database" "mydb3" {
+  name = "mydb3"
+}
+
+resource "postgresql_grant" "mygrant" {
+  database = "${postgresql_database.mydb3.name}"
+  role = "${postgresql_role.myrole3.name}"
+  privileges = ["CONNECT", "TEMPORARY"]
+}
+`
@@ -11,8 +11,9 @@ func Provider() terraform.ResourceProvider {
 		Schema: map[string]*schema.Schema{},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"random_id":      resourceId(),
-			"random_shuffle": resourceShuffle(),
+			"random_id":       resourceId(),
+			"random_password": resourcePassword(),
+			"random_shuffle":  resourceShuffle(),
 		},
 	}
 }
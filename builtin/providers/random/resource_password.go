@@ -0,0 +1,252 @@
+package random
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	passwordLowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumericChars = "0123456789"
+	passwordSpecialChars = "!@#$%&*()-_=+[]{}<>:?"
+)
+
+func resourcePassword() *schema.Resource {
+	return &schema.Resource{
+		Create: CreatePassword,
+		Read:   ReadPassword,
+		Delete: stubDelete,
+
+		Schema: map[string]*schema.Schema{
+			"keepers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"length": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"upper": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"lower": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"number": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"special": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"override_special": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePasswordSpecialChars,
+			},
+
+			"min_upper": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_lower": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_numeric": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_special": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"rotation_hours": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+				ForceNew: true,
+			},
+
+			"rotation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"result": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// validatePasswordSpecialChars only allows printable ASCII characters, since
+// the password is built from single bytes of the character sets.
+func validatePasswordSpecialChars(v interface{}, k string) (ws []string, es []error) {
+	for _, r := range v.(string) {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			es = append(es, fmt.Errorf(
+				"%q can only contain printable ASCII characters, got %q", k, r))
+			return
+		}
+	}
+	return
+}
+
+func CreatePassword(d *schema.ResourceData, meta interface{}) error {
+	length := d.Get("length").(int)
+
+	specialChars := passwordSpecialChars
+	if v := d.Get("override_special").(string); v != "" {
+		specialChars = v
+	}
+
+	classes := []struct {
+		name    string
+		enabled bool
+		chars   string
+		min     int
+	}{
+		{"upper", d.Get("upper").(bool), passwordUpperChars, d.Get("min_upper").(int)},
+		{"lower", d.Get("lower").(bool), passwordLowerChars, d.Get("min_lower").(int)},
+		{"number", d.Get("number").(bool), passwordNumericChars, d.Get("min_numeric").(int)},
+		{"special", d.Get("special").(bool), specialChars, d.Get("min_special").(int)},
+	}
+
+	var chars string
+	var result []byte
+	for _, class := range classes {
+		if !class.enabled {
+			if class.min > 0 {
+				return fmt.Errorf("a minimum number of %s characters can't be required when %q is false", class.name, class.name)
+			}
+			continue
+		}
+		chars += class.chars
+
+		// Satisfy the minimum for each class first, then fill the rest of
+		// the password from all of the enabled classes.
+		classResult, err := generateRandomBytes(class.chars, class.min)
+		if err != nil {
+			return err
+		}
+		result = append(result, classResult...)
+	}
+
+	if chars == "" {
+		return fmt.Errorf("at least one of upper, lower, number and special must be true")
+	}
+	if len(result) > length {
+		return fmt.Errorf("length (%d) is less than the sum of the minimum character counts (%d)", length, len(result))
+	}
+
+	rest, err := generateRandomBytes(chars, length-len(result))
+	if err != nil {
+		return err
+	}
+	result = append(result, rest...)
+
+	// Shuffle so that the characters satisfying the minimums aren't always
+	// at the start of the password.
+	for i := len(result) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return fmt.Errorf("error generating random number: %s", err)
+		}
+		result[i], result[j.Int64()] = result[j.Int64()], result[i]
+	}
+
+	if hours := d.Get("rotation_hours").(int); hours > 0 {
+		rotationTime := time.Now().Add(time.Duration(hours) * time.Hour)
+		d.Set("rotation_time", rotationTime.Format(time.RFC3339))
+	}
+
+	d.SetId("none")
+	d.Set("result", string(result))
+
+	return nil
+}
+
+func ReadPassword(d *schema.ResourceData, meta interface{}) error {
+	rotationTimeStr := d.Get("rotation_time").(string)
+	if rotationTimeStr == "" {
+		return nil
+	}
+
+	rotationTime, err := time.Parse(time.RFC3339, rotationTimeStr)
+	if err != nil {
+		// If the rotation time is invalid then we'll just throw away the
+		// whole thing so we can generate a new one.
+		d.SetId("")
+		return nil
+	}
+
+	if time.Now().After(rotationTime) {
+		// Treat a password which is due for rotation as not existing, so
+		// we'll generate a new one with the next plan.
+		d.SetId("")
+	}
+
+	return nil
+}
+
+// generateRandomBytes returns length characters chosen from charSet using a
+// cryptographic random number generator.
+func generateRandomBytes(charSet string, length int) ([]byte, error) {
+	bytes := make([]byte, length)
+	setLen := big.NewInt(int64(len(charSet)))
+	for i := range bytes {
+		idx, err := rand.Int(rand.Reader, setLen)
+		if err != nil {
+			return nil, fmt.Errorf("error generating random number: %s", err)
+		}
+		bytes[i] = charSet[idx.Int64()]
+	}
+	return bytes, nil
+}
@@ -0,0 +1,116 @@
+package random
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourcePassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourcePasswordConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourcePasswordCheck("random_password.foo"),
+					resource.TestMatchResourceAttr(
+						"random_password.foo", "rotation_time", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_minimumsTooLong(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccResourcePasswordConfig_minimumsTooLong,
+				ExpectError: regexp.MustCompile("less than the sum of the minimum character counts"),
+			},
+		},
+	})
+}
+
+func TestResourcePassword_validation(t *testing.T) {
+	s := resourcePassword().Schema
+
+	cases := []struct {
+		Key   string
+		Value interface{}
+		Err   bool
+	}{
+		{"length", 16, false},
+		{"length", 0, true},
+		{"min_upper", 0, false},
+		{"min_upper", -1, true},
+		{"min_lower", -1, true},
+		{"min_numeric", -1, true},
+		{"min_special", -1, true},
+		{"override_special", "!@#", false},
+		{"override_special", "!é#", true},
+		{"override_special", "\t", true},
+	}
+
+	for _, tc := range cases {
+		_, es := s[tc.Key].ValidateFunc(tc.Value, tc.Key)
+		if (len(es) > 0) != tc.Err {
+			t.Fatalf("%s = %#v: bad errors: %v", tc.Key, tc.Value, es)
+		}
+	}
+}
+
+func testAccResourcePasswordCheck(id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[id]
+		if !ok {
+			return fmt.Errorf("Not found: %s", id)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		result := rs.Primary.Attributes["result"]
+
+		if got, want := len(result), 16; got != want {
+			return fmt.Errorf("password length is %d; want %d", got, want)
+		}
+		if strings.ContainsAny(result, passwordSpecialChars) {
+			return fmt.Errorf("password %q contains special characters", result)
+		}
+		if got := len(regexp.MustCompile("[A-Z]").FindAllString(result, -1)); got < 4 {
+			return fmt.Errorf("password %q has %d upper case characters; want at least 4", result, got)
+		}
+		if got := len(regexp.MustCompile("[0-9]").FindAllString(result, -1)); got < 4 {
+			return fmt.Errorf("password %q has %d numeric characters; want at least 4", result, got)
+		}
+
+		return nil
+	}
+}
+
+const testAccResourcePasswordConfig = `
+resource "random_password" "foo" {
+    length = 16
+    special = false
+    min_upper = 4
+    min_numeric = 4
+    rotation_hours = 24
+}
+`
+
+const testAccResourcePasswordConfig_minimumsTooLong = `
+resource "random_password" "foo" {
+    length = 4
+    min_upper = 3
+    min_numeric = 3
+}
+`
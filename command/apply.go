@@ -40,15 +40,26 @@ func (c *ApplyCommand) Run(args []string) int {
 		cmdFlags.BoolVar(&destroyForce, "force", false, "force")
 	}
 	cmdFlags.BoolVar(&refresh, "refresh", true, "refresh")
+	cmdFlags.BoolVar(&c.Meta.allowDestroyTargets, "allow-destroy-targets", false, "allow-destroy-targets")
 	cmdFlags.IntVar(
 		&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.BoolVar(&c.Meta.json, "json", false, "json")
+	c.Meta.addStateLockFlags(cmdFlags)
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
+	c.Meta.processJSON()
+
+	if c.Destroy && c.Meta.json && !destroyForce {
+		c.Ui.Error(
+			"The -json flag requires -force with destroy, since the\n" +
+				"confirmation can't be asked for.")
+		return 1
+	}
 
 	pwd, err := os.Getwd()
 	if err != nil {
@@ -96,11 +107,13 @@ func (c *ApplyCommand) Run(args []string) int {
 	}
 
 	// Build the context based on the arguments given
+	defer c.Meta.unlockState()
 	ctx, planned, err := c.Context(contextOpts{
-		Destroy:     c.Destroy,
-		Path:        configPath,
-		StatePath:   c.Meta.statePath,
-		Parallelism: c.Meta.parallelism,
+		Destroy:       c.Destroy,
+		Path:          configPath,
+		StatePath:     c.Meta.statePath,
+		Parallelism:   c.Meta.parallelism,
+		LockOperation: cmdName,
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
@@ -232,6 +245,16 @@ func (c *ApplyCommand) Run(args []string) int {
 		return 1
 	}
 
+	if c.Meta.jsonUi != nil {
+		c.Meta.jsonUi.ChangeSummary(
+			cmdName, countHook.Added, countHook.Changed, countHook.Removed)
+		if !c.Destroy {
+			c.Meta.jsonUi.Outputs(state)
+		}
+
+		return 0
+	}
+
 	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
 		"[reset][bold][green]\n"+
 			"Apply complete! Resources: %d added, %d changed, %d destroyed.",
@@ -295,12 +318,23 @@ Usage: terraform apply [options] [DIR-OR-PLAN]
 
 Options:
 
+  -allow-destroy-targets Allow the resources given with -target to be
+                         destroyed even if they have prevent_destroy set.
+
   -backup=path           Path to backup the existing state file before
                          modifying. Defaults to the "-state-out" path with
                          ".backup" extension. Set to "-" to disable backup.
 
   -input=true            Ask for input for variables if not directly set.
 
+  -json                  If specified, the progress is output as
+                         newline-delimited JSON messages instead of
+                         human-readable text. This implies -input=false.
+
+  -lock=true             Lock the state file when locking is supported.
+
+  -lock-timeout=0s       Duration to retry a state lock.
+
   -no-color              If specified, output won't contain any color.
 
   -parallelism=n         Limit the number of concurrent operations.
@@ -340,12 +374,23 @@ Usage: terraform destroy [options] [DIR]
 
 Options:
 
+  -allow-destroy-targets Allow the resources given with -target to be
+                         destroyed even if they have prevent_destroy set.
+
   -backup=path           Path to backup the existing state file before
                          modifying. Defaults to the "-state-out" path with
                          ".backup" extension. Set to "-" to disable backup.
 
   -force                 Don't ask for input for destroy confirmation.
 
+  -json                  If specified, the progress is output as
+                         newline-delimited JSON messages instead of
+                         human-readable text. This requires -force.
+
+  -lock=true             Lock the state file when locking is supported.
+
+  -lock-timeout=0s       Duration to retry a state lock.
+
   -no-color              If specified, output won't contain any color.
 
   -parallelism=n         Limit the number of concurrent operations.
@@ -59,6 +59,102 @@ func TestApply(t *testing.T) {
 	}
 }
 
+func TestApply_json(t *testing.T) {
+	statePath := testTempFile(t)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		"-state", statePath,
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	var types []string
+	messages := testJSONMessages(t, ui.OutputWriter)
+	for _, m := range messages {
+		types = append(types, m.Type)
+	}
+	expected := []string{"apply_start", "apply_complete", "change_summary"}
+	if !reflect.DeepEqual(types, expected) {
+		t.Fatalf("bad: %#v", types)
+	}
+
+	if messages[0].Action != "create" ||
+		messages[0].Resource.Address != "test_instance.foo" {
+		t.Fatalf("bad: %#v", messages[0])
+	}
+
+	summary := &jsonChangeSummary{Operation: "apply", Add: 1}
+	if !reflect.DeepEqual(messages[2].Changes, summary) {
+		t.Fatalf("bad: %#v", messages[2].Changes)
+	}
+}
+
+func TestApply_lockedState(t *testing.T) {
+	statePath := testTempFile(t)
+
+	unlock := testLockState(t, statePath)
+	defer unlock()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code == 0 {
+		t.Fatal("expected error")
+	}
+
+	output := ui.ErrorWriter.String()
+	if !strings.Contains(output, "lock") {
+		t.Fatalf("bad: %s", output)
+	}
+}
+
+func TestApply_lockedStateDisabled(t *testing.T) {
+	statePath := testTempFile(t)
+
+	unlock := testLockState(t, statePath)
+	defer unlock()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-lock=false",
+		"-state", statePath,
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+}
+
 func TestApply_parallelism(t *testing.T) {
 	provider := testProvider()
 	statePath := testTempFile(t)
@@ -406,6 +502,39 @@ func TestApply_noArgs(t *testing.T) {
 	}
 }
 
+func TestApply_planWorkspace(t *testing.T) {
+	planPath := testPlanFile(t, &terraform.Plan{
+		Module:    testModule(t, "apply"),
+		Workspace: "foo",
+	})
+	statePath := testTempFile(t)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	// The plan was created in another workspace
+	args := []string{
+		"-state", statePath,
+		planPath,
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), `workspace "foo"`) {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+
+	if p.ApplyCalled {
+		t.Fatal("apply should not be called")
+	}
+}
+
 func TestApply_plan(t *testing.T) {
 	// Disable test mode so input would be asked
 	test = false
@@ -16,6 +16,18 @@ const DefaultDataDir = ".terraform"
 // DefaultStateFilename is the default filename used for the state file.
 const DefaultStateFilename = "terraform.tfstate"
 
+// DefaultWorkspaceDir is the directory holding the state files of the
+// workspaces other than the default one, next to the default state file.
+const DefaultWorkspaceDir = "terraform.tfstate.d"
+
+// DefaultWorkspaceFilename is the file in the data directory holding the
+// name of the selected workspace.
+const DefaultWorkspaceFilename = "workspace"
+
+// WorkspaceNameEnvVar is the environment variable that, if set, overrides
+// the selected workspace.
+const WorkspaceNameEnvVar = "TF_WORKSPACE"
+
 // DefaultVarsFilename is the default filename used for vars
 const DefaultVarsFilename = "terraform.tfvars"
 
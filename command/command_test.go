@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-getter"
 	"github.com/hashicorp/terraform/config/module"
 	"github.com/hashicorp/terraform/helper/logging"
+	"github.com/hashicorp/terraform/state"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -224,6 +225,22 @@ func testStateOutput(t *testing.T, path string, expected string) {
 	}
 }
 
+// testLockState locks the local state at the given path, as another
+// Terraform process would. The returned function unlocks it again.
+func testLockState(t *testing.T, path string) func() {
+	ls := &state.LocalState{Path: path}
+	id, err := ls.Lock(state.NewLockInfo())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return func() {
+		if err := ls.Unlock(id); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+}
+
 func testProvider() *terraform.MockResourceProvider {
 	p := new(terraform.MockResourceProvider)
 	p.DiffReturn = &terraform.InstanceDiff{}
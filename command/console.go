@@ -0,0 +1,175 @@
+package command
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/repl"
+	"github.com/mattn/go-isatty"
+)
+
+// ConsoleCommand is a Command implementation that starts an interactive
+// console to evaluate interpolations against the configuration and state.
+type ConsoleCommand struct {
+	Meta
+
+	// When this channel is closed, the console exits.
+	ShutdownCh <-chan struct{}
+}
+
+func (c *ConsoleCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+
+	cmdFlags := c.Meta.flagSet("console")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	var configPath string
+	args = cmdFlags.Args()
+	if len(args) > 1 {
+		c.Ui.Error("The console command expects at most one argument.")
+		cmdFlags.Usage()
+		return 1
+	} else if len(args) == 1 {
+		configPath = args[0]
+	} else {
+		var err error
+		configPath, err = os.Getwd()
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting pwd: %s", err))
+			return 1
+		}
+	}
+
+	// The state isn't locked, since the console never modifies it.
+	ctx, _, err := c.Context(contextOpts{
+		Path:      configPath,
+		StatePath: c.Meta.statePath,
+	})
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	if err := ctx.Input(c.InputMode()); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring: %s", err))
+		return 1
+	}
+
+	session := &repl.Session{
+		Interpolater: ctx.Interpolater(),
+	}
+
+	var r io.Reader = os.Stdin
+	if defaultInputReader != nil {
+		r = defaultInputReader
+	}
+
+	// Only show a prompt and continue after errors if a user is typing
+	// the input. Otherwise every line is evaluated and the first error
+	// stops the console.
+	interactive := false
+	if f, ok := r.(*os.File); ok {
+		interactive = isatty.IsTerminal(f.Fd())
+	}
+
+	return c.run(session, r, interactive)
+}
+
+func (c *ConsoleCommand) run(session *repl.Session, r io.Reader, interactive bool) int {
+	var w io.Writer = os.Stdout
+	if defaultInputWriter != nil {
+		w = defaultInputWriter
+	}
+
+	// Read the lines in the background so that we can be interrupted
+	// while waiting for input.
+	lineCh := make(chan string)
+	go func() {
+		defer close(lineCh)
+
+		s := bufio.NewScanner(r)
+		for s.Scan() {
+			lineCh <- s.Text()
+		}
+	}()
+
+	for {
+		if interactive {
+			fmt.Fprint(w, "> ")
+		}
+
+		var line string
+		var ok bool
+		select {
+		case line, ok = <-lineCh:
+		case <-c.ShutdownCh:
+			ok = false
+		}
+		if !ok {
+			if interactive {
+				fmt.Fprintln(w)
+			}
+			return 0
+		}
+
+		out, err := session.Handle(line)
+		if err == repl.ErrSessionExit {
+			return 0
+		}
+		if err != nil {
+			c.Ui.Error(err.Error())
+			if !interactive {
+				return 1
+			}
+
+			continue
+		}
+
+		if out != "" {
+			c.Ui.Output(out)
+		}
+	}
+}
+
+func (c *ConsoleCommand) Help() string {
+	helpText := `
+Usage: terraform console [options] [DIR]
+
+  Starts an interactive console for experimenting with Terraform
+  interpolations.
+
+  This will open an interactive console that you can use to type
+  interpolations into and inspect their values. This command loads the
+  current state. This lets you explore and test interpolations before
+  using them in future configurations.
+
+  This command will never modify your state.
+
+  If the input isn't a terminal, every line of it is evaluated and the
+  results are output. The first error exits the console with an exit
+  code of 1.
+
+Options:
+
+  -state=path       Path to read state. Defaults to "terraform.tfstate".
+
+  -var 'foo=bar'    Set a variable in the Terraform configuration. This
+                    flag can be set multiple times.
+
+  -var-file=foo     Set variables in the Terraform configuration from
+                    a file. If "terraform.tfvars" is present, it will be
+                    automatically loaded if this flag is not specified.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ConsoleCommand) Synopsis() string {
+	return "Interactive console for Terraform interpolations"
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestConsole_basic(t *testing.T) {
+	state := testState()
+	state.RootModule().Resources["test_instance.foo"].Primary.Attributes = map[string]string{
+		"id": "bar",
+	}
+	statePath := testStateFile(t, state)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	defaultInputReader = strings.NewReader(
+		"1 + 5\ncidrsubnet(var.cidr, 8, 2)\ntest_instance.foo.id\n")
+	defaultInputWriter = new(bytes.Buffer)
+	defer func() {
+		defaultInputReader = nil
+		defaultInputWriter = nil
+	}()
+
+	args := []string{
+		"-state", statePath,
+		testFixturePath("console"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	actual := ui.OutputWriter.String()
+	expected := "6\n10.0.2.0/24\nbar\n"
+	if actual != expected {
+		t.Fatalf("bad: %q", actual)
+	}
+}
+
+func TestConsole_error(t *testing.T) {
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	defaultInputReader = strings.NewReader("var.nope\n1 + 5\n")
+	defaultInputWriter = new(bytes.Buffer)
+	defer func() {
+		defaultInputReader = nil
+		defaultInputWriter = nil
+	}()
+
+	args := []string{testFixturePath("console")}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if ui.OutputWriter.Len() != 0 {
+		t.Fatalf("bad: %s", ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "nope") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+}
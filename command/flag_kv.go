@@ -115,8 +115,8 @@ func loadKVFile(rawPath string) (map[string]interface{}, error) {
 	return result, nil
 }
 
-// FlagStringSlice is a flag.Value implementation for parsing targets from the
-// command line, e.g. -target=aws_instance.foo -target=aws_vpc.bar
+// FlagStringSlice is a flag.Value implementation for parsing a list of
+// strings from the command line, e.g. -overwrite=foo -overwrite=bar
 
 type FlagStringSlice []string
 
@@ -129,6 +129,27 @@ func (v *FlagStringSlice) Set(raw string) error {
 	return nil
 }
 
+// FlagTargets is a flag.Value implementation for parsing targets from the
+// command line, e.g. -target=aws_instance.foo -target=module.bar,aws_vpc.baz
+// where one flag can hold several targets separated by commas.
+type FlagTargets []string
+
+func (v *FlagTargets) String() string {
+	return ""
+}
+
+func (v *FlagTargets) Set(raw string) error {
+	for _, target := range strings.Split(raw, ",") {
+		target = strings.TrimSpace(target)
+		if target == "" {
+			continue
+		}
+		*v = append(*v, target)
+	}
+
+	return nil
+}
+
 // parseVarFlagAsHCL parses the value of a single variable as would have been specified
 // on the command line via -var or in an environment variable named TF_VAR_x, where x is
 // the name of the variable. In order to get around the restriction of HCL requiring a
@@ -198,3 +198,43 @@ foo = "bar"
 		}
 	}
 }
+
+func TestFlagTargets_impl(t *testing.T) {
+	var _ flag.Value = new(FlagTargets)
+}
+
+func TestFlagTargets(t *testing.T) {
+	cases := []struct {
+		Input  []string
+		Output []string
+	}{
+		{
+			[]string{"aws_instance.foo"},
+			[]string{"aws_instance.foo"},
+		},
+
+		{
+			[]string{"aws_instance.foo", "module.bar"},
+			[]string{"aws_instance.foo", "module.bar"},
+		},
+
+		{
+			[]string{"aws_instance.foo, module.bar,", "aws_vpc.baz[*]"},
+			[]string{"aws_instance.foo", "module.bar", "aws_vpc.baz[*]"},
+		},
+	}
+
+	for _, tc := range cases {
+		f := new(FlagTargets)
+		for _, input := range tc.Input {
+			if err := f.Set(input); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+		}
+
+		actual := []string(*f)
+		if !reflect.DeepEqual(actual, tc.Output) {
+			t.Fatalf("bad: %#v", actual)
+		}
+	}
+}
@@ -1,13 +1,17 @@
 package command
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/hcl/hcl/fmtcmd"
+	"github.com/hashicorp/hcl/hcl/printer"
 	"github.com/mitchellh/cli"
 )
 
@@ -25,6 +29,8 @@ type FmtCommand struct {
 }
 
 func (c *FmtCommand) Run(args []string) int {
+	var check, recursive bool
+
 	if c.input == nil {
 		c.input = os.Stdin
 	}
@@ -35,6 +41,8 @@ func (c *FmtCommand) Run(args []string) int {
 	cmdFlags.BoolVar(&c.opts.List, "list", true, "list")
 	cmdFlags.BoolVar(&c.opts.Write, "write", true, "write")
 	cmdFlags.BoolVar(&c.opts.Diff, "diff", false, "diff")
+	cmdFlags.BoolVar(&check, "check", false, "check")
+	cmdFlags.BoolVar(&recursive, "recursive", false, "recursive")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 
 	if err := cmdFlags.Parse(args); err != nil {
@@ -48,26 +56,144 @@ func (c *FmtCommand) Run(args []string) int {
 		return 1
 	}
 
-	var dirs []string
-	if len(args) == 0 {
-		dirs = []string{"."}
-	} else if args[0] == stdinArg {
-		c.opts.List = false
+	// Checking never modifies the files
+	if check {
 		c.opts.Write = false
-	} else {
-		dirs = []string{args[0]}
 	}
 
 	output := &cli.UiWriter{Ui: c.Ui}
-	err := fmtcmd.Run(dirs, []string{fileExtension}, c.input, output, c.opts)
+
+	if len(args) == 1 && args[0] == stdinArg {
+		c.opts.List = false
+		c.opts.Write = false
+
+		src, err := ioutil.ReadAll(c.input)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error reading STDIN: %s", err))
+			return 2
+		}
+
+		// When checking, only the diff is output and not the formatted
+		// content.
+		if !check || c.opts.Diff {
+			err = fmtcmd.Run(nil, nil, bytes.NewReader(src), output, c.opts)
+			if err != nil {
+				c.Ui.Error(fmt.Sprintf("Error running fmt: %s", err))
+				return 2
+			}
+		}
+
+		if check {
+			return c.checkResult(map[string][]byte{stdinArg: src})
+		}
+
+		return 0
+	}
+
+	path := "."
+	if len(args) == 1 {
+		path = args[0]
+	}
+
+	files, err := fmtFiles(path, recursive)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error running fmt: %s", err))
 		return 2
 	}
 
+	// Read the files before they're rewritten so that the check can tell
+	// whether they were formatted.
+	srcs := make(map[string][]byte)
+	if check {
+		for _, f := range files {
+			src, err := ioutil.ReadFile(f)
+			if err != nil {
+				c.Ui.Error(fmt.Sprintf("Error running fmt: %s", err))
+				return 2
+			}
+
+			srcs[f] = src
+		}
+	}
+
+	// fmtcmd reads from STDIN if there are no paths, so don't call it
+	// at all if there are no files.
+	if len(files) > 0 {
+		err = fmtcmd.Run(files, []string{fileExtension}, nil, output, c.opts)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error running fmt: %s", err))
+			return 2
+		}
+	}
+
+	if check {
+		return c.checkResult(srcs)
+	}
+
 	return 0
 }
 
+// checkResult returns the exit code of a check of the given sources, which
+// is 3 if any of them isn't formatted.
+func (c *FmtCommand) checkResult(srcs map[string][]byte) int {
+	for name, src := range srcs {
+		res, err := printer.Format(src)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error running fmt: %s: %s", name, err))
+			return 2
+		}
+
+		if !bytes.Equal(src, res) {
+			return 3
+		}
+	}
+
+	return 0
+}
+
+// fmtFiles returns the configuration files to format for the given path.
+// If the path is a file, only that file is formatted. If it is a directory,
+// the configuration files in it are formatted, along with the files in
+// its subdirectories if recursive is true. Hidden files and directories,
+// such as the ".terraform" directory with the downloaded modules, are
+// skipped.
+func fmtFiles(path string, recursive bool) ([]string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.Walk(path, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		hidden := p != path && strings.HasPrefix(fi.Name(), ".")
+		if fi.IsDir() {
+			if p != path && (!recursive || hidden) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !hidden && strings.HasSuffix(fi.Name(), "."+fileExtension) {
+			files = append(files, p)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
 func (c *FmtCommand) Help() string {
 	helpText := `
 Usage: terraform fmt [options] [DIR]
@@ -77,14 +203,25 @@ Usage: terraform fmt [options] [DIR]
 	If DIR is not specified then the current working directory will be used.
 	If DIR is "-" then content will be read from STDIN.
 
+	The canonical format aligns the equals signs of consecutive attributes
+	and normalizes the indentation and spacing. Only the files in DIR are
+	formatted unless -recursive is given.
+
 Options:
 
   -list=true       List files whose formatting differs (always false if using STDIN)
 
-  -write=true      Write result to source file instead of STDOUT (always false if using STDIN)
+  -write=true      Write result to source file instead of STDOUT (always false if using STDIN or -check)
 
   -diff=false      Display diffs of formatting changes
 
+  -check=false     Check if the input is formatted. The exit code is 0 if
+                   all of it is formatted, and 3 otherwise. Files are never
+                   rewritten when checking.
+
+  -recursive=false Also format the files in the subdirectories of DIR, such
+                   as local modules. Hidden directories are skipped.
+
 `
 	return strings.TrimSpace(helpText)
 }
@@ -179,6 +179,163 @@ func TestFmt_nonDefaultOptions(t *testing.T) {
 	}
 }
 
+func TestFmt_check(t *testing.T) {
+	tempDir, err := fmtFixtureWriteDir()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	ui := new(cli.MockUi)
+	c := &FmtCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-check", tempDir}
+	if code := c.Run(args); code != 3 {
+		t.Fatalf("wrong exit code %d. errors: \n%s", code, ui.ErrorWriter.String())
+	}
+
+	expected := fmt.Sprintf("%s\n", filepath.Join(tempDir, fmtFixture.filename))
+	if actual := ui.OutputWriter.String(); actual != expected {
+		t.Fatalf("got: %q\nexpected: %q", actual, expected)
+	}
+
+	// The file must not have been rewritten
+	actual, err := ioutil.ReadFile(filepath.Join(tempDir, fmtFixture.filename))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, fmtFixture.input) {
+		t.Fatalf("file was modified: %q", actual)
+	}
+
+	// Once formatted, the check passes
+	ui = new(cli.MockUi)
+	c = &FmtCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+	if code := c.Run([]string{tempDir}); code != 0 {
+		t.Fatalf("wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+	}
+
+	ui = new(cli.MockUi)
+	c = &FmtCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+	if code := c.Run([]string{"-check", tempDir}); code != 0 {
+		t.Fatalf("wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+	}
+	if actual := ui.OutputWriter.String(); strings.Contains(actual, fmtFixture.filename) {
+		t.Fatalf("bad: %q", actual)
+	}
+}
+
+func TestFmt_checkStdin(t *testing.T) {
+	input := new(bytes.Buffer)
+	input.Write(fmtFixture.input)
+
+	ui := new(cli.MockUi)
+	c := &FmtCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+		input: input,
+	}
+
+	args := []string{"-check", "-"}
+	if code := c.Run(args); code != 3 {
+		t.Fatalf("wrong exit code %d. errors: \n%s", code, ui.ErrorWriter.String())
+	}
+	if actual := ui.OutputWriter.String(); strings.Contains(actual, string(fmtFixture.golden)) {
+		t.Fatalf("formatted content should not be output: %q", actual)
+	}
+}
+
+func TestFmt_recursive(t *testing.T) {
+	tempDir, err := fmtFixtureWriteDir()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// A module in a subdirectory, and a downloaded module in a hidden
+	// directory that must never be formatted.
+	modDir := filepath.Join(tempDir, "modules", "foo")
+	hiddenDir := filepath.Join(tempDir, ".terraform", "modules", "bar")
+	for _, dir := range []string{modDir, hiddenDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		err := ioutil.WriteFile(filepath.Join(dir, fmtFixture.filename), fmtFixture.input, 0644)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	// Without -recursive, only the files of the directory are formatted
+	ui := new(cli.MockUi)
+	c := &FmtCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+	if code := c.Run([]string{"-write=false", tempDir}); code != 0 {
+		t.Fatalf("wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+	}
+
+	expected := fmt.Sprintf("%s\n", filepath.Join(tempDir, fmtFixture.filename))
+	if actual := ui.OutputWriter.String(); actual != expected {
+		t.Fatalf("got: %q\nexpected: %q", actual, expected)
+	}
+
+	ui = new(cli.MockUi)
+	c = &FmtCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+	if code := c.Run([]string{"-recursive", tempDir}); code != 0 {
+		t.Fatalf("wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+	}
+
+	expected = fmt.Sprintf(
+		"%s\n%s\n",
+		filepath.Join(tempDir, fmtFixture.filename),
+		filepath.Join(modDir, fmtFixture.filename))
+	if actual := ui.OutputWriter.String(); actual != expected {
+		t.Fatalf("got: %q\nexpected: %q", actual, expected)
+	}
+
+	actual, err := ioutil.ReadFile(filepath.Join(modDir, fmtFixture.filename))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, fmtFixture.golden) {
+		t.Fatalf("bad: %q", actual)
+	}
+
+	actual, err = ioutil.ReadFile(filepath.Join(hiddenDir, fmtFixture.filename))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, fmtFixture.input) {
+		t.Fatalf("hidden directory was formatted: %q", actual)
+	}
+}
+
 var fmtFixture = struct {
 	filename      string
 	input, golden []byte
@@ -0,0 +1,381 @@
+package command
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// jsonFormatVersion is the version of the JSON representation of plans and
+// states output by `terraform show -json`. The minor version is incremented
+// when fields are added, and the major version when the representation
+// changes in a way that isn't backward compatible.
+const jsonFormatVersion = "1.0"
+
+// jsonPlan is the JSON representation of a plan.
+type jsonPlan struct {
+	FormatVersion    string               `json:"format_version"`
+	TerraformVersion string               `json:"terraform_version"`
+	Workspace        string               `json:"workspace,omitempty"`
+	Targets          []string             `json:"targets,omitempty"`
+	ResourceChanges  []jsonResourceChange `json:"resource_changes"`
+	PriorState       *jsonState           `json:"prior_state,omitempty"`
+}
+
+// jsonResourceChange is the planned change of a resource instance.
+type jsonResourceChange struct {
+	jsonResourceAddress
+	Change jsonChange `json:"change"`
+
+	// Deposed is set if the deposed objects of the resource, which are
+	// left over from a create_before_destroy replacement, are destroyed.
+	// If only those are destroyed, Actions is ["delete"] and After is the
+	// same as Before.
+	Deposed bool `json:"deposed,omitempty"`
+}
+
+// jsonChange describes a change. Attribute values are flattened like in
+// the state, and Before and After are null when the resource doesn't exist
+// before or after the change.
+type jsonChange struct {
+	// Actions is one of ["create"], ["read"], ["update"], ["delete"] or
+	// ["delete", "create"] when the resource is replaced.
+	Actions []string `json:"actions"`
+
+	Before map[string]string `json:"before"`
+	After  map[string]string `json:"after"`
+
+	// AfterUnknown lists the attributes whose values are only known after
+	// the change is applied, which aren't in After.
+	AfterUnknown []string `json:"after_unknown"`
+
+	// RequiresReplace lists the changed attributes that cause the resource
+	// to be replaced.
+	RequiresReplace []string `json:"requires_replace"`
+
+	// Sensitive lists the attributes that are sensitive. The messages
+	// streamed by commands run with -json leave their values out of Before
+	// and After.
+	Sensitive []string `json:"sensitive"`
+}
+
+// redactSensitive leaves the values of the sensitive attributes out of
+// Before and After. The maps are copied, since they may belong to a state.
+func (c *jsonChange) redactSensitive() {
+	c.Sensitive = uniqueSortedStrings(c.Sensitive)
+	if len(c.Sensitive) == 0 {
+		return
+	}
+
+	redact := func(attrs map[string]string) map[string]string {
+		if attrs == nil {
+			return nil
+		}
+
+		result := make(map[string]string, len(attrs))
+		for k, v := range attrs {
+			result[k] = v
+		}
+		for _, k := range c.Sensitive {
+			delete(result, k)
+		}
+
+		return result
+	}
+
+	c.Before = redact(c.Before)
+	c.After = redact(c.After)
+}
+
+// uniqueSortedStrings returns the given strings sorted and without
+// duplicates. It never returns nil, so that the result is encoded as an
+// empty list.
+func uniqueSortedStrings(vs []string) []string {
+	result := make([]string, 0, len(vs))
+	seen := make(map[string]struct{}, len(vs))
+	for _, v := range vs {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// jsonState is the JSON representation of a state.
+type jsonState struct {
+	FormatVersion    string                `json:"format_version"`
+	TerraformVersion string                `json:"terraform_version"`
+	Serial           int64                 `json:"serial"`
+	Lineage          string                `json:"lineage,omitempty"`
+	Outputs          map[string]jsonOutput `json:"outputs"`
+	Resources        []jsonResource        `json:"resources"`
+}
+
+// jsonOutput is an output of the root module.
+type jsonOutput struct {
+	Sensitive bool        `json:"sensitive"`
+	Type      string      `json:"type"`
+	Value     interface{} `json:"value"`
+}
+
+// jsonResource is a resource instance in the state.
+type jsonResource struct {
+	jsonResourceAddress
+	ID         string            `json:"id"`
+	Tainted    bool              `json:"tainted,omitempty"`
+	Attributes map[string]string `json:"attributes"`
+
+	// SensitiveAttributes lists the attributes that are sensitive. Their
+	// values are still in Attributes.
+	SensitiveAttributes []string `json:"sensitive_attributes,omitempty"`
+}
+
+// jsonResourceAddress identifies a resource instance.
+type jsonResourceAddress struct {
+	Address       string `json:"address"`
+	ModuleAddress string `json:"module_address,omitempty"`
+	Mode          string `json:"mode"`
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	Index         *int   `json:"index,omitempty"`
+}
+
+// FormatPlanJSON returns the JSON representation of the given plan.
+func FormatPlanJSON(p *terraform.Plan) ([]byte, error) {
+	result := &jsonPlan{
+		FormatVersion:    jsonFormatVersion,
+		TerraformVersion: terraform.VersionString(),
+		Workspace:        p.Workspace,
+		Targets:          p.Targets,
+		ResourceChanges:  make([]jsonResourceChange, 0),
+	}
+
+	if p.State != nil {
+		result.PriorState = newJSONState(p.State)
+	}
+
+	if p.Diff != nil {
+		for _, m := range p.Diff.Modules {
+			changes, err := newJSONResourceChanges(m, p.State)
+			if err != nil {
+				return nil, err
+			}
+			result.ResourceChanges = append(result.ResourceChanges, changes...)
+		}
+	}
+
+	sort.Sort(jsonResourceChangesByAddress(result.ResourceChanges))
+
+	return json.MarshalIndent(result, "", "  ")
+}
+
+// FormatStateJSON returns the JSON representation of the given state.
+func FormatStateJSON(s *terraform.State) ([]byte, error) {
+	return json.MarshalIndent(newJSONState(s), "", "  ")
+}
+
+func newJSONState(s *terraform.State) *jsonState {
+	result := &jsonState{
+		FormatVersion:    jsonFormatVersion,
+		TerraformVersion: terraform.VersionString(),
+		Serial:           s.Serial,
+		Lineage:          s.Lineage,
+		Outputs:          make(map[string]jsonOutput),
+		Resources:        make([]jsonResource, 0),
+	}
+
+	for _, m := range s.Modules {
+		if m.IsRoot() {
+			for name, output := range m.Outputs {
+				result.Outputs[name] = jsonOutput{
+					Sensitive: output.Sensitive,
+					Type:      output.Type,
+					Value:     output.Value,
+				}
+			}
+		}
+
+		for key, r := range m.Resources {
+			if r.Primary == nil {
+				continue
+			}
+
+			addr, err := newJSONResourceAddress(m.Path, key)
+			if err != nil {
+				continue
+			}
+
+			attrs := r.Primary.Attributes
+			if attrs == nil {
+				attrs = make(map[string]string)
+			}
+
+			result.Resources = append(result.Resources, jsonResource{
+				jsonResourceAddress: addr,
+				ID:                  r.Primary.ID,
+				Tainted:             r.Primary.Tainted,
+				Attributes:          attrs,
+				SensitiveAttributes: r.Primary.SensitiveAttributes,
+			})
+		}
+	}
+
+	sort.Sort(jsonResourcesByAddress(result.Resources))
+	return result
+}
+
+func newJSONResourceChanges(m *terraform.ModuleDiff, s *terraform.State) ([]jsonResourceChange, error) {
+	var stateModule *terraform.ModuleState
+	if s != nil {
+		stateModule = s.ModuleByPath(m.Path)
+	}
+
+	var result []jsonResourceChange
+	for key, rdiff := range m.Resources {
+		if rdiff.Empty() {
+			continue
+		}
+
+		addr, err := newJSONResourceAddress(m.Path, key)
+		if err != nil {
+			return nil, err
+		}
+
+		var before map[string]string
+		sensitive := make([]string, 0)
+		if stateModule != nil {
+			if r, ok := stateModule.Resources[key]; ok && r.Primary != nil {
+				before = r.Primary.Attributes
+				sensitive = append(sensitive, r.Primary.SensitiveAttributes...)
+			}
+		}
+
+		change := jsonChange{
+			Before:          before,
+			AfterUnknown:    make([]string, 0),
+			RequiresReplace: make([]string, 0),
+			Sensitive:       sensitive,
+		}
+
+		switch rdiff.ChangeType() {
+		case terraform.DiffCreate:
+			change.Actions = []string{"create"}
+			if addr.Mode == "data" {
+				change.Actions = []string{"read"}
+			}
+			change.Before = nil
+		case terraform.DiffUpdate:
+			change.Actions = []string{"update"}
+		case terraform.DiffDestroy:
+			change.Actions = []string{"delete"}
+		case terraform.DiffDestroyCreate:
+			change.Actions = []string{"delete", "create"}
+		default:
+			continue
+		}
+
+		deposedOnly := rdiff.DestroyDeposed && !rdiff.Destroy
+		if change.Actions[0] != "delete" || len(change.Actions) > 1 || deposedOnly {
+			change.After = make(map[string]string)
+			for k, v := range change.Before {
+				change.After[k] = v
+			}
+
+			attrs := rdiff.CopyAttributes()
+			names := make([]string, 0, len(attrs))
+			for name := range attrs {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				attr := attrs[name]
+				switch {
+				case attr.NewRemoved:
+					delete(change.After, name)
+				case attr.NewComputed:
+					delete(change.After, name)
+					change.AfterUnknown = append(change.AfterUnknown, name)
+				default:
+					change.After[name] = attr.New
+				}
+
+				if attr.RequiresNew {
+					change.RequiresReplace = append(change.RequiresReplace, name)
+				}
+				if attr.Sensitive {
+					change.Sensitive = append(change.Sensitive, name)
+				}
+			}
+		}
+		change.Sensitive = uniqueSortedStrings(change.Sensitive)
+
+		result = append(result, jsonResourceChange{
+			jsonResourceAddress: addr,
+			Change:              change,
+			Deposed:             rdiff.DestroyDeposed,
+		})
+	}
+
+	return result, nil
+}
+
+// newJSONResourceAddress returns the address of the resource with the
+// given key in the module with the given path.
+func newJSONResourceAddress(path []string, key string) (jsonResourceAddress, error) {
+	k, err := terraform.ParseResourceStateKey(key)
+	if err != nil {
+		return jsonResourceAddress{}, err
+	}
+
+	addr := &terraform.ResourceAddress{
+		Path:  path[1:],
+		Mode:  k.Mode,
+		Type:  k.Type,
+		Name:  k.Name,
+		Index: k.Index,
+	}
+
+	result := jsonResourceAddress{
+		Address: addr.String(),
+		Mode:    "managed",
+		Type:    k.Type,
+		Name:    k.Name,
+	}
+
+	if len(addr.Path) > 0 {
+		result.ModuleAddress = (&terraform.ResourceAddress{Path: addr.Path, Index: -1}).String()
+	}
+	if k.Mode == config.DataResourceMode {
+		result.Mode = "data"
+	}
+	if k.Index >= 0 {
+		index := k.Index
+		result.Index = &index
+	}
+
+	return result, nil
+}
+
+type jsonResourceChangesByAddress []jsonResourceChange
+
+func (s jsonResourceChangesByAddress) Len() int      { return len(s) }
+func (s jsonResourceChangesByAddress) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s jsonResourceChangesByAddress) Less(i, j int) bool {
+	return s[i].Address < s[j].Address
+}
+
+type jsonResourcesByAddress []jsonResource
+
+func (s jsonResourcesByAddress) Len() int      { return len(s) }
+func (s jsonResourcesByAddress) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s jsonResourcesByAddress) Less(i, j int) bool {
+	return s[i].Address < s[j].Address
+}
@@ -0,0 +1,234 @@
+package command
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestFormatPlanJSON(t *testing.T) {
+	state := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root", "child"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.update": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "foo",
+							Attributes: map[string]string{
+								"id":     "foo",
+								"ami":    "ami-1",
+								"tags.%": "0",
+							},
+						},
+					},
+					"test_instance.replace.1": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "bar",
+							Attributes: map[string]string{
+								"id":  "bar",
+								"ami": "ami-1",
+							},
+						},
+					},
+					"test_instance.delete": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "baz",
+							Attributes: map[string]string{
+								"id": "baz",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diff := &terraform.Diff{
+		Modules: []*terraform.ModuleDiff{
+			&terraform.ModuleDiff{
+				Path: []string{"root", "child"},
+				Resources: map[string]*terraform.InstanceDiff{
+					"test_instance.update": &terraform.InstanceDiff{
+						Attributes: map[string]*terraform.ResourceAttrDiff{
+							"ami": &terraform.ResourceAttrDiff{
+								Old: "ami-1",
+								New: "ami-2",
+							},
+							"tags.%": &terraform.ResourceAttrDiff{
+								Old:        "0",
+								NewRemoved: true,
+							},
+						},
+					},
+					"test_instance.replace.1": &terraform.InstanceDiff{
+						Destroy: true,
+						Attributes: map[string]*terraform.ResourceAttrDiff{
+							"ami": &terraform.ResourceAttrDiff{
+								Old:         "ami-1",
+								New:         "ami-2",
+								RequiresNew: true,
+							},
+							"id": &terraform.ResourceAttrDiff{
+								Old:         "bar",
+								NewComputed: true,
+							},
+						},
+					},
+					"test_instance.delete": &terraform.InstanceDiff{
+						Destroy: true,
+					},
+					"data.test_data.read": &terraform.InstanceDiff{
+						Attributes: map[string]*terraform.ResourceAttrDiff{
+							"id": &terraform.ResourceAttrDiff{
+								NewComputed: true,
+								RequiresNew: true,
+							},
+							"password": &terraform.ResourceAttrDiff{
+								New:       "secret",
+								Sensitive: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	data, err := FormatPlanJSON(&terraform.Plan{
+		Diff:  diff,
+		State: state,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var actual jsonPlan
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, data)
+	}
+
+	if actual.FormatVersion != jsonFormatVersion {
+		t.Fatalf("bad: %s", actual.FormatVersion)
+	}
+
+	index := 1
+	expected := []jsonResourceChange{
+		{
+			jsonResourceAddress: jsonResourceAddress{
+				Address:       "module.child.data.test_data.read",
+				ModuleAddress: "module.child",
+				Mode:          "data",
+				Type:          "test_data",
+				Name:          "read",
+			},
+			Change: jsonChange{
+				Actions:         []string{"read"},
+				After:           map[string]string{"password": "secret"},
+				AfterUnknown:    []string{"id"},
+				RequiresReplace: []string{"id"},
+				Sensitive:       []string{"password"},
+			},
+		},
+		{
+			jsonResourceAddress: jsonResourceAddress{
+				Address:       "module.child.test_instance.delete",
+				ModuleAddress: "module.child",
+				Mode:          "managed",
+				Type:          "test_instance",
+				Name:          "delete",
+			},
+			Change: jsonChange{
+				Actions:         []string{"delete"},
+				Before:          map[string]string{"id": "baz"},
+				AfterUnknown:    []string{},
+				RequiresReplace: []string{},
+				Sensitive:       []string{},
+			},
+		},
+		{
+			jsonResourceAddress: jsonResourceAddress{
+				Address:       "module.child.test_instance.replace[1]",
+				ModuleAddress: "module.child",
+				Mode:          "managed",
+				Type:          "test_instance",
+				Name:          "replace",
+				Index:         &index,
+			},
+			Change: jsonChange{
+				Actions:         []string{"delete", "create"},
+				Before:          map[string]string{"id": "bar", "ami": "ami-1"},
+				After:           map[string]string{"ami": "ami-2"},
+				AfterUnknown:    []string{"id"},
+				RequiresReplace: []string{"ami"},
+				Sensitive:       []string{},
+			},
+		},
+		{
+			jsonResourceAddress: jsonResourceAddress{
+				Address:       "module.child.test_instance.update",
+				ModuleAddress: "module.child",
+				Mode:          "managed",
+				Type:          "test_instance",
+				Name:          "update",
+			},
+			Change: jsonChange{
+				Actions:         []string{"update"},
+				Before:          map[string]string{"id": "foo", "ami": "ami-1", "tags.%": "0"},
+				After:           map[string]string{"id": "foo", "ami": "ami-2"},
+				AfterUnknown:    []string{},
+				RequiresReplace: []string{},
+				Sensitive:       []string{},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(actual.ResourceChanges, expected) {
+		t.Fatalf("expected:\n%#v\n\ngot:\n%#v", expected, actual.ResourceChanges)
+	}
+}
+
+func TestFormatStateJSON(t *testing.T) {
+	state := testState()
+	state.RootModule().Outputs["foo"] = &terraform.OutputState{
+		Type:      "string",
+		Value:     "bar",
+		Sensitive: true,
+	}
+
+	data, err := FormatStateJSON(state)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var actual jsonState
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, data)
+	}
+
+	expected := []jsonResource{
+		{
+			jsonResourceAddress: jsonResourceAddress{
+				Address: "test_instance.foo",
+				Mode:    "managed",
+				Type:    "test_instance",
+				Name:    "foo",
+			},
+			ID:         "bar",
+			Attributes: map[string]string{},
+		},
+	}
+	if !reflect.DeepEqual(actual.Resources, expected) {
+		t.Fatalf("expected:\n%#v\n\ngot:\n%#v", expected, actual.Resources)
+	}
+
+	output := actual.Outputs["foo"]
+	if !output.Sensitive || output.Value != "bar" {
+		t.Fatalf("bad: %#v", actual.Outputs)
+	}
+}
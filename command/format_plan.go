@@ -118,6 +118,9 @@ func formatPlanModuleExpand(
 		if rdiff.DestroyTainted {
 			taintStr = " (tainted)"
 		}
+		if rdiff.DestroyDeposed {
+			taintStr += " (deposed)"
+		}
 
 		buf.WriteString(opts.Color.Color(fmt.Sprintf(
 			"[%s]%s %s%s\n",
@@ -147,6 +150,12 @@ func formatPlanModuleExpand(
 			v := attrDiff.New
 			if attrDiff.NewComputed {
 				v = "<computed>"
+
+				// Data resources with computed values are read during
+				// apply, so make it clear when their values are known.
+				if dataSource {
+					v = "(known after apply)"
+				}
 			}
 
 			if attrDiff.Sensitive {
@@ -89,3 +89,49 @@ func TestFormatPlan_nestedDataSource(t *testing.T) {
 		t.Fatalf("expected:\n\n%s\n\ngot:\n\n%s", expected, actual)
 	}
 }
+
+// Test that the computed values of a data source read during apply are
+// shown as known after apply
+func TestFormatPlan_deferredDataSource(t *testing.T) {
+	plan := &terraform.Plan{
+		Diff: &terraform.Diff{
+			Modules: []*terraform.ModuleDiff{
+				&terraform.ModuleDiff{
+					Path: []string{"root"},
+					Resources: map[string]*terraform.InstanceDiff{
+						"data.type.name": &terraform.InstanceDiff{
+							Attributes: map[string]*terraform.ResourceAttrDiff{
+								"A": &terraform.ResourceAttrDiff{
+									New:         "B",
+									RequiresNew: true,
+								},
+								"C": &terraform.ResourceAttrDiff{
+									NewComputed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	opts := &FormatPlanOpts{
+		Plan: plan,
+		Color: &colorstring.Colorize{
+			Colors:  colorstring.DefaultColors,
+			Disable: true,
+		},
+		ModuleDepth: 1,
+	}
+
+	actual := FormatPlan(opts)
+
+	expected := strings.TrimSpace(`
+ <= data.type.name
+    A: "B"
+    C: "(known after apply)"
+	`)
+	if actual != expected {
+		t.Fatalf("expected:\n\n%s\n\ngot:\n\n%s", expected, actual)
+	}
+}
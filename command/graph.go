@@ -19,6 +19,9 @@ func (c *GraphCommand) Run(args []string) int {
 	var moduleDepth int
 	var verbose bool
 	var drawCycles bool
+	var graphType string
+	var jsonOutput bool
+	var statePath string
 
 	args = c.Meta.process(args, false)
 
@@ -26,6 +29,9 @@ func (c *GraphCommand) Run(args []string) int {
 	c.addModuleDepthFlag(cmdFlags, &moduleDepth)
 	cmdFlags.BoolVar(&verbose, "verbose", false, "verbose")
 	cmdFlags.BoolVar(&drawCycles, "draw-cycles", false, "draw-cycles")
+	cmdFlags.StringVar(&graphType, "type", "", "type")
+	cmdFlags.StringVar(&statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -47,15 +53,41 @@ func (c *GraphCommand) Run(args []string) int {
 		}
 	}
 
-	ctx, _, err := c.Context(contextOpts{
-		Path:      path,
-		StatePath: "",
-	})
+	// The configuration graph is built without state. The plan graphs
+	// include what is in the state, like orphaned resources.
+	ctxOpts := contextOpts{Path: path}
+	switch graphType {
+	case "", "apply":
+	case "plan":
+		ctxOpts.StatePath = statePath
+	case "plan-destroy":
+		ctxOpts.StatePath = statePath
+		ctxOpts.Destroy = true
+	default:
+		c.Ui.Error(fmt.Sprintf(
+			"Invalid graph type %q. Valid types are \"plan\", \"plan-destroy\"\n"+
+				"and \"apply\".", graphType))
+		return 1
+	}
+
+	ctx, planned, err := c.Context(ctxOpts)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error loading Terraform: %s", err))
 		return 1
 	}
 
+	// The graph of a plan file is the graph that applies it, and applying
+	// needs a plan.
+	if planned && graphType != "" && graphType != "apply" {
+		c.Ui.Error(fmt.Sprintf(
+			"A plan file can only be shown as an \"apply\" graph, not %q.", graphType))
+		return 1
+	}
+	if !planned && graphType == "apply" {
+		c.Ui.Error("The \"apply\" graph requires a plan file.")
+		return 1
+	}
+
 	// Skip validation during graph generation - we want to see the graph even if
 	// it is invalid for some reason.
 	g, err := ctx.Graph(&terraform.ContextGraphOpts{
@@ -67,11 +99,18 @@ func (c *GraphCommand) Run(args []string) int {
 		return 1
 	}
 
-	graphStr, err := terraform.GraphDot(g, &terraform.GraphDotOpts{
+	opts := &terraform.GraphDotOpts{
 		DrawCycles: drawCycles,
 		MaxDepth:   moduleDepth,
 		Verbose:    verbose,
-	})
+	}
+
+	var graphStr string
+	if jsonOutput {
+		graphStr, err = terraform.GraphJSON(g, opts)
+	} else {
+		graphStr, err = terraform.GraphDot(g, opts)
+	}
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error converting graph: %s", err))
 		return 1
@@ -89,6 +128,8 @@ Usage: terraform graph [options] [DIR]
   Outputs the visual dependency graph of Terraform resources according to
   configuration files in DIR (or the current directory if omitted).
 
+  If DIR is a plan file, the graph that applies the plan is output.
+
   The graph is outputted in DOT format. The typical program that can
   read this format is GraphViz, but many web services are also available
   to read this format.
@@ -98,9 +139,22 @@ Options:
   -draw-cycles         Highlight any cycles in the graph with colored edges.
                        This helps when diagnosing cycle errors.
 
+  -json                Output the graph as JSON instead, with the nodes of the
+                       graph, what each of them depends on and the cycles in
+                       the graph.
+
   -module-depth=n      The maximum depth to expand modules. By default this is
                        -1, which will expand resources within all modules.
 
+  -state=path          Path to the state file to build the plan graphs with.
+                       Defaults to "terraform.tfstate".
+
+  -type=plan           Type of graph to output. Can be "plan", which includes
+                       the resources in the state, "plan-destroy" or "apply".
+                       The "apply" graph requires a plan file. By default the
+                       graph of the configuration alone is output, or the
+                       "apply" graph if DIR is a plan file.
+
   -verbose             Generate a verbose, "worst-case" graph, with all nodes
                        for potential operations in place.
 
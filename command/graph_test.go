@@ -1,7 +1,9 @@
 package command
 
 import (
+	"encoding/json"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -103,3 +105,113 @@ func TestGraph_plan(t *testing.T) {
 		t.Fatalf("doesn't look like digraph: %s", output)
 	}
 }
+
+func TestGraph_json(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var actual struct {
+		Nodes []struct {
+			ID           string   `json:"id"`
+			Dependencies []string `json:"dependencies"`
+		} `json:"nodes"`
+	}
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	deps := make(map[string][]string)
+	for _, n := range actual.Nodes {
+		deps[n.ID] = n.Dependencies
+	}
+
+	expected := []string{"[root] provider.test"}
+	if !reflect.DeepEqual(deps["[root] test_instance.foo"], expected) {
+		t.Fatalf("bad: %#v", actual.Nodes)
+	}
+}
+
+func TestGraph_typePlan(t *testing.T) {
+	state := testState()
+	state.RootModule().Resources["test_instance.orphan"] = &terraform.ResourceState{
+		Type: "test_instance",
+		Primary: &terraform.InstanceState{
+			ID: "baz",
+		},
+	}
+	statePath := testStateFile(t, state)
+
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	// Without a type, the state isn't used
+	args := []string{
+		"-state", statePath,
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+	if output := ui.OutputWriter.String(); strings.Contains(output, "test_instance.orphan") {
+		t.Fatalf("config graph includes orphan: %s", output)
+	}
+
+	ui = new(cli.MockUi)
+	c = &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args = []string{
+		"-type", "plan",
+		"-state", statePath,
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+	if output := ui.OutputWriter.String(); !strings.Contains(output, "test_instance.orphan") {
+		t.Fatalf("plan graph doesn't include orphan: %s", output)
+	}
+}
+
+func TestGraph_typeApplyNoPlan(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-type", "apply",
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: \n%s", ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "requires a plan file") {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+}
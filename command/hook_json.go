@@ -0,0 +1,220 @@
+package command
+
+import (
+	"bufio"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// JSONHook is a hook that reports the progress of an operation as JSON
+// messages, for commands that are run with -json. It is used instead of
+// the UiHook.
+type JSONHook struct {
+	terraform.NilHook
+
+	Ui *JSONUi
+
+	l         sync.Mutex
+	applying  map[string]time.Time
+	refreshed map[string]*terraform.InstanceState
+}
+
+func (h *JSONHook) PreApply(
+	n *terraform.InstanceInfo,
+	s *terraform.InstanceState,
+	d *terraform.InstanceDiff) (terraform.HookAction, error) {
+	id := n.HumanId()
+
+	action := "update"
+	if d.Destroy {
+		action = "delete"
+	} else if s == nil || s.ID == "" {
+		action = "create"
+	}
+
+	h.l.Lock()
+	if h.applying == nil {
+		h.applying = make(map[string]time.Time)
+	}
+	h.applying[id] = time.Now()
+	h.l.Unlock()
+
+	m := h.message(n, "apply_start", fmt.Sprintf("%s: Starting %s", id, action))
+	m.Action = action
+	if s != nil {
+		m.ID = s.ID
+	}
+	h.Ui.emit(m)
+
+	return terraform.HookActionContinue, nil
+}
+
+func (h *JSONHook) PostApply(
+	n *terraform.InstanceInfo,
+	s *terraform.InstanceState,
+	applyerr error) (terraform.HookAction, error) {
+	id := n.HumanId()
+
+	h.l.Lock()
+	start, ok := h.applying[id]
+	delete(h.applying, id)
+	h.l.Unlock()
+
+	var m *jsonMessage
+	if applyerr != nil {
+		m = h.message(n, "apply_errored", fmt.Sprintf("%s: %s", id, applyerr))
+	} else {
+		m = h.message(n, "apply_complete", fmt.Sprintf("%s: Complete", id))
+		if s != nil {
+			m.ID = s.ID
+		}
+	}
+	if ok {
+		elapsed := int(time.Since(start).Seconds())
+		m.Elapsed = &elapsed
+	}
+	h.Ui.emit(m)
+
+	return terraform.HookActionContinue, nil
+}
+
+func (h *JSONHook) PreProvision(
+	n *terraform.InstanceInfo,
+	provId string) (terraform.HookAction, error) {
+	m := h.message(n, "provision_start", fmt.Sprintf(
+		"%s: Provisioning with '%s'", n.HumanId(), provId))
+	m.Provisioner = provId
+	h.Ui.emit(m)
+
+	return terraform.HookActionContinue, nil
+}
+
+func (h *JSONHook) PostProvision(
+	n *terraform.InstanceInfo,
+	provId string) (terraform.HookAction, error) {
+	m := h.message(n, "provision_complete", fmt.Sprintf(
+		"%s: Provisioning with '%s' complete", n.HumanId(), provId))
+	m.Provisioner = provId
+	h.Ui.emit(m)
+
+	return terraform.HookActionContinue, nil
+}
+
+// ProvisionOutput writes a message for every non-empty line of output.
+func (h *JSONHook) ProvisionOutput(
+	n *terraform.InstanceInfo,
+	provId string,
+	msg string) {
+	s := bufio.NewScanner(strings.NewReader(msg))
+	s.Split(scanLines)
+	for s.Scan() {
+		line := strings.TrimRightFunc(s.Text(), unicode.IsSpace)
+		if line == "" {
+			continue
+		}
+
+		m := h.message(n, "provision_output", fmt.Sprintf(
+			"%s (%s): %s", n.HumanId(), provId, line))
+		m.Provisioner = provId
+		m.Output = line
+		h.Ui.emit(m)
+	}
+}
+
+func (h *JSONHook) PreRefresh(
+	n *terraform.InstanceInfo,
+	s *terraform.InstanceState) (terraform.HookAction, error) {
+	id := n.HumanId()
+
+	// Remember the attributes of existing resources, so that we can
+	// report if they were changed outside of Terraform. Data resources
+	// refresh before they have ids and are always read anew.
+	if s != nil && s.ID != "" {
+		h.l.Lock()
+		if h.refreshed == nil {
+			h.refreshed = make(map[string]*terraform.InstanceState)
+		}
+		h.refreshed[id] = s.DeepCopy()
+		h.l.Unlock()
+	}
+
+	m := h.message(n, "refresh_start", fmt.Sprintf("%s: Refreshing state", id))
+	if s != nil {
+		m.ID = s.ID
+	}
+	h.Ui.emit(m)
+
+	return terraform.HookActionContinue, nil
+}
+
+func (h *JSONHook) PostRefresh(
+	n *terraform.InstanceInfo,
+	s *terraform.InstanceState) (terraform.HookAction, error) {
+	id := n.HumanId()
+
+	h.l.Lock()
+	before, ok := h.refreshed[id]
+	delete(h.refreshed, id)
+	h.l.Unlock()
+
+	m := h.message(n, "refresh_complete", fmt.Sprintf("%s: Refresh complete", id))
+	if s != nil {
+		m.ID = s.ID
+	}
+	h.Ui.emit(m)
+
+	if !ok {
+		return terraform.HookActionContinue, nil
+	}
+
+	change := &jsonChange{
+		Before:          before.Attributes,
+		AfterUnknown:    make([]string, 0),
+		RequiresReplace: make([]string, 0),
+		Sensitive:       before.SensitiveAttributes,
+	}
+	switch {
+	case s == nil || s.ID == "":
+		change.Actions = []string{"delete"}
+	case len(before.Attributes)+len(s.Attributes) > 0 &&
+		!reflect.DeepEqual(before.Attributes, s.Attributes):
+		change.Actions = []string{"update"}
+		change.After = s.Attributes
+		change.Sensitive = append(change.Sensitive, s.SensitiveAttributes...)
+	default:
+		return terraform.HookActionContinue, nil
+	}
+	change.redactSensitive()
+
+	m = h.message(n, "resource_drift", fmt.Sprintf(
+		"%s: Changed outside of Terraform", id))
+	m.Change = change
+	h.Ui.emit(m)
+
+	return terraform.HookActionContinue, nil
+}
+
+// message returns a message of the given type about the given instance.
+func (h *JSONHook) message(
+	n *terraform.InstanceInfo, typ, msg string) *jsonMessage {
+	m := &jsonMessage{
+		Type:    typ,
+		Message: msg,
+	}
+
+	path := n.ModulePath
+	if len(path) == 0 {
+		path = terraform.RootModulePath
+	}
+	if addr, err := newJSONResourceAddress(path, n.Id); err == nil {
+		m.Resource = &addr
+	}
+
+	return m
+}
@@ -0,0 +1,271 @@
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestJSONHook_impl(t *testing.T) {
+	var _ terraform.Hook = new(JSONHook)
+}
+
+func TestJSONHook_refresh(t *testing.T) {
+	ui := new(cli.MockUi)
+	h := &JSONHook{Ui: &JSONUi{Ui: ui}}
+
+	n := &terraform.InstanceInfo{
+		Id:         "aws_instance.foo",
+		ModulePath: []string{"root", "child"},
+		Type:       "aws_instance",
+	}
+	before := &terraform.InstanceState{
+		ID:         "i-abc",
+		Attributes: map[string]string{"id": "i-abc", "ami": "foo"},
+	}
+	after := &terraform.InstanceState{
+		ID:         "i-abc",
+		Attributes: map[string]string{"id": "i-abc", "ami": "bar"},
+	}
+
+	h.PreRefresh(n, before)
+	h.PostRefresh(n, after)
+
+	messages := testJSONMessages(t, ui.OutputWriter)
+
+	var types []string
+	for _, m := range messages {
+		types = append(types, m.Type)
+	}
+	expected := []string{"refresh_start", "refresh_complete", "resource_drift"}
+	if !reflect.DeepEqual(types, expected) {
+		t.Fatalf("bad: %#v", types)
+	}
+
+	drift := messages[2]
+	if drift.Resource == nil || drift.Resource.Address != "module.child.aws_instance.foo" {
+		t.Fatalf("bad: %#v", drift.Resource)
+	}
+	if !reflect.DeepEqual(drift.Change.Actions, []string{"update"}) {
+		t.Fatalf("bad: %#v", drift.Change.Actions)
+	}
+	if drift.Change.Before["ami"] != "foo" || drift.Change.After["ami"] != "bar" {
+		t.Fatalf("bad: %#v", drift.Change)
+	}
+}
+
+func TestJSONHook_refreshNoDrift(t *testing.T) {
+	ui := new(cli.MockUi)
+	h := &JSONHook{Ui: &JSONUi{Ui: ui}}
+
+	n := &terraform.InstanceInfo{Id: "aws_instance.foo", Type: "aws_instance"}
+	s := &terraform.InstanceState{
+		ID:         "i-abc",
+		Attributes: map[string]string{"id": "i-abc"},
+	}
+
+	h.PreRefresh(n, s)
+	h.PostRefresh(n, s)
+
+	for _, m := range testJSONMessages(t, ui.OutputWriter) {
+		if m.Type == "resource_drift" {
+			t.Fatalf("unexpected drift: %#v", m)
+		}
+	}
+}
+
+func TestJSONHook_refreshSensitive(t *testing.T) {
+	ui := new(cli.MockUi)
+	h := &JSONHook{Ui: &JSONUi{Ui: ui}}
+
+	n := &terraform.InstanceInfo{Id: "aws_db_instance.foo", Type: "aws_db_instance"}
+	before := &terraform.InstanceState{
+		ID: "db-abc",
+		Attributes: map[string]string{
+			"id":       "db-abc",
+			"password": "hunter2-before",
+		},
+		SensitiveAttributes: []string{"password"},
+	}
+	after := &terraform.InstanceState{
+		ID: "db-abc",
+		Attributes: map[string]string{
+			"id":       "db-abc",
+			"password": "hunter2-secret",
+		},
+		SensitiveAttributes: []string{"password"},
+	}
+
+	h.PreRefresh(n, before)
+	h.PostRefresh(n, after)
+
+	output := ui.OutputWriter.String()
+	if strings.Contains(output, "hunter2") {
+		t.Fatalf("sensitive value in output:\n%s", output)
+	}
+
+	messages := testJSONMessages(t, ui.OutputWriter)
+	drift := messages[len(messages)-1]
+	if drift.Type != "resource_drift" {
+		t.Fatalf("bad: %#v", drift)
+	}
+	if !reflect.DeepEqual(drift.Change.Sensitive, []string{"password"}) {
+		t.Fatalf("bad: %#v", drift.Change.Sensitive)
+	}
+	if drift.Change.After["id"] != "db-abc" {
+		t.Fatalf("bad: %#v", drift.Change.After)
+	}
+
+	// The state itself must keep the value
+	if after.Attributes["password"] != "hunter2-secret" {
+		t.Fatalf("bad: %#v", after.Attributes)
+	}
+}
+
+func TestJSONHook_provisionOutput(t *testing.T) {
+	ui := new(cli.MockUi)
+	h := &JSONHook{Ui: &JSONUi{Ui: ui}}
+
+	n := &terraform.InstanceInfo{Id: "aws_instance.foo", Type: "aws_instance"}
+	h.ProvisionOutput(n, "local-exec", "hello\r\n\nworld\n")
+
+	var lines []string
+	for _, m := range testJSONMessages(t, ui.OutputWriter) {
+		if m.Type != "provision_output" || m.Provisioner != "local-exec" {
+			t.Fatalf("bad: %#v", m)
+		}
+		lines = append(lines, m.Output)
+	}
+
+	expected := []string{"hello", "world"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Fatalf("bad: %#v", lines)
+	}
+}
+
+func TestJSONUi_error(t *testing.T) {
+	ui := new(cli.MockUi)
+	u := &JSONUi{Ui: ui}
+
+	u.Error("Error applying plan:\n\n1 error(s) occurred")
+
+	messages := testJSONMessages(t, ui.OutputWriter)
+	if len(messages) != 1 {
+		t.Fatalf("bad: %#v", messages)
+	}
+
+	expected := &jsonDiagnostic{
+		Severity: "error",
+		Summary:  "Error applying plan:",
+		Detail:   "1 error(s) occurred",
+	}
+	if !reflect.DeepEqual(messages[0].Diagnostic, expected) {
+		t.Fatalf("bad: %#v", messages[0].Diagnostic)
+	}
+	if ui.ErrorWriter.Len() != 0 {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+}
+
+func TestJSONUi_plannedChangesSensitive(t *testing.T) {
+	ui := new(cli.MockUi)
+	u := &JSONUi{Ui: ui}
+
+	state := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"aws_db_instance.foo": &terraform.ResourceState{
+						Type: "aws_db_instance",
+						Primary: &terraform.InstanceState{
+							ID: "db-abc",
+							Attributes: map[string]string{
+								"id":       "db-abc",
+								"password": "hunter2-before",
+								"username": "foo",
+							},
+							SensitiveAttributes: []string{"password"},
+						},
+					},
+				},
+			},
+		},
+	}
+	diff := &terraform.Diff{
+		Modules: []*terraform.ModuleDiff{
+			&terraform.ModuleDiff{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.InstanceDiff{
+					"aws_db_instance.foo": &terraform.InstanceDiff{
+						Attributes: map[string]*terraform.ResourceAttrDiff{
+							"password": &terraform.ResourceAttrDiff{
+								Old:       "hunter2-before",
+								New:       "hunter2-secret",
+								Sensitive: true,
+							},
+							"username": &terraform.ResourceAttrDiff{
+								Old: "foo",
+								New: "bar",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := u.PlannedChanges(&terraform.Plan{Diff: diff, State: state}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	output := ui.OutputWriter.String()
+	if strings.Contains(output, "hunter2") {
+		t.Fatalf("sensitive value in output:\n%s", output)
+	}
+
+	messages := testJSONMessages(t, ui.OutputWriter)
+	if len(messages) != 1 {
+		t.Fatalf("bad: %#v", messages)
+	}
+	change := messages[0].Change
+	if !reflect.DeepEqual(change.Sensitive, []string{"password"}) {
+		t.Fatalf("bad: %#v", change.Sensitive)
+	}
+	if change.After["username"] != "bar" {
+		t.Fatalf("bad: %#v", change.After)
+	}
+
+	// The state itself must keep the value
+	attrs := state.RootModule().Resources["aws_db_instance.foo"].Primary.Attributes
+	if attrs["password"] != "hunter2-before" {
+		t.Fatalf("bad: %#v", attrs)
+	}
+}
+
+// testJSONMessages decodes the newline-delimited JSON messages written to
+// the given buffer.
+func testJSONMessages(t *testing.T, b *bytes.Buffer) []jsonMessage {
+	var result []jsonMessage
+
+	s := bufio.NewScanner(bytes.NewReader(b.Bytes()))
+	for s.Scan() {
+		var m jsonMessage
+		if err := json.Unmarshal(s.Bytes(), &m); err != nil {
+			t.Fatalf("err: %s\n\n%s", err, s.Text())
+		}
+		if m.Timestamp == "" {
+			t.Fatalf("no timestamp: %s", s.Text())
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
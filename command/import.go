@@ -3,8 +3,11 @@ package command
 import (
 	"fmt"
 	"log"
+	"os"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -15,13 +18,26 @@ type ImportCommand struct {
 }
 
 func (c *ImportCommand) Run(args []string) int {
+	// Get the pwd since its our default -config flag value
+	pwd, err := os.Getwd()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting pwd: %s", err))
+		return 1
+	}
+
+	var configPath, provider string
+	var dryRun bool
 	args = c.Meta.process(args, true)
 
 	cmdFlags := c.Meta.flagSet("import")
+	cmdFlags.StringVar(&configPath, "config", pwd, "path")
+	cmdFlags.StringVar(&provider, "provider", "", "provider")
+	cmdFlags.BoolVar(&dryRun, "dry-run", false, "dry-run")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
 	cmdFlags.IntVar(&c.Meta.parallelism, "parallelism", 0, "parallelism")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	c.Meta.addStateLockFlags(cmdFlags)
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -34,32 +50,65 @@ func (c *ImportCommand) Run(args []string) int {
 		return 1
 	}
 
+	// The configuration is only used to configure providers, so it's fine
+	// for there to be none.
+	empty, err := config.IsEmptyDir(configPath)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error checking configuration: %s", err))
+		return 1
+	}
+	if empty {
+		configPath = ""
+	}
+
 	// Build the context based on the arguments given
+	defer c.Meta.unlockState()
 	ctx, _, err := c.Context(contextOpts{
-		StatePath:   c.Meta.statePath,
-		Parallelism: c.Meta.parallelism,
+		Path:          configPath,
+		StatePath:     c.Meta.statePath,
+		Parallelism:   c.Meta.parallelism,
+		LockOperation: "import",
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
 		return 1
 	}
 
+	// Keep a copy of the state before the import, so that a dry run can
+	// show what was imported.
+	var oldState *terraform.State
+	if c.Meta.state != nil && c.Meta.state.State() != nil {
+		oldState = c.Meta.state.State().DeepCopy()
+	}
+
 	// Perform the import. Note that as you can see it is possible for this
 	// API to import more than one resource at once. For now, we only allow
 	// one while we stabilize this feature.
 	newState, err := ctx.Import(&terraform.ImportOpts{
 		Targets: []*terraform.ImportTarget{
 			&terraform.ImportTarget{
-				Addr: args[0],
-				ID:   args[1],
+				Addr:     args[0],
+				ID:       args[1],
+				Provider: provider,
 			},
 		},
+		Module: ctx.Module(),
 	})
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error importing: %s", err))
 		return 1
 	}
 
+	if dryRun {
+		c.Ui.Output(c.Colorize().Color(
+			"[reset][bold]Dry run: the following resources would be imported. " +
+				"The state has not been modified.\n"))
+		for _, line := range importedResources(oldState, newState) {
+			c.Ui.Output("  " + line)
+		}
+		return 0
+	}
+
 	// Persist the final state
 	log.Printf("[INFO] Writing state output to: %s", c.Meta.StateOutPath())
 	if err := c.Meta.PersistState(newState); err != nil {
@@ -111,16 +160,40 @@ Options:
                       modifying. Defaults to the "-state-out" path with
                       ".backup" extension. Set to "-" to disable backup.
 
+  -config=path        Path to a directory of Terraform configuration files
+                      to use to configure the provider. Defaults to pwd.
+                      If no config files are present, they must be provided
+                      via the input prompts or env vars.
+
+  -dry-run            Import the resource and show what would be added to
+                      the state, without writing the state.
+
   -input=true         Ask for input for variables if not directly set.
 
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
   -no-color           If specified, output won't contain any color.
 
+  -provider=provider  Specific provider to use for import. This is used for
+                      specifying aliases, such as "aws.eu". Defaults to the
+                      normal provider prefix of the resource being imported.
+
   -state=path         Path to read and save state (unless state-out
                       is specified). Defaults to "terraform.tfstate".
 
   -state-out=path     Path to write updated state file. By default, the
                       "-state" path will be used.
 
+  -var 'foo=bar'      Set a variable in the Terraform configuration. This
+                      flag can be set multiple times. This is only useful
+                      with the "-config" flag.
+
+  -var-file=foo       Set variables in the Terraform configuration from
+                      a file. If "terraform.tfvars" is present, it will be
+                      automatically loaded if this flag is not specified.
+
 `
 	return strings.TrimSpace(helpText)
 }
@@ -128,3 +201,35 @@ Options:
 func (c *ImportCommand) Synopsis() string {
 	return "Import existing infrastructure into Terraform"
 }
+
+// importedResources returns a description of each resource which is in the
+// new state but not in the old one, sorted by address.
+func importedResources(old, new *terraform.State) []string {
+	var result []string
+	for _, m := range new.Modules {
+		var oldModule *terraform.ModuleState
+		if old != nil {
+			oldModule = old.ModuleByPath(m.Path)
+		}
+
+		prefix := ""
+		for _, name := range m.Path[1:] {
+			prefix += fmt.Sprintf("module.%s.", name)
+		}
+
+		for k, r := range m.Resources {
+			if oldModule != nil && oldModule.Resources[k] != nil {
+				continue
+			}
+
+			id := ""
+			if r.Primary != nil {
+				id = r.Primary.ID
+			}
+			result = append(result, fmt.Sprintf("%s%s (ID: %s)", prefix, k, id))
+		}
+	}
+
+	sort.Strings(result)
+	return result
+}
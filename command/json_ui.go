@@ -0,0 +1,214 @@
+package command
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+// jsonMessage is a single message of the output of the commands that are
+// run with -json. Each message is written as one line of JSON, and only
+// the fields relevant for the type of the message are set.
+type jsonMessage struct {
+	Type      string `json:"type"`
+	Message   string `json:"@message,omitempty"`
+	Timestamp string `json:"@timestamp"`
+
+	Resource    *jsonResourceAddress  `json:"resource,omitempty"`
+	Action      string                `json:"action,omitempty"`
+	ID          string                `json:"id,omitempty"`
+	Elapsed     *int                  `json:"elapsed_seconds,omitempty"`
+	Provisioner string                `json:"provisioner,omitempty"`
+	Output      string                `json:"output,omitempty"`
+	Change      *jsonChange           `json:"change,omitempty"`
+	Changes     *jsonChangeSummary    `json:"changes,omitempty"`
+	Outputs     map[string]jsonOutput `json:"outputs,omitempty"`
+	Diagnostic  *jsonDiagnostic       `json:"diagnostic,omitempty"`
+}
+
+// jsonChangeSummary counts the changes of a plan, apply or destroy.
+type jsonChangeSummary struct {
+	Operation string `json:"operation"`
+	Add       int    `json:"add"`
+	Change    int    `json:"change"`
+	Remove    int    `json:"remove"`
+}
+
+// jsonDiagnostic is an error or warning. Summary is the first line of the
+// message and Detail the rest of it. Range is the position in the
+// configuration the diagnostic is about, if it is known.
+type jsonDiagnostic struct {
+	Severity string     `json:"severity"`
+	Summary  string     `json:"summary"`
+	Detail   string     `json:"detail,omitempty"`
+	Range    *jsonRange `json:"range,omitempty"`
+}
+
+// jsonRange is a position in a configuration file. Filename is relative to
+// the directory of the configuration, and may be empty if the file isn't
+// known.
+type jsonRange struct {
+	Filename string  `json:"filename,omitempty"`
+	Start    jsonPos `json:"start"`
+}
+
+// jsonPos is a position in a file.
+type jsonPos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// JSONUi is a cli.Ui implementation that writes everything as
+// newline-delimited JSON messages to the output of the wrapped Ui, so that
+// the output of a command can be parsed. Errors and warnings become
+// diagnostic messages and anything else becomes a log message.
+type JSONUi struct {
+	Ui cli.Ui
+
+	l sync.Mutex
+}
+
+func (u *JSONUi) Ask(query string) (string, error) {
+	return u.Ui.Ask(query)
+}
+
+func (u *JSONUi) AskSecret(query string) (string, error) {
+	return u.Ui.AskSecret(query)
+}
+
+func (u *JSONUi) Output(message string) {
+	u.log(message)
+}
+
+func (u *JSONUi) Info(message string) {
+	u.log(message)
+}
+
+func (u *JSONUi) Error(message string) {
+	u.diagnostic("error", message)
+}
+
+func (u *JSONUi) Warn(message string) {
+	u.diagnostic("warning", message)
+}
+
+// PlannedChanges writes a planned_change message for every resource the
+// plan changes, in the order of their addresses.
+func (u *JSONUi) PlannedChanges(p *terraform.Plan) error {
+	if p.Diff == nil {
+		return nil
+	}
+
+	var changes []jsonResourceChange
+	for _, m := range p.Diff.Modules {
+		mChanges, err := newJSONResourceChanges(m, p.State)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, mChanges...)
+	}
+	sort.Sort(jsonResourceChangesByAddress(changes))
+
+	for _, c := range changes {
+		c := c
+		c.Change.redactSensitive()
+		u.emit(&jsonMessage{
+			Type:     "planned_change",
+			Message:  c.Address + ": Plan to " + strings.Join(c.Change.Actions, " and "),
+			Resource: &c.jsonResourceAddress,
+			Change:   &c.Change,
+		})
+	}
+
+	return nil
+}
+
+// ChangeSummary writes the number of changes of the given operation.
+func (u *JSONUi) ChangeSummary(operation string, add, change, remove int) {
+	u.emit(&jsonMessage{
+		Type: "change_summary",
+		Changes: &jsonChangeSummary{
+			Operation: operation,
+			Add:       add,
+			Change:    change,
+			Remove:    remove,
+		},
+	})
+}
+
+// Outputs writes the outputs of the root module in the given state. The
+// values of sensitive outputs are left out.
+func (u *JSONUi) Outputs(s *terraform.State) {
+	if s == nil || s.RootModule() == nil || len(s.RootModule().Outputs) == 0 {
+		return
+	}
+
+	outputs := make(map[string]jsonOutput)
+	for name, output := range s.RootModule().Outputs {
+		o := jsonOutput{
+			Sensitive: output.Sensitive,
+			Type:      output.Type,
+		}
+		if !output.Sensitive {
+			o.Value = output.Value
+		}
+
+		outputs[name] = o
+	}
+
+	u.emit(&jsonMessage{
+		Type:    "outputs",
+		Outputs: outputs,
+	})
+}
+
+func (u *JSONUi) log(message string) {
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return
+	}
+
+	u.emit(&jsonMessage{
+		Type:    "log",
+		Message: message,
+	})
+}
+
+func (u *JSONUi) diagnostic(severity, message string) {
+	message = strings.TrimSpace(message)
+	parts := strings.SplitN(message, "\n", 2)
+
+	diag := &jsonDiagnostic{
+		Severity: severity,
+		Summary:  strings.TrimSpace(parts[0]),
+	}
+	if len(parts) > 1 {
+		diag.Detail = strings.TrimSpace(parts[1])
+	}
+
+	u.emit(&jsonMessage{
+		Type:       "diagnostic",
+		Message:    diag.Summary,
+		Diagnostic: diag,
+	})
+}
+
+func (u *JSONUi) emit(m *jsonMessage) {
+	m.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+
+	raw, err := json.Marshal(m)
+	if err != nil {
+		// The messages only contain values that can be encoded, so this
+		// can't happen unless there is a bug.
+		panic(err)
+	}
+
+	u.l.Lock()
+	defer u.l.Unlock()
+	u.Ui.Output(string(raw))
+}
@@ -5,9 +5,13 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-getter"
 	"github.com/hashicorp/terraform/config/module"
@@ -43,9 +47,18 @@ type Meta struct {
 	// Targets for this context (private)
 	targets []string
 
+	// allowDestroyTargets allows the targets to be destroyed even if they
+	// have prevent_destroy set.
+	allowDestroyTargets bool
+
 	color bool
 	oldUi cli.Ui
 
+	// json is set by commands with the -json flag. Once processJSON is
+	// called, jsonUi is the Ui everything is written to.
+	json   bool
+	jsonUi *JSONUi
+
 	// The fields below are expected to be set by the command via
 	// command line flags. See the Apply command for an example.
 	//
@@ -63,10 +76,19 @@ type Meta struct {
 	//
 	// parallelism is used to control the number of concurrent operations
 	// allowed when walking the graph
-	statePath    string
-	stateOutPath string
-	backupPath   string
-	parallelism  int
+	//
+	// stateLock is set to false to disable state locking, and
+	// stateLockTimeout is how long to retry acquiring the state lock.
+	statePath        string
+	stateOutPath     string
+	backupPath       string
+	parallelism      int
+	stateLock        bool
+	stateLockTimeout time.Duration
+
+	// The state locked by lockState and the ID of its lock.
+	lockedState state.State
+	stateLockID string
 }
 
 // initStatePaths is used to initialize the default values for
@@ -100,16 +122,31 @@ func (m *Meta) Colorize() *colorstring.Colorize {
 // Context returns a Terraform Context taking into account the context
 // options used to initialize this meta configuration.
 func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
-	opts := m.contextOpts()
+	opts, err := m.contextOpts()
+	if err != nil {
+		return nil, false, err
+	}
 
 	// First try to just read the plan directly from the path given.
 	f, err := os.Open(copts.Path)
 	if err == nil {
-		plan, err := terraform.ReadPlan(f)
+		plan, err := state.ReadPlan(f)
 		f.Close()
 		if err == nil {
+			// A plan can only be applied to the state it was created for
+			if plan.Workspace != "" && plan.Workspace != opts.Workspace {
+				return nil, false, fmt.Errorf(
+					"The plan was created in the workspace %q, but the selected\n"+
+						"workspace is %q. Select the workspace of the plan with\n"+
+						"`terraform workspace select` to apply it.",
+					plan.Workspace, opts.Workspace)
+			}
+
 			// Setup our state
-			state, statePath, err := StateFromPlan(m.statePath, m.stateOutPath, plan)
+			state, statePath, err := StateFromPlan(
+				workspaceFlagStatePath(m.statePath, opts.Workspace),
+				workspaceFlagStatePath(m.stateOutPath, opts.Workspace),
+				plan)
 			if err != nil {
 				return nil, false, fmt.Errorf("Error loading plan: %s", err)
 			}
@@ -117,6 +154,10 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 			// Set our state
 			m.state = state
 
+			if err := m.lockState(state, copts.LockOperation); err != nil {
+				return nil, false, err
+			}
+
 			// this is used for printing the saved location later
 			if m.stateOutPath == "" {
 				m.stateOutPath = statePath
@@ -149,6 +190,17 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 		return nil, false, err
 	}
 
+	// Lock the state and make sure we have the latest copy once it's locked
+	if err := m.lockState(state, copts.LockOperation); err != nil {
+		return nil, false, err
+	}
+	if m.lockedState != nil {
+		if err := state.RefreshState(); err != nil {
+			m.unlockState()
+			return nil, false, fmt.Errorf("Error refreshing state: %s", err)
+		}
+	}
+
 	// Load the root module
 	var mod *module.Tree
 	if copts.Path != "" {
@@ -220,7 +272,12 @@ func (m *Meta) State() (state.State, error) {
 		return m.state, nil
 	}
 
-	result, err := State(m.StateOpts())
+	opts, err := m.StateOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := State(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -245,20 +302,101 @@ func (m *Meta) StateRaw(opts *StateOpts) (*StateResult, error) {
 }
 
 // StateOpts returns the default state options
-func (m *Meta) StateOpts() *StateOpts {
+func (m *Meta) StateOpts() (*StateOpts, error) {
 	localPath := m.statePath
 	if localPath == "" {
 		localPath = DefaultStateFilename
 	}
 	remotePath := filepath.Join(m.DataDir(), DefaultStateFilename)
 
+	workspace, err := m.Workspace()
+	if err != nil {
+		return nil, err
+	}
+
 	return &StateOpts{
-		LocalPath:     localPath,
-		LocalPathOut:  m.stateOutPath,
+		LocalPath:     workspaceFlagStatePath(localPath, workspace),
+		LocalPathOut:  workspaceFlagStatePath(m.stateOutPath, workspace),
 		RemotePath:    remotePath,
 		RemoteRefresh: true,
 		BackupPath:    m.backupPath,
+		Workspace:     workspace,
+	}, nil
+}
+
+// workspaceStateOpts returns the state options of the workspace with the
+// given name, which isn't necessarily the selected one.
+func (m *Meta) workspaceStateOpts(name string) *StateOpts {
+	return &StateOpts{
+		LocalPath:     workspaceStatePath(DefaultStateFilename, name),
+		RemotePath:    filepath.Join(m.DataDir(), DefaultStateFilename),
+		RemoteRefresh: true,
+		BackupPath:    "-",
+		Workspace:     name,
+	}
+}
+
+// Workspace returns the name of the selected workspace, which can be
+// overridden with the TF_WORKSPACE environment variable. The name is used
+// in state paths and remote keys, so an error is returned if it isn't a
+// valid workspace name.
+func (m *Meta) Workspace() (string, error) {
+	name := os.Getenv(WorkspaceNameEnvVar)
+	if name == "" {
+		data, err := ioutil.ReadFile(filepath.Join(m.DataDir(), DefaultWorkspaceFilename))
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("[ERROR] Error reading the selected workspace: %s", err)
+		}
+
+		name = strings.TrimSpace(string(data))
 	}
+	if name == "" {
+		return terraform.DefaultWorkspace, nil
+	}
+
+	if !validWorkspaceName(name) {
+		return "", fmt.Errorf(errWorkspaceInvalidName, name)
+	}
+
+	return name, nil
+}
+
+// SetWorkspace selects the workspace with the given name.
+func (m *Meta) SetWorkspace(name string) error {
+	path := filepath.Join(m.DataDir(), DefaultWorkspaceFilename)
+	if name == terraform.DefaultWorkspace {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(m.DataDir(), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, []byte(name+"\n"), 0644)
+}
+
+// workspaceStatePath returns the path of the local state file of the
+// selected workspace if the given path is the default state path. Paths
+// given explicitly with the -state flag are used as is.
+func (m *Meta) workspaceStatePath(path string) (string, error) {
+	workspace, err := m.Workspace()
+	if err != nil {
+		return "", err
+	}
+
+	return workspaceFlagStatePath(path, workspace), nil
+}
+
+// workspaceFlagStatePath is workspaceStatePath for the given workspace.
+func workspaceFlagStatePath(path, workspace string) string {
+	if path != DefaultStateFilename {
+		return path
+	}
+
+	return workspaceStatePath(path, workspace)
 }
 
 // UIInput returns a UIInput object to be used for asking for input.
@@ -285,12 +423,16 @@ func (m *Meta) Input() bool {
 
 // contextOpts returns the options to use to initialize a Terraform
 // context with the settings from this Meta.
-func (m *Meta) contextOpts() *terraform.ContextOpts {
+func (m *Meta) contextOpts() (*terraform.ContextOpts, error) {
 	var opts terraform.ContextOpts = *m.ContextOpts
 	opts.Hooks = make(
 		[]terraform.Hook,
 		len(m.ContextOpts.Hooks)+len(m.extraHooks)+1)
-	opts.Hooks[0] = m.uiHook()
+	if m.jsonUi != nil {
+		opts.Hooks[0] = &JSONHook{Ui: m.jsonUi}
+	} else {
+		opts.Hooks[0] = m.uiHook()
+	}
 	copy(opts.Hooks[1:], m.ContextOpts.Hooks)
 	copy(opts.Hooks[len(m.ContextOpts.Hooks)+1:], m.extraHooks)
 
@@ -306,9 +448,16 @@ func (m *Meta) contextOpts() *terraform.ContextOpts {
 	}
 	opts.Variables = vs
 	opts.Targets = m.targets
+	opts.AllowDestroyTargets = m.allowDestroyTargets
 	opts.UIInput = m.UIInput()
 
-	return &opts
+	workspace, err := m.Workspace()
+	if err != nil {
+		return nil, err
+	}
+	opts.Workspace = workspace
+
+	return &opts, nil
 }
 
 // flags adds the meta flags to the given FlagSet.
@@ -317,7 +466,7 @@ func (m *Meta) flagSet(n string) *flag.FlagSet {
 	f.BoolVar(&m.input, "input", true, "input")
 	f.Var((*FlagTypedKV)(&m.variables), "var", "variables")
 	f.Var((*FlagKVFile)(&m.variables), "var-file", "variable file")
-	f.Var((*FlagStringSlice)(&m.targets), "target", "resource to target")
+	f.Var((*FlagTargets)(&m.targets), "target", "resource to target")
 
 	if m.autoKey != "" {
 		f.Var((*FlagKVFile)(&m.autoVariables), m.autoKey, "variable file")
@@ -364,6 +513,7 @@ func (m *Meta) process(args []string, vars bool) []string {
 	if m.oldUi != nil {
 		m.Ui = m.oldUi
 	}
+	m.jsonUi = nil
 
 	// Set colorization
 	m.color = m.Color
@@ -411,6 +561,20 @@ func (m *Meta) process(args []string, vars bool) []string {
 	return args
 }
 
+// processJSON switches the output to JSON messages if the -json flag was
+// given. It must be called after the flags are parsed. Input can't be
+// asked for with -json, since the prompts would end up in the output.
+func (m *Meta) processJSON() {
+	if !m.json {
+		return
+	}
+
+	m.color = false
+	m.input = false
+	m.jsonUi = &JSONUi{Ui: m.oldUi}
+	m.Ui = m.jsonUi
+}
+
 // uiHook returns the UiHook to use with the context.
 func (m *Meta) uiHook() *UiHook {
 	return &UiHook{
@@ -438,6 +602,72 @@ func (m *Meta) addModuleDepthFlag(flags *flag.FlagSet, moduleDepth *int) {
 	}
 }
 
+// addStateLockFlags adds the flags controlling state locking.
+func (m *Meta) addStateLockFlags(flags *flag.FlagSet) {
+	flags.BoolVar(&m.stateLock, "lock", true, "lock state")
+	flags.DurationVar(&m.stateLockTimeout, "lock-timeout", 0, "lock timeout")
+}
+
+// lockState locks the given state for the operation if locking is enabled
+// and the state supports it. The lock is released with unlockState.
+func (m *Meta) lockState(s state.State, op string) error {
+	if !m.stateLock || op == "" || m.lockedState != nil {
+		return nil
+	}
+
+	info := state.NewLockInfo()
+	info.Operation = op
+
+	id, err := state.LockWithTimeout(s, info, m.stateLockTimeout)
+	if err != nil {
+		return fmt.Errorf(errStateLock, err)
+	}
+
+	m.lockedState = s
+	m.stateLockID = id
+	return nil
+}
+
+// unlockState releases the lock taken by lockState, if any.
+func (m *Meta) unlockState() {
+	if m.lockedState == nil {
+		return
+	}
+
+	if err := state.Unlock(m.lockedState, m.stateLockID); err != nil {
+		m.Ui.Error(fmt.Sprintf(errStateUnlock, err, m.stateLockID))
+	}
+
+	m.lockedState = nil
+	m.stateLockID = ""
+}
+
+const errStateLock = `Error acquiring the state lock: %s
+
+Terraform acquires a state lock to protect the state from being written
+by multiple users at the same time. Please resolve the issue above and try
+again. For most commands, you can disable locking with the "-lock=false"
+flag, but this is not recommended.`
+
+const errStateUnlock = `Error releasing the state lock!
+
+Error message: %s
+
+Terraform acquires a lock when accessing your state to prevent others
+running Terraform to potentially modify the state at the same time. An
+error occurred while releasing this lock. This could mean that the lock
+did or did not release properly. If the lock didn't release properly,
+Terraform may not be able to run future commands since it'll appear as if
+the lock is held.
+
+In this scenario, please call the "force-unlock" command to unlock the
+state manually. This is a very dangerous operation since if it is done
+erroneously it could result in two people modifying state at the same time.
+Only call this command if you're certain that the unlock above failed and
+that no one else is holding a lock.
+
+The lock ID is: %s`
+
 // contextOpts are the options used to load a context from a command.
 type contextOpts struct {
 	// Path to the directory where the root module is.
@@ -457,4 +687,8 @@ type contextOpts struct {
 
 	// Number of concurrent operations allowed
 	Parallelism int
+
+	// LockOperation describes the operation in the state lock. The state is
+	// only locked if this is set and locking wasn't disabled with -lock.
+	LockOperation string
 }
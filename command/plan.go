@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform/state"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -25,16 +26,20 @@ func (c *PlanCommand) Run(args []string) int {
 	cmdFlags := c.Meta.flagSet("plan")
 	cmdFlags.BoolVar(&destroy, "destroy", false, "destroy")
 	cmdFlags.BoolVar(&refresh, "refresh", true, "refresh")
+	cmdFlags.BoolVar(&c.Meta.allowDestroyTargets, "allow-destroy-targets", false, "allow-destroy-targets")
 	c.addModuleDepthFlag(cmdFlags, &moduleDepth)
 	cmdFlags.StringVar(&outPath, "out", "", "path")
 	cmdFlags.IntVar(
 		&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
 	cmdFlags.BoolVar(&detailed, "detailed-exitcode", false, "detailed-exitcode")
+	cmdFlags.BoolVar(&c.Meta.json, "json", false, "json")
+	c.Meta.addStateLockFlags(cmdFlags)
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
+	c.Meta.processJSON()
 
 	var path string
 	args = cmdFlags.Args()
@@ -57,11 +62,13 @@ func (c *PlanCommand) Run(args []string) int {
 	countHook := new(CountHook)
 	c.Meta.extraHooks = []terraform.Hook{countHook}
 
+	defer c.Meta.unlockState()
 	ctx, _, err := c.Context(contextOpts{
-		Destroy:     destroy,
-		Path:        path,
-		StatePath:   c.Meta.statePath,
-		Parallelism: c.Meta.parallelism,
+		Destroy:       destroy,
+		Path:          path,
+		StatePath:     c.Meta.statePath,
+		Parallelism:   c.Meta.parallelism,
+		LockOperation: "plan",
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
@@ -78,9 +85,11 @@ func (c *PlanCommand) Run(args []string) int {
 	}
 
 	if refresh {
-		c.Ui.Output("Refreshing Terraform state in-memory prior to plan...")
-		c.Ui.Output("The refreshed state will be used to calculate this plan, but")
-		c.Ui.Output("will not be persisted to local or remote state storage.\n")
+		if c.Meta.jsonUi == nil {
+			c.Ui.Output("Refreshing Terraform state in-memory prior to plan...")
+			c.Ui.Output("The refreshed state will be used to calculate this plan, but")
+			c.Ui.Output("will not be persisted to local or remote state storage.\n")
+		}
 		_, err := ctx.Refresh()
 		if err != nil {
 			c.Ui.Error(fmt.Sprintf("Error refreshing state: %s", err))
@@ -100,7 +109,7 @@ func (c *PlanCommand) Run(args []string) int {
 		f, err := os.Create(outPath)
 		if err == nil {
 			defer f.Close()
-			err = terraform.WritePlan(plan, f)
+			err = state.WritePlan(plan, f)
 		}
 		if err != nil {
 			c.Ui.Error(fmt.Sprintf("Error writing plan file: %s", err))
@@ -108,6 +117,23 @@ func (c *PlanCommand) Run(args []string) int {
 		}
 	}
 
+	if c.Meta.jsonUi != nil {
+		if err := c.Meta.jsonUi.PlannedChanges(plan); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing plan: %s", err))
+			return 1
+		}
+		c.Meta.jsonUi.ChangeSummary(
+			"plan",
+			countHook.ToAdd+countHook.ToRemoveAndAdd,
+			countHook.ToChange,
+			countHook.ToRemove+countHook.ToRemoveAndAdd)
+
+		if detailed && !plan.Diff.Empty() {
+			return 2
+		}
+		return 0
+	}
+
 	if plan.Diff.Empty() {
 		c.Ui.Output(
 			"No changes. Infrastructure is up-to-date. This means that Terraform\n" +
@@ -157,6 +183,10 @@ Usage: terraform plan [options] [dir]
 
 Options:
 
+  -allow-destroy-targets
+                      Allow the resources given with -target to be destroyed
+                      even if they have prevent_destroy set.
+
   -destroy            If set, a plan will be generated to destroy all resources
                       managed by the given configuration and state.
 
@@ -168,6 +198,14 @@ Options:
 
   -input=true         Ask for input for variables if not directly set.
 
+  -json               If specified, the progress and the planned changes are
+                      output as newline-delimited JSON messages instead of
+                      human-readable text. This implies -input=false.
+
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
   -module-depth=n     Specifies the depth of modules to show in the output.
                       This does not affect the plan itself, only the output
                       shown. By default, this is -1, which will expand all.
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -37,6 +38,83 @@ func TestPlan(t *testing.T) {
 	}
 }
 
+func TestPlan_json(t *testing.T) {
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		"-detailed-exitcode",
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 2 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	messages := testJSONMessages(t, ui.OutputWriter)
+	if len(messages) != 2 {
+		t.Fatalf("bad: %#v", messages)
+	}
+
+	change := messages[0]
+	if change.Type != "planned_change" {
+		t.Fatalf("bad: %#v", change)
+	}
+	if change.Resource.Address != "test_instance.foo" {
+		t.Fatalf("bad: %#v", change.Resource)
+	}
+	if !reflect.DeepEqual(change.Change.Actions, []string{"create"}) {
+		t.Fatalf("bad: %#v", change.Change.Actions)
+	}
+
+	expected := &jsonChangeSummary{Operation: "plan", Add: 1}
+	if messages[1].Type != "change_summary" ||
+		!reflect.DeepEqual(messages[1].Changes, expected) {
+		t.Fatalf("bad: %#v", messages[1])
+	}
+}
+
+func TestPlan_lockedState(t *testing.T) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	testPath := testFixturePath("plan")
+	unlock := testLockState(t, filepath.Join(testPath, DefaultStateFilename))
+	defer unlock()
+
+	if err := os.Chdir(testPath); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Chdir(cwd)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{}
+	if code := c.Run(args); code == 0 {
+		t.Fatal("expected error")
+	}
+
+	output := ui.ErrorWriter.String()
+	if !strings.Contains(output, "lock") {
+		t.Fatalf("bad: %s", output)
+	}
+}
+
 func TestPlan_destroy(t *testing.T) {
 	originalState := &terraform.State{
 		Modules: []*terraform.ModuleState{
@@ -0,0 +1,32 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// ProvidersCommand is a Command implementation that just shows help for
+// the subcommands nested below it.
+type ProvidersCommand struct {
+	Meta
+}
+
+func (c *ProvidersCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func (c *ProvidersCommand) Help() string {
+	helpText := `
+Usage: terraform providers <subcommand> [options] [args]
+
+  This command has subcommands for inspecting the providers used by the
+  configuration.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ProvidersCommand) Synopsis() string {
+	return "Inspect the providers of the configuration"
+}
@@ -0,0 +1,272 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/config/module"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// ProvidersSchemaCommand is a Command implementation that outputs the
+// schemas of the providers used by the configuration.
+type ProvidersSchemaCommand struct {
+	Meta
+}
+
+func (c *ProvidersSchemaCommand) Run(args []string) int {
+	var jsonOutput bool
+
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("providers schema")
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	if !jsonOutput {
+		c.Ui.Error(
+			"The -json flag is required, since the schemas can only be\n" +
+				"output in JSON format for now.")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	var configPath string
+	args = cmdFlags.Args()
+	if len(args) > 1 {
+		c.Ui.Error("The providers schema command expects at most one argument.")
+		cmdFlags.Usage()
+		return 1
+	} else if len(args) == 1 {
+		configPath = args[0]
+	} else {
+		var err error
+		configPath, err = os.Getwd()
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting pwd: %s", err))
+			return 1
+		}
+	}
+
+	mod, err := module.NewTreeModule("", configPath)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading config: %s", err))
+		return 1
+	}
+	err = mod.Load(c.moduleStorage(c.DataDir()), module.GetModeNone)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading modules: %s", err))
+		return 1
+	}
+
+	output := &jsonProviderSchemas{
+		FormatVersion:   jsonFormatVersion,
+		ProviderSchemas: make(map[string]*jsonProviderSchema),
+	}
+	for _, name := range providersSchemaNames(mod) {
+		schema, err := c.providerSchema(name)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf(
+				"Error loading the schema of provider %q: %s", name, err))
+			return 1
+		}
+
+		output.ProviderSchemas[name] = newJSONProviderSchema(schema)
+	}
+
+	raw, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error marshaling output: %s", err))
+		return 1
+	}
+	c.Ui.Output(string(raw))
+
+	return 0
+}
+
+// providerSchema starts the provider with the given name and returns its
+// schema.
+func (c *ProvidersSchemaCommand) providerSchema(name string) (*terraform.ProviderSchema, error) {
+	f, ok := c.ContextOpts.Providers[name]
+	if !ok {
+		return nil, fmt.Errorf("provider is not available")
+	}
+
+	p, err := f()
+	if err != nil {
+		return nil, err
+	}
+	if closer, ok := p.(terraform.ResourceProviderCloser); ok {
+		defer closer.Close()
+	}
+
+	return p.GetSchema()
+}
+
+// providersSchemaNames returns the sorted names of the providers that are
+// configured or used by the resources in the module tree.
+func providersSchemaNames(t *module.Tree) []string {
+	names := make(map[string]struct{})
+
+	var walk func(t *module.Tree)
+	walk = func(t *module.Tree) {
+		cfg := t.Config()
+		for _, pc := range cfg.ProviderConfigs {
+			names[pc.Name] = struct{}{}
+		}
+		for _, r := range cfg.Resources {
+			name := r.Provider
+			if name == "" {
+				name = r.Type
+			}
+
+			// The provider of a resource is either an alias like
+			// "aws.west" or the prefix of the type like "aws_instance".
+			if idx := strings.IndexAny(name, "._"); idx != -1 {
+				name = name[:idx]
+			}
+
+			names[name] = struct{}{}
+		}
+
+		for _, child := range t.Children() {
+			walk(child)
+		}
+	}
+	walk(t)
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+func (c *ProvidersSchemaCommand) Help() string {
+	helpText := `
+Usage: terraform providers schema -json [DIR]
+
+  Outputs the schemas of the providers used by the configuration in DIR,
+  which is the current directory if not given. The schemas describe the
+  configuration of the providers and of their resources and data sources.
+
+  The modules of the configuration must be installed with "terraform get"
+  first, so that the providers they use are included.
+
+Options:
+
+  -json               Output the schemas in JSON format. This is required.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ProvidersSchemaCommand) Synopsis() string {
+	return "Output the schemas of the providers"
+}
+
+// jsonProviderSchemas is the JSON output of providers schema.
+type jsonProviderSchemas struct {
+	FormatVersion   string                         `json:"format_version"`
+	ProviderSchemas map[string]*jsonProviderSchema `json:"provider_schemas"`
+}
+
+type jsonProviderSchema struct {
+	Provider          *jsonSchema            `json:"provider"`
+	ResourceSchemas   map[string]*jsonSchema `json:"resource_schemas"`
+	DataSourceSchemas map[string]*jsonSchema `json:"data_source_schemas"`
+}
+
+type jsonSchema struct {
+	Version int              `json:"version"`
+	Block   *jsonSchemaBlock `json:"block"`
+}
+
+type jsonSchemaBlock struct {
+	Attributes map[string]*jsonSchemaAttribute   `json:"attributes,omitempty"`
+	BlockTypes map[string]*jsonSchemaNestedBlock `json:"block_types,omitempty"`
+}
+
+type jsonSchemaAttribute struct {
+	Type               string `json:"type"`
+	Description        string `json:"description,omitempty"`
+	Required           bool   `json:"required,omitempty"`
+	Optional           bool   `json:"optional,omitempty"`
+	Computed           bool   `json:"computed,omitempty"`
+	Sensitive          bool   `json:"sensitive,omitempty"`
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+}
+
+type jsonSchemaNestedBlock struct {
+	NestingMode string           `json:"nesting_mode"`
+	Block       *jsonSchemaBlock `json:"block"`
+	MinItems    int              `json:"min_items,omitempty"`
+	MaxItems    int              `json:"max_items,omitempty"`
+}
+
+func newJSONProviderSchema(s *terraform.ProviderSchema) *jsonProviderSchema {
+	result := &jsonProviderSchema{
+		Provider:          &jsonSchema{Block: newJSONSchemaBlock(s.Provider)},
+		ResourceSchemas:   make(map[string]*jsonSchema),
+		DataSourceSchemas: make(map[string]*jsonSchema),
+	}
+
+	for k, b := range s.ResourceTypes {
+		result.ResourceSchemas[k] = &jsonSchema{
+			Version: s.ResourceTypeSchemaVersions[k],
+			Block:   newJSONSchemaBlock(b),
+		}
+	}
+	for k, b := range s.DataSources {
+		result.DataSourceSchemas[k] = &jsonSchema{
+			Block: newJSONSchemaBlock(b),
+		}
+	}
+
+	return result
+}
+
+func newJSONSchemaBlock(b *terraform.SchemaBlock) *jsonSchemaBlock {
+	result := &jsonSchemaBlock{}
+	if b == nil {
+		return result
+	}
+
+	if len(b.Attributes) > 0 {
+		result.Attributes = make(map[string]*jsonSchemaAttribute)
+		for k, a := range b.Attributes {
+			result.Attributes[k] = &jsonSchemaAttribute{
+				Type:               a.Type,
+				Description:        a.Description,
+				Required:           a.Required,
+				Optional:           a.Optional,
+				Computed:           a.Computed,
+				Sensitive:          a.Sensitive,
+				DeprecationMessage: a.Deprecated,
+			}
+		}
+	}
+
+	if len(b.BlockTypes) > 0 {
+		result.BlockTypes = make(map[string]*jsonSchemaNestedBlock)
+		for k, nb := range b.BlockTypes {
+			nb := nb
+			result.BlockTypes[k] = &jsonSchemaNestedBlock{
+				NestingMode: nb.Nesting,
+				Block:       newJSONSchemaBlock(&nb.SchemaBlock),
+				MinItems:    nb.MinItems,
+				MaxItems:    nb.MaxItems,
+			}
+		}
+	}
+
+	return result
+}
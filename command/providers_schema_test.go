@@ -0,0 +1,183 @@
+package command
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestProvidersSchema(t *testing.T) {
+	p := testProvider()
+	p.GetSchemaReturn = &terraform.ProviderSchema{
+		Provider: &terraform.SchemaBlock{
+			Attributes: map[string]*terraform.SchemaAttribute{
+				"region": &terraform.SchemaAttribute{
+					Type:     "string",
+					Required: true,
+				},
+			},
+		},
+		ResourceTypes: map[string]*terraform.SchemaBlock{
+			"test_instance": &terraform.SchemaBlock{
+				Attributes: map[string]*terraform.SchemaAttribute{
+					"ami": &terraform.SchemaAttribute{
+						Type:     "string",
+						Optional: true,
+					},
+				},
+				BlockTypes: map[string]*terraform.SchemaNestedBlock{
+					"disk": &terraform.SchemaNestedBlock{
+						SchemaBlock: terraform.SchemaBlock{
+							Attributes: map[string]*terraform.SchemaAttribute{
+								"size": &terraform.SchemaAttribute{
+									Type:     "number",
+									Computed: true,
+								},
+							},
+						},
+						Nesting:  "set",
+						MaxItems: 2,
+					},
+				},
+			},
+		},
+		ResourceTypeSchemaVersions: map[string]int{
+			"test_instance": 1,
+		},
+	}
+
+	ui := new(cli.MockUi)
+	c := &ProvidersSchemaCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-json", testFixturePath("providers-schema")}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	var actual interface{}
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+
+	var expected interface{}
+	err := json.Unmarshal([]byte(strings.TrimSpace(testProvidersSchemaStr)), &expected)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad:\n\n%s", ui.OutputWriter.String())
+	}
+}
+
+func TestProvidersSchema_noJSON(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &ProvidersSchemaCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{testFixturePath("providers-schema")}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if !strings.Contains(ui.ErrorWriter.String(), "-json flag is required") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+}
+
+func TestProvidersSchema_unavailableProvider(t *testing.T) {
+	td := tempDir(t)
+	os.MkdirAll(td, 0755)
+	defer os.RemoveAll(td)
+
+	err := ioutil.WriteFile(
+		filepath.Join(td, "main.tf"),
+		[]byte(`resource "other_instance" "foo" {}`),
+		0644)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui := new(cli.MockUi)
+	c := &ProvidersSchemaCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-json", td}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	expected := `Error loading the schema of provider "other": provider is not available`
+	if !strings.Contains(ui.ErrorWriter.String(), expected) {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+}
+
+const testProvidersSchemaStr = `
+{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "test": {
+      "provider": {
+        "version": 0,
+        "block": {
+          "attributes": {
+            "region": {
+              "type": "string",
+              "required": true
+            }
+          }
+        }
+      },
+      "resource_schemas": {
+        "test_instance": {
+          "version": 1,
+          "block": {
+            "attributes": {
+              "ami": {
+                "type": "string",
+                "optional": true
+              }
+            },
+            "block_types": {
+              "disk": {
+                "nesting_mode": "set",
+                "block": {
+                  "attributes": {
+                    "size": {
+                      "type": "number",
+                      "computed": true
+                    }
+                  }
+                },
+                "max_items": 2
+              }
+            }
+          }
+        }
+      },
+      "data_source_schemas": {}
+    }
+  }
+}
+`
@@ -23,6 +23,7 @@ func (c *RefreshCommand) Run(args []string) int {
 	cmdFlags.IntVar(&c.Meta.parallelism, "parallelism", 0, "parallelism")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	c.Meta.addStateLockFlags(cmdFlags)
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -55,7 +56,12 @@ func (c *RefreshCommand) Run(args []string) int {
 	// will actually do this, but we want to provide a richer error message
 	// if possible.
 	if !state.State().IsRemote() {
-		if _, err := os.Stat(c.Meta.statePath); err != nil {
+		statePath, err := c.Meta.workspaceStatePath(c.Meta.statePath)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+		if _, err := os.Stat(statePath); err != nil {
 			if os.IsNotExist(err) {
 				c.Ui.Error(fmt.Sprintf(
 					"The Terraform state file for your infrastructure does not\n"+
@@ -65,7 +71,7 @@ func (c *RefreshCommand) Run(args []string) int {
 						"haven't created infrastructure with Terraform yet, use the\n"+
 						"'terraform apply' command.\n\n"+
 						"Path: %s",
-					c.Meta.statePath))
+					statePath))
 				return 1
 			}
 
@@ -73,17 +79,19 @@ func (c *RefreshCommand) Run(args []string) int {
 				"There was an error reading the Terraform state that is needed\n"+
 					"for refreshing. The path and error are shown below.\n\n"+
 					"Path: %s\n\nError: %s",
-				c.Meta.statePath,
+				statePath,
 				err))
 			return 1
 		}
 	}
 
 	// Build the context based on the arguments given
+	defer c.Meta.unlockState()
 	ctx, _, err := c.Context(contextOpts{
-		Path:        configPath,
-		StatePath:   c.Meta.statePath,
-		Parallelism: c.Meta.parallelism,
+		Path:          configPath,
+		StatePath:     c.Meta.statePath,
+		Parallelism:   c.Meta.parallelism,
+		LockOperation: "refresh",
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
@@ -137,6 +145,10 @@ Options:
 
   -input=true         Ask for input for variables if not directly set.
 
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
   -no-color           If specified, output won't contain any color.
 
   -state=path         Path to read and save state (unless state-out
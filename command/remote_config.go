@@ -45,6 +45,22 @@ func (c *RemoteConfigCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Remote state is configured for the default workspace, and the other
+	// workspaces are stored along with it.
+	workspace, err := c.Workspace()
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	if workspace != terraform.DefaultWorkspace {
+		c.Ui.Error(fmt.Sprintf(
+			"Remote state can only be configured in the %q workspace, but the\n"+
+				"selected workspace is %q. Select the %q workspace with\n"+
+				"`terraform workspace select` to configure remote state.",
+			terraform.DefaultWorkspace, workspace, terraform.DefaultWorkspace))
+		return 1
+	}
+
 	// Lowercase the type
 	c.remoteConf.Type = strings.ToLower(c.remoteConf.Type)
 
@@ -57,7 +73,11 @@ func (c *RemoteConfigCommand) Run(args []string) int {
 	// Get the state information. We specifically request the cache only
 	// for the remote state here because it is possible the remote state
 	// is invalid and we don't want to error.
-	stateOpts := c.StateOpts()
+	stateOpts, err := c.StateOpts()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading local state: %s", err))
+		return 1
+	}
 	stateOpts.RemoteCacheOnly = true
 	if _, err := c.StateRaw(stateOpts); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error loading local state: %s", err))
@@ -120,7 +140,11 @@ func (c *RemoteConfigCommand) Run(args []string) int {
 	}
 
 	// Otherwise, refresh the state
-	stateResult, err := c.StateRaw(c.StateOpts())
+	var stateResult *StateResult
+	stateOpts, err = c.StateOpts()
+	if err == nil {
+		stateResult, err = c.StateRaw(stateOpts)
+	}
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf(
 			"Error while performing the initial pull. The error message is shown\n"+
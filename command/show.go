@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform/state"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -17,11 +18,13 @@ type ShowCommand struct {
 
 func (c *ShowCommand) Run(args []string) int {
 	var moduleDepth int
+	var jsonOutput bool
 
 	args = c.Meta.process(args, false)
 
 	cmdFlags := flag.NewFlagSet("show", flag.ContinueOnError)
 	c.addModuleDepthFlag(cmdFlags, &moduleDepth)
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -39,7 +42,7 @@ func (c *ShowCommand) Run(args []string) int {
 	var planErr, stateErr error
 	var path string
 	var plan *terraform.Plan
-	var state *terraform.State
+	var stateReal *terraform.State
 	if len(args) > 0 {
 		path = args[0]
 		f, err := os.Open(path)
@@ -49,7 +52,7 @@ func (c *ShowCommand) Run(args []string) int {
 		}
 		defer f.Close()
 
-		plan, err = terraform.ReadPlan(f)
+		plan, err = state.ReadPlan(f)
 		if err != nil {
 			if _, err := f.Seek(0, 0); err != nil {
 				c.Ui.Error(fmt.Sprintf("Error reading file: %s", err))
@@ -60,27 +63,35 @@ func (c *ShowCommand) Run(args []string) int {
 			planErr = err
 		}
 		if plan == nil {
-			state, err = terraform.ReadState(f)
+			stateReal, err = state.ReadState(f)
 			if err != nil {
 				stateErr = err
 			}
 		}
 	} else {
-		stateOpts := c.StateOpts()
+		stateOpts, err := c.StateOpts()
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error reading state: %s", err))
+			return 1
+		}
 		stateOpts.RemoteCacheOnly = true
 		result, err := State(stateOpts)
 		if err != nil {
 			c.Ui.Error(fmt.Sprintf("Error reading state: %s", err))
 			return 1
 		}
-		state = result.State.State()
-		if state == nil {
+		stateReal = result.State.State()
+		if stateReal == nil {
+			if jsonOutput {
+				return c.outputJSON(FormatStateJSON(&terraform.State{}))
+			}
+
 			c.Ui.Output("No state.")
 			return 0
 		}
 	}
 
-	if plan == nil && state == nil {
+	if plan == nil && stateReal == nil {
 		c.Ui.Error(fmt.Sprintf(
 			"Terraform couldn't read the given file as a state or plan file.\n"+
 				"The errors while attempting to read the file as each format are\n"+
@@ -91,6 +102,13 @@ func (c *ShowCommand) Run(args []string) int {
 		return 1
 	}
 
+	if jsonOutput {
+		if plan != nil {
+			return c.outputJSON(FormatPlanJSON(plan))
+		}
+		return c.outputJSON(FormatStateJSON(stateReal))
+	}
+
 	if plan != nil {
 		c.Ui.Output(FormatPlan(&FormatPlanOpts{
 			Plan:        plan,
@@ -101,13 +119,24 @@ func (c *ShowCommand) Run(args []string) int {
 	}
 
 	c.Ui.Output(FormatState(&FormatStateOpts{
-		State:       state,
+		State:       stateReal,
 		Color:       c.Colorize(),
 		ModuleDepth: moduleDepth,
 	}))
 	return 0
 }
 
+// outputJSON outputs the given JSON representation of a plan or state.
+func (c *ShowCommand) outputJSON(data []byte, err error) int {
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error formatting JSON: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(string(data))
+	return 0
+}
+
 func (c *ShowCommand) Help() string {
 	helpText := `
 Usage: terraform show [options] [path]
@@ -117,6 +146,11 @@ Usage: terraform show [options] [path]
 
 Options:
 
+  -json               If specified, output the plan or state in a
+                      machine-readable JSON form, including the planned
+                      changes of the resources with their values before
+                      and after the changes.
+
   -module-depth=n     Specifies the depth of modules to show in the output.
                       By default this is -1, which will expand all.
 
@@ -1,9 +1,11 @@
 package command
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -183,3 +185,108 @@ func TestShow_state(t *testing.T) {
 		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
 	}
 }
+
+func TestShow_planJSON(t *testing.T) {
+	planPath := testPlanFile(t, &terraform.Plan{
+		Module: new(module.Tree),
+		Diff: &terraform.Diff{
+			Modules: []*terraform.ModuleDiff{
+				&terraform.ModuleDiff{
+					Path: []string{"root"},
+					Resources: map[string]*terraform.InstanceDiff{
+						"test_instance.foo": &terraform.InstanceDiff{
+							Attributes: map[string]*terraform.ResourceAttrDiff{
+								"ami": &terraform.ResourceAttrDiff{
+									New: "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		State:     testState(),
+		Workspace: "foo",
+	})
+
+	ui := new(cli.MockUi)
+	c := &ShowCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		planPath,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var actual struct {
+		Workspace       string `json:"workspace"`
+		ResourceChanges []struct {
+			Address string `json:"address"`
+			Change  struct {
+				Actions []string          `json:"actions"`
+				After   map[string]string `json:"after"`
+			} `json:"change"`
+		} `json:"resource_changes"`
+	}
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+
+	if actual.Workspace != "foo" {
+		t.Fatalf("bad: %#v", actual)
+	}
+	if len(actual.ResourceChanges) != 1 {
+		t.Fatalf("bad: %#v", actual)
+	}
+	change := actual.ResourceChanges[0]
+	if change.Address != "test_instance.foo" {
+		t.Fatalf("bad: %#v", change)
+	}
+	if !reflect.DeepEqual(change.Change.Actions, []string{"update"}) {
+		t.Fatalf("bad: %#v", change)
+	}
+	if change.Change.After["ami"] != "bar" {
+		t.Fatalf("bad: %#v", change)
+	}
+}
+
+func TestShow_stateJSON(t *testing.T) {
+	statePath := testStateFile(t, testState())
+
+	ui := new(cli.MockUi)
+	c := &ShowCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		statePath,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var actual struct {
+		Resources []struct {
+			Address string `json:"address"`
+			ID      string `json:"id"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+
+	if len(actual.Resources) != 1 || actual.Resources[0].Address != "test_instance.foo" || actual.Resources[0].ID != "bar" {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
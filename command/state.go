@@ -33,6 +33,12 @@ type StateOpts struct {
 	// it is assumed to be the path where the state is stored locally
 	// plus the DefaultBackupExtension.
 	BackupPath string
+
+	// Workspace is the name of the selected workspace. The remote state of
+	// workspaces other than the default one is cached next to RemotePath,
+	// and stored remotely as configured for the default workspace, along
+	// with the name of the workspace.
+	Workspace string
 }
 
 // StateResult is the result of calling State and holds various different
@@ -90,8 +96,9 @@ func State(opts *StateOpts) (*StateResult, error) {
 		} else {
 			if _, err := os.Stat(opts.RemotePath); err == nil {
 				// We have a remote state, initialize that.
-				remote, err = remoteStateFromPath(
+				remote, result.RemotePath, err = remoteStateFromPath(
 					opts.RemotePath,
+					opts.Workspace,
 					opts.RemoteRefresh)
 				if err != nil {
 					return nil, err
@@ -101,7 +108,7 @@ func State(opts *StateOpts) (*StateResult, error) {
 
 		if remote != nil {
 			result.State = remote
-			result.StatePath = opts.RemotePath
+			result.StatePath = result.RemotePath
 			result.Remote = remote
 		}
 	}
@@ -179,7 +186,8 @@ func StateFromPlan(
 
 		// It looks like we have a remote state in the plan, so
 		// we have to initialize that.
-		resultPath = filepath.Join(DefaultDataDir, DefaultStateFilename)
+		resultPath = workspaceStatePath(
+			filepath.Join(DefaultDataDir, DefaultStateFilename), plan.Workspace)
 		result, err = remoteState(plan.State, resultPath, false)
 		if err != nil {
 			return nil, "", err
@@ -268,13 +276,69 @@ func remoteState(
 	return cache, nil
 }
 
-func remoteStateFromPath(path string, refresh bool) (*state.CacheState, error) {
+// remoteStateFromPath returns the remote state of the given workspace,
+// configured by the remote state cache at path, along with the path where
+// the state of the workspace is cached.
+func remoteStateFromPath(path, workspace string, refresh bool) (*state.CacheState, string, error) {
 	// First create the local state for the path
 	local := &state.LocalState{Path: path}
 	if err := local.RefreshState(); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	localState := local.State()
 
-	return remoteState(localState, path, refresh)
+	if workspace == "" || workspace == terraform.DefaultWorkspace {
+		cache, err := remoteState(localState, path, refresh)
+		return cache, path, err
+	}
+
+	if localState == nil || localState.Remote == nil {
+		return nil, "", fmt.Errorf("Remote state cache has no remote info")
+	}
+
+	// Other workspaces are stored with the configuration of the default
+	// workspace, which the remote clients use along with the name of the
+	// workspace to find where their state is.
+	cachePath := workspaceStatePath(path, workspace)
+	cache := &state.LocalState{Path: cachePath}
+	if err := cache.RefreshState(); err != nil {
+		return nil, "", err
+	}
+
+	workspaceState := cache.State()
+	if workspaceState == nil {
+		workspaceState = terraform.NewState()
+	}
+
+	config := make(map[string]string)
+	for k, v := range localState.Remote.Config {
+		config[k] = v
+	}
+	config["workspace"] = workspace
+	workspaceRemote := &terraform.RemoteState{
+		Type:   localState.Remote.Type,
+		Config: config,
+	}
+
+	// Keep the cache of the workspace in sync with the remote
+	// configuration, since it's the cache the remote state is read from.
+	if workspaceState.Remote == nil || !workspaceState.Remote.Equals(workspaceRemote) {
+		workspaceState.Remote = workspaceRemote
+		if err := cache.WriteState(workspaceState); err != nil {
+			return nil, "", err
+		}
+	}
+
+	result, err := remoteState(workspaceState, cachePath, refresh)
+	return result, cachePath, err
+}
+
+// workspaceStatePath returns the path of the state file of the given
+// workspace, given the path of the state file of the default workspace.
+func workspaceStatePath(path, workspace string) string {
+	if workspace == "" || workspace == terraform.DefaultWorkspace {
+		return path
+	}
+
+	return filepath.Join(filepath.Dir(path), DefaultWorkspaceDir, workspace, filepath.Base(path))
 }
@@ -16,7 +16,9 @@ type StateMeta struct{}
 // in the way that backups are done. This configures backups to be timestamped
 // rather than just the original state path plus a backup path.
 func (c *StateMeta) State(m *Meta) (state.State, error) {
-	// Disable backups since we wrap it manually below
+	// Disable backups since we wrap it manually below. A backup path set
+	// with the -backup flag is used instead of the timestamped one.
+	backupPath := m.backupPath
 	m.backupPath = "-"
 
 	// Get the state (shouldn't be wrapped in a backup)
@@ -27,11 +29,13 @@ func (c *StateMeta) State(m *Meta) (state.State, error) {
 
 	// Determine the backup path. stateOutPath is set to the resulting
 	// file where state is written (cached in the case of remote state)
-	backupPath := fmt.Sprintf(
-		"%s.%d.%s",
-		m.stateOutPath,
-		time.Now().UTC().Unix(),
-		DefaultBackupExtension)
+	if backupPath == "" || backupPath == "-" {
+		backupPath = fmt.Sprintf(
+			"%s.%d.%s",
+			m.stateOutPath,
+			time.Now().UTC().Unix(),
+			DefaultBackupExtension)
+	}
 
 	// Wrap it for backups
 	s = &state.BackupState{
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+// StatePullCommand is a Command implementation that writes the current
+// state to stdout.
+type StatePullCommand struct {
+	Meta
+}
+
+func (c *StatePullCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+
+	cmdFlags := c.Meta.flagSet("state pull")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	if err := cmdFlags.Parse(args); err != nil {
+		return cli.RunResultHelp
+	}
+
+	state, err := c.State()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return 1
+	}
+
+	// Make sure we have the latest copy of remote state
+	if err := state.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to refresh the state: %s", err))
+		return 1
+	}
+
+	stateReal := state.State()
+	if stateReal == nil {
+		c.Ui.Error(fmt.Sprintf(errStateNotFound))
+		return 1
+	}
+
+	var buf bytes.Buffer
+	if err := terraform.WriteState(stateReal, &buf); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to write the state: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(buf.String())
+	return 0
+}
+
+func (c *StatePullCommand) Help() string {
+	helpText := `
+Usage: terraform state pull [options]
+
+  Pull the state from its location and output it to stdout.
+
+  This command "pulls" the current state and outputs it to stdout.
+  The primary use of this is for state stored remotely. This command
+  will still work with local state but is less useful for this.
+
+Options:
+
+  -state=PATH         Path to a Terraform state file to read. By default it
+                      will use the state "terraform.tfstate" if it exists.
+                      Ignored when remote state is used.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StatePullCommand) Synopsis() string {
+	return "Pull current state and output to stdout"
+}
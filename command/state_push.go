@@ -0,0 +1,162 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/mitchellh/cli"
+)
+
+// StatePushCommand is a Command implementation that replaces the current
+// state with a local state file.
+type StatePushCommand struct {
+	Meta
+	StateMeta
+}
+
+func (c *StatePushCommand) Run(args []string) int {
+	var force bool
+	args = c.Meta.process(args, true)
+
+	cmdFlags := c.Meta.flagSet("state push")
+	cmdFlags.BoolVar(&force, "force", false, "")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	c.Meta.addStateLockFlags(cmdFlags)
+	if err := cmdFlags.Parse(args); err != nil {
+		return cli.RunResultHelp
+	}
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("Exactly one argument expected: path to state to push\n")
+		return cli.RunResultHelp
+	}
+
+	// Read the state to push
+	var r io.Reader = os.Stdin
+	if args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+		defer f.Close()
+		r = f
+	}
+
+	sourceState, err := state.ReadState(r)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading source state %q: %s", args[0], err))
+		return 1
+	}
+
+	// Get and lock the destination state, making sure it's up to date
+	state, err := c.StateMeta.State(&c.Meta)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return 1
+	}
+	defer c.Meta.unlockState()
+	if err := c.Meta.lockState(state, "state push"); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	if err := state.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to refresh the destination state: %s", err))
+		return 1
+	}
+
+	// Unless forced, only a newer state of the same lineage can replace
+	// the destination state.
+	if dstState := state.State(); dstState != nil && !force {
+		if !dstState.SameLineage(sourceState) {
+			c.Ui.Error(strings.TrimSpace(errStatePushLineage))
+			return 1
+		}
+
+		if dstState.Serial > sourceState.Serial {
+			c.Ui.Error(strings.TrimSpace(fmt.Sprintf(
+				errStatePushSerialNewer, dstState.Serial, sourceState.Serial)))
+			return 1
+		}
+	}
+
+	if err := state.WriteState(sourceState); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing the state: %s", err))
+		return 1
+	}
+
+	if err := state.PersistState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error saving the state: %s", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (c *StatePushCommand) Help() string {
+	helpText := `
+Usage: terraform state push [options] PATH
+
+  Update remote state from a local state file at PATH.
+
+  This command "pushes" a local state and overwrites remote state
+  with a local state file. The command will protect you against writing
+  an older serial or a different state file lineage unless you specify the
+  "-force" flag.
+
+  This command works with local state (it will overwrite the local
+  state), but is less useful for this use case.
+
+  If PATH is "-", then this command will read the state to push from stdin.
+  Data from stdin is not streamed to the backend: it is loaded completely
+  (until pipe close), verified, and then pushed.
+
+  This command creates a timestamped backup of the state on every invocation.
+
+Options:
+
+  -force              Write the state even if lineages don't match or the
+                      remote serial is higher.
+
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
+  -state=PATH         Path to the local Terraform state file to overwrite.
+                      By default it will use the state "terraform.tfstate".
+                      Ignored when remote state is used.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StatePushCommand) Synopsis() string {
+	return "Update remote state from a local state file"
+}
+
+const errStatePushLineage = `
+The lineages do not match! The state will not be pushed.
+
+The "lineage" is a unique identifier given to a state on creation. It helps
+protect Terraform from overwriting a seemingly unrelated state file since it
+represents potentially losing real state.
+
+Please verify you're pushing the correct state. If you're sure you are, you
+can force the behavior with the "-force" flag.
+`
+
+const errStatePushSerialNewer = `
+The destination state has a higher serial number! The state will not be pushed.
+
+A higher serial could indicate that there is data in the destination state
+that was not present when the source state was created. As a protection measure,
+Terraform will not automatically overwrite this state.
+
+The destination state serial is %d and the state being pushed has serial %d.
+
+Please verify you're pushing the correct state. If you're sure you are, you
+can force the behavior with the "-force" flag.
+`
@@ -0,0 +1,211 @@
+package command
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestStatePush(t *testing.T) {
+	dst := testState()
+	dst.Lineage = "lineage"
+	dst.Serial = 1
+	statePath := testStateFile(t, dst)
+
+	src := testStatePushSource()
+	src.Lineage = "lineage"
+	src.Serial = 2
+	srcPath := testStateFile(t, src)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &StatePushCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-state", statePath, srcPath}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	testStateOutput(t, statePath, testStatePushOutput)
+}
+
+func TestStatePush_replaceStdin(t *testing.T) {
+	dst := testState()
+	dst.Lineage = "lineage"
+	statePath := testStateFile(t, dst)
+
+	src := testStatePushSource()
+	src.Lineage = "lineage"
+	src.Serial = 2
+	srcPath := testStateFile(t, src)
+
+	f, err := os.Open(srcPath)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer f.Close()
+
+	stdin := os.Stdin
+	os.Stdin = f
+	defer func() { os.Stdin = stdin }()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &StatePushCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-state", statePath, "-"}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	testStateOutput(t, statePath, testStatePushOutput)
+}
+
+func TestStatePush_lineageMismatch(t *testing.T) {
+	dst := testState()
+	dst.Lineage = "lineage"
+	statePath := testStateFile(t, dst)
+
+	src := testStatePushSource()
+	src.Lineage = "other"
+	src.Serial = 2
+	srcPath := testStateFile(t, src)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &StatePushCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-state", statePath, srcPath}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d", code)
+	}
+
+	testStateOutput(t, statePath, dst.String())
+}
+
+func TestStatePush_serialNewer(t *testing.T) {
+	dst := testState()
+	dst.Lineage = "lineage"
+	dst.Serial = 3
+	statePath := testStateFile(t, dst)
+
+	src := testStatePushSource()
+	src.Lineage = "lineage"
+	src.Serial = 2
+	srcPath := testStateFile(t, src)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &StatePushCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-state", statePath, srcPath}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d", code)
+	}
+
+	testStateOutput(t, statePath, dst.String())
+}
+
+func TestStatePush_force(t *testing.T) {
+	dst := testState()
+	dst.Lineage = "lineage"
+	dst.Serial = 3
+	statePath := testStateFile(t, dst)
+
+	src := testStatePushSource()
+	src.Lineage = "other"
+	src.Serial = 2
+	srcPath := testStateFile(t, src)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &StatePushCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-force", "-state", statePath, srcPath}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	testStateOutput(t, statePath, testStatePushOutput)
+}
+
+func TestStatePush_lockedState(t *testing.T) {
+	dst := testState()
+	dst.Lineage = "lineage"
+	dst.Serial = 1
+	statePath := testStateFile(t, dst)
+
+	src := testStatePushSource()
+	src.Lineage = "lineage"
+	src.Serial = 2
+	srcPath := testStateFile(t, src)
+
+	unlock := testLockState(t, statePath)
+	defer unlock()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &StatePushCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{"-state", statePath, srcPath}
+	if code := c.Run(args); code == 0 {
+		t.Fatal("expected error")
+	}
+
+	testStateOutput(t, statePath, dst.String())
+}
+
+func testStatePushSource() *terraform.State {
+	return &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.baz": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "foo",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const testStatePushOutput = `
+test_instance.baz:
+  ID = foo
+`
@@ -25,6 +25,7 @@ func (c *TaintCommand) Run(args []string) int {
 	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	c.Meta.addStateLockFlags(cmdFlags)
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -63,6 +64,19 @@ func (c *TaintCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Lock the state and make sure we have the latest copy once it's locked
+	defer c.Meta.unlockState()
+	if err := c.Meta.lockState(state, "taint"); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	if c.Meta.lockedState != nil {
+		if err := state.RefreshState(); err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to load state: %s", err))
+			return 1
+		}
+	}
+
 	// Get the actual state structure
 	s := state.State()
 	if s.Empty() {
@@ -155,6 +169,10 @@ Options:
                       modifying. Defaults to the "-state-out" path with
                       ".backup" extension. Set to "-" to disable backup.
 
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
   -module=path        The module path where the resource lives. By
                       default this will be root. Child modules can be specified
                       by names. Ex. "consul" or "consul.vpc" (nested modules).
@@ -0,0 +1,4 @@
+provider "test" {
+  foo   = "bar"
+  alias = "alias"
+}
@@ -0,0 +1,7 @@
+provider "test" {
+  region = "us-east-1"
+}
+
+resource "test_instance" "foo" {
+  ami = "bar"
+}
@@ -0,0 +1,5 @@
+variable "ami" {}
+
+resource "test_instance" "foo" {
+  ami = "${var.ami}"
+}
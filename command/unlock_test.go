@@ -0,0 +1,71 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/mitchellh/cli"
+)
+
+func TestUnlock(t *testing.T) {
+	s := &state.InmemState{}
+	id, err := s.Lock(state.NewLockInfo())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui := new(cli.MockUi)
+	c := &UnlockCommand{
+		Meta: Meta{
+			Ui:    ui,
+			state: s,
+		},
+	}
+
+	args := []string{"-force", id}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	// The state can be locked again
+	id, err = s.Lock(state.NewLockInfo())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	s.Unlock(id)
+}
+
+func TestUnlock_badID(t *testing.T) {
+	s := &state.InmemState{}
+	id, err := s.Lock(state.NewLockInfo())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer s.Unlock(id)
+
+	ui := new(cli.MockUi)
+	c := &UnlockCommand{
+		Meta: Meta{
+			Ui:    ui,
+			state: s,
+		},
+	}
+
+	args := []string{"-force", "not-the-lock-id"}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+}
+
+func TestUnlock_noArgs(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &UnlockCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	if code := c.Run(nil); code != 1 {
+		t.Fatalf("bad: %d", code)
+	}
+}
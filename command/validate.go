@@ -1,9 +1,14 @@
 package command
 
 import (
+	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/config"
 )
 
@@ -15,45 +20,256 @@ type ValidateCommand struct {
 const defaultPath = "."
 
 func (c *ValidateCommand) Help() string {
-	return ""
+	helpText := `
+Usage: terraform validate [options] [dir]
+
+  Validate the Terraform files in a directory. Validation includes a basic
+  check of syntax as well as checking that all variables declared in the
+  configuration are specified in one of the possible ways:
+
+      -var foo=...
+      -var-file=foo.vars
+      TF_VAR_foo environment variable
+      terraform.tfvars
+      default value
+
+  The configuration is also checked against the schemas of the providers,
+  and the modules it uses are checked against the variables they declare.
+  The modules must be installed with "terraform get" first.
+
+  If dir is not specified, then the current directory will be used.
+
+Options:
+
+  -check-variables=true If set to true (default), the command will check
+                        whether all required variables have been specified,
+                        and will validate the configuration against the
+                        providers and modules. If set to false, only the
+                        configuration files themselves are checked.
+
+  -json                 Produce output in a machine-readable JSON format.
+
+  -no-color             If specified, output won't contain any color.
+
+  -var 'foo=bar'        Set a variable in the Terraform configuration. This
+                        flag can be set multiple times.
+
+  -var-file=foo         Set variables in the Terraform configuration from
+                        a file. If "terraform.tfvars" is present, it will be
+                        automatically loaded if this flag is not specified.
+`
+	return strings.TrimSpace(helpText)
 }
 
 func (c *ValidateCommand) Run(args []string) int {
-	args = c.Meta.process(args, false)
-	var dirPath string
+	var checkVars, jsonOutput bool
+
+	args = c.Meta.process(args, true)
+
+	cmdFlags := c.Meta.flagSet("validate")
+	cmdFlags.BoolVar(&checkVars, "check-variables", true, "check-variables")
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
 
+	// Variables can't be asked for, since validate is also used in
+	// scripts and editors.
+	c.Meta.input = false
+
+	var dirPath string
+	args = cmdFlags.Args()
 	if len(args) == 1 {
 		dirPath = args[0]
 	} else {
-		dirPath = "."
+		dirPath = defaultPath
 	}
 	dir, err := filepath.Abs(dirPath)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf(
 			"Unable to locate directory %v\n", err.Error()))
+		return 1
+	}
+
+	result := c.validate(dir, checkVars)
+	if jsonOutput {
+		return c.outputJSON(dir, result)
 	}
 
-	rtnCode := c.validate(dir)
+	for _, w := range result.Warnings {
+		c.Ui.Warn(fmt.Sprintf("Warning: %s\n", w))
+	}
+	if result.LoadErr != nil {
+		c.Ui.Error(fmt.Sprintf(
+			"Error loading files %v\n", result.LoadErr.Error()))
+		return 1
+	}
+	for _, err := range result.Errors {
+		c.Ui.Error(fmt.Sprintf(
+			"Error validating: %v\n", err.Error()))
+	}
+	if len(result.Errors) > 0 {
+		return 1
+	}
 
-	return rtnCode
+	return 0
 }
 
 func (c *ValidateCommand) Synopsis() string {
 	return "Validates the Terraform files"
 }
 
-func (c *ValidateCommand) validate(dir string) int {
+// validateResult is the result of validating a configuration.
+type validateResult struct {
+	// LoadErr is set if the configuration couldn't be loaded at all.
+	LoadErr error
+
+	Warnings []string
+	Errors   []error
+}
+
+func (c *ValidateCommand) validate(dir string, checkVars bool) *validateResult {
+	var result validateResult
+
 	cfg, err := config.LoadDir(dir)
 	if err != nil {
-		c.Ui.Error(fmt.Sprintf(
-			"Error loading files %v\n", err.Error()))
-		return 1
+		result.LoadErr = err
+		return &result
+	}
+	if err := cfg.Validate(); err != nil {
+		result.Errors = []error{err}
+		return &result
+	}
+
+	if !checkVars {
+		return &result
 	}
-	err = cfg.Validate()
+
+	// Validate the whole configuration as plan would, which includes the
+	// modules, the variables and the provider schemas.
+	ctx, _, err := c.Context(contextOpts{Path: dir})
 	if err != nil {
-		c.Ui.Error(fmt.Sprintf(
-			"Error validating: %v\n", err.Error()))
+		result.Errors = []error{err}
+		return &result
+	}
+
+	ws, es := ctx.Validate()
+	result.Warnings = ws
+	for _, err := range es {
+		if merr, ok := err.(*multierror.Error); ok {
+			result.Errors = append(result.Errors, merr.WrappedErrors()...)
+			continue
+		}
+
+		result.Errors = append(result.Errors, err)
+	}
+
+	return &result
+}
+
+// jsonValidate is the JSON output of validate.
+type jsonValidate struct {
+	FormatVersion string           `json:"format_version"`
+	Valid         bool             `json:"valid"`
+	ErrorCount    int              `json:"error_count"`
+	WarningCount  int              `json:"warning_count"`
+	Diagnostics   []jsonDiagnostic `json:"diagnostics"`
+}
+
+func (c *ValidateCommand) outputJSON(dir string, result *validateResult) int {
+	output := &jsonValidate{
+		FormatVersion: jsonFormatVersion,
+		Diagnostics:   make([]jsonDiagnostic, 0),
+	}
+
+	for _, w := range result.Warnings {
+		output.Diagnostics = append(
+			output.Diagnostics, newValidateDiagnostic(dir, "warning", w))
+		output.WarningCount++
+	}
+
+	errs := result.Errors
+	if result.LoadErr != nil {
+		errs = []error{result.LoadErr}
+	}
+	for _, err := range errs {
+		// A validation error can be a list of errors itself
+		var msgs []string
+		if merr, ok := err.(*multierror.Error); ok {
+			for _, err := range merr.WrappedErrors() {
+				msgs = append(msgs, err.Error())
+			}
+		} else {
+			msgs = []string{err.Error()}
+		}
+
+		for _, msg := range msgs {
+			output.Diagnostics = append(
+				output.Diagnostics, newValidateDiagnostic(dir, "error", msg))
+			output.ErrorCount++
+		}
+	}
+
+	output.Valid = output.ErrorCount == 0
+
+	raw, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error marshaling output: %s", err))
+		return 1
+	}
+	c.Ui.Output(string(raw))
+
+	if !output.Valid {
 		return 1
 	}
 	return 0
 }
+
+var (
+	// validateParseErrRe matches the syntax errors of the configuration
+	// files, which have the file and the position.
+	validateParseErrRe = regexp.MustCompile(`Error parsing (\S+): At (\d+):(\d+): `)
+
+	// validatePositionRe matches the errors of the loader that have the
+	// position of the problem.
+	validatePositionRe = regexp.MustCompile(`position (?:(\S+):)?(\d+):(\d+): `)
+)
+
+// newValidateDiagnostic returns the diagnostic for a validation message. If
+// the message has the position of the problem, it is moved to the range of
+// the diagnostic.
+func newValidateDiagnostic(dir, severity, msg string) jsonDiagnostic {
+	var rng *jsonRange
+	for _, re := range []*regexp.Regexp{validateParseErrRe, validatePositionRe} {
+		m := re.FindStringSubmatchIndex(msg)
+		if m == nil {
+			continue
+		}
+
+		rng = &jsonRange{}
+		if m[2] >= 0 {
+			rng.Filename = msg[m[2]:m[3]]
+			if rel, err := filepath.Rel(dir, rng.Filename); err == nil {
+				rng.Filename = rel
+			}
+		}
+		rng.Start.Line, _ = strconv.Atoi(msg[m[4]:m[5]])
+		rng.Start.Column, _ = strconv.Atoi(msg[m[6]:m[7]])
+
+		msg = msg[m[1]:]
+		break
+	}
+
+	parts := strings.SplitN(strings.TrimSpace(msg), "\n", 2)
+	diag := jsonDiagnostic{
+		Severity: severity,
+		Summary:  strings.TrimSpace(parts[0]),
+		Range:    rng,
+	}
+	if len(parts) > 1 {
+		diag.Detail = strings.TrimSpace(parts[1])
+	}
+
+	return diag
+}
@@ -1,6 +1,9 @@
 package command
 
 import (
+	"encoding/json"
+	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -11,7 +14,8 @@ func setupTest(fixturepath string) (*cli.MockUi, int) {
 	ui := new(cli.MockUi)
 	c := &ValidateCommand{
 		Meta: Meta{
-			Ui: ui,
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
 		},
 	}
 
@@ -122,3 +126,119 @@ func TestWronglyUsedInterpolationShouldFail(t *testing.T) {
 		t.Fatalf("Should have failed: %d\n\n'%s'", code, ui.ErrorWriter.String())
 	}
 }
+
+func TestValidateMissingDefinedVariable(t *testing.T) {
+	ui, code := setupTest("validate-invalid/missing_defined_var")
+	if code != 1 {
+		t.Fatalf("Should have failed: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "Required variable not set: ami") {
+		t.Fatalf("Should have failed: %d\n\n'%s'", code, ui.ErrorWriter.String())
+	}
+}
+
+func TestValidateMissingDefinedVariable_noCheck(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &ValidateCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-check-variables=false",
+		testFixturePath("validate-invalid/missing_defined_var"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+}
+
+func TestValidateProviderSchema(t *testing.T) {
+	p := testProvider()
+	p.ValidateResourceReturnErrors = []error{
+		fmt.Errorf("\"bad\": this field cannot be set"),
+	}
+
+	ui := new(cli.MockUi)
+	c := &ValidateCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{testFixturePath("validate-valid")}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("Should have failed: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "this field cannot be set") {
+		t.Fatalf("Should have failed:\n\n'%s'", ui.ErrorWriter.String())
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &ValidateCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		testFixturePath("validate-invalid/missing_quote"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("Should have failed: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	var actual jsonValidate
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+
+	if actual.Valid || actual.ErrorCount != 1 || len(actual.Diagnostics) != 1 {
+		t.Fatalf("bad: %#v", actual)
+	}
+
+	diag := actual.Diagnostics[0]
+	expected := &jsonRange{
+		Filename: "main.tf",
+		Start:    jsonPos{Line: 6, Column: 14},
+	}
+	if !reflect.DeepEqual(diag.Range, expected) {
+		t.Fatalf("bad: %#v", diag.Range)
+	}
+	if diag.Severity != "error" || !strings.HasSuffix(diag.Summary, "IDENT test") {
+		t.Fatalf("bad: %#v", diag)
+	}
+}
+
+func TestValidateJSON_valid(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &ValidateCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		testFixturePath("validate-valid"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	var actual jsonValidate
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !actual.Valid || len(actual.Diagnostics) != 0 {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
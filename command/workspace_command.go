@@ -0,0 +1,116 @@
+package command
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+// WorkspaceCommand is a Command implementation that just shows help for
+// the subcommands nested below it.
+type WorkspaceCommand struct {
+	Meta
+}
+
+func (c *WorkspaceCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func (c *WorkspaceCommand) Help() string {
+	helpText := `
+Usage: terraform workspace <subcommand> [options] [args]
+
+  This command has subcommands for workspace management.
+
+  Workspaces hold separate states for the same configuration, so that a
+  single configuration can be used to manage several sets of resources.
+  The state of the "default" workspace is stored where it always has
+  been, and the states of the other workspaces are stored next to it.
+
+  The name of the selected workspace is available in the configuration
+  as "${terraform.workspace}".
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *WorkspaceCommand) Synopsis() string {
+	return "Workspace management"
+}
+
+// workspaceNameRegexp matches the valid workspace names. Names are used in
+// paths and remote state keys, so they're restricted to characters that
+// are safe in both.
+var workspaceNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-][a-zA-Z0-9_.-]*$`)
+
+// validWorkspaceName returns whether the given workspace name is valid.
+func validWorkspaceName(name string) bool {
+	return workspaceNameRegexp.MatchString(name)
+}
+
+// workspaces returns the sorted names of the existing workspaces, which are
+// the default workspace along with the workspaces having a local state
+// directory or a cached remote state.
+func (m *Meta) workspaces() ([]string, error) {
+	names := map[string]struct{}{
+		terraform.DefaultWorkspace: struct{}{},
+	}
+
+	dirs := []string{
+		DefaultWorkspaceDir,
+		filepath.Join(m.DataDir(), DefaultWorkspaceDir),
+	}
+	for _, dir := range dirs {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() && validWorkspaceName(entry.Name()) {
+				names[entry.Name()] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// workspaceExists returns whether the workspace with the given name exists.
+func (m *Meta) workspaceExists(name string) (bool, error) {
+	names, err := m.workspaces()
+	if err != nil {
+		return false, err
+	}
+
+	for _, n := range names {
+		if n == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+const errWorkspaceInvalidName = `Invalid workspace name %q.
+
+Workspace names can only contain letters, digits, underscores, hyphens and
+periods, and can't start with a period.
+`
+
+const errWorkspaceList = `Error listing the workspaces: %s`
@@ -0,0 +1,260 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestWorkspace_newListSelect(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	for _, name := range []string{"foo", "bar"} {
+		ui := new(cli.MockUi)
+		c := &WorkspaceNewCommand{Meta: Meta{Ui: ui}}
+		if code := c.Run([]string{name}); code != 0 {
+			t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+
+		path := filepath.Join(DefaultWorkspaceDir, name, DefaultStateFilename)
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("state of workspace %q should exist: %s", name, err)
+		}
+	}
+
+	ui := new(cli.MockUi)
+	list := &WorkspaceListCommand{Meta: Meta{Ui: ui}}
+	if code := list.Run(nil); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	expected := "* bar\n  default\n  foo\n"
+	if actual := ui.OutputWriter.String(); actual != expected {
+		t.Fatalf("expected:\n%q\n\ngot:\n%q", expected, actual)
+	}
+
+	ui = new(cli.MockUi)
+	sel := &WorkspaceSelectCommand{Meta: Meta{Ui: ui}}
+	if code := sel.Run([]string{"foo"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if actual, err := sel.Workspace(); err != nil || actual != "foo" {
+		t.Fatalf("bad: %s %s", actual, err)
+	}
+
+	// The selected workspace is used for the state
+	opts, err := sel.StateOpts()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expectedPath := filepath.Join(DefaultWorkspaceDir, "foo", DefaultStateFilename)
+	if opts.LocalPath != expectedPath {
+		t.Fatalf("bad: %s", opts.LocalPath)
+	}
+
+	ui = new(cli.MockUi)
+	sel = &WorkspaceSelectCommand{Meta: Meta{Ui: ui}}
+	if code := sel.Run([]string{terraform.DefaultWorkspace}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if _, err := os.Stat(filepath.Join(DefaultDataDir, DefaultWorkspaceFilename)); !os.IsNotExist(err) {
+		t.Fatalf("selecting the default workspace should remove the file: %s", err)
+	}
+	if opts, err := sel.StateOpts(); err != nil || opts.LocalPath != DefaultStateFilename {
+		t.Fatalf("bad: %#v %s", opts, err)
+	}
+}
+
+func TestWorkspace_invalid(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	cases := []struct {
+		Command cli.Command
+		Args    []string
+	}{
+		{&WorkspaceNewCommand{}, []string{"../foo"}},
+		{&WorkspaceNewCommand{}, []string{terraform.DefaultWorkspace}},
+		{&WorkspaceSelectCommand{}, []string{"missing"}},
+		{&WorkspaceDeleteCommand{}, []string{terraform.DefaultWorkspace}},
+		{&WorkspaceDeleteCommand{}, []string{"missing"}},
+	}
+
+	for i, tc := range cases {
+		ui := new(cli.MockUi)
+		switch c := tc.Command.(type) {
+		case *WorkspaceNewCommand:
+			c.Ui = ui
+		case *WorkspaceSelectCommand:
+			c.Ui = ui
+		case *WorkspaceDeleteCommand:
+			c.Ui = ui
+		}
+
+		if code := tc.Command.Run(tc.Args); code != 1 {
+			t.Fatalf("%d: bad: %d\n\n%s", i, code, ui.OutputWriter.String())
+		}
+	}
+}
+
+func TestWorkspace_envVar(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	defer os.Setenv(WorkspaceNameEnvVar, os.Getenv(WorkspaceNameEnvVar))
+	os.Setenv(WorkspaceNameEnvVar, "foo")
+
+	m := &Meta{}
+	if actual, err := m.Workspace(); err != nil || actual != "foo" {
+		t.Fatalf("bad: %s %s", actual, err)
+	}
+
+	// The environment variable overrides the selected workspace
+	if err := m.SetWorkspace("bar"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if actual, err := m.Workspace(); err != nil || actual != "foo" {
+		t.Fatalf("bad: %s %s", actual, err)
+	}
+
+	// Names that could escape the workspace directory are rejected
+	os.Setenv(WorkspaceNameEnvVar, "../../x")
+	if actual, err := m.Workspace(); err == nil {
+		t.Fatalf("expected error, got: %s", actual)
+	}
+	if _, err := m.StateOpts(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestWorkspaceDelete(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	ui := new(cli.MockUi)
+	c := &WorkspaceNewCommand{Meta: Meta{Ui: ui}}
+	if code := c.Run([]string{"foo"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	// The selected workspace can't be deleted
+	ui = new(cli.MockUi)
+	del := &WorkspaceDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := del.Run([]string{"foo"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if err := del.SetWorkspace(terraform.DefaultWorkspace); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// A workspace with resources requires -force
+	path := filepath.Join(DefaultWorkspaceDir, "foo", DefaultStateFilename)
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	err = terraform.WriteState(testState(), f)
+	f.Close()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui = new(cli.MockUi)
+	del = &WorkspaceDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := del.Run([]string{"foo"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "-force") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+
+	ui = new(cli.MockUi)
+	del = &WorkspaceDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := del.Run([]string{"-force", "foo"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if _, err := os.Stat(filepath.Join(DefaultWorkspaceDir, "foo")); !os.IsNotExist(err) {
+		t.Fatalf("workspace directory should be deleted: %s", err)
+	}
+}
+
+func TestWorkspace_remote(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	remotePath := filepath.Join(tmp, "remote.tfstate")
+	s := terraform.NewState()
+	s.Remote = &terraform.RemoteState{
+		Type:   "_local",
+		Config: map[string]string{"path": remotePath},
+	}
+	testStateFileRemote(t, s)
+
+	ui := new(cli.MockUi)
+	c := &WorkspaceNewCommand{Meta: Meta{Ui: ui}}
+	if code := c.Run([]string{"foo"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	// The state of the workspace is stored next to the default one
+	workspaceRemotePath := remotePath + "-env-foo"
+	if _, err := os.Stat(workspaceRemotePath); err != nil {
+		t.Fatalf("remote state of the workspace should exist: %s", err)
+	}
+
+	// The selected workspace uses its own cache, configured for the
+	// workspace.
+	st, err := c.State()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expectedCache := filepath.Join(DefaultDataDir, DefaultWorkspaceDir, "foo", DefaultStateFilename)
+	if c.stateResult.StatePath != expectedCache {
+		t.Fatalf("bad: %s", c.stateResult.StatePath)
+	}
+	if ws := st.State().Remote.Config["workspace"]; ws != "foo" {
+		t.Fatalf("bad: %s", ws)
+	}
+
+	// Remote state can only be configured in the default workspace
+	ui = new(cli.MockUi)
+	rc := &RemoteConfigCommand{Meta: Meta{Ui: ui}}
+	if code := rc.Run([]string{"-backend=_local", "-backend-config=path=" + remotePath}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if err := c.SetWorkspace(terraform.DefaultWorkspace); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui = new(cli.MockUi)
+	list := &WorkspaceListCommand{Meta: Meta{Ui: ui}}
+	if code := list.Run(nil); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if expected, actual := "* default\n  foo\n", ui.OutputWriter.String(); actual != expected {
+		t.Fatalf("expected:\n%q\n\ngot:\n%q", expected, actual)
+	}
+
+	ui = new(cli.MockUi)
+	del := &WorkspaceDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := del.Run([]string{"foo"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if _, err := os.Stat(workspaceRemotePath); !os.IsNotExist(err) {
+		t.Fatalf("remote state of the workspace should be deleted: %s", err)
+	}
+	if _, err := os.Stat(expectedCache); !os.IsNotExist(err) {
+		t.Fatalf("cached state of the workspace should be deleted: %s", err)
+	}
+}
@@ -0,0 +1,142 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform/state/remote"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// WorkspaceDeleteCommand is a Command implementation that deletes a
+// workspace along with its state.
+type WorkspaceDeleteCommand struct {
+	Meta
+}
+
+func (c *WorkspaceDeleteCommand) Run(args []string) int {
+	var force bool
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("workspace delete")
+	cmdFlags.BoolVar(&force, "force", false, "force")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("workspace delete requires exactly one argument: the workspace name")
+		cmdFlags.Usage()
+		return 1
+	}
+	name := args[0]
+
+	if !validWorkspaceName(name) {
+		c.Ui.Error(fmt.Sprintf(errWorkspaceInvalidName, name))
+		return 1
+	}
+	if name == terraform.DefaultWorkspace {
+		c.Ui.Error(fmt.Sprintf("The %q workspace can't be deleted.", name))
+		return 1
+	}
+	current, err := c.Workspace()
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	if name == current {
+		c.Ui.Error(fmt.Sprintf(
+			"Workspace %q is selected and can't be deleted. Select another\n"+
+				"workspace with `terraform workspace select` first.", name))
+		return 1
+	}
+
+	exists, err := c.workspaceExists(name)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errWorkspaceList, err))
+		return 1
+	}
+	if !exists {
+		c.Ui.Error(fmt.Sprintf("Workspace %q doesn't exist.", name))
+		return 1
+	}
+
+	result, err := State(c.workspaceStateOpts(name))
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return 1
+	}
+
+	if stateHasResources(result.State.State()) && !force {
+		c.Ui.Error(fmt.Sprintf(
+			"The state of workspace %q isn't empty. Deleting it would leave its\n"+
+				"resources unmanaged by Terraform. Destroy the resources first, or\n"+
+				"use -force to delete the workspace anyway.", name))
+		return 1
+	}
+
+	// Delete the remote state of the workspace, if any
+	if result.Remote != nil {
+		if durable, ok := result.Remote.Durable.(*remote.State); ok {
+			if err := durable.Client.Delete(); err != nil {
+				c.Ui.Error(fmt.Sprintf("Error deleting the remote state of workspace %q: %s", name, err))
+				return 1
+			}
+		}
+	}
+
+	dirs := []string{
+		filepath.Join(DefaultWorkspaceDir, name),
+		filepath.Join(c.DataDir(), DefaultWorkspaceDir, name),
+	}
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error deleting the state of workspace %q: %s", name, err))
+			return 1
+		}
+	}
+
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"[reset][green]Deleted workspace %q.", name)))
+	return 0
+}
+
+func (c *WorkspaceDeleteCommand) Help() string {
+	helpText := `
+Usage: terraform workspace delete [options] NAME
+
+  Delete a workspace along with its state.
+
+  The default workspace and the selected workspace can't be deleted.
+
+Options:
+
+  -force              Delete the workspace even if its state still holds
+                      resources, leaving them unmanaged by Terraform.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *WorkspaceDeleteCommand) Synopsis() string {
+	return "Delete a workspace"
+}
+
+// stateHasResources returns whether the given state holds any resources.
+func stateHasResources(s *terraform.State) bool {
+	if s == nil {
+		return false
+	}
+
+	for _, m := range s.Modules {
+		if len(m.Resources) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
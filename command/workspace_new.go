@@ -0,0 +1,117 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// WorkspaceNewCommand is a Command implementation that creates a new
+// workspace and selects it.
+type WorkspaceNewCommand struct {
+	Meta
+}
+
+func (c *WorkspaceNewCommand) Run(args []string) int {
+	var statePath string
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("workspace new")
+	cmdFlags.StringVar(&statePath, "state", "", "path")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("workspace new requires exactly one argument: the workspace name")
+		cmdFlags.Usage()
+		return 1
+	}
+	name := args[0]
+
+	if !validWorkspaceName(name) {
+		c.Ui.Error(fmt.Sprintf(errWorkspaceInvalidName, name))
+		return 1
+	}
+
+	exists, err := c.workspaceExists(name)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errWorkspaceList, err))
+		return 1
+	}
+	if exists {
+		c.Ui.Error(fmt.Sprintf("Workspace %q already exists.", name))
+		return 1
+	}
+
+	// The new workspace starts with an empty state, or with a copy of the
+	// given state.
+	newState := terraform.NewState()
+	if statePath != "" {
+		f, err := os.Open(statePath)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error opening the state %q: %s", statePath, err))
+			return 1
+		}
+		newState, err = state.ReadState(f)
+		f.Close()
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error reading the state %q: %s", statePath, err))
+			return 1
+		}
+	}
+
+	result, err := State(c.workspaceStateOpts(name))
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return 1
+	}
+
+	// The remote info always comes from the workspace, in case the given
+	// state was pulled from elsewhere.
+	newState.Remote = nil
+	if current := result.State.State(); current != nil {
+		newState.Remote = current.Remote
+	}
+	if err := result.State.WriteState(newState); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing the state of workspace %q: %s", name, err))
+		return 1
+	}
+	if err := result.State.PersistState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing the state of workspace %q: %s", name, err))
+		return 1
+	}
+
+	if err := c.SetWorkspace(name); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error selecting the workspace: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"[reset][green]Created and switched to workspace %q.", name)))
+	return 0
+}
+
+func (c *WorkspaceNewCommand) Help() string {
+	helpText := `
+Usage: terraform workspace new [options] NAME
+
+  Create a new workspace and select it.
+
+Options:
+
+  -state=path         Copy the state at the given path into the new
+                      workspace.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *WorkspaceNewCommand) Synopsis() string {
+	return "Create a new workspace"
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WorkspaceSelectCommand is a Command implementation that selects the
+// workspace used by the other commands.
+type WorkspaceSelectCommand struct {
+	Meta
+}
+
+func (c *WorkspaceSelectCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("workspace select")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("workspace select requires exactly one argument: the workspace name")
+		cmdFlags.Usage()
+		return 1
+	}
+	name := args[0]
+
+	if !validWorkspaceName(name) {
+		c.Ui.Error(fmt.Sprintf(errWorkspaceInvalidName, name))
+		return 1
+	}
+
+	exists, err := c.workspaceExists(name)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errWorkspaceList, err))
+		return 1
+	}
+	if !exists {
+		c.Ui.Error(fmt.Sprintf(
+			"Workspace %q doesn't exist. Create it with `terraform workspace new`.", name))
+		return 1
+	}
+
+	if err := c.SetWorkspace(name); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error selecting the workspace: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"[reset][green]Switched to workspace %q.", name)))
+	return 0
+}
+
+func (c *WorkspaceSelectCommand) Help() string {
+	helpText := `
+Usage: terraform workspace select NAME
+
+  Select the workspace used by the other commands.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *WorkspaceSelectCommand) Synopsis() string {
+	return "Select a workspace"
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/hil"
 	"github.com/hashicorp/hil/ast"
 	"github.com/hashicorp/terraform/helper/hilmapstructure"
@@ -53,6 +54,8 @@ type AtlasConfig struct {
 type Module struct {
 	Name      string
 	Source    string
+	Version   string
+	Providers map[string]string
 	RawConfig *RawConfig
 }
 
@@ -123,6 +126,95 @@ func (r *ResourceLifecycle) Copy() *ResourceLifecycle {
 	return n
 }
 
+// IgnoreAllChanges returns true if ignore_changes is "*" or "all", which
+// ignores the changes to all the attributes of the resource.
+func (r *ResourceLifecycle) IgnoreAllChanges() bool {
+	for _, v := range r.IgnoreChanges {
+		if v == "*" || v == "all" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IgnoreChangesPaths returns the attribute paths of ignore_changes, each
+// split into the steps of the path. Map keys and list indexes can be written
+// in brackets, so both `tags["owner"]` and "tags.owner" are
+// ["tags", "owner"]. A "*" step, like in "disk.*.size", matches any key or
+// index.
+func (r *ResourceLifecycle) IgnoreChangesPaths() ([][]string, error) {
+	result := make([][]string, 0, len(r.IgnoreChanges))
+	for _, v := range r.IgnoreChanges {
+		path, err := parseIgnoreChangesPath(v)
+		if err != nil {
+			return nil, fmt.Errorf("ignore_changes: %q: %s", v, err)
+		}
+
+		result = append(result, path)
+	}
+
+	return result, nil
+}
+
+func parseIgnoreChangesPath(v string) ([]string, error) {
+	var path []string
+	for i := 0; i < len(v); {
+		switch {
+		case v[i] == '[':
+			if len(path) == 0 {
+				return nil, fmt.Errorf("empty attribute name")
+			}
+			end := strings.IndexRune(v[i:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("missing ]")
+			}
+			key := v[i+1 : i+end]
+			if strings.HasPrefix(key, `"`) {
+				unquoted, err := strconv.Unquote(key)
+				if err != nil {
+					return nil, fmt.Errorf("invalid key %s", key)
+				}
+				key = unquoted
+			} else if _, err := strconv.Atoi(key); err != nil && key != "*" {
+				return nil, fmt.Errorf(
+					"key %q must be quoted, an index or *", key)
+			}
+			if key == "" {
+				return nil, fmt.Errorf("empty key")
+			}
+
+			path = append(path, key)
+			i += end + 1
+		case v[i] == '.' && len(path) > 0:
+			i++
+			fallthrough
+		default:
+			end := strings.IndexAny(v[i:], ".[")
+			if end == -1 {
+				end = len(v) - i
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty attribute name")
+			}
+
+			path = append(path, v[i:i+end])
+			i += end
+		}
+
+		// A key in brackets must be followed by another step or nothing
+		if i < len(v) && v[i] != '.' && v[i] != '[' {
+			return nil, fmt.Errorf("unexpected %q", v[i:])
+		}
+	}
+
+	if len(path) == 0 {
+		return nil, fmt.Errorf("empty attribute name")
+	}
+
+	return path, nil
+}
+
 // Provisioner is a configured provisioner step on a resource.
 type Provisioner struct {
 	Type      string
@@ -201,8 +293,22 @@ func (r *Module) Id() string {
 	return fmt.Sprintf("%s", r.Name)
 }
 
+// CountComputed returns true if the count of this resource depends on
+// values that aren't known yet.
+func (r *Resource) CountComputed() bool {
+	return len(r.RawCount.UnknownKeys()) > 0
+}
+
 // Count returns the count of this resource.
 func (r *Resource) Count() (int, error) {
+	// The count may reference data sources which can only be read during
+	// apply, in which case the resource can't be expanded yet.
+	if r.CountComputed() {
+		return 0, fmt.Errorf(
+			"%s: value of 'count' cannot be computed, it depends on values "+
+				"that are only known after apply", r.Id())
+	}
+
 	v, err := strconv.ParseInt(r.RawCount.Value().(string), 0, 0)
 	if err != nil {
 		return 0, err
@@ -304,6 +410,13 @@ func (c *Config) Validate() error {
 						source,
 						v.FullKey()))
 				}
+			case *TerraformVariable:
+				if v.Field != "workspace" {
+					errs = append(errs, fmt.Errorf(
+						"%s: invalid terraform variable: %s",
+						source,
+						v.FullKey()))
+				}
 			}
 		}
 	}
@@ -356,6 +469,33 @@ func (c *Config) Validate() error {
 				m.Id()))
 		}
 
+		// Check that the version is a valid constraint
+		if m.Version != "" {
+			if _, err := version.NewConstraint(m.Version); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"%s: invalid version constraint %q: %s",
+					m.Id(), m.Version, err))
+			}
+		}
+
+		// Check that the providers passed to the module are configured
+		// here and have the type of the provider they're passed as
+		for k, v := range m.Providers {
+			if _, ok := providerSet[v]; !ok {
+				errs = append(errs, fmt.Errorf(
+					"%s: module is passed non-configured provider '%s'",
+					m.Id(), v))
+				continue
+			}
+
+			if strings.SplitN(k, ".", 2)[0] != strings.SplitN(v, ".", 2)[0] {
+				errs = append(errs, fmt.Errorf(
+					"%s: provider '%s' can't be passed as '%s', "+
+						"the provider types must match",
+					m.Id(), v, k))
+			}
+		}
+
 		// Check that the name matches our regexp
 		if !NameRegexp.Match([]byte(m.Name)) {
 			errs = append(errs, fmt.Errorf(
@@ -464,6 +604,13 @@ func (c *Config) Validate() error {
 					n,
 					v.FullKey()))
 			case *ResourceVariable:
+				// Data sources are read before the plan is made, so their
+				// attributes can be used unless they're only known after
+				// apply, which is checked when the count is expanded.
+				if v.(*ResourceVariable).Mode == DataResourceMode {
+					continue
+				}
+
 				errs = append(errs, fmt.Errorf(
 					"%s: resource count can't reference resource variable: %s",
 					n,
@@ -526,6 +673,17 @@ func (c *Config) Validate() error {
 			}
 		}
 
+		// Verify the ignore_changes paths are valid, and that "*" isn't
+		// combined with other attributes since it ignores all of them.
+		if _, err := r.Lifecycle.IgnoreChangesPaths(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", n, err))
+		}
+		if r.Lifecycle.IgnoreAllChanges() && len(r.Lifecycle.IgnoreChanges) > 1 {
+			errs = append(errs, fmt.Errorf(
+				"%s: ignore_changes: %q can't be combined with other attributes",
+				n, r.Lifecycle.IgnoreChanges[0]))
+		}
+
 		// Verify provisioners don't contain any splats
 		for _, p := range r.Provisioners {
 			// This validation checks that there are now splat variables
@@ -770,6 +928,14 @@ func (m *Module) mergerMerge(other merger) merger {
 		result.Source = m2.Source
 	}
 
+	if m2.Version != "" {
+		result.Version = m2.Version
+	}
+
+	if len(m2.Providers) > 0 {
+		result.Providers = m2.Providers
+	}
+
 	return &result
 }
 
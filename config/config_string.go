@@ -76,6 +76,18 @@ func modulesStr(ms []*Module) string {
 		sort.Strings(ks)
 
 		result += fmt.Sprintf("  source = %s\n", m.Source)
+		if m.Version != "" {
+			result += fmt.Sprintf("  version = %s\n", m.Version)
+		}
+
+		pks := make([]string, 0, len(m.Providers))
+		for k, _ := range m.Providers {
+			pks = append(pks, k)
+		}
+		sort.Strings(pks)
+		for _, k := range pks {
+			result += fmt.Sprintf("  provider %s = %s\n", k, m.Providers[k])
+		}
 
 		for _, k := range ks {
 			result += fmt.Sprintf("  %s\n", k)
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/hil/ast"
 	"github.com/hashicorp/terraform/helper/logging"
 )
 
@@ -105,6 +106,29 @@ func TestConfigCount_var(t *testing.T) {
 	}
 }
 
+func TestConfigCount_computed(t *testing.T) {
+	c := testConfig(t, "count-data-var")
+	r := c.Resources[1]
+
+	vars := map[string]ast.Variable{
+		"data.aws_availability_zones.available.names": ast.Variable{
+			Value: UnknownVariableValue,
+			Type:  ast.TypeString,
+		},
+	}
+	if err := r.RawCount.Interpolate(vars); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err := r.Count()
+	if err == nil {
+		t.Fatalf("should error")
+	}
+	if !strings.Contains(err.Error(), "cannot be computed") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
 func TestConfig_emptyCollections(t *testing.T) {
 	c := testConfig(t, "empty-collections")
 	if len(c.Variables) != 3 {
@@ -174,6 +198,13 @@ func TestConfigValidate_countCountVar(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_countDataVar(t *testing.T) {
+	c := testConfig(t, "validate-count-data-var")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
 func TestConfigValidate_countModuleVar(t *testing.T) {
 	c := testConfig(t, "validate-count-module-var")
 	if err := c.Validate(); err == nil {
@@ -237,6 +268,27 @@ func TestConfigValidate_dupResource(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_ignoreChanges(t *testing.T) {
+	c := testConfig(t, "validate-ignore-changes-good")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_ignoreChangesBad(t *testing.T) {
+	c := testConfig(t, "validate-ignore-changes-bad")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_ignoreChangesAllMixed(t *testing.T) {
+	c := testConfig(t, "validate-ignore-changes-all-mixed")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_moduleNameBad(t *testing.T) {
 	c := testConfig(t, "validate-module-name-bad")
 	if err := c.Validate(); err == nil {
@@ -251,6 +303,27 @@ func TestConfigValidate_moduleSourceVar(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_moduleVersionBad(t *testing.T) {
+	c := testConfig(t, "validate-module-version-bad")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_moduleProvidersBad(t *testing.T) {
+	c := testConfig(t, "validate-module-providers-bad")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_moduleProvidersMissing(t *testing.T) {
+	c := testConfig(t, "validate-module-providers-missing")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_moduleVarInt(t *testing.T) {
 	c := testConfig(t, "validate-module-var-int")
 	if err := c.Validate(); err != nil {
@@ -307,6 +380,20 @@ func TestConfigValidate_pathVarInvalid(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_terraformVar(t *testing.T) {
+	c := testConfig(t, "validate-terraform-var")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_terraformVarInvalid(t *testing.T) {
+	c := testConfig(t, "validate-terraform-var-invalid")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_providerMulti(t *testing.T) {
 	c := testConfig(t, "validate-provider-multi")
 	if err := c.Validate(); err == nil {
@@ -517,3 +604,43 @@ func testConfig(t *testing.T, name string) *Config {
 
 	return c
 }
+
+func TestResourceLifecycleIgnoreChangesPaths(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected []string
+		Err      bool
+	}{
+		{"ami", []string{"ami"}, false},
+		{"tags.owner", []string{"tags", "owner"}, false},
+		{`tags["owner"]`, []string{"tags", "owner"}, false},
+		{`tags["a.b"]`, []string{"tags", "a.b"}, false},
+		{"disk.*.size", []string{"disk", "*", "size"}, false},
+		{"disk[*].size", []string{"disk", "*", "size"}, false},
+		{"disk[0]", []string{"disk", "0"}, false},
+		{"*", []string{"*"}, false},
+		{"", nil, true},
+		{".ami", nil, true},
+		{"tags.", nil, true},
+		{"tags..owner", nil, true},
+		{"tags[owner]", nil, true},
+		{`tags["owner"`, nil, true},
+		{`tags["owner"]x`, nil, true},
+		{`["owner"]`, nil, true},
+	}
+
+	for _, tc := range cases {
+		l := &ResourceLifecycle{IgnoreChanges: []string{tc.Input}}
+		actual, err := l.IgnoreChangesPaths()
+		if (err != nil) != tc.Err {
+			t.Fatalf("%q: err: %s", tc.Input, err)
+		}
+		if tc.Err {
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, [][]string{tc.Expected}) {
+			t.Fatalf("%q: bad: %#v", tc.Input, actual)
+		}
+	}
+}
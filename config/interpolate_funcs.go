@@ -1,6 +1,8 @@
 package config
 
 import (
+	"bytes"
+	"compress/gzip"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -10,11 +12,14 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net"
+	"net/url"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/apparentlymart/go-cidr/cidr"
 	"github.com/hashicorp/go-uuid"
@@ -53,20 +58,25 @@ func Funcs() map[string]ast.Function {
 	return map[string]ast.Function{
 		"base64decode": interpolationFuncBase64Decode(),
 		"base64encode": interpolationFuncBase64Encode(),
+		"base64gzip":   interpolationFuncBase64Gzip(),
 		"base64sha256": interpolationFuncBase64Sha256(),
 		"cidrhost":     interpolationFuncCidrHost(),
 		"cidrnetmask":  interpolationFuncCidrNetmask(),
 		"cidrsubnet":   interpolationFuncCidrSubnet(),
+		"cidrsubnets":  interpolationFuncCidrSubnets(),
 		"coalesce":     interpolationFuncCoalesce(),
 		"compact":      interpolationFuncCompact(),
 		"concat":       interpolationFuncConcat(),
+		"contains":     interpolationFuncContains(),
 		"distinct":     interpolationFuncDistinct(),
 		"element":      interpolationFuncElement(),
 		"file":         interpolationFuncFile(),
+		"flatten":      interpolationFuncFlatten(),
 		"format":       interpolationFuncFormat(),
 		"formatlist":   interpolationFuncFormatList(),
 		"index":        interpolationFuncIndex(),
 		"join":         interpolationFuncJoin(),
+		"jsondecode":   interpolationFuncJSONDecode(),
 		"jsonencode":   interpolationFuncJSONEncode(),
 		"length":       interpolationFuncLength(),
 		"list":         interpolationFuncList(),
@@ -79,10 +89,15 @@ func Funcs() map[string]ast.Function {
 		"sha1":         interpolationFuncSha1(),
 		"sha256":       interpolationFuncSha256(),
 		"signum":       interpolationFuncSignum(),
+		"slice":        interpolationFuncSlice(),
 		"sort":         interpolationFuncSort(),
 		"split":        interpolationFuncSplit(),
+		"timeadd":      interpolationFuncTimeAdd(),
+		"timestamp":    interpolationFuncTimestamp(),
 		"trimspace":    interpolationFuncTrimSpace(),
 		"upper":        interpolationFuncUpper(),
+		"urlencode":    interpolationFuncURLEncode(),
+		"zipmap":       interpolationFuncZipMap(),
 	}
 }
 
@@ -284,6 +299,69 @@ func interpolationFuncCidrSubnet() ast.Function {
 	}
 }
 
+// interpolationFuncCidrSubnets implements the "cidrsubnets" function that
+// allocates consecutive subnets of the given additional prefix lengths
+// within an IP block expressed in CIDR notation.
+func interpolationFuncCidrSubnets() ast.Function {
+	return ast.Function{
+		ArgTypes: []ast.Type{
+			ast.TypeString, // starting CIDR mask
+		},
+		ReturnType:   ast.TypeList,
+		Variadic:     true,
+		VariadicType: ast.TypeInt, // number of bits to extend the prefix
+		Callback: func(args []interface{}) (interface{}, error) {
+			if len(args) < 2 {
+				return nil, fmt.Errorf("must provide at least one prefix extension")
+			}
+
+			_, network, err := net.ParseCIDR(args[0].(string))
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR expression: %s", err)
+			}
+			prefixLen, addrLen := network.Mask.Size()
+
+			// offset is the number of addresses from the start of the
+			// network to the first one which hasn't been allocated yet.
+			offset := big.NewInt(0)
+			subnets := make([]string, 0, len(args)-1)
+			for i, arg := range args[1:] {
+				extraBits := arg.(int)
+
+				// See interpolationFuncCidrSubnet for why this is limited.
+				if extraBits < 1 || extraBits > 32 {
+					return nil, fmt.Errorf(
+						"prefix extension %d must be between 1 and 32 bits, got %d", i+1, extraBits)
+				}
+				if prefixLen+extraBits > addrLen {
+					return nil, fmt.Errorf(
+						"insufficient address space to extend prefix of %d by %d", prefixLen, extraBits)
+				}
+
+				// Each subnet starts at the first address after the previous
+				// one which is aligned to its own size.
+				size := new(big.Int).Lsh(big.NewInt(1), uint(addrLen-prefixLen-extraBits))
+				num := new(big.Int).Add(offset, new(big.Int).Sub(size, big.NewInt(1)))
+				num.Div(num, size)
+
+				// The subnet number is always below 2^32, so it only
+				// overflows cidr.Subnet when there's no room left.
+				subnet, err := cidr.Subnet(network, extraBits, int(num.Int64()))
+				if err != nil {
+					return nil, fmt.Errorf(
+						"not enough remaining address space for a subnet with a prefix of %d bits after %s",
+						prefixLen+extraBits, strings.Join(subnets, ", "))
+				}
+
+				subnets = append(subnets, subnet.String())
+				offset.Mul(num.Add(num, big.NewInt(1)), size)
+			}
+
+			return stringSliceToVariableValue(subnets), nil
+		},
+	}
+}
+
 // interpolationFuncCoalesce implements the "coalesce" function that
 // returns the first non null / empty string from the provided input
 func interpolationFuncCoalesce() ast.Function {
@@ -349,6 +427,31 @@ func interpolationFuncConcat() ast.Function {
 	}
 }
 
+// interpolationFuncContains implements the "contains" function that
+// returns whether a list contains the given string.
+func interpolationFuncContains() ast.Function {
+	return ast.Function{
+		ArgTypes:   []ast.Type{ast.TypeList, ast.TypeString},
+		ReturnType: ast.TypeString,
+		Callback: func(args []interface{}) (interface{}, error) {
+			haystack := args[0].([]ast.Variable)
+			needle := args[1].(string)
+			for _, element := range haystack {
+				if element.Type != ast.TypeString {
+					return nil, fmt.Errorf(
+						"contains() may only be used with flat lists, this list contains elements of %s",
+						element.Type.Printable())
+				}
+
+				if needle == element.Value.(string) {
+					return "true", nil
+				}
+			}
+			return "false", nil
+		},
+	}
+}
+
 // interpolationFuncFile implements the "file" function that allows
 // loading contents from a file.
 func interpolationFuncFile() ast.Function {
@@ -370,6 +473,45 @@ func interpolationFuncFile() ast.Function {
 	}
 }
 
+// interpolationFuncFlatten implements the "flatten" function that replaces
+// the nested lists of a list with their elements, recursively.
+func interpolationFuncFlatten() ast.Function {
+	return ast.Function{
+		ArgTypes:   []ast.Type{ast.TypeList},
+		ReturnType: ast.TypeList,
+		Callback: func(args []interface{}) (interface{}, error) {
+			outputList := flattenListVariable(args[0].([]ast.Variable))
+
+			// we don't support heterogeneous types, so make sure all types match the first
+			if len(outputList) > 0 {
+				firstType := outputList[0].Type
+				for _, v := range outputList[1:] {
+					if v.Type != firstType {
+						return nil, fmt.Errorf("unexpected %s in list of %s", v.Type.Printable(), firstType.Printable())
+					}
+				}
+			}
+
+			return outputList, nil
+		},
+	}
+}
+
+// flattenListVariable returns the elements of a list, replacing the nested
+// lists with their own flattened elements.
+func flattenListVariable(list []ast.Variable) []ast.Variable {
+	outputList := make([]ast.Variable, 0, len(list))
+	for _, v := range list {
+		if v.Type == ast.TypeList {
+			outputList = append(outputList, flattenListVariable(v.Value.([]ast.Variable))...)
+			continue
+		}
+
+		outputList = append(outputList, v)
+	}
+	return outputList
+}
+
 // interpolationFuncFormat implements the "format" function that does
 // string formatting.
 func interpolationFuncFormat() ast.Function {
@@ -544,6 +686,71 @@ func interpolationFuncJoin() ast.Function {
 	}
 }
 
+// interpolationFuncJSONDecode implements the "jsondecode" function that
+// decodes a JSON object into a map. Numbers and booleans are decoded as
+// strings, and null as an empty string.
+func interpolationFuncJSONDecode() ast.Function {
+	return ast.Function{
+		ArgTypes:   []ast.Type{ast.TypeString},
+		ReturnType: ast.TypeMap,
+		Callback: func(args []interface{}) (interface{}, error) {
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(args[0].(string)), &decoded); err != nil {
+				return nil, fmt.Errorf("failed to decode JSON data: %s", err)
+			}
+
+			if _, ok := decoded.(map[string]interface{}); !ok {
+				return nil, fmt.Errorf("jsondecode() may only be used with JSON objects")
+			}
+
+			variable, err := jsonValueToVariable(decoded)
+			if err != nil {
+				return nil, err
+			}
+
+			return variable.Value, nil
+		},
+	}
+}
+
+// jsonValueToVariable converts a value decoded by encoding/json into a
+// variable, since the interpolation language has no number, boolean or
+// null types.
+func jsonValueToVariable(value interface{}) (ast.Variable, error) {
+	switch v := value.(type) {
+	case nil:
+		return ast.Variable{Type: ast.TypeString, Value: ""}, nil
+	case string:
+		return ast.Variable{Type: ast.TypeString, Value: v}, nil
+	case bool:
+		return ast.Variable{Type: ast.TypeString, Value: strconv.FormatBool(v)}, nil
+	case float64:
+		return ast.Variable{Type: ast.TypeString, Value: strconv.FormatFloat(v, 'f', -1, 64)}, nil
+	case []interface{}:
+		list := make([]ast.Variable, len(v))
+		for i, element := range v {
+			variable, err := jsonValueToVariable(element)
+			if err != nil {
+				return ast.Variable{}, err
+			}
+			list[i] = variable
+		}
+		return ast.Variable{Type: ast.TypeList, Value: list}, nil
+	case map[string]interface{}:
+		m := make(map[string]ast.Variable, len(v))
+		for k, element := range v {
+			variable, err := jsonValueToVariable(element)
+			if err != nil {
+				return ast.Variable{}, err
+			}
+			m[k] = variable
+		}
+		return ast.Variable{Type: ast.TypeMap, Value: m}, nil
+	}
+
+	return ast.Variable{}, fmt.Errorf("unknown type for JSON decoding: %T", value)
+}
+
 // interpolationFuncJSONEncode implements the "jsonencode" function that encodes
 // a string, list, or map as its JSON representation. For now, values in the
 // list or map may only be strings.
@@ -667,6 +874,44 @@ func interpolationFuncSignum() ast.Function {
 	}
 }
 
+// interpolationFuncSlice implements the "slice" function that returns the
+// elements of a list from the start index (inclusive) to the end index
+// (exclusive).
+func interpolationFuncSlice() ast.Function {
+	return ast.Function{
+		ArgTypes: []ast.Type{
+			ast.TypeList, // input list
+			ast.TypeInt,  // from index
+			ast.TypeInt,  // to index
+		},
+		ReturnType: ast.TypeList,
+		Variadic:   false,
+		Callback: func(args []interface{}) (interface{}, error) {
+			inputList := args[0].([]ast.Variable)
+			from := args[1].(int)
+			to := args[2].(int)
+
+			if from < 0 {
+				return nil, fmt.Errorf("from index must be >= 0")
+			}
+			if to > len(inputList) {
+				return nil, fmt.Errorf("to index must be <= length of the input list")
+			}
+			if from > to {
+				return nil, fmt.Errorf("from index must be <= to index")
+			}
+
+			outputList := []ast.Variable{}
+			for i, val := range inputList {
+				if i >= from && i < to {
+					outputList = append(outputList, val)
+				}
+			}
+			return outputList, nil
+		},
+	}
+}
+
 // interpolationFuncSort sorts a list of a strings lexographically
 func interpolationFuncSort() ast.Function {
 	return ast.Function{
@@ -872,6 +1117,29 @@ func interpolationFuncBase64Decode() ast.Function {
 	}
 }
 
+// interpolationFuncBase64Gzip implements the "base64gzip" function that
+// compresses a string with gzip and then encodes the result with Base64.
+func interpolationFuncBase64Gzip() ast.Function {
+	return ast.Function{
+		ArgTypes:   []ast.Type{ast.TypeString},
+		ReturnType: ast.TypeString,
+		Callback: func(args []interface{}) (interface{}, error) {
+			s := args[0].(string)
+
+			var b bytes.Buffer
+			gz := gzip.NewWriter(&b)
+			if _, err := gz.Write([]byte(s)); err != nil {
+				return "", fmt.Errorf("failed to write gzip raw data: '%s'", s)
+			}
+			if err := gz.Close(); err != nil {
+				return "", fmt.Errorf("failed to close gzip writer: %s", err)
+			}
+
+			return base64.StdEncoding.EncodeToString(b.Bytes()), nil
+		},
+	}
+}
+
 // interpolationFuncLower implements the "lower" function that does
 // string lower casing.
 func interpolationFuncLower() ast.Function {
@@ -932,6 +1200,18 @@ func interpolationFuncUpper() ast.Function {
 	}
 }
 
+// interpolationFuncURLEncode implements the "urlencode" function that
+// escapes a string so it can be safely placed inside a URL query.
+func interpolationFuncURLEncode() ast.Function {
+	return ast.Function{
+		ArgTypes:   []ast.Type{ast.TypeString},
+		ReturnType: ast.TypeString,
+		Callback: func(args []interface{}) (interface{}, error) {
+			return url.QueryEscape(args[0].(string)), nil
+		},
+	}
+}
+
 func interpolationFuncSha1() ast.Function {
 	return ast.Function{
 		ArgTypes:   []ast.Type{ast.TypeString},
@@ -996,3 +1276,79 @@ func interpolationFuncUUID() ast.Function {
 		},
 	}
 }
+
+// interpolationFuncTimestamp implements the "timestamp" function that
+// returns the current time as an RFC 3339 timestamp in UTC. The result
+// differs on every run, so it always produces a diff unless ignored.
+func interpolationFuncTimestamp() ast.Function {
+	return ast.Function{
+		ArgTypes:   []ast.Type{},
+		ReturnType: ast.TypeString,
+		Callback: func(args []interface{}) (interface{}, error) {
+			return time.Now().UTC().Format(time.RFC3339), nil
+		},
+	}
+}
+
+// interpolationFuncTimeAdd implements the "timeadd" function that adds a
+// duration, such as "1h30m", to an RFC 3339 timestamp.
+func interpolationFuncTimeAdd() ast.Function {
+	return ast.Function{
+		ArgTypes: []ast.Type{
+			ast.TypeString, // input timestamp
+			ast.TypeString, // duration to add
+		},
+		ReturnType: ast.TypeString,
+		Callback: func(args []interface{}) (interface{}, error) {
+			ts, err := time.Parse(time.RFC3339, args[0].(string))
+			if err != nil {
+				return nil, fmt.Errorf("invalid RFC 3339 timestamp: %s", err)
+			}
+
+			duration, err := time.ParseDuration(args[1].(string))
+			if err != nil {
+				return nil, fmt.Errorf("invalid duration: %s", err)
+			}
+
+			return ts.Add(duration).Format(time.RFC3339), nil
+		},
+	}
+}
+
+// interpolationFuncZipMap implements the "zipmap" function that creates a
+// map from a list of keys and a list of values.
+func interpolationFuncZipMap() ast.Function {
+	return ast.Function{
+		ArgTypes: []ast.Type{
+			ast.TypeList, // Keys
+			ast.TypeList, // Values
+		},
+		ReturnType: ast.TypeMap,
+		Callback: func(args []interface{}) (interface{}, error) {
+			keys := args[0].([]ast.Variable)
+			values := args[1].([]ast.Variable)
+
+			if len(keys) != len(values) {
+				return nil, fmt.Errorf("count of keys (%d) does not match count of values (%d)",
+					len(keys), len(values))
+			}
+
+			outputMap := make(map[string]ast.Variable)
+			for i, key := range keys {
+				if key.Type != ast.TypeString {
+					return nil, fmt.Errorf("keys must be strings, key %d is %s", i, key.Type.Printable())
+				}
+
+				// Enforce map type homogeneity
+				if values[i].Type != values[0].Type {
+					return nil, fmt.Errorf("all map values must have the same type, got %s then %s",
+						values[0].Type.Printable(), values[i].Type.Printable())
+				}
+
+				outputMap[key.Value.(string)] = values[i]
+			}
+
+			return outputMap, nil
+		},
+	}
+}
@@ -1,11 +1,15 @@
 package config
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/hil"
 	"github.com/hashicorp/hil/ast"
@@ -331,6 +335,58 @@ func TestInterpolateFuncCidrSubnet(t *testing.T) {
 	})
 }
 
+func TestInterpolateFuncCidrSubnets(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${cidrsubnets("10.1.0.0/16", 4, 4, 8, 4)}`,
+				[]interface{}{"10.1.0.0/20", "10.1.16.0/20", "10.1.32.0/24", "10.1.48.0/20"},
+				false,
+			},
+			{
+				`${cidrsubnets("fd00:fd12:3456:7890::/56", 16, 16, 16, 32)}`,
+				[]interface{}{
+					"fd00:fd12:3456:7800::/72",
+					"fd00:fd12:3456:7800:100::/72",
+					"fd00:fd12:3456:7800:200::/72",
+					"fd00:fd12:3456:7800:300::/88",
+				},
+				false,
+			},
+			{
+				`${cidrsubnets("10.1.0.0/16", 1, 1)}`,
+				[]interface{}{"10.1.0.0/17", "10.1.128.0/17"},
+				false,
+			},
+			{
+				`${cidrsubnets("10.1.0.0/16", 1, 1, 1)}`,
+				nil,
+				true, // not enough address space left
+			},
+			{
+				`${cidrsubnets("10.1.0.0/30", 4)}`,
+				nil,
+				true, // not enough bits left
+			},
+			{
+				`${cidrsubnets("10.1.0.0/16", 0)}`,
+				nil,
+				true, // the prefix must be extended
+			},
+			{
+				`${cidrsubnets("10.1.0.0/16")}`,
+				nil,
+				true, // no prefix extensions
+			},
+			{
+				`${cidrsubnets("not-a-cidr", 4)}`,
+				nil,
+				true, // not a valid CIDR mask
+			},
+		},
+	})
+}
+
 func TestInterpolateFuncCoalesce(t *testing.T) {
 	testFunction(t, testFunctionConfig{
 		Cases: []testFunctionCase{
@@ -498,6 +554,37 @@ func TestInterpolateFuncConcat(t *testing.T) {
 	})
 }
 
+func TestInterpolateFuncContains(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Vars: map[string]ast.Variable{
+			"var.listOfStrings": interfaceToVariableSwallowError([]string{"notfoo", "stillnotfoo", "bar"}),
+			"var.listOfLists":   interfaceToVariableSwallowError([]interface{}{[]string{"foo"}}),
+		},
+		Cases: []testFunctionCase{
+			{
+				`${contains(list(), "foo")}`,
+				"false",
+				false,
+			},
+			{
+				`${contains(var.listOfStrings, "bar")}`,
+				"true",
+				false,
+			},
+			{
+				`${contains(var.listOfStrings, "foo")}`,
+				"false",
+				false,
+			},
+			{
+				`${contains(var.listOfLists, "foo")}`,
+				nil,
+				true,
+			},
+		},
+	})
+}
+
 func TestInterpolateFuncMerge(t *testing.T) {
 	testFunction(t, testFunctionConfig{
 		Cases: []testFunctionCase{
@@ -661,6 +748,41 @@ func TestInterpolateFuncFile(t *testing.T) {
 	})
 }
 
+func TestInterpolateFuncFlatten(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${flatten(list())}`,
+				[]interface{}{},
+				false,
+			},
+			{
+				`${flatten(list("a", "b"))}`,
+				[]interface{}{"a", "b"},
+				false,
+			},
+			{
+				`${flatten(list(list("a", "b"), list("c"), list(list("d"), list())))}`,
+				[]interface{}{"a", "b", "c", "d"},
+				false,
+			},
+			{
+				`${flatten(list(list(map("a", "b")), list(map("c", "d"))))}`,
+				[]interface{}{
+					map[string]interface{}{"a": "b"},
+					map[string]interface{}{"c": "d"},
+				},
+				false,
+			},
+			{
+				`${flatten(list(list("a"), list(map("c", "d"))))}`,
+				nil,
+				true, // heterogeneous elements
+			},
+		},
+	})
+}
+
 func TestInterpolateFuncFormat(t *testing.T) {
 	testFunction(t, testFunctionConfig{
 		Cases: []testFunctionCase{
@@ -820,6 +942,60 @@ func TestInterpolateFuncJoin(t *testing.T) {
 	})
 }
 
+func TestInterpolateFuncJSONDecode(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${jsondecode("{}")}`,
+				map[string]interface{}{},
+				false,
+			},
+			{
+				`${jsondecode(var.scalars)}`,
+				map[string]interface{}{
+					"foo":     "bar",
+					"count":   "1.5",
+					"enabled": "true",
+					"none":    "",
+				},
+				false,
+			},
+			{
+				`${jsondecode(var.nested)}`,
+				map[string]interface{}{
+					"list": []interface{}{"a", "b"},
+					"map":  map[string]interface{}{"c": "d"},
+				},
+				false,
+			},
+			{
+				`${jsondecode(var.list)}`,
+				nil,
+				true, // not an object
+			},
+			{
+				`${jsondecode("{")}`,
+				nil,
+				true, // invalid JSON
+			},
+		},
+		Vars: map[string]ast.Variable{
+			"var.scalars": ast.Variable{
+				Type:  ast.TypeString,
+				Value: `{"foo": "bar", "count": 1.5, "enabled": true, "none": null}`,
+			},
+			"var.nested": ast.Variable{
+				Type:  ast.TypeString,
+				Value: `{"list": ["a", "b"], "map": {"c": "d"}}`,
+			},
+			"var.list": ast.Variable{
+				Type:  ast.TypeString,
+				Value: `["foo"]`,
+			},
+		},
+	})
+}
+
 func TestInterpolateFuncJSONEncode(t *testing.T) {
 	testFunction(t, testFunctionConfig{
 		Vars: map[string]ast.Variable{
@@ -1061,6 +1237,83 @@ func TestInterpolateFuncSignum(t *testing.T) {
 	})
 }
 
+func TestInterpolateFuncSlice(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			// Negative from index
+			{
+				`${slice(list("a"), -1, 0)}`,
+				nil,
+				true,
+			},
+			// From index > to index
+			{
+				`${slice(list("a", "b", "c"), 2, 1)}`,
+				nil,
+				true,
+			},
+			// To index too large
+			{
+				`${slice(var.list_of_strings, 1, 4)}`,
+				nil,
+				true,
+			},
+			// Empty slice
+			{
+				`${slice(var.list_of_strings, 1, 1)}`,
+				[]interface{}{},
+				false,
+			},
+			{
+				`${slice(var.list_of_strings, 1, 2)}`,
+				[]interface{}{"b"},
+				false,
+			},
+			{
+				`${slice(var.list_of_strings, 0, length(var.list_of_strings) - 1)}`,
+				[]interface{}{"a", "b"},
+				false,
+			},
+		},
+		Vars: map[string]ast.Variable{
+			"var.list_of_strings": {
+				Type: ast.TypeList,
+				Value: []ast.Variable{
+					{
+						Type:  ast.TypeString,
+						Value: "a",
+					},
+					{
+						Type:  ast.TypeString,
+						Value: "b",
+					},
+					{
+						Type:  ast.TypeString,
+						Value: "c",
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestInterpolateFuncSlice_emptyRange(t *testing.T) {
+	input := []ast.Variable{
+		{Type: ast.TypeString, Value: "a"},
+		{Type: ast.TypeString, Value: "b"},
+	}
+
+	actual, err := interpolationFuncSlice().Callback([]interface{}{input, 1, 1})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	list, ok := actual.([]ast.Variable)
+	if !ok || list == nil || len(list) != 0 {
+		t.Fatalf("expected an empty, non-nil list, got: %#v", actual)
+	}
+}
+
 func TestInterpolateFuncSort(t *testing.T) {
 	testFunction(t, testFunctionConfig{
 		Vars: map[string]ast.Variable{
@@ -1481,6 +1734,37 @@ func TestInterpolateFuncBase64Decode(t *testing.T) {
 	})
 }
 
+func TestInterpolateFuncBase64Gzip(t *testing.T) {
+	ast, err := hil.Parse(`${base64gzip("test")}`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	result, err := hil.Eval(ast, langEvalConfig(nil))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The compressed bytes depend on the gzip implementation, so check
+	// that the result decodes back to the input instead.
+	compressed, err := base64.StdEncoding.DecodeString(result.Value.(string))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	actual, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if string(actual) != "test" {
+		t.Fatalf("bad: %q", actual)
+	}
+}
+
 func TestInterpolateFuncLower(t *testing.T) {
 	testFunction(t, testFunctionConfig{
 		Cases: []testFunctionCase{
@@ -1529,6 +1813,33 @@ func TestInterpolateFuncUpper(t *testing.T) {
 	})
 }
 
+func TestInterpolateFuncURLEncode(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${urlencode("abc123-_")}`,
+				"abc123-_",
+				false,
+			},
+			{
+				`${urlencode("foo:bar@localhost?foo=bar&bar=baz")}`,
+				"foo%3Abar%40localhost%3Ffoo%3Dbar%26bar%3Dbaz",
+				false,
+			},
+			{
+				`${urlencode("mailto:email?subject=this+is+my+subject")}`,
+				"mailto%3Aemail%3Fsubject%3Dthis%2Bis%2Bmy%2Bsubject",
+				false,
+			},
+			{
+				`${urlencode("foo/bar")}`,
+				"foo%2Fbar",
+				false,
+			},
+		},
+	})
+}
+
 func TestInterpolateFuncSha1(t *testing.T) {
 	testFunction(t, testFunctionConfig{
 		Cases: []testFunctionCase{
@@ -1626,6 +1937,109 @@ func TestInterpolateFuncUUID(t *testing.T) {
 	}
 }
 
+func TestInterpolateFuncTimestamp(t *testing.T) {
+	currentTime := time.Now().UTC()
+	ast, err := hil.Parse("${timestamp()}")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	result, err := hil.Eval(ast, langEvalConfig(nil))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resultTime, err := time.Parse(time.RFC3339, result.Value.(string))
+	if err != nil {
+		t.Fatalf("Error parsing timestamp: %s", err)
+	}
+
+	if resultTime.Sub(currentTime).Seconds() > 10.0 {
+		t.Fatalf("Timestamp Diff too large. Expected: %s\nReceived: %s", currentTime.Format(time.RFC3339), result.Value.(string))
+	}
+}
+
+func TestInterpolateFuncTimeAdd(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${timeadd("2017-11-22T00:00:00Z", "1s")}`,
+				"2017-11-22T00:00:01Z",
+				false,
+			},
+			{
+				`${timeadd("2017-11-22T00:00:00Z", "10m1s")}`,
+				"2017-11-22T00:10:01Z",
+				false,
+			},
+			{
+				`${timeadd("2017-11-22T00:00:00Z", "-1h")}`,
+				"2017-11-21T23:00:00Z",
+				false,
+			},
+			{
+				`${timeadd("2017-11-22T00:00:00+02:00", "1h")}`,
+				"2017-11-22T01:00:00+02:00",
+				false,
+			},
+			// Invalid format timestamp
+			{
+				`${timeadd("2017-11-22", "-1h")}`,
+				nil,
+				true,
+			},
+			// Invalid format duration (day is not supported by ParseDuration)
+			{
+				`${timeadd("2017-11-22T00:00:00Z", "1d")}`,
+				nil,
+				true,
+			},
+		},
+	})
+}
+
+func TestInterpolateFuncZipMap(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${zipmap(var.list, var.list2)}`,
+				map[string]interface{}{
+					"Hello": "bar",
+					"World": "baz",
+				},
+				false,
+			},
+			{
+				`${zipmap(var.list, var.nonstrings)}`,
+				map[string]interface{}{
+					"Hello": []interface{}{"bar", "baz"},
+					"World": []interface{}{"boo", "foo"},
+				},
+				false,
+			},
+			{
+				`${zipmap(var.nonstrings, var.list2)}`,
+				nil,
+				true, // keys must be strings
+			},
+			{
+				`${zipmap(var.list, var.list3)}`,
+				nil,
+				true, // lengths don't match
+			},
+		},
+		Vars: map[string]ast.Variable{
+			"var.list":  interfaceToVariableSwallowError([]string{"Hello", "World"}),
+			"var.list2": interfaceToVariableSwallowError([]string{"bar", "baz"}),
+			"var.list3": interfaceToVariableSwallowError([]string{"bar"}),
+			"var.nonstrings": interfaceToVariableSwallowError([]interface{}{
+				[]string{"bar", "baz"},
+				[]string{"boo", "foo"},
+			}),
+		},
+	})
+}
+
 type testFunctionConfig struct {
 	Cases []testFunctionCase
 	Vars  map[string]ast.Variable
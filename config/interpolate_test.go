@@ -63,6 +63,14 @@ func TestNewInterpolatedVariable(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"terraform.workspace",
+			&TerraformVariable{
+				Field: "workspace",
+				key:   "terraform.workspace",
+			},
+			false,
+		},
 	}
 
 	for i, tc := range cases {
@@ -282,6 +282,42 @@ func TestLoadFileBasic_modules(t *testing.T) {
 	}
 }
 
+func TestLoadFileBasic_modulesVersion(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "modules-version.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c == nil {
+		t.Fatal("config should not be nil")
+	}
+
+	actual := modulesStr(c.Modules)
+	if actual != strings.TrimSpace(modulesVersionModulesStr) {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestLoadFileBasic_modulesProviders(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "modules-providers.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c == nil {
+		t.Fatal("config should not be nil")
+	}
+
+	actual := modulesStr(c.Modules)
+	if actual != strings.TrimSpace(modulesProvidersModulesStr) {
+		t.Fatalf("bad:\n%s", actual)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
 func TestLoadJSONBasic(t *testing.T) {
 	raw, err := ioutil.ReadFile(filepath.Join(fixtureDir, "basic.tf.json"))
 	if err != nil {
@@ -948,6 +984,19 @@ bar
   memory
 `
 
+const modulesVersionModulesStr = `
+consul
+  source = hashicorp/consul/aws
+  version = ~> 1.0
+  servers
+`
+
+const modulesProvidersModulesStr = `
+west
+  source = ./child
+  provider aws = aws.west
+`
+
 const provisionerResourcesStr = `
 aws_instance.web (x1)
   ami
@@ -0,0 +1,394 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-getter"
+	"github.com/hashicorp/go-version"
+)
+
+// DefaultRegistryHost is the registry modules are installed from when the
+// source doesn't include a hostname.
+const DefaultRegistryHost = "registry.terraform.io"
+
+// registryServiceID is the key of the module registry API in the
+// discovery document of a host.
+const registryServiceID = "modules.v1"
+
+var (
+	registryHostRe      = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z-]*(\.[0-9A-Za-z-]+)+(:[0-9]+)?$`)
+	registryNamespaceRe = regexp.MustCompile(`^[0-9A-Za-z](?:[0-9A-Za-z-_]{0,62}[0-9A-Za-z])?$`)
+	registryProviderRe  = regexp.MustCompile(`^[0-9a-z]{1,64}$`)
+
+	// These hosts are handled by the go-getter detectors and are never
+	// treated as module registries.
+	registryExcludedHosts = map[string]struct{}{
+		"github.com":    struct{}{},
+		"bitbucket.org": struct{}{},
+	}
+)
+
+// registryHTTPClient is the client used to talk to module registries.
+var registryHTTPClient = cleanhttp.DefaultClient()
+
+// registryModule is the address of a module in a registry, in the form
+// [hostname/]namespace/name/provider.
+type registryModule struct {
+	Host      string
+	Namespace string
+	Name      string
+	Provider  string
+}
+
+// parseRegistryModule parses a module source as a registry address. The
+// second return value is false if the source isn't a registry address.
+func parseRegistryModule(source string) (*registryModule, bool) {
+	parts := strings.Split(source, "/")
+
+	host := DefaultRegistryHost
+	switch len(parts) {
+	case 3:
+	case 4:
+		host = parts[0]
+		parts = parts[1:]
+		if _, ok := registryExcludedHosts[host]; ok {
+			return nil, false
+		}
+		if !registryHostRe.MatchString(host) {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	if !registryNamespaceRe.MatchString(parts[0]) ||
+		!registryNamespaceRe.MatchString(parts[1]) ||
+		!registryProviderRe.MatchString(parts[2]) {
+		return nil, false
+	}
+
+	return &registryModule{
+		Host:      host,
+		Namespace: parts[0],
+		Name:      parts[1],
+		Provider:  parts[2],
+	}, true
+}
+
+func (m *registryModule) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", m.Host, m.Namespace, m.Name, m.Provider)
+}
+
+// path returns the path of the module relative to the modules API.
+func (m *registryModule) path() string {
+	return fmt.Sprintf("%s/%s/%s", m.Namespace, m.Name, m.Provider)
+}
+
+// discover returns the base URL of the modules API of the registry host,
+// as published in the discovery document of the host.
+func (m *registryModule) discover() (*url.URL, error) {
+	u := &url.URL{
+		Scheme: "https",
+		Host:   m.Host,
+		Path:   "/.well-known/terraform.json",
+	}
+
+	var doc map[string]interface{}
+	if _, err := registryGet(u, &doc); err != nil {
+		return nil, err
+	}
+
+	raw, ok := doc[registryServiceID].(string)
+	if !ok {
+		return nil, fmt.Errorf("host %s does not provide a module registry", m.Host)
+	}
+
+	base, err := u.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module registry URL %q: %s", raw, err)
+	}
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+
+	return base, nil
+}
+
+// registryVersions is the response of the versions endpoint of the
+// modules API.
+type registryVersions struct {
+	Modules []struct {
+		Versions []struct {
+			Version string `json:"version"`
+		} `json:"versions"`
+	} `json:"modules"`
+}
+
+// resolve returns the newest version of the module that satisfies the
+// constraints. Pre-release versions are never selected.
+func (m *registryModule) resolve(base *url.URL, cs version.Constraints) (*version.Version, error) {
+	u, err := base.Parse(m.path() + "/versions")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp registryVersions
+	if _, err := registryGet(u, &resp); err != nil {
+		return nil, err
+	}
+
+	var available version.Collection
+	for _, mod := range resp.Modules {
+		for _, raw := range mod.Versions {
+			v, err := version.NewVersion(raw.Version)
+			if err != nil || v.Prerelease() != "" {
+				continue
+			}
+
+			if cs.Check(v) {
+				available = append(available, v)
+			}
+		}
+	}
+
+	if len(available) == 0 {
+		if len(cs) == 0 {
+			return nil, fmt.Errorf("no versions of %s found", m)
+		}
+
+		return nil, fmt.Errorf(
+			"no versions of %s satisfy the constraint %q", m, cs)
+	}
+
+	sort.Sort(available)
+	return available[len(available)-1], nil
+}
+
+// download returns the go-getter source the given version of the module
+// can be downloaded from.
+func (m *registryModule) download(base *url.URL, v *version.Version) (string, error) {
+	u, err := base.Parse(fmt.Sprintf("%s/%s/download", m.path(), v))
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := registryGet(u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	location := resp.Header.Get("X-Terraform-Get")
+	if location == "" {
+		return "", fmt.Errorf("no download location returned for %s %s", m, v)
+	}
+
+	// Relative locations are relative to the download endpoint
+	if strings.HasPrefix(location, "/") ||
+		strings.HasPrefix(location, "./") ||
+		strings.HasPrefix(location, "../") {
+		rel, err := u.Parse(location)
+		if err != nil {
+			return "", err
+		}
+		location = rel.String()
+	}
+
+	return location, nil
+}
+
+// registryGet requests the given URL from a registry. If out is non-nil the
+// body is decoded into it as JSON.
+func registryGet(u *url.URL, out interface{}) (*http.Response, error) {
+	resp, err := registryHTTPClient.Get(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("error contacting module registry: %s", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s not found in the module registry", u)
+	default:
+		return nil, fmt.Errorf("error requesting %s: %s", u, resp.Status)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return nil, fmt.Errorf("error decoding response from %s: %s", u, err)
+		}
+	}
+
+	return resp, nil
+}
+
+// registryLock records which version of a registry module is installed, so
+// that loading the module again uses the same version without contacting
+// the registry.
+type registryLock struct {
+	Source  string `json:"source"`
+	Version string `json:"version"`
+	SubDir  string `json:"subdir,omitempty"`
+}
+
+// registryLockPath returns the path of the lock file for the module
+// installed in dir. It is kept beside the module so that the contents of
+// the module aren't modified.
+func registryLockPath(dir string) string {
+	return strings.TrimSuffix(dir, string(filepath.Separator)) + ".lock.json"
+}
+
+func readRegistryLock(dir string) (*registryLock, error) {
+	raw, err := ioutil.ReadFile(registryLockPath(dir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var lock registryLock
+	if err := json.Unmarshal(raw, &lock); err != nil {
+		return nil, fmt.Errorf("error reading module lock: %s", err)
+	}
+	if lock.SubDir, err = cleanRegistrySubDir(lock.SubDir); err != nil {
+		return nil, fmt.Errorf("error reading module lock: %s", err)
+	}
+
+	return &lock, nil
+}
+
+// cleanRegistrySubDir cleans the subdirectory of a module download location
+// and rejects one that would point outside of the downloaded module.
+func cleanRegistrySubDir(subDir string) (string, error) {
+	if subDir == "" {
+		return "", nil
+	}
+
+	clean := filepath.Clean(filepath.FromSlash(subDir))
+	if filepath.IsAbs(clean) || clean == ".." ||
+		strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf(
+			"module subdirectory %q is outside of the module", subDir)
+	}
+
+	return clean, nil
+}
+
+func writeRegistryLock(dir string, lock *registryLock) error {
+	raw, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(registryLockPath(dir), raw, 0644)
+}
+
+// getRegistryStorage is like getStorage for modules installed from a
+// registry. The version that is installed is recorded in a lock file, and
+// the registry is only asked for a version if none is installed yet, the
+// installed one doesn't satisfy the constraint anymore, or we're updating.
+func getRegistryStorage(
+	s getter.Storage, key string, m *registryModule,
+	constraint string, mode GetMode) (string, bool, error) {
+	var cs version.Constraints
+	if constraint != "" {
+		var err error
+		cs, err = version.NewConstraint(constraint)
+		if err != nil {
+			return "", false, fmt.Errorf(
+				"invalid version constraint %q: %s", constraint, err)
+		}
+	}
+
+	dir, ok, err := s.Dir(key)
+	if err != nil {
+		return "", false, err
+	}
+
+	// Check whether what is installed is still usable
+	var lock *registryLock
+	if ok {
+		if lock, err = readRegistryLock(dir); err != nil {
+			return "", false, err
+		}
+	}
+	current := false
+	if lock != nil && lock.Source == m.String() {
+		if v, err := version.NewVersion(lock.Version); err == nil {
+			current = cs.Check(v)
+		}
+	}
+
+	if mode == GetModeNone || (mode == GetModeGet && current) {
+		if ok && !current {
+			return "", false, fmt.Errorf(
+				"installed version doesn't match the source or version " +
+					"constraint, may need to be updated using 'terraform get -update'")
+		}
+		if ok {
+			dir = filepath.Join(dir, lock.SubDir)
+		}
+
+		return dir, ok, nil
+	}
+
+	base, err := m.discover()
+	if err != nil {
+		return "", false, err
+	}
+	v, err := m.resolve(base, cs)
+	if err != nil {
+		return "", false, err
+	}
+
+	// If the newest version is the one we have, there is nothing to do
+	if current && lock.Version == v.String() {
+		return filepath.Join(dir, lock.SubDir), true, nil
+	}
+
+	location, err := m.download(base, v)
+	if err != nil {
+		return "", false, err
+	}
+	source, subDir := getter.SourceDirSubdir(location)
+	if subDir, err = cleanRegistrySubDir(subDir); err != nil {
+		return "", false, err
+	}
+
+	// Start from scratch, so nothing of the previous version is left
+	if ok {
+		if err := os.RemoveAll(dir); err != nil {
+			return "", false, err
+		}
+	}
+	if err := s.Get(key, source, true); err != nil {
+		return "", false, err
+	}
+
+	dir, ok, err = s.Dir(key)
+	if err != nil || !ok {
+		return "", ok, err
+	}
+
+	lock = &registryLock{
+		Source:  m.String(),
+		Version: v.String(),
+		SubDir:  subDir,
+	}
+	if err := writeRegistryLock(dir, lock); err != nil {
+		return "", false, err
+	}
+
+	return filepath.Join(dir, subDir), true, nil
+}
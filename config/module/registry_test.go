@@ -0,0 +1,226 @@
+package module
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+)
+
+func TestParseRegistryModule(t *testing.T) {
+	cases := []struct {
+		Source string
+		Result *registryModule
+	}{
+		{
+			"hashicorp/consul/aws",
+			&registryModule{
+				Host:      DefaultRegistryHost,
+				Namespace: "hashicorp",
+				Name:      "consul",
+				Provider:  "aws",
+			},
+		},
+		{
+			"example.com/hashicorp/consul/aws",
+			&registryModule{
+				Host:      "example.com",
+				Namespace: "hashicorp",
+				Name:      "consul",
+				Provider:  "aws",
+			},
+		},
+		{
+			"example.com:8443/hashicorp/consul/aws",
+			&registryModule{
+				Host:      "example.com:8443",
+				Namespace: "hashicorp",
+				Name:      "consul",
+				Provider:  "aws",
+			},
+		},
+		{"./foo/bar/baz", nil},
+		{"/foo/bar/baz", nil},
+		{"foo/bar", nil},
+		{"github.com/hashicorp/consul/aws", nil},
+		{"github.com/hashicorp/example", nil},
+		{"git::https://example.com/foo/bar.git", nil},
+		{"hashicorp/consul/AWS", nil},
+		{"localhost/hashicorp/consul/aws", nil},
+	}
+
+	for _, tc := range cases {
+		m, ok := parseRegistryModule(tc.Source)
+		if ok != (tc.Result != nil) {
+			t.Fatalf("%s: bad: %t", tc.Source, ok)
+		}
+		if !reflect.DeepEqual(m, tc.Result) {
+			t.Fatalf("%s: bad: %#v", tc.Source, m)
+		}
+	}
+}
+
+// testRegistry starts a module registry serving hashicorp/consul/aws and
+// returns its host. The returned pointer counts the requests made to the
+// registry, and the returned function stops it.
+func TestCleanRegistrySubDir(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Output string
+		Err    bool
+	}{
+		{"", "", false},
+		{"modules/consul", filepath.Join("modules", "consul"), false},
+		{"modules/../consul/", "consul", false},
+		{"..", "", true},
+		{"../consul", "", true},
+		{"modules/../../consul", "", true},
+		{"/etc", "", true},
+	}
+
+	for _, tc := range cases {
+		actual, err := cleanRegistrySubDir(tc.Input)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%s: err: %s", tc.Input, err)
+		}
+		if actual != tc.Output {
+			t.Fatalf("%s: bad: %q", tc.Input, actual)
+		}
+	}
+}
+
+func testRegistry(t *testing.T) (string, *int, func()) {
+	fixture, err := filepath.Abs(filepath.Join(fixtureDir, "registry-consul"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	requests := new(int)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/terraform.json", func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		fmt.Fprint(w, `{"modules.v1": "/v1/modules/"}`)
+	})
+	mux.HandleFunc("/v1/modules/hashicorp/consul/aws/versions", func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		fmt.Fprint(w, `{"modules": [{"versions": [
+			{"version": "0.1.0"},
+			{"version": "1.0.0"},
+			{"version": "1.2.0"},
+			{"version": "1.3.0-beta1"},
+			{"version": "2.0.0"}
+		]}]}`)
+	})
+	mux.HandleFunc("/v1/modules/hashicorp/consul/aws/1.2.0/download", func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Header().Set("X-Terraform-Get", "file://"+fixture)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewTLSServer(mux)
+
+	oldClient := registryHTTPClient
+	registryHTTPClient = &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	return host, requests, func() {
+		server.Close()
+		registryHTTPClient = oldClient
+	}
+}
+
+func testRegistryTree(host, constraint string) *Tree {
+	return NewTree("", &config.Config{
+		Modules: []*config.Module{
+			&config.Module{
+				Name:    "consul",
+				Source:  host + "/hashicorp/consul/aws",
+				Version: constraint,
+			},
+		},
+	})
+}
+
+func TestTreeLoad_registry(t *testing.T) {
+	host, requests, closeFn := testRegistry(t)
+	defer closeFn()
+
+	storage := testStorage(t)
+	tree := testRegistryTree(host, "~> 1.0")
+	if err := tree.Load(storage, GetModeGet); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	child := tree.Child([]string{"consul"})
+	if child == nil {
+		t.Fatal("should have child")
+	}
+	if len(child.Config().Outputs) != 1 {
+		t.Fatalf("bad: %#v", child.Config().Outputs)
+	}
+	if *requests != 3 {
+		t.Fatalf("bad requests: %d", *requests)
+	}
+
+	dir, _, err := storage.Dir("root.consul")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	lock, err := readRegistryLock(dir)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if lock == nil || lock.Version != "1.2.0" {
+		t.Fatalf("bad: %#v", lock)
+	}
+
+	// Loading again uses the recorded version without asking the registry
+	if err := tree.Load(storage, GetModeGet); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := tree.Load(storage, GetModeNone); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if *requests != 3 {
+		t.Fatalf("bad requests: %d", *requests)
+	}
+
+	// A constraint the installed version doesn't satisfy needs a get
+	tree = testRegistryTree(host, "~> 0.1")
+	if err := tree.Load(storage, GetModeNone); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestTreeLoad_registryNoVersion(t *testing.T) {
+	host, _, closeFn := testRegistry(t)
+	defer closeFn()
+
+	tree := testRegistryTree(host, ">= 3.0")
+	err := tree.Load(testStorage(t), GetModeGet)
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "no versions") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
+func TestTreeLoad_versionNotRegistry(t *testing.T) {
+	tree := NewTree("", testConfig(t, "basic"))
+	tree.config.Modules[0].Version = "1.0.0"
+
+	if err := tree.Load(testStorage(t), GetModeGet); err == nil {
+		t.Fatal("should error")
+	}
+}
@@ -0,0 +1,7 @@
+variable "servers" {
+    default = "3"
+}
+
+output "servers" {
+    value = "${var.servers}"
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -103,8 +104,9 @@ func (t *Tree) Modules() []*Module {
 	result := make([]*Module, len(t.config.Modules))
 	for i, m := range t.config.Modules {
 		result[i] = &Module{
-			Name:   m.Name,
-			Source: m.Source,
+			Name:    m.Name,
+			Source:  m.Source,
+			Version: m.Version,
 		}
 	}
 
@@ -157,23 +159,40 @@ func (t *Tree) Load(s getter.Storage, mode GetMode) error {
 		// Split out the subdir if we have one
 		source, subDir := getter.SourceDirSubdir(m.Source)
 
-		source, err := getter.Detect(source, t.config.Dir, getter.Detectors)
-		if err != nil {
-			return fmt.Errorf("module %s: %s", m.Name, err)
-		}
-
-		// Check if the detector introduced something new.
-		source, subDir2 := getter.SourceDirSubdir(source)
-		if subDir2 != "" {
-			subDir = filepath.Join(subDir2, subDir)
-		}
-
 		// Get the directory where this module is so we can load it
 		key := strings.Join(path, ".")
 		key = "root." + key
-		dir, ok, err := getStorage(s, key, source, mode)
-		if err != nil {
-			return err
+
+		var dir string
+		var ok bool
+		if rm, isRegistry := t.registryModule(source); isRegistry {
+			var err error
+			dir, ok, err = getRegistryStorage(s, key, rm, m.Version, mode)
+			if err != nil {
+				return fmt.Errorf("module %s: %s", m.Name, err)
+			}
+		} else {
+			if m.Version != "" {
+				return fmt.Errorf(
+					"module %s: version constraints are only supported "+
+						"for modules from a registry", m.Name)
+			}
+
+			source, err := getter.Detect(source, t.config.Dir, getter.Detectors)
+			if err != nil {
+				return fmt.Errorf("module %s: %s", m.Name, err)
+			}
+
+			// Check if the detector introduced something new.
+			source, subDir2 := getter.SourceDirSubdir(source)
+			if subDir2 != "" {
+				subDir = filepath.Join(subDir2, subDir)
+			}
+
+			dir, ok, err = getStorage(s, key, source, mode)
+			if err != nil {
+				return err
+			}
 		}
 		if !ok {
 			return fmt.Errorf(
@@ -186,6 +205,7 @@ func (t *Tree) Load(s getter.Storage, mode GetMode) error {
 		}
 
 		// Load the configurations.Dir(source)
+		var err error
 		children[m.Name], err = NewTreeModule(m.Name, dir)
 		if err != nil {
 			return fmt.Errorf(
@@ -209,6 +229,22 @@ func (t *Tree) Load(s getter.Storage, mode GetMode) error {
 	return nil
 }
 
+// registryModule parses the source of a module as a registry address. A
+// source is only a registry address if it doesn't also name a directory
+// relative to this module, so existing local sources keep working.
+func (t *Tree) registryModule(source string) (*registryModule, bool) {
+	m, ok := parseRegistryModule(source)
+	if !ok {
+		return nil, false
+	}
+
+	if _, err := os.Stat(filepath.Join(t.config.Dir, source)); err == nil {
+		return nil, false
+	}
+
+	return m, true
+}
+
 // Path is the full path to this tree.
 func (t *Tree) Path() []string {
 	return t.path
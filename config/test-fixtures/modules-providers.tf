@@ -0,0 +1,11 @@
+provider "aws" {
+    alias = "west"
+}
+
+module "west" {
+    source = "./child"
+
+    providers = {
+        aws = "aws.west"
+    }
+}
@@ -0,0 +1,5 @@
+module "consul" {
+    source  = "hashicorp/consul/aws"
+    version = "~> 1.0"
+    servers = "3"
+}
@@ -0,0 +1,17 @@
+resource "aws_instance" "web" {
+  lifecycle {
+    ignore_changes = [
+      "ami",
+      "tags.owner",
+      "tags[\"cost-center\"]",
+      "ebs_block_device.*.volume_size",
+      "network_interface[0].private_ips",
+    ]
+  }
+}
+
+resource "aws_instance" "all" {
+  lifecycle {
+    ignore_changes = ["*"]
+  }
+}
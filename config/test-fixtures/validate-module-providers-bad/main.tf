@@ -0,0 +1,11 @@
+provider "google" {
+    alias = "west"
+}
+
+module "west" {
+    source = "./child"
+
+    providers = {
+        aws = "google.west"
+    }
+}
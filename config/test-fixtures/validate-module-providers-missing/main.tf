@@ -0,0 +1,7 @@
+module "west" {
+    source = "./child"
+
+    providers = {
+        aws = "aws.west"
+    }
+}
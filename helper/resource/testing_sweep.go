@@ -0,0 +1,164 @@
+package resource
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// flagSweep is a flag available when running tests on the command line. It
+// contains a comma separated list of regions for the sweeper functions to
+// run in.
+var flagSweep = flag.String("sweep", "", "List of Regions to run available Sweepers")
+
+// flagSweepRun is an optional comma separated list of the names of the
+// sweepers to run, along with their dependencies. All the sweepers are run
+// when it's empty.
+var flagSweepRun = flag.String("sweep-run", "", "Comma separated list of Sweepers to run")
+
+var sweeperFuncs map[string]*Sweeper
+
+// SweeperFunc is a function deleting the resources leaked by acceptance
+// tests in the given region.
+type SweeperFunc func(r string) error
+
+// Sweeper deletes the resources of one type leaked by acceptance tests,
+// such as when a test panics or the destroy step fails, so that the test
+// accounts don't fill up with resources which count towards quotas.
+type Sweeper struct {
+	// Name for sweeper. Must be unique to be ran by the Sweeper Runner
+	Name string
+
+	// Dependencies list the names of the sweepers which must run before
+	// this one, such as the sweepers of the resources which would prevent
+	// the deletion of the resources swept by this one.
+	Dependencies []string
+
+	// Sweeper function that when invoked sweeps the Provider of specific
+	// resources
+	F SweeperFunc
+}
+
+func init() {
+	sweeperFuncs = make(map[string]*Sweeper)
+}
+
+// AddTestSweepers function adds a given name and Sweeper configuration
+// pair to the internal sweeperFuncs map. Invoke this function to register a
+// resource sweeper to be available for running when the -sweep flag is used
+// with `go test`. Sweeper names must be unique to help ensure a given sweeper
+// is only ran once per run.
+func AddTestSweepers(name string, s *Sweeper) {
+	if _, ok := sweeperFuncs[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweeperFuncs: function already exists in map", name)
+	}
+
+	sweeperFuncs[name] = s
+}
+
+// TestMain runs the sweepers when the -sweep flag is given, and the tests
+// of the package otherwise. Providers registering sweepers call it from
+// the TestMain function of their test package:
+//
+//	func TestMain(m *testing.M) {
+//	    resource.TestMain(m)
+//	}
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if *flagSweep != "" {
+		regions := strings.Split(*flagSweep, ",")
+		if err := runSweepers(regions, filterSweepers(*flagSweepRun, sweeperFuncs)); err != nil {
+			log.Fatalf("[ERR] %s", err)
+		}
+
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// runSweepers runs the given sweepers, along with their dependencies, in
+// each region. Each sweeper runs at most once per region.
+func runSweepers(regions []string, sweepers map[string]*Sweeper) error {
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+		ran := make(map[string]bool)
+		for _, name := range names {
+			if err := runSweeperWithRegion(region, name, ran, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// runSweeperWithRegion runs the dependencies of the named sweeper and then
+// the sweeper itself, unless it already ran. running holds the sweepers
+// whose dependencies are being run, to detect dependency cycles.
+func runSweeperWithRegion(region, name string, ran map[string]bool, running []string) error {
+	if ran[name] {
+		return nil
+	}
+
+	for _, r := range running {
+		if r == name {
+			return fmt.Errorf("dependency cycle between sweepers: %s", strings.Join(append(running, name), " -> "))
+		}
+	}
+
+	s, ok := sweeperFuncs[name]
+	if !ok {
+		return fmt.Errorf("unknown sweeper (%s)", name)
+	}
+
+	for _, dep := range s.Dependencies {
+		if err := runSweeperWithRegion(region, dep, ran, append(running, name)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+	if err := s.F(region); err != nil {
+		return fmt.Errorf("error running Sweeper (%s) in region (%s): %s", name, region, err)
+	}
+	ran[name] = true
+
+	return nil
+}
+
+// filterSweepers takes a comma separated string listing the names of
+// sweepers to be ran, and returns a filtered set from the list of all of
+// sweepers to run based on the names given. Names match sweepers whose name
+// contains them, ignoring case.
+func filterSweepers(f string, source map[string]*Sweeper) map[string]*Sweeper {
+	filterSlice := strings.Split(strings.ToLower(f), ",")
+	if len(filterSlice) == 1 && filterSlice[0] == "" {
+		// if the filter slice is a single element of "" then no sweeper list was
+		// given, so just return the full list
+		return source
+	}
+
+	sweepers := make(map[string]*Sweeper)
+	for name, sweeper := range source {
+		for _, s := range filterSlice {
+			if strings.Contains(strings.ToLower(name), strings.TrimSpace(s)) {
+				sweepers[name] = sweeper
+			}
+		}
+	}
+
+	return sweepers
+}
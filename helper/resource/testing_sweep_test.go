@@ -0,0 +1,166 @@
+package resource
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestFilterSweepers(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Sweepers         []string
+		Filter           string
+		ExpectedSweepers []string
+	}{
+		{
+			Name:             "normal",
+			Sweepers:         []string{"aws_dummy"},
+			ExpectedSweepers: []string{"aws_dummy"},
+		},
+		{
+			Name:             "with dep",
+			Sweepers:         []string{"aws_dummy", "aws_top", "aws_sub"},
+			ExpectedSweepers: []string{"aws_dummy", "aws_sub", "aws_top"},
+		},
+		{
+			Name:             "with filter",
+			Sweepers:         []string{"aws_dummy", "aws_top", "aws_sub"},
+			ExpectedSweepers: []string{"aws_dummy"},
+			Filter:           "aws_dummy",
+		},
+		{
+			Name:             "with two filters",
+			Sweepers:         []string{"aws_dummy", "aws_top", "aws_sub"},
+			ExpectedSweepers: []string{"aws_dummy", "aws_sub"},
+			Filter:           "aws_dummy, AWS_SUB",
+		},
+		{
+			Name:             "with no matches",
+			Sweepers:         []string{"aws_dummy", "aws_top", "aws_sub"},
+			ExpectedSweepers: []string{},
+			Filter:           "none",
+		},
+	}
+
+	for _, tc := range cases {
+		source := make(map[string]*Sweeper)
+		for _, name := range tc.Sweepers {
+			source[name] = &Sweeper{Name: name}
+		}
+
+		actualSweepers := filterSweepers(tc.Filter, source)
+
+		actual := make([]string, 0)
+		for name := range actualSweepers {
+			actual = append(actual, name)
+		}
+		sort.Strings(actual)
+
+		if !reflect.DeepEqual(actual, tc.ExpectedSweepers) {
+			t.Fatalf("%s: expected: %#v\n\ngot: %#v", tc.Name, tc.ExpectedSweepers, actual)
+		}
+	}
+}
+
+func TestRunSweepers(t *testing.T) {
+	defer func(funcs map[string]*Sweeper) { sweeperFuncs = funcs }(sweeperFuncs)
+	sweeperFuncs = make(map[string]*Sweeper)
+
+	var ran []string
+	sweeper := func(name string) SweeperFunc {
+		return func(r string) error {
+			ran = append(ran, fmt.Sprintf("%s/%s", r, name))
+			return nil
+		}
+	}
+
+	AddTestSweepers("aws_top", &Sweeper{
+		Name:         "aws_top",
+		Dependencies: []string{"aws_sub"},
+		F:            sweeper("aws_top"),
+	})
+	AddTestSweepers("aws_sub", &Sweeper{
+		Name:         "aws_sub",
+		Dependencies: []string{"aws_leaf"},
+		F:            sweeper("aws_sub"),
+	})
+	AddTestSweepers("aws_leaf", &Sweeper{
+		Name: "aws_leaf",
+		F:    sweeper("aws_leaf"),
+	})
+
+	// Only aws_top is selected, its dependencies run first and every
+	// sweeper runs once per region.
+	err := runSweepers([]string{"us-east-1", " us-west-2"}, filterSweepers("aws_top,aws_sub", sweeperFuncs))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{
+		"us-east-1/aws_leaf",
+		"us-east-1/aws_sub",
+		"us-east-1/aws_top",
+		"us-west-2/aws_leaf",
+		"us-west-2/aws_sub",
+		"us-west-2/aws_top",
+	}
+	if !reflect.DeepEqual(ran, expected) {
+		t.Fatalf("expected: %#v\n\ngot: %#v", expected, ran)
+	}
+}
+
+func TestRunSweepers_errors(t *testing.T) {
+	defer func(funcs map[string]*Sweeper) { sweeperFuncs = funcs }(sweeperFuncs)
+
+	cases := map[string]struct {
+		Sweepers map[string]*Sweeper
+		Err      string
+	}{
+		"unknown dependency": {
+			Sweepers: map[string]*Sweeper{
+				"aws_top": &Sweeper{
+					Name:         "aws_top",
+					Dependencies: []string{"aws_missing"},
+					F:            func(r string) error { return nil },
+				},
+			},
+			Err: "unknown sweeper (aws_missing)",
+		},
+		"dependency cycle": {
+			Sweepers: map[string]*Sweeper{
+				"aws_a": &Sweeper{
+					Name:         "aws_a",
+					Dependencies: []string{"aws_b"},
+					F:            func(r string) error { return nil },
+				},
+				"aws_b": &Sweeper{
+					Name:         "aws_b",
+					Dependencies: []string{"aws_a"},
+					F:            func(r string) error { return nil },
+				},
+			},
+			Err: "dependency cycle between sweepers: aws_a -> aws_b -> aws_a",
+		},
+		"sweeper error": {
+			Sweepers: map[string]*Sweeper{
+				"aws_top": &Sweeper{
+					Name: "aws_top",
+					F:    func(r string) error { return fmt.Errorf("failed") },
+				},
+			},
+			Err: "error running Sweeper (aws_top) in region (us-east-1): failed",
+		},
+	}
+
+	for name, tc := range cases {
+		sweeperFuncs = tc.Sweepers
+
+		err := runSweepers([]string{"us-east-1"}, tc.Sweepers)
+		if err == nil || !strings.Contains(err.Error(), tc.Err) {
+			t.Fatalf("%s: expected error %q, got: %v", name, tc.Err, err)
+		}
+	}
+}
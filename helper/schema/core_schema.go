@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// CoreSchema returns the schema of the resource in the form that is
+// exposed to Terraform core through terraform.ResourceProvider.GetSchema.
+func (r *Resource) CoreSchema() *terraform.SchemaBlock {
+	return schemaMap(r.Schema).CoreSchema()
+}
+
+// CoreSchema returns the schema of the block described by the schema map.
+//
+// Lists and sets of resources are nested blocks, and everything else is an
+// attribute. Removed attributes are left out, since they can't be set
+// anymore.
+func (m schemaMap) CoreSchema() *terraform.SchemaBlock {
+	ret := &terraform.SchemaBlock{
+		Attributes: make(map[string]*terraform.SchemaAttribute),
+		BlockTypes: make(map[string]*terraform.SchemaNestedBlock),
+	}
+
+	for name, s := range m {
+		if s.Removed != "" {
+			continue
+		}
+
+		if r, ok := s.Elem.(*Resource); ok && (s.Type == TypeList || s.Type == TypeSet) {
+			ret.BlockTypes[name] = s.coreNestedBlock(r)
+			continue
+		}
+
+		ret.Attributes[name] = s.coreAttribute()
+	}
+
+	return ret
+}
+
+func (s *Schema) coreAttribute() *terraform.SchemaAttribute {
+	return &terraform.SchemaAttribute{
+		Type:        s.coreType(),
+		Description: s.Description,
+		Required:    s.Required,
+		Optional:    s.Optional,
+		Computed:    s.Computed,
+		Sensitive:   s.Sensitive,
+		Deprecated:  s.Deprecated,
+	}
+}
+
+func (s *Schema) coreNestedBlock(r *Resource) *terraform.SchemaNestedBlock {
+	nesting := "list"
+	if s.Type == TypeSet {
+		nesting = "set"
+	}
+
+	return &terraform.SchemaNestedBlock{
+		SchemaBlock: *r.CoreSchema(),
+		Nesting:     nesting,
+		MinItems:    s.MinItems,
+		MaxItems:    s.MaxItems,
+	}
+}
+
+// coreType returns the type of the value of the schema, like "string" or
+// "list(string)".
+func (s *Schema) coreType() string {
+	switch s.Type {
+	case TypeBool:
+		return "bool"
+	case TypeInt, TypeFloat:
+		return "number"
+	case TypeString:
+		return "string"
+	}
+
+	// Collections of strings are the default when the type of the
+	// elements isn't given, and maps of resources are maps of strings
+	// as well.
+	elem := "string"
+	switch e := s.Elem.(type) {
+	case *Schema:
+		elem = e.coreType()
+	case ValueType:
+		elem = (&Schema{Type: e}).coreType()
+	}
+
+	switch s.Type {
+	case TypeList:
+		return fmt.Sprintf("list(%s)", elem)
+	case TypeSet:
+		return fmt.Sprintf("set(%s)", elem)
+	case TypeMap:
+		return fmt.Sprintf("map(%s)", elem)
+	default:
+		// Can't happen for a schema that passes InternalValidate
+		return "string"
+	}
+}
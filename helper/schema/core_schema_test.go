@@ -0,0 +1,185 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSchemaMapCoreSchema(t *testing.T) {
+	cases := map[string]struct {
+		Schema map[string]*Schema
+		Want   *terraform.SchemaBlock
+	}{
+		"empty": {
+			map[string]*Schema{},
+			&terraform.SchemaBlock{
+				Attributes: map[string]*terraform.SchemaAttribute{},
+				BlockTypes: map[string]*terraform.SchemaNestedBlock{},
+			},
+		},
+
+		"primitives": {
+			map[string]*Schema{
+				"int": &Schema{
+					Type:        TypeInt,
+					Required:    true,
+					Description: "foo bar baz",
+				},
+				"float": &Schema{
+					Type:     TypeFloat,
+					Optional: true,
+				},
+				"bool": &Schema{
+					Type:     TypeBool,
+					Computed: true,
+				},
+				"string": &Schema{
+					Type:       TypeString,
+					Optional:   true,
+					Computed:   true,
+					Sensitive:  true,
+					Deprecated: "use int instead",
+				},
+				"removed": &Schema{
+					Type:     TypeString,
+					Optional: true,
+					Removed:  "use int instead",
+				},
+			},
+			&terraform.SchemaBlock{
+				Attributes: map[string]*terraform.SchemaAttribute{
+					"int": &terraform.SchemaAttribute{
+						Type:        "number",
+						Required:    true,
+						Description: "foo bar baz",
+					},
+					"float": &terraform.SchemaAttribute{
+						Type:     "number",
+						Optional: true,
+					},
+					"bool": &terraform.SchemaAttribute{
+						Type:     "bool",
+						Computed: true,
+					},
+					"string": &terraform.SchemaAttribute{
+						Type:       "string",
+						Optional:   true,
+						Computed:   true,
+						Sensitive:  true,
+						Deprecated: "use int instead",
+					},
+				},
+				BlockTypes: map[string]*terraform.SchemaNestedBlock{},
+			},
+		},
+
+		"collections": {
+			map[string]*Schema{
+				"list": &Schema{
+					Type:     TypeList,
+					Optional: true,
+					Elem:     &Schema{Type: TypeInt},
+				},
+				"set": &Schema{
+					Type:     TypeSet,
+					Required: true,
+					Elem:     &Schema{Type: TypeString},
+				},
+				"map": &Schema{
+					Type:     TypeMap,
+					Optional: true,
+				},
+				"nested": &Schema{
+					Type:     TypeList,
+					Optional: true,
+					Elem: &Schema{
+						Type: TypeList,
+						Elem: &Schema{Type: TypeBool},
+					},
+				},
+			},
+			&terraform.SchemaBlock{
+				Attributes: map[string]*terraform.SchemaAttribute{
+					"list": &terraform.SchemaAttribute{
+						Type:     "list(number)",
+						Optional: true,
+					},
+					"set": &terraform.SchemaAttribute{
+						Type:     "set(string)",
+						Required: true,
+					},
+					"map": &terraform.SchemaAttribute{
+						Type:     "map(string)",
+						Optional: true,
+					},
+					"nested": &terraform.SchemaAttribute{
+						Type:     "list(list(bool))",
+						Optional: true,
+					},
+				},
+				BlockTypes: map[string]*terraform.SchemaNestedBlock{},
+			},
+		},
+
+		"nested blocks": {
+			map[string]*Schema{
+				"list": &Schema{
+					Type:     TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 2,
+					Elem: &Resource{
+						Schema: map[string]*Schema{
+							"foo": &Schema{
+								Type:     TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"set": &Schema{
+					Type:     TypeSet,
+					Optional: true,
+					Elem: &Resource{
+						Schema: map[string]*Schema{},
+					},
+				},
+			},
+			&terraform.SchemaBlock{
+				Attributes: map[string]*terraform.SchemaAttribute{},
+				BlockTypes: map[string]*terraform.SchemaNestedBlock{
+					"list": &terraform.SchemaNestedBlock{
+						SchemaBlock: terraform.SchemaBlock{
+							Attributes: map[string]*terraform.SchemaAttribute{
+								"foo": &terraform.SchemaAttribute{
+									Type:     "string",
+									Required: true,
+								},
+							},
+							BlockTypes: map[string]*terraform.SchemaNestedBlock{},
+						},
+						Nesting:  "list",
+						MinItems: 1,
+						MaxItems: 2,
+					},
+					"set": &terraform.SchemaNestedBlock{
+						SchemaBlock: terraform.SchemaBlock{
+							Attributes: map[string]*terraform.SchemaAttribute{},
+							BlockTypes: map[string]*terraform.SchemaNestedBlock{},
+						},
+						Nesting: "set",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		got := schemaMap(tc.Schema).CoreSchema()
+		if !reflect.DeepEqual(got, tc.Want) {
+			t.Fatalf("%s: bad: %#v", name, got)
+		}
+	}
+}
@@ -201,6 +201,26 @@ func (p *Provider) Refresh(
 	return r.Refresh(s, p.meta)
 }
 
+// GetSchema implementation of terraform.ResourceProvider interface.
+func (p *Provider) GetSchema() (*terraform.ProviderSchema, error) {
+	result := &terraform.ProviderSchema{
+		Provider:                   schemaMap(p.Schema).CoreSchema(),
+		ResourceTypes:              make(map[string]*terraform.SchemaBlock),
+		DataSources:                make(map[string]*terraform.SchemaBlock),
+		ResourceTypeSchemaVersions: make(map[string]int),
+	}
+
+	for k, r := range p.ResourcesMap {
+		result.ResourceTypes[k] = r.CoreSchema()
+		result.ResourceTypeSchemaVersions[k] = r.SchemaVersion
+	}
+	for k, r := range p.DataSourcesMap {
+		result.DataSources[k] = r.CoreSchema()
+	}
+
+	return result, nil
+}
+
 // Resources implementation of terraform.ResourceProvider interface.
 func (p *Provider) Resources() []terraform.ResourceType {
 	keys := make([]string, 0, len(p.ResourcesMap))
@@ -85,6 +85,51 @@ func TestProviderConfigure(t *testing.T) {
 	}
 }
 
+func TestProviderGetSchema(t *testing.T) {
+	p := &Provider{
+		Schema: map[string]*Schema{
+			"region": &Schema{
+				Type:     TypeString,
+				Required: true,
+			},
+		},
+		ResourcesMap: map[string]*Resource{
+			"foo": &Resource{
+				SchemaVersion: 2,
+				Schema: map[string]*Schema{
+					"bar": &Schema{
+						Type:     TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		DataSourcesMap: map[string]*Resource{
+			"baz": &Resource{
+				Schema: map[string]*Schema{},
+			},
+		},
+	}
+
+	actual, err := p.GetSchema()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if a := actual.Provider.Attributes["region"]; a == nil || !a.Required || a.Type != "string" {
+		t.Fatalf("bad: %#v", a)
+	}
+	if a := actual.ResourceTypes["foo"].Attributes["bar"]; a == nil || !a.Computed {
+		t.Fatalf("bad: %#v", a)
+	}
+	if v := actual.ResourceTypeSchemaVersions["foo"]; v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+	if _, ok := actual.DataSources["baz"]; !ok {
+		t.Fatalf("bad: %#v", actual.DataSources)
+	}
+}
+
 func TestProviderResources(t *testing.T) {
 	cases := []struct {
 		P      *Provider
@@ -34,9 +34,9 @@ type Resource struct {
 	// resource. Provider authors can increment this version number
 	// when Schema semantics change. If the State's SchemaVersion is less than
 	// the current SchemaVersion, the InstanceState is yielded to the
-	// MigrateState callback, where the provider can make whatever changes it
-	// needs to update the state to be compatible to the latest version of the
-	// Schema.
+	// StateUpgraders and MigrateState callback, where the provider can make
+	// whatever changes it needs to update the state to be compatible to the
+	// latest version of the Schema.
 	//
 	// When unset, SchemaVersion defaults to 0, so provider authors can start
 	// their Versioning at any integer >= 1
@@ -54,6 +54,20 @@ type Resource struct {
 	// needs to make any remote API calls.
 	MigrateState StateMigrateFunc
 
+	// StateUpgraders contains the functions responsible for upgrading an
+	// InstanceState from one version of the Schema to the next. Each
+	// StateUpgrader upgrades the state from its Version to Version+1, and
+	// they're run in order until the state matches the current
+	// SchemaVersion, so each function only has to know about two versions
+	// of the Schema.
+	//
+	// The Version of the StateUpgraders must be consecutive, and the last
+	// one must be SchemaVersion-1. States older than the Version of the
+	// first StateUpgrader are given to MigrateState first, so that
+	// resources which already have a MigrateState function can switch to
+	// StateUpgraders for the versions that follow.
+	StateUpgraders []StateUpgrader
+
 	// The functions below are the CRUD operations for this resource.
 	//
 	// The only optional operation is Update. If Update is not implemented,
@@ -90,6 +104,13 @@ type Resource struct {
 	// by InternalValidate on Resource.
 	Importer *ResourceImporter
 
+	// Timeouts holds the default timeouts of the operations of this
+	// resource, which users can override in a timeouts block in the
+	// configuration of the resource. The timeouts are available to the
+	// operations through ResourceData.Timeout. If this is nil, the resource
+	// has no configurable timeouts.
+	Timeouts *ResourceTimeout
+
 	// If non-empty, this string is emitted as a warning during Validate.
 	// This is a private interface for now, for use by DataSourceResourceShim,
 	// and not for general use. (But maybe later...)
@@ -115,6 +136,21 @@ type ExistsFunc func(*ResourceData, interface{}) (bool, error)
 type StateMigrateFunc func(
 	int, *terraform.InstanceState, interface{}) (*terraform.InstanceState, error)
 
+// StateUpgrader upgrades the state of a resource from one version of its
+// Schema to the next. See Resource documentation.
+type StateUpgrader struct {
+	// Version is the version of the Schema the state is upgraded from.
+	Version int
+
+	// Upgrade is yielded the state to upgrade and the provider's configured
+	// meta interface{}, and returns the upgraded state.
+	Upgrade StateUpgradeFunc
+}
+
+// See StateUpgrader documentation.
+type StateUpgradeFunc func(
+	*terraform.InstanceState, interface{}) (*terraform.InstanceState, error)
+
 // Apply creates, updates, and/or deletes a resource.
 func (r *Resource) Apply(
 	s *terraform.InstanceState,
@@ -124,6 +160,7 @@ func (r *Resource) Apply(
 	if err != nil {
 		return s, err
 	}
+	r.setTimeouts(data, s, d)
 
 	if s == nil {
 		// The Terraform API dictates that this should never happen, but
@@ -153,6 +190,7 @@ func (r *Resource) Apply(
 		if err != nil {
 			return nil, err
 		}
+		r.setTimeouts(data, nil, d)
 	}
 
 	err = nil
@@ -176,12 +214,40 @@ func (r *Resource) Apply(
 func (r *Resource) Diff(
 	s *terraform.InstanceState,
 	c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
-	return schemaMap(r.Schema).Diff(s, c)
+	diff, err := schemaMap(r.Schema).Diff(s, c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the configured timeouts in the diff so that they're available
+	// when it's applied.
+	if diff != nil && r.Timeouts != nil {
+		t := r.Timeouts.copy()
+		if err := t.configDecode(c); err != nil {
+			return nil, err
+		}
+
+		if diff.Meta == nil {
+			diff.Meta = make(map[string]string)
+		}
+		t.metaEncode(diff.Meta)
+	}
+
+	return diff, nil
 }
 
 // Validate validates the resource configuration against the schema.
 func (r *Resource) Validate(c *terraform.ResourceConfig) ([]string, []error) {
-	warns, errs := schemaMap(r.Schema).Validate(c)
+	schema := schemaMap(r.Schema)
+	if r.Timeouts != nil {
+		schema = make(schemaMap, len(r.Schema)+1)
+		for k, v := range r.Schema {
+			schema[k] = v
+		}
+		schema[TimeoutsConfigKey] = r.Timeouts.schema()
+	}
+
+	warns, errs := schema.Validate(c)
 
 	if r.deprecationMessage != "" {
 		warns = append(warns, r.deprecationMessage)
@@ -203,6 +269,7 @@ func (r *Resource) ReadDataApply(
 	if err != nil {
 		return nil, err
 	}
+	r.setTimeouts(data, nil, d)
 
 	err = r.Read(data, meta)
 	state := data.State()
@@ -226,6 +293,11 @@ func (r *Resource) Refresh(
 		return nil, nil
 	}
 
+	s, err := r.upgradeState(s, meta)
+	if err != nil || s == nil {
+		return s, err
+	}
+
 	if r.Exists != nil {
 		// Make a copy of data so that if it is modified it doesn't
 		// affect our Read later.
@@ -233,6 +305,7 @@ func (r *Resource) Refresh(
 		if err != nil {
 			return s, err
 		}
+		r.setTimeouts(data, s, nil)
 
 		exists, err := r.Exists(data, meta)
 		if err != nil {
@@ -243,18 +316,11 @@ func (r *Resource) Refresh(
 		}
 	}
 
-	needsMigration, stateSchemaVersion := r.checkSchemaVersion(s)
-	if needsMigration && r.MigrateState != nil {
-		s, err := r.MigrateState(stateSchemaVersion, s, meta)
-		if err != nil {
-			return s, err
-		}
-	}
-
 	data, err := schemaMap(r.Schema).Data(s, nil)
 	if err != nil {
 		return s, err
 	}
+	r.setTimeouts(data, s, nil)
 
 	err = r.Read(data, meta)
 	state := data.State()
@@ -317,6 +383,14 @@ func (r *Resource) InternalValidate(topSchemaMap schemaMap, writable bool) error
 
 		tsm = schemaMap(r.Schema)
 
+		if r.Timeouts != nil {
+			if _, ok := r.Schema[TimeoutsConfigKey]; ok {
+				return fmt.Errorf(
+					"%q is reserved for the timeouts block when Timeouts is set",
+					TimeoutsConfigKey)
+			}
+		}
+
 		// If we have an importer, we need to verify the importer.
 		if r.Importer != nil {
 			if err := r.Importer.InternalValidate(); err != nil {
@@ -325,6 +399,10 @@ func (r *Resource) InternalValidate(topSchemaMap schemaMap, writable bool) error
 		}
 	}
 
+	if err := r.validateStateUpgraders(); err != nil {
+		return err
+	}
+
 	return schemaMap(r.Schema).InternalValidate(tsm)
 }
 
@@ -367,6 +445,29 @@ func (r *Resource) isTopLevel() bool {
 	return r.Create != nil
 }
 
+// setTimeouts sets the timeouts of the given data to the timeouts of the
+// resource, overridden by the ones configured by the user recorded in the
+// state and then the diff. They're recorded in the meta of the data so that
+// the resulting state keeps them for later operations, such as deleting.
+func (r *Resource) setTimeouts(
+	data *ResourceData, s *terraform.InstanceState, d *terraform.InstanceDiff) {
+	if r.Timeouts == nil {
+		return
+	}
+
+	t := r.Timeouts.copy()
+	if s != nil {
+		t.metaDecode(s.Meta)
+	}
+	if d != nil {
+		t.metaDecode(d.Meta)
+	}
+
+	data.timeouts = t
+	data.meta = make(map[string]string)
+	t.metaEncode(data.meta)
+}
+
 // Determines if a given InstanceState needs to be migrated by checking the
 // stored version number with the current SchemaVersion
 func (r *Resource) checkSchemaVersion(is *terraform.InstanceState) (bool, int) {
@@ -374,6 +475,83 @@ func (r *Resource) checkSchemaVersion(is *terraform.InstanceState) (bool, int) {
 	return stateSchemaVersion < r.SchemaVersion, stateSchemaVersion
 }
 
+// upgradeState upgrades the given state to the current SchemaVersion, using
+// MigrateState for the versions older than the first StateUpgrader and the
+// StateUpgraders for the others.
+func (r *Resource) upgradeState(
+	s *terraform.InstanceState,
+	meta interface{}) (*terraform.InstanceState, error) {
+	needsMigration, stateSchemaVersion := r.checkSchemaVersion(s)
+	if !needsMigration {
+		return s, nil
+	}
+
+	if r.MigrateState != nil {
+		if len(r.StateUpgraders) == 0 || stateSchemaVersion < r.StateUpgraders[0].Version {
+			var err error
+			s, err = r.MigrateState(stateSchemaVersion, s, meta)
+			if err != nil || s == nil {
+				return s, err
+			}
+
+			if len(r.StateUpgraders) == 0 {
+				return s, nil
+			}
+			stateSchemaVersion = r.StateUpgraders[0].Version
+		}
+	} else if len(r.StateUpgraders) > 0 && stateSchemaVersion < r.StateUpgraders[0].Version {
+		return s, fmt.Errorf(
+			"no MigrateState to upgrade from version %d", stateSchemaVersion)
+	}
+
+	for _, upgrader := range r.StateUpgraders {
+		if upgrader.Version < stateSchemaVersion {
+			continue
+		}
+
+		var err error
+		s, err = upgrader.Upgrade(s, meta)
+		if err != nil {
+			return s, fmt.Errorf(
+				"Error upgrading the state from schema version %d: %s", upgrader.Version, err)
+		}
+		if s == nil {
+			return nil, nil
+		}
+	}
+
+	return s, nil
+}
+
+// validateStateUpgraders checks that the StateUpgraders are consecutive and
+// upgrade the state up to the current SchemaVersion.
+func (r *Resource) validateStateUpgraders() error {
+	if len(r.StateUpgraders) == 0 {
+		return nil
+	}
+
+	version := r.StateUpgraders[0].Version
+	for _, upgrader := range r.StateUpgraders {
+		if upgrader.Version != version {
+			return fmt.Errorf(
+				"StateUpgraders must be consecutive, expected version %d, got %d",
+				version, upgrader.Version)
+		}
+		if upgrader.Upgrade == nil {
+			return fmt.Errorf("StateUpgrader for version %d has no Upgrade function", version)
+		}
+		version++
+	}
+
+	if version != r.SchemaVersion {
+		return fmt.Errorf(
+			"StateUpgraders must upgrade the state to SchemaVersion %d, last upgrades to %d",
+			r.SchemaVersion, version)
+	}
+
+	return nil
+}
+
 func (r *Resource) recordCurrentSchemaVersion(
 	state *terraform.InstanceState) *terraform.InstanceState {
 	if state != nil && r.SchemaVersion > 0 {
@@ -3,8 +3,10 @@ package schema
 import (
 	"log"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -19,11 +21,12 @@ import (
 // The most relevant methods to take a look at are Get, Set, and Partial.
 type ResourceData struct {
 	// Settable (internally)
-	schema map[string]*Schema
-	config *terraform.ResourceConfig
-	state  *terraform.InstanceState
-	diff   *terraform.InstanceDiff
-	meta   map[string]string
+	schema   map[string]*Schema
+	config   *terraform.ResourceConfig
+	state    *terraform.InstanceState
+	diff     *terraform.InstanceDiff
+	meta     map[string]string
+	timeouts *ResourceTimeout
 
 	// Don't set
 	multiReader *MultiLevelFieldReader
@@ -237,6 +240,20 @@ func (d *ResourceData) SetType(t string) {
 	d.newState.Ephemeral.Type = t
 }
 
+// Timeout returns the timeout of the given operation of the resource, such
+// as TimeoutCreate. This is the timeout configured by the user in the
+// timeouts block of the resource, or else the default timeout of the
+// resource.
+func (d *ResourceData) Timeout(key string) time.Duration {
+	if d.timeouts != nil {
+		if v := d.timeouts.get(key); v != nil {
+			return *v
+		}
+	}
+
+	return defaultTimeout
+}
+
 // State returns the new InstanceState after the diff and any Set
 // calls.
 func (d *ResourceData) State() *terraform.InstanceState {
@@ -324,6 +341,8 @@ func (d *ResourceData) State() *terraform.InstanceState {
 		result.Attributes["id"] = d.Id()
 	}
 
+	result.SensitiveAttributes = d.sensitiveAttributes(result.Attributes)
+
 	if d.state != nil {
 		result.Tainted = d.state.Tainted
 	}
@@ -331,6 +350,24 @@ func (d *ResourceData) State() *terraform.InstanceState {
 	return &result
 }
 
+// sensitiveAttributes returns the sorted keys of the given attributes that
+// are sensitive, because they or the attributes they're nested in are
+// Sensitive in the schema.
+func (d *ResourceData) sensitiveAttributes(attrs map[string]string) []string {
+	var result []string
+	for k := range attrs {
+		for _, s := range addrToSchema(strings.Split(k, "."), d.schema) {
+			if s.Sensitive {
+				result = append(result, k)
+				break
+			}
+		}
+	}
+
+	sort.Strings(result)
+	return result
+}
+
 func (d *ResourceData) init() {
 	// Initialize the field that will store our new state
 	var copyState terraform.InstanceState
@@ -2916,6 +2916,69 @@ func TestResourceDataState_schema(t *testing.T) {
 				},
 			},
 		},
+
+		// Sensitive attributes
+		{
+			Schema: map[string]*Schema{
+				"name": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+
+				"password": &Schema{
+					Type:      TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+
+				"ports": &Schema{
+					Type:     TypeList,
+					Optional: true,
+					Elem: &Resource{
+						Schema: map[string]*Schema{
+							"index": &Schema{Type: TypeInt},
+							"secrets": &Schema{
+								Type:      TypeMap,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+			},
+
+			State: nil,
+
+			Diff: nil,
+
+			Set: map[string]interface{}{
+				"name":     "foo",
+				"password": "bar",
+				"ports": []interface{}{
+					map[string]interface{}{
+						"index": 10,
+						"secrets": map[string]interface{}{
+							"key": "value",
+						},
+					},
+				},
+			},
+
+			Result: &terraform.InstanceState{
+				Attributes: map[string]string{
+					"name":                "foo",
+					"password":            "bar",
+					"ports.#":             "1",
+					"ports.0.index":       "10",
+					"ports.0.secrets.%":   "1",
+					"ports.0.secrets.key": "value",
+				},
+				SensitiveAttributes: []string{
+					"password",
+					"ports.0.secrets.%",
+					"ports.0.secrets.key",
+				},
+			},
+		},
 	}
 
 	for i, tc := range cases {
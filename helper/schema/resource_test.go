@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -393,6 +396,193 @@ func TestResourceApply_isNewResource(t *testing.T) {
 	}
 }
 
+func TestResourceApply_timeouts(t *testing.T) {
+	r := &Resource{
+		Schema: map[string]*Schema{
+			"foo": &Schema{
+				Type:     TypeInt,
+				Optional: true,
+			},
+		},
+		Timeouts: &ResourceTimeout{
+			Create: DefaultTimeout(40 * time.Minute),
+			Delete: DefaultTimeout(10 * time.Minute),
+		},
+	}
+
+	var create, update, read time.Duration
+	r.Create = func(d *ResourceData, m interface{}) error {
+		create = d.Timeout(TimeoutCreate)
+		update = d.Timeout(TimeoutUpdate)
+		d.SetId("foo")
+		return nil
+	}
+
+	d := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"foo": &terraform.ResourceAttrDiff{
+				New: "42",
+			},
+		},
+		Meta: map[string]string{
+			"timeouts.create": "1h0m0s",
+		},
+	}
+
+	actual, err := r.Apply(nil, d, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if create != time.Hour {
+		t.Fatalf("bad create timeout: %s", create)
+	}
+	if update != defaultTimeout {
+		t.Fatalf("bad update timeout: %s", update)
+	}
+
+	expected := map[string]string{
+		"timeouts.create": "1h0m0s",
+		"timeouts.delete": "10m0s",
+	}
+	if !reflect.DeepEqual(actual.Meta, expected) {
+		t.Fatalf("bad: %#v", actual.Meta)
+	}
+
+	// The timeouts in the state are used when there's no diff
+	r.Timeouts.Read = DefaultTimeout(5 * time.Minute)
+	r.Read = func(d *ResourceData, m interface{}) error {
+		read = d.Timeout(TimeoutRead)
+		create = d.Timeout(TimeoutCreate)
+		return nil
+	}
+
+	actual, err = r.Refresh(actual, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if create != time.Hour {
+		t.Fatalf("bad create timeout: %s", create)
+	}
+	if read != 5*time.Minute {
+		t.Fatalf("bad read timeout: %s", read)
+	}
+	if actual.Meta["timeouts.create"] != "1h0m0s" {
+		t.Fatalf("bad: %#v", actual.Meta)
+	}
+}
+
+func TestResourceDiff_timeouts(t *testing.T) {
+	r := &Resource{
+		Schema: map[string]*Schema{
+			"foo": &Schema{
+				Type:     TypeInt,
+				Optional: true,
+			},
+		},
+		Timeouts: &ResourceTimeout{
+			Create: DefaultTimeout(40 * time.Minute),
+			Delete: DefaultTimeout(10 * time.Minute),
+		},
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"foo": 42,
+		"timeouts": []map[string]interface{}{
+			map[string]interface{}{
+				"create": "1h",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual, err := r.Diff(nil, terraform.NewResourceConfig(raw))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]string{
+		"timeouts.create": "1h0m0s",
+		"timeouts.delete": "10m0s",
+	}
+	if !reflect.DeepEqual(actual.Meta, expected) {
+		t.Fatalf("bad: %#v", actual.Meta)
+	}
+}
+
+func TestResourceValidate_timeouts(t *testing.T) {
+	cases := map[string]struct {
+		Timeouts *ResourceTimeout
+		Config   map[string]interface{}
+		Err      bool
+	}{
+		"valid": {
+			&ResourceTimeout{
+				Create: DefaultTimeout(40 * time.Minute),
+			},
+			map[string]interface{}{
+				"create": "1h",
+			},
+			false,
+		},
+
+		"invalid duration": {
+			&ResourceTimeout{
+				Create: DefaultTimeout(40 * time.Minute),
+			},
+			map[string]interface{}{
+				"create": "1 hour",
+			},
+			true,
+		},
+
+		"unsupported operation": {
+			&ResourceTimeout{
+				Create: DefaultTimeout(40 * time.Minute),
+			},
+			map[string]interface{}{
+				"delete": "1h",
+			},
+			true,
+		},
+
+		"no timeouts": {
+			nil,
+			map[string]interface{}{
+				"create": "1h",
+			},
+			true,
+		},
+	}
+
+	for tn, tc := range cases {
+		r := &Resource{
+			Schema: map[string]*Schema{
+				"foo": &Schema{
+					Type:     TypeInt,
+					Optional: true,
+				},
+			},
+			Timeouts: tc.Timeouts,
+		}
+
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"timeouts": []map[string]interface{}{tc.Config},
+		})
+		if err != nil {
+			t.Fatalf("%s: err: %s", tn, err)
+		}
+
+		_, es := r.Validate(terraform.NewResourceConfig(raw))
+		if len(es) > 0 != tc.Err {
+			t.Fatalf("%s: bad errors: %#v", tn, es)
+		}
+	}
+}
+
 func TestResourceInternalValidate(t *testing.T) {
 	cases := []struct {
 		In       *Resource
@@ -480,6 +670,88 @@ func TestResourceInternalValidate(t *testing.T) {
 			false,
 			true,
 		},
+
+		// StateUpgraders must be consecutive
+		{
+			&Resource{
+				SchemaVersion: 3,
+				StateUpgraders: []StateUpgrader{
+					{Version: 0, Upgrade: upgradeStateNoop},
+					{Version: 2, Upgrade: upgradeStateNoop},
+				},
+				Schema: map[string]*Schema{
+					"goo": &Schema{
+						Type:     TypeInt,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+			true,
+			true,
+		},
+
+		// StateUpgraders must upgrade to the SchemaVersion
+		{
+			&Resource{
+				SchemaVersion: 3,
+				StateUpgraders: []StateUpgrader{
+					{Version: 0, Upgrade: upgradeStateNoop},
+					{Version: 1, Upgrade: upgradeStateNoop},
+				},
+				Schema: map[string]*Schema{
+					"goo": &Schema{
+						Type:     TypeInt,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+			true,
+			true,
+		},
+
+		// StateUpgraders must have an Upgrade function
+		{
+			&Resource{
+				SchemaVersion: 1,
+				StateUpgraders: []StateUpgrader{
+					{Version: 0},
+				},
+				Schema: map[string]*Schema{
+					"goo": &Schema{
+						Type:     TypeInt,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+			true,
+			true,
+		},
+
+		// StateUpgraders following a MigrateState function
+		{
+			&Resource{
+				SchemaVersion: 3,
+				MigrateState: func(v int, s *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+					return s, nil
+				},
+				StateUpgraders: []StateUpgrader{
+					{Version: 1, Upgrade: upgradeStateNoop},
+					{Version: 2, Upgrade: upgradeStateNoop},
+				},
+				Schema: map[string]*Schema{
+					"goo": &Schema{
+						Type:     TypeInt,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+			true,
+			false,
+		},
 	}
 
 	for i, tc := range cases {
@@ -490,6 +762,10 @@ func TestResourceInternalValidate(t *testing.T) {
 	}
 }
 
+func upgradeStateNoop(s *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	return s, nil
+}
+
 func TestResourceRefresh(t *testing.T) {
 	r := &Resource{
 		SchemaVersion: 2,
@@ -881,6 +1157,224 @@ func TestResourceRefresh_migrateStateErr(t *testing.T) {
 	}
 }
 
+func TestResourceRefresh_migrateStateNewState(t *testing.T) {
+	r := &Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*Schema{
+			"newfoo": &Schema{
+				Type:     TypeInt,
+				Optional: true,
+			},
+		},
+	}
+
+	r.Read = func(d *ResourceData, m interface{}) error {
+		return nil
+	}
+
+	// The migrated state is a new InstanceState rather than the given one
+	// modified in place.
+	r.MigrateState = func(
+		v int,
+		s *terraform.InstanceState,
+		meta interface{}) (*terraform.InstanceState, error) {
+		return &terraform.InstanceState{
+			ID: s.ID,
+			Attributes: map[string]string{
+				"newfoo": s.Attributes["oldfoo"],
+			},
+		}, nil
+	}
+
+	s := &terraform.InstanceState{
+		ID: "bar",
+		Attributes: map[string]string{
+			"oldfoo": "12",
+		},
+	}
+
+	actual, err := r.Refresh(s, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if actual.Attributes["newfoo"] != "12" {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestResourceRefresh_stateUpgraders(t *testing.T) {
+	// Schema v3 deals in newfoo, v1 and v2 dealt in oldfoo and midfoo,
+	// and v0 dealt in foo.
+	r := &Resource{
+		SchemaVersion: 3,
+		Schema: map[string]*Schema{
+			"newfoo": &Schema{
+				Type:     TypeInt,
+				Optional: true,
+			},
+		},
+	}
+
+	r.Read = func(d *ResourceData, m interface{}) error {
+		return d.Set("newfoo", d.Get("newfoo").(int)+1)
+	}
+
+	r.MigrateState = func(
+		v int,
+		s *terraform.InstanceState,
+		meta interface{}) (*terraform.InstanceState, error) {
+		if v != 0 {
+			t.Fatalf("Expected StateSchemaVersion to be 0, got %d", v)
+		}
+
+		s.Attributes["oldfoo"] = s.Attributes["foo"]
+		delete(s.Attributes, "foo")
+		return s, nil
+	}
+
+	r.StateUpgraders = []StateUpgrader{
+		{
+			Version: 1,
+			Upgrade: func(s *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+				if meta != 42 {
+					t.Fatal("Expected meta to be passed through to the upgrade function")
+				}
+
+				s.Attributes["midfoo"] = s.Attributes["oldfoo"]
+				delete(s.Attributes, "oldfoo")
+				return s, nil
+			},
+		},
+		{
+			Version: 2,
+			Upgrade: func(s *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+				s.Attributes["newfoo"] = s.Attributes["midfoo"]
+				delete(s.Attributes, "midfoo")
+				return s, nil
+			},
+		},
+	}
+
+	cases := []struct {
+		Version    string
+		Attributes map[string]string
+	}{
+		{"", map[string]string{"foo": "12"}},
+		{"1", map[string]string{"oldfoo": "12"}},
+		{"2", map[string]string{"midfoo": "12"}},
+		{"3", map[string]string{"newfoo": "12"}},
+	}
+
+	for _, tc := range cases {
+		s := &terraform.InstanceState{
+			ID:         "bar",
+			Attributes: tc.Attributes,
+			Meta: map[string]string{
+				"schema_version": tc.Version,
+			},
+		}
+
+		actual, err := r.Refresh(s, 42)
+		if err != nil {
+			t.Fatalf("version %q: err: %s", tc.Version, err)
+		}
+
+		expected := &terraform.InstanceState{
+			ID: "bar",
+			Attributes: map[string]string{
+				"id":     "bar",
+				"newfoo": "13",
+			},
+			Meta: map[string]string{
+				"schema_version": "3",
+			},
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("version %q: bad:\n\nexpected: %#v\ngot: %#v", tc.Version, expected, actual)
+		}
+	}
+}
+
+func TestResourceRefresh_stateUpgraderErr(t *testing.T) {
+	r := &Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*Schema{
+			"newfoo": &Schema{
+				Type:     TypeInt,
+				Optional: true,
+			},
+		},
+		StateUpgraders: []StateUpgrader{
+			{
+				Version: 0,
+				Upgrade: func(s *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+					return s, fmt.Errorf("triggering an error")
+				},
+			},
+		},
+	}
+
+	r.Read = func(d *ResourceData, m interface{}) error {
+		t.Fatal("Read should never be called!")
+		return nil
+	}
+
+	s := &terraform.InstanceState{
+		ID: "bar",
+		Attributes: map[string]string{
+			"oldfoo": "12",
+		},
+	}
+
+	_, err := r.Refresh(s, nil)
+	if err == nil {
+		t.Fatal("expected error, but got none!")
+	}
+}
+
+func TestResourceRefresh_stateUpgraderNoMigrateState(t *testing.T) {
+	r := &Resource{
+		SchemaVersion: 2,
+		Schema: map[string]*Schema{
+			"newfoo": &Schema{
+				Type:     TypeInt,
+				Optional: true,
+			},
+		},
+		StateUpgraders: []StateUpgrader{
+			{
+				Version: 1,
+				Upgrade: func(s *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+					t.Fatal("Upgrade should never be called!")
+					return s, nil
+				},
+			},
+		},
+	}
+
+	r.Read = func(d *ResourceData, m interface{}) error {
+		t.Fatal("Read should never be called!")
+		return nil
+	}
+
+	s := &terraform.InstanceState{
+		ID: "bar",
+		Attributes: map[string]string{
+			"oldfoo": "12",
+		},
+	}
+
+	_, err := r.Refresh(s, nil)
+	if err == nil {
+		t.Fatal("expected error, but got none!")
+	}
+	if !strings.Contains(err.Error(), "no MigrateState to upgrade from version 0") {
+		t.Fatalf("bad error: %s", err)
+	}
+}
+
 func TestResourceData(t *testing.T) {
 	r := &Resource{
 		SchemaVersion: 2,
@@ -0,0 +1,175 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The operations of a resource that can have a timeout, which are also the
+// keys of the timeouts block in the configuration of the resource.
+const (
+	TimeoutCreate = "create"
+	TimeoutRead   = "read"
+	TimeoutUpdate = "update"
+	TimeoutDelete = "delete"
+)
+
+// TimeoutsConfigKey is the key of the block in the configuration of a
+// resource in which users can override its timeouts.
+const TimeoutsConfigKey = "timeouts"
+
+// timeoutsMetaPrefix prefixes the keys of the timeouts recorded in the meta
+// of diffs and states.
+const timeoutsMetaPrefix = "timeouts."
+
+// defaultTimeout is the timeout of the operations a resource doesn't have a
+// timeout for.
+const defaultTimeout = 20 * time.Minute
+
+var timeoutKeys = []string{TimeoutCreate, TimeoutRead, TimeoutUpdate, TimeoutDelete}
+
+// ResourceTimeout holds the default timeouts of the operations of a
+// resource. Users can only configure the timeouts of the operations that
+// have a default.
+type ResourceTimeout struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+// DefaultTimeout returns a pointer to the given duration, to set the
+// timeouts of a ResourceTimeout.
+func DefaultTimeout(d time.Duration) *time.Duration {
+	return &d
+}
+
+func (t *ResourceTimeout) get(key string) *time.Duration {
+	switch key {
+	case TimeoutCreate:
+		return t.Create
+	case TimeoutRead:
+		return t.Read
+	case TimeoutUpdate:
+		return t.Update
+	case TimeoutDelete:
+		return t.Delete
+	default:
+		return nil
+	}
+}
+
+func (t *ResourceTimeout) set(key string, d time.Duration) {
+	switch key {
+	case TimeoutCreate:
+		t.Create = &d
+	case TimeoutRead:
+		t.Read = &d
+	case TimeoutUpdate:
+		t.Update = &d
+	case TimeoutDelete:
+		t.Delete = &d
+	}
+}
+
+func (t *ResourceTimeout) copy() *ResourceTimeout {
+	result := &ResourceTimeout{}
+	for _, key := range timeoutKeys {
+		if v := t.get(key); v != nil {
+			result.set(key, *v)
+		}
+	}
+	return result
+}
+
+// schema returns the schema of the timeouts block, which accepts the
+// operations with a default timeout.
+func (t *ResourceTimeout) schema() *Schema {
+	elem := make(map[string]*Schema)
+	for _, key := range timeoutKeys {
+		if t.get(key) != nil {
+			elem[key] = &Schema{
+				Type:         TypeString,
+				Optional:     true,
+				ValidateFunc: validateTimeout,
+			}
+		}
+	}
+
+	return &Schema{
+		Type:     TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     &Resource{Schema: elem},
+	}
+}
+
+// configDecode overrides the timeouts with the ones in the timeouts block of
+// the given configuration.
+func (t *ResourceTimeout) configDecode(c *terraform.ResourceConfig) error {
+	for _, key := range timeoutKeys {
+		if t.get(key) == nil {
+			continue
+		}
+
+		k := fmt.Sprintf("%s.0.%s", TimeoutsConfigKey, key)
+		raw, ok := c.Get(k)
+		if !ok || c.IsComputed(k) {
+			continue
+		}
+
+		v, ok := raw.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected a duration, got %#v", k, raw)
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("%s: %s", k, err)
+		}
+		t.set(key, d)
+	}
+
+	return nil
+}
+
+// metaEncode records the timeouts in the given meta of a diff or state.
+func (t *ResourceTimeout) metaEncode(meta map[string]string) {
+	for _, key := range timeoutKeys {
+		if v := t.get(key); v != nil {
+			meta[timeoutsMetaPrefix+key] = v.String()
+		}
+	}
+}
+
+// metaDecode overrides the timeouts with the ones recorded in the given meta
+// of a diff or state.
+func (t *ResourceTimeout) metaDecode(meta map[string]string) {
+	for _, key := range timeoutKeys {
+		if t.get(key) == nil {
+			continue
+		}
+
+		raw, ok := meta[timeoutsMetaPrefix+key]
+		if !ok {
+			continue
+		}
+		if d, err := time.ParseDuration(raw); err == nil {
+			t.set(key, d)
+		}
+	}
+}
+
+func validateTimeout(v interface{}, k string) (ws []string, errors []error) {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a duration like \"30m\" or \"1h\": %s", k, err))
+		return
+	}
+	if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be positive", k))
+	}
+	return
+}
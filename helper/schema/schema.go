@@ -100,13 +100,23 @@ type Schema struct {
 	// the element type is just a simple value. If it is *Resource, the
 	// element type is a complex structure, potentially with its own lifecycle.
 	//
+	// A TypeList with a *Resource Elem is diffed element by element using
+	// the index of each block, so it's the preferred way to represent
+	// nested blocks whose order is meaningful, or single blocks with a
+	// MaxItems of 1, since changing one field of a block doesn't replace
+	// the whole block in the diff as it does with a TypeSet.
+	Elem interface{}
+
 	// MaxItems defines a maximum amount of items that can exist within a
 	// TypeSet or TypeList. Specific use cases would be if a TypeSet is being
 	// used to wrap a complex structure, however more than one instance would
 	// cause instability.
-	Elem     interface{}
 	MaxItems int
 
+	// MinItems defines a minimum amount of items that can exist within a
+	// TypeSet or TypeList.
+	MinItems int
+
 	// The following fields are only valid for a TypeSet type.
 	//
 	// Set defines a function to determine the unique ID of an item so that
@@ -123,6 +133,15 @@ type Schema struct {
 	// ConflictsWith is a set of schema keys that conflict with this schema
 	ConflictsWith []string
 
+	// ExactlyOneOf is a set of schema keys that, when set, only one of the
+	// keys in that list can be specified. It will error if none are
+	// specified as well. The list should include this schema's own key.
+	ExactlyOneOf []string
+
+	// RequiredWith is a set of schema keys that must be set when this
+	// schema is set.
+	RequiredWith []string
+
 	// When Deprecated is set, this attribute is deprecated.
 	//
 	// A deprecated field still works, but will probably stop working in near
@@ -147,12 +166,32 @@ type Schema struct {
 	ValidateFunc SchemaValidateFunc
 
 	// Sensitive ensures that the attribute's value does not get displayed in
-	// logs or regular output. It should be used for passwords or other
-	// secret fields. Futrure versions of Terraform may encrypt these
-	// values.
+	// logs or regular output, such as plans, applies and `terraform show`.
+	// It should be used for passwords or other secret fields. The value is
+	// still stored in the state, where it's listed in the
+	// SensitiveAttributes of the InstanceState. Setting Sensitive on a list,
+	// set or map hides all of the values nested in it.
 	Sensitive bool
+
+	// DiffSuppressFunc allows a field to ignore differences between the old
+	// and new values that aren't significant, such as differences in case
+	// or in the whitespace of JSON documents, which would otherwise produce
+	// a diff on every plan. It's called with the old and new values of each
+	// changed attribute of the field, including the attributes nested in
+	// lists, sets and maps, and the diff of the attribute is dropped when
+	// it returns true.
+	//
+	// Unlike StateFunc, DiffSuppressFunc doesn't change the value stored in
+	// the state.
+	DiffSuppressFunc SchemaDiffSuppressFunc
 }
 
+// SchemaDiffSuppressFunc is a function used to determine whether the
+// difference between the old and new values of the attribute with the given
+// key is insignificant, and must be suppressed from the diff. It returns
+// true to suppress the difference.
+type SchemaDiffSuppressFunc func(k, old, new string, d *ResourceData) bool
+
 // SchemaDefaultFunc is a function called to return a default value for
 // a field.
 type SchemaDefaultFunc func() (interface{}, error)
@@ -524,29 +563,9 @@ func (m schemaMap) InternalValidate(topSchemaMap schemaMap) error {
 
 		if len(v.ConflictsWith) > 0 {
 			for _, key := range v.ConflictsWith {
-				parts := strings.Split(key, ".")
-				sm := topSchemaMap
-				var target *Schema
-				for _, part := range parts {
-					// Skip index fields
-					if _, err := strconv.Atoi(part); err == nil {
-						continue
-					}
-
-					var ok bool
-					if target, ok = sm[part]; !ok {
-						return fmt.Errorf("%s: ConflictsWith references unknown attribute (%s)", k, key)
-					}
-
-					if subResource, ok := target.Elem.(*Resource); ok {
-						sm = schemaMap(subResource.Schema)
-					}
-				}
-				if target == nil {
-					return fmt.Errorf("%s: ConflictsWith cannot find target attribute (%s), sm: %#v", k, key, sm)
-				}
-				if target.Required {
-					return fmt.Errorf("%s: ConflictsWith cannot contain Required attribute (%s)", k, key)
+				target, err := checkKeysAgainstSchemaFlags(k, "ConflictsWith", key, topSchemaMap)
+				if err != nil {
+					return err
 				}
 
 				if target.Computed || len(target.ComputedWhen) > 0 {
@@ -555,6 +574,26 @@ func (m schemaMap) InternalValidate(topSchemaMap schemaMap) error {
 			}
 		}
 
+		if len(v.ExactlyOneOf) > 0 && v.Required {
+			return fmt.Errorf("%s: ExactlyOneOf cannot be set with Required", k)
+		}
+
+		for _, key := range v.ExactlyOneOf {
+			if _, err := checkKeysAgainstSchemaFlags(k, "ExactlyOneOf", key, topSchemaMap); err != nil {
+				return err
+			}
+		}
+
+		if len(v.RequiredWith) > 0 && v.Required {
+			return fmt.Errorf("%s: RequiredWith cannot be set with Required", k)
+		}
+
+		for _, key := range v.RequiredWith {
+			if _, err := checkKeysAgainstSchemaFlags(k, "RequiredWith", key, topSchemaMap); err != nil {
+				return err
+			}
+		}
+
 		if v.Type == TypeList || v.Type == TypeSet {
 			if v.Elem == nil {
 				return fmt.Errorf("%s: Elem must be set for lists", k)
@@ -568,6 +607,10 @@ func (m schemaMap) InternalValidate(topSchemaMap schemaMap) error {
 				return fmt.Errorf("%s: Set can only be set for TypeSet", k)
 			}
 
+			if v.MaxItems > 0 && v.MinItems > v.MaxItems {
+				return fmt.Errorf("%s: MinItems cannot be greater than MaxItems", k)
+			}
+
 			switch t := v.Elem.(type) {
 			case *Resource:
 				if err := t.InternalValidate(topSchemaMap, true); err != nil {
@@ -584,6 +627,10 @@ func (m schemaMap) InternalValidate(topSchemaMap schemaMap) error {
 			if v.MaxItems > 0 {
 				return fmt.Errorf("%s: MaxItems is only supported on lists or sets", k)
 			}
+
+			if v.MinItems > 0 {
+				return fmt.Errorf("%s: MinItems is only supported on lists or sets", k)
+			}
 		}
 
 		if v.ValidateFunc != nil {
@@ -597,26 +644,78 @@ func (m schemaMap) InternalValidate(topSchemaMap schemaMap) error {
 	return nil
 }
 
+// checkKeysAgainstSchemaFlags looks up the attribute referenced by key,
+// which is an absolute path in the top level schema, for the given
+// constraint of the attribute k. Referenced attributes can't be Required,
+// since they would always be set.
+func checkKeysAgainstSchemaFlags(k, constraint, key string, topSchemaMap schemaMap) (*Schema, error) {
+	parts := strings.Split(key, ".")
+	sm := topSchemaMap
+	var target *Schema
+	for _, part := range parts {
+		// Skip index fields
+		if _, err := strconv.Atoi(part); err == nil {
+			continue
+		}
+
+		var ok bool
+		if target, ok = sm[part]; !ok {
+			return nil, fmt.Errorf("%s: %s references unknown attribute (%s)", k, constraint, key)
+		}
+
+		if subResource, ok := target.Elem.(*Resource); ok {
+			sm = schemaMap(subResource.Schema)
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("%s: %s cannot find target attribute (%s), sm: %#v", k, constraint, key, sm)
+	}
+	if target.Required {
+		return nil, fmt.Errorf("%s: %s cannot contain Required attribute (%s)", k, constraint, key)
+	}
+
+	return target, nil
+}
+
 func (m schemaMap) diff(
 	k string,
 	schema *Schema,
 	diff *terraform.InstanceDiff,
 	d *ResourceData,
 	all bool) error {
+	// The diff of the field is computed separately so that the differences
+	// the field suppresses can be dropped before they're merged.
+	fieldDiff := diff
+	if schema.DiffSuppressFunc != nil {
+		fieldDiff = new(terraform.InstanceDiff)
+		fieldDiff.Attributes = make(map[string]*terraform.ResourceAttrDiff)
+	}
+
 	var err error
 	switch schema.Type {
 	case TypeBool, TypeInt, TypeFloat, TypeString:
-		err = m.diffString(k, schema, diff, d, all)
+		err = m.diffString(k, schema, fieldDiff, d, all)
 	case TypeList:
-		err = m.diffList(k, schema, diff, d, all)
+		err = m.diffList(k, schema, fieldDiff, d, all)
 	case TypeMap:
-		err = m.diffMap(k, schema, diff, d, all)
+		err = m.diffMap(k, schema, fieldDiff, d, all)
 	case TypeSet:
-		err = m.diffSet(k, schema, diff, d, all)
+		err = m.diffSet(k, schema, fieldDiff, d, all)
 	default:
 		err = fmt.Errorf("%s: unknown type %#v", k, schema.Type)
 	}
 
+	if schema.DiffSuppressFunc != nil {
+		for attrK, attrV := range fieldDiff.Attributes {
+			if attrV != nil && !attrV.NewComputed && !attrV.NewRemoved &&
+				schema.DiffSuppressFunc(attrK, attrV.Old, attrV.New, d) {
+				continue
+			}
+
+			diff.Attributes[attrK] = attrV
+		}
+	}
+
 	return err
 }
 
@@ -1037,6 +1136,12 @@ func (m schemaMap) validate(
 		// We're okay as long as we had a value set
 		ok = raw != nil
 	}
+
+	err := validateExactlyOneAttribute(k, schema, c)
+	if err != nil {
+		return nil, []error{err}
+	}
+
 	if !ok {
 		if schema.Required {
 			return nil, []error{fmt.Errorf(
@@ -1052,7 +1157,12 @@ func (m schemaMap) validate(
 			"%q: this field cannot be set", k)}
 	}
 
-	err := m.validateConflictingAttributes(k, schema, c)
+	err = m.validateConflictingAttributes(k, schema, c)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	err = validateRequiredWithAttribute(k, schema, c)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -1079,6 +1189,53 @@ func (m schemaMap) validateConflictingAttributes(
 	return nil
 }
 
+// validateExactlyOneAttribute checks that exactly one of the keys in
+// ExactlyOneOf is set in the configuration.
+func validateExactlyOneAttribute(
+	k string,
+	schema *Schema,
+	c *terraform.ResourceConfig) error {
+
+	if len(schema.ExactlyOneOf) == 0 {
+		return nil
+	}
+
+	allKeys := strings.Join(schema.ExactlyOneOf, ",")
+	specified := make([]string, 0)
+	for _, exactlyOneOfKey := range schema.ExactlyOneOf {
+		if _, ok := c.Get(exactlyOneOfKey); ok {
+			specified = append(specified, exactlyOneOfKey)
+		}
+	}
+
+	if len(specified) == 0 {
+		return fmt.Errorf("%q: one of `%s` must be specified", k, allKeys)
+	}
+	if len(specified) > 1 {
+		return fmt.Errorf("%q: only one of `%s` can be specified, but `%s` were specified",
+			k, allKeys, strings.Join(specified, ","))
+	}
+
+	return nil
+}
+
+// validateRequiredWithAttribute checks that all the keys in RequiredWith are
+// set in the configuration. It's only called when k itself is set.
+func validateRequiredWithAttribute(
+	k string,
+	schema *Schema,
+	c *terraform.ResourceConfig) error {
+
+	for _, requiredWithKey := range schema.RequiredWith {
+		if _, ok := c.Get(requiredWithKey); !ok {
+			return fmt.Errorf("%q: all of `%s` must be specified",
+				k, strings.Join(append([]string{k}, schema.RequiredWith...), ","))
+		}
+	}
+
+	return nil
+}
+
 func (m schemaMap) validateList(
 	k string,
 	raw interface{},
@@ -1098,6 +1255,11 @@ func (m schemaMap) validateList(
 			"%s: attribute supports %d item maximum, config has %d declared", k, schema.MaxItems, rawV.Len())}
 	}
 
+	if schema.MinItems > 0 && rawV.Len() < schema.MinItems {
+		return nil, []error{fmt.Errorf(
+			"%s: attribute supports %d item as a minimum, config has %d declared", k, schema.MinItems, rawV.Len())}
+	}
+
 	// Now build the []interface{}
 	raws := make([]interface{}, rawV.Len())
 	for i, _ := range raws {
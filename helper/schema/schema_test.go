@@ -6,6 +6,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/hil"
@@ -2400,6 +2401,118 @@ func TestSchemaMap_Diff(t *testing.T) {
 
 			Err: false,
 		},
+
+		"DiffSuppressFunc suppresses insignificant differences": {
+			Schema: map[string]*Schema{
+				"location": &Schema{
+					Type:     TypeString,
+					Optional: true,
+					DiffSuppressFunc: func(k, old, new string, d *ResourceData) bool {
+						return strings.ToLower(old) == strings.ToLower(new)
+					},
+				},
+
+				"name": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+			},
+
+			State: &terraform.InstanceState{
+				Attributes: map[string]string{
+					"location": "westeurope",
+					"name":     "foo",
+				},
+			},
+
+			Config: map[string]interface{}{
+				"location": "WestEurope",
+				"name":     "bar",
+			},
+
+			Diff: &terraform.InstanceDiff{
+				Attributes: map[string]*terraform.ResourceAttrDiff{
+					"name": &terraform.ResourceAttrDiff{
+						Old: "foo",
+						New: "bar",
+					},
+				},
+			},
+
+			Err: false,
+		},
+
+		"DiffSuppressFunc keeps significant differences": {
+			Schema: map[string]*Schema{
+				"location": &Schema{
+					Type:     TypeString,
+					Optional: true,
+					DiffSuppressFunc: func(k, old, new string, d *ResourceData) bool {
+						return strings.ToLower(old) == strings.ToLower(new)
+					},
+				},
+			},
+
+			State: &terraform.InstanceState{
+				Attributes: map[string]string{
+					"location": "westeurope",
+				},
+			},
+
+			Config: map[string]interface{}{
+				"location": "NorthEurope",
+			},
+
+			Diff: &terraform.InstanceDiff{
+				Attributes: map[string]*terraform.ResourceAttrDiff{
+					"location": &terraform.ResourceAttrDiff{
+						Old: "westeurope",
+						New: "NorthEurope",
+					},
+				},
+			},
+
+			Err: false,
+		},
+
+		"DiffSuppressFunc in a nested list": {
+			Schema: map[string]*Schema{
+				"ip_configuration": &Schema{
+					Type:     TypeList,
+					Optional: true,
+					Elem: &Resource{
+						Schema: map[string]*Schema{
+							"subnet_id": &Schema{
+								Type:     TypeString,
+								Optional: true,
+								DiffSuppressFunc: func(k, old, new string, d *ResourceData) bool {
+									return strings.ToLower(old) == strings.ToLower(new)
+								},
+							},
+						},
+					},
+				},
+			},
+
+			State: &terraform.InstanceState{
+				Attributes: map[string]string{
+					"ip_configuration.#":           "1",
+					"ip_configuration.0.subnet_id": "/subscriptions/foo/resourcegroups/bar",
+				},
+			},
+
+			Config: map[string]interface{}{
+				"ip_configuration": []map[string]interface{}{
+					map[string]interface{}{
+						"subnet_id": "/subscriptions/foo/resourceGroups/bar",
+					},
+				},
+			},
+
+			Diff: nil,
+
+			Err: false,
+		},
 	}
 
 	for tn, tc := range cases {
@@ -2860,6 +2973,111 @@ func TestSchemaMap_InternalValidate(t *testing.T) {
 			true,
 		},
 
+		"MinItems on a non-list": {
+			map[string]*Schema{
+				"foo": &Schema{
+					Type:     TypeString,
+					Optional: true,
+					MinItems: 1,
+				},
+			},
+			true,
+		},
+
+		"MinItems greater than MaxItems": {
+			map[string]*Schema{
+				"foo": &Schema{
+					Type:     TypeList,
+					Optional: true,
+					MinItems: 2,
+					MaxItems: 1,
+					Elem:     &Schema{Type: TypeString},
+				},
+			},
+			true,
+		},
+
+		"ExactlyOneOf cannot be set with Required": {
+			map[string]*Schema{
+				"whitelist": &Schema{
+					Type:         TypeBool,
+					Required:     true,
+					ExactlyOneOf: []string{"whitelist", "blacklist"},
+				},
+				"blacklist": &Schema{
+					Type:     TypeBool,
+					Optional: true,
+				},
+			},
+			true,
+		},
+
+		"ExactlyOneOf references unknown attribute": {
+			map[string]*Schema{
+				"whitelist": &Schema{
+					Type:         TypeBool,
+					Optional:     true,
+					ExactlyOneOf: []string{"whitelist", "blacklist"},
+				},
+			},
+			true,
+		},
+
+		"ExactlyOneOf can reference Optional Computed attributes": {
+			map[string]*Schema{
+				"whitelist": &Schema{
+					Type:         TypeBool,
+					Optional:     true,
+					ExactlyOneOf: []string{"whitelist", "blacklist"},
+				},
+				"blacklist": &Schema{
+					Type:     TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+			false,
+		},
+
+		"RequiredWith cannot contain Required attribute": {
+			map[string]*Schema{
+				"username": &Schema{
+					Type:     TypeString,
+					Required: true,
+				},
+				"password": &Schema{
+					Type:         TypeString,
+					Optional:     true,
+					RequiredWith: []string{"username"},
+				},
+			},
+			true,
+		},
+
+		"RequiredWith references nested attribute": {
+			map[string]*Schema{
+				"login": &Schema{
+					Type:     TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &Resource{
+						Schema: map[string]*Schema{
+							"password": &Schema{
+								Type:     TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"username": &Schema{
+					Type:         TypeString,
+					Optional:     true,
+					RequiredWith: []string{"login.0.password"},
+				},
+			},
+			false,
+		},
+
 		"Sub-resource invalid": {
 			map[string]*Schema{
 				"foo": &Schema{
@@ -2908,7 +3126,7 @@ func TestSchemaMap_InternalValidate(t *testing.T) {
 	}
 
 	for tn, tc := range cases {
-		err := schemaMap(tc.In).InternalValidate(schemaMap{})
+		err := schemaMap(tc.In).InternalValidate(nil)
 		if err != nil != tc.Err {
 			if tc.Err {
 				t.Fatalf("%q: Expected error did not occur:\n\n%#v", tn, tc.In)
@@ -3565,6 +3783,135 @@ func TestSchemaMap_Validate(t *testing.T) {
 			},
 		},
 
+		"ExactlyOneOf with none of the attributes set": {
+			Schema: map[string]*Schema{
+				"whitelist": &Schema{
+					Type:         TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{"whitelist", "blacklist"},
+				},
+				"blacklist": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+			},
+
+			Config: map[string]interface{}{},
+
+			Err: true,
+			Errors: []error{
+				fmt.Errorf("\"whitelist\": one of `whitelist,blacklist` must be specified"),
+			},
+		},
+
+		"ExactlyOneOf with one of the attributes set": {
+			Schema: map[string]*Schema{
+				"whitelist": &Schema{
+					Type:         TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{"whitelist", "blacklist"},
+				},
+				"blacklist": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+			},
+
+			Config: map[string]interface{}{
+				"blacklist": "black-val",
+			},
+
+			Err: false,
+		},
+
+		"ExactlyOneOf with both of the attributes set": {
+			Schema: map[string]*Schema{
+				"whitelist": &Schema{
+					Type:         TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{"whitelist", "blacklist"},
+				},
+				"blacklist": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+			},
+
+			Config: map[string]interface{}{
+				"whitelist": "white-val",
+				"blacklist": "black-val",
+			},
+
+			Err: true,
+			Errors: []error{
+				fmt.Errorf("\"whitelist\": only one of `whitelist,blacklist` can be specified, but `whitelist,blacklist` were specified"),
+			},
+		},
+
+		"RequiredWith with all of the attributes set": {
+			Schema: map[string]*Schema{
+				"username": &Schema{
+					Type:         TypeString,
+					Optional:     true,
+					RequiredWith: []string{"password"},
+				},
+				"password": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+			},
+
+			Config: map[string]interface{}{
+				"username": "admin",
+				"password": "secret",
+			},
+
+			Err: false,
+		},
+
+		"RequiredWith with the attribute itself unset": {
+			Schema: map[string]*Schema{
+				"username": &Schema{
+					Type:         TypeString,
+					Optional:     true,
+					RequiredWith: []string{"password"},
+				},
+				"password": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+			},
+
+			Config: map[string]interface{}{
+				"password": "secret",
+			},
+
+			Err: false,
+		},
+
+		"RequiredWith with a missing attribute": {
+			Schema: map[string]*Schema{
+				"username": &Schema{
+					Type:         TypeString,
+					Optional:     true,
+					RequiredWith: []string{"password"},
+				},
+				"password": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+			},
+
+			Config: map[string]interface{}{
+				"username": "admin",
+			},
+
+			Err: true,
+			Errors: []error{
+				fmt.Errorf("\"username\": all of `username,password` must be specified"),
+			},
+		},
+
 		"Good with ValidateFunc": {
 			Schema: map[string]*Schema{
 				"validate_me": &Schema{
@@ -3789,3 +4136,94 @@ func TestSchemaSet_ValidateMaxItems(t *testing.T) {
 		}
 	}
 }
+
+func TestSchemaSet_ValidateMinItems(t *testing.T) {
+	cases := map[string]struct {
+		Schema          map[string]*Schema
+		State           *terraform.InstanceState
+		Config          map[string]interface{}
+		ConfigVariables map[string]string
+		Diff            *terraform.InstanceDiff
+		Err             bool
+		Errors          []error
+	}{
+		"#0": {
+			Schema: map[string]*Schema{
+				"aliases": &Schema{
+					Type:     TypeSet,
+					Optional: true,
+					MinItems: 2,
+					Elem:     &Schema{Type: TypeString},
+				},
+			},
+			State: nil,
+			Config: map[string]interface{}{
+				"aliases": []interface{}{"foo", "bar"},
+			},
+			Diff:   nil,
+			Err:    false,
+			Errors: nil,
+		},
+		"#1": {
+			Schema: map[string]*Schema{
+				"aliases": &Schema{
+					Type:     TypeSet,
+					Optional: true,
+					Elem:     &Schema{Type: TypeString},
+				},
+			},
+			State: nil,
+			Config: map[string]interface{}{
+				"aliases": []interface{}{"foo", "bar"},
+			},
+			Diff:   nil,
+			Err:    false,
+			Errors: nil,
+		},
+		"#2": {
+			Schema: map[string]*Schema{
+				"aliases": &Schema{
+					Type:     TypeList,
+					Optional: true,
+					MinItems: 2,
+					Elem:     &Schema{Type: TypeString},
+				},
+			},
+			State: nil,
+			Config: map[string]interface{}{
+				"aliases": []interface{}{"foo"},
+			},
+			Diff: nil,
+			Err:  true,
+			Errors: []error{
+				fmt.Errorf("aliases: attribute supports 2 item as a minimum, config has 1 declared"),
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		c, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%q: err: %s", tn, err)
+		}
+		_, es := schemaMap(tc.Schema).Validate(terraform.NewResourceConfig(c))
+
+		if len(es) > 0 != tc.Err {
+			if len(es) == 0 {
+				t.Errorf("%q: no errors", tn)
+			}
+
+			for _, e := range es {
+				t.Errorf("%q: err: %s", tn, e)
+			}
+
+			t.FailNow()
+		}
+
+		if tc.Errors != nil {
+			if !reflect.DeepEqual(es, tc.Errors) {
+				t.Fatalf("%q: expected: %q\ngot: %q", tn, tc.Errors, es)
+			}
+		}
+	}
+}
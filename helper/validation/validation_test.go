@@ -0,0 +1,254 @@
+package validation
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type testCase struct {
+	val         interface{}
+	f           schema.SchemaValidateFunc
+	expectedErr *regexp.Regexp
+}
+
+func TestValidationIntBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: 1,
+			f:   IntBetween(1, 1),
+		},
+		{
+			val: 1,
+			f:   IntBetween(0, 2),
+		},
+		{
+			val:         1,
+			f:           IntBetween(2, 3),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be in the range \\(2 - 3\\), got 1"),
+		},
+		{
+			val:         "1",
+			f:           IntBetween(2, 3),
+			expectedErr: regexp.MustCompile("expected type of [\\w]+ to be int"),
+		},
+	})
+}
+
+func TestValidationIntAtLeast(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: 1,
+			f:   IntAtLeast(1),
+		},
+		{
+			val:         1,
+			f:           IntAtLeast(2),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be at least \\(2\\), got 1"),
+		},
+	})
+}
+
+func TestValidationIntAtMost(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: 1,
+			f:   IntAtMost(1),
+		},
+		{
+			val:         1,
+			f:           IntAtMost(0),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be at most \\(0\\), got 1"),
+		},
+	})
+}
+
+func TestValidationIsPortNumber(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: 443,
+			f:   IsPortNumber(),
+		},
+		{
+			val:         0,
+			f:           IsPortNumber(),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be in the range \\(1 - 65535\\), got 0"),
+		},
+	})
+}
+
+func TestValidationPortRange(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "80",
+			f:   PortRange(false),
+		},
+		{
+			val: "8000-8080",
+			f:   PortRange(false),
+		},
+		{
+			val: "*",
+			f:   PortRange(true),
+		},
+		{
+			val:         "*",
+			f:           PortRange(false),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be a port or a range of ports"),
+		},
+		{
+			val:         "8080-8000",
+			f:           PortRange(false),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be a range of ports between 1 and 65535"),
+		},
+		{
+			val:         "0-80",
+			f:           PortRange(false),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be a range of ports between 1 and 65535"),
+		},
+	})
+}
+
+func TestValidationStringInSlice(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "ValidValue",
+			f:   StringInSlice([]string{"ValidValue", "AnotherValidValue"}, false),
+		},
+		// ignore case
+		{
+			val: "VALIDVALUE",
+			f:   StringInSlice([]string{"ValidValue", "AnotherValidValue"}, true),
+		},
+		{
+			val:         "VALIDVALUE",
+			f:           StringInSlice([]string{"ValidValue", "AnotherValidValue"}, false),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be one of \\[ValidValue AnotherValidValue\\], got VALIDVALUE"),
+		},
+		{
+			val:         "InvalidValue",
+			f:           StringInSlice([]string{"ValidValue", "AnotherValidValue"}, false),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be one of \\[ValidValue AnotherValidValue\\], got InvalidValue"),
+		},
+		{
+			val:         1,
+			f:           StringInSlice([]string{"ValidValue", "AnotherValidValue"}, false),
+			expectedErr: regexp.MustCompile("expected type of [\\w]+ to be string"),
+		},
+	})
+}
+
+func TestValidationStringLenBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "abc",
+			f:   StringLenBetween(1, 3),
+		},
+		{
+			val:         "abcd",
+			f:           StringLenBetween(1, 3),
+			expectedErr: regexp.MustCompile("expected length of [\\w]+ to be in the range \\(1 - 3\\), got abcd"),
+		},
+	})
+}
+
+func TestValidationStringMatch(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "foobar",
+			f:   StringMatch(regexp.MustCompile(".*foo.*"), ""),
+		},
+		{
+			val:         "bar",
+			f:           StringMatch(regexp.MustCompile(".*foo.*"), ""),
+			expectedErr: regexp.MustCompile("expected value of [\\w]+ to match regular expression " + regexp.QuoteMeta(`".*foo.*"`)),
+		},
+		{
+			val:         "bar",
+			f:           StringMatch(regexp.MustCompile(".*foo.*"), "value must contain foo"),
+			expectedErr: regexp.MustCompile("invalid value for [\\w]+ \\(value must contain foo\\)"),
+		},
+	})
+}
+
+func TestValidationCIDRNetwork(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "10.0.0.0/16",
+			f:   CIDRNetwork(0, 32),
+		},
+		{
+			val:         "10.0.0.1/16",
+			f:           CIDRNetwork(0, 32),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to contain a valid network CIDR, expected 10.0.0.0/16, got 10.0.0.1/16"),
+		},
+		{
+			val:         "10.0.0.0/8",
+			f:           CIDRNetwork(16, 32),
+			expectedErr: regexp.MustCompile("expected \"[\\w]+\" to contain a network CIDR with between 16 and 32 significant bits, got: 8"),
+		},
+		{
+			val:         "10.0.0.0",
+			f:           CIDRNetwork(0, 32),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to contain a valid CIDR"),
+		},
+	})
+}
+
+func TestValidationIsUUID(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "00000000-0000-0000-0000-000000000000",
+			f:   IsUUID,
+		},
+		{
+			val:         "00000000-0000-0000-0000",
+			f:           IsUUID,
+			expectedErr: regexp.MustCompile("expected \"[\\w]+\" to be a valid UUID"),
+		},
+	})
+}
+
+func TestValidationRFC3339TimeString(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "2018-01-01T01:02:03Z",
+			f:   ValidateRFC3339TimeString,
+		},
+		{
+			val:         "2018-01-01",
+			f:           ValidateRFC3339TimeString,
+			expectedErr: regexp.MustCompile("\"[\\w]+\": invalid RFC3339 timestamp"),
+		},
+	})
+}
+
+func runTestCases(t *testing.T, cases []testCase) {
+	matchErr := func(errs []error, r *regexp.Regexp) bool {
+		// err must match one provided
+		for _, err := range errs {
+			if r.MatchString(err.Error()) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i, tc := range cases {
+		_, errs := tc.f(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if !matchErr(errs, tc.expectedErr) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
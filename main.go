@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/terraform/helper/logging"
+	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/state/encryption"
 	"github.com/mattn/go-colorable"
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/panicwrap"
@@ -141,6 +143,14 @@ func wrappedMain() int {
 	ContextOpts.Providers = config.ProviderFactories()
 	ContextOpts.Provisioners = config.ProvisionerFactories()
 
+	// Configure the encryption of the states at rest, if enabled
+	stateEncryption, err := encryption.FromEnv()
+	if err != nil {
+		Ui.Error(err.Error())
+		return 1
+	}
+	state.Encryption = stateEncryption
+
 	exitCode, err := cli.Run()
 	if err != nil {
 		Ui.Error(fmt.Sprintf("Error executing CLI: %s", err.Error()))
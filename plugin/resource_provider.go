@@ -220,6 +220,19 @@ func (p *ResourceProvider) ImportState(
 	return resp.State, err
 }
 
+func (p *ResourceProvider) GetSchema() (*terraform.ProviderSchema, error) {
+	var resp ResourceProviderGetSchemaResponse
+	err := p.Client.Call("Plugin.GetSchema", new(interface{}), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		err = resp.Error
+	}
+
+	return resp.Schema, err
+}
+
 func (p *ResourceProvider) Resources() []terraform.ResourceType {
 	var result []terraform.ResourceType
 
@@ -351,6 +364,11 @@ type ResourceProviderImportStateResponse struct {
 	Error *plugin.BasicError
 }
 
+type ResourceProviderGetSchemaResponse struct {
+	Schema *terraform.ProviderSchema
+	Error  *plugin.BasicError
+}
+
 type ResourceProviderReadDataApplyArgs struct {
 	Info *terraform.InstanceInfo
 	Diff *terraform.InstanceDiff
@@ -498,6 +516,17 @@ func (s *ResourceProviderServer) ImportState(
 	return nil
 }
 
+func (s *ResourceProviderServer) GetSchema(
+	nothing interface{},
+	result *ResourceProviderGetSchemaResponse) error {
+	schema, err := s.Provider.GetSchema()
+	*result = ResourceProviderGetSchemaResponse{
+		Schema: schema,
+		Error:  plugin.NewBasicError(err),
+	}
+	return nil
+}
+
 func (s *ResourceProviderServer) Resources(
 	nothing interface{},
 	result *[]terraform.ResourceType) error {
@@ -356,6 +356,69 @@ func TestResourceProvider_importState(t *testing.T) {
 	}
 }
 
+func TestResourceProvider_getSchema(t *testing.T) {
+	p := new(terraform.MockResourceProvider)
+
+	// Create a mock provider
+	client, _ := plugin.TestPluginRPCConn(t, pluginMap(&ServeOpts{
+		ProviderFunc: testProviderFixed(p),
+	}))
+	defer client.Close()
+
+	// Request the provider
+	raw, err := client.Dispense(ProviderPluginName)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	provider := raw.(terraform.ResourceProvider)
+
+	expected := &terraform.ProviderSchema{
+		Provider: &terraform.SchemaBlock{
+			Attributes: map[string]*terraform.SchemaAttribute{
+				"region": &terraform.SchemaAttribute{
+					Type:     "string",
+					Required: true,
+				},
+			},
+		},
+		ResourceTypes: map[string]*terraform.SchemaBlock{
+			"test_instance": &terraform.SchemaBlock{
+				BlockTypes: map[string]*terraform.SchemaNestedBlock{
+					"disk": &terraform.SchemaNestedBlock{
+						SchemaBlock: terraform.SchemaBlock{
+							Attributes: map[string]*terraform.SchemaAttribute{
+								"size": &terraform.SchemaAttribute{
+									Type:     "number",
+									Optional: true,
+								},
+							},
+						},
+						Nesting:  "list",
+						MaxItems: 1,
+					},
+				},
+			},
+		},
+		ResourceTypeSchemaVersions: map[string]int{
+			"test_instance": 1,
+		},
+	}
+
+	p.GetSchemaReturn = expected
+
+	// GetSchema
+	result, err := provider.GetSchema()
+	if !p.GetSchemaCalled {
+		t.Fatal("get schema should be called")
+	}
+	if err != nil {
+		t.Fatalf("bad: %#v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("bad: %#v", result)
+	}
+}
+
 func TestResourceProvider_resources(t *testing.T) {
 	p := new(terraform.MockResourceProvider)
 
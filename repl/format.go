@@ -0,0 +1,102 @@
+package repl
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FormatResult formats the given result value for human-readable output.
+//
+// Strings are output as-is, lists and maps are output in a form similar to
+// how they would be written in the configuration.
+func FormatResult(value interface{}) (string, error) {
+	switch output := value.(type) {
+	case string:
+		return output, nil
+	case []interface{}:
+		return formatListResult(output)
+	case map[string]interface{}:
+		return formatMapResult(output)
+	default:
+		return "", fmt.Errorf("unknown value type: %T", value)
+	}
+}
+
+func formatListResult(value []interface{}) (string, error) {
+	var outputBuf bytes.Buffer
+	outputBuf.WriteString("[")
+	if len(value) > 0 {
+		outputBuf.WriteString("\n")
+	}
+
+	for _, v := range value {
+		raw, err := formatNestedResult(v)
+		if err != nil {
+			return "", err
+		}
+
+		outputBuf.WriteString(indent(raw))
+		outputBuf.WriteString(",\n")
+	}
+
+	outputBuf.WriteString("]")
+	return outputBuf.String(), nil
+}
+
+func formatMapResult(value map[string]interface{}) (string, error) {
+	ks := make([]string, 0, len(value))
+	for k := range value {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+
+	var outputBuf bytes.Buffer
+	outputBuf.WriteString("{")
+	if len(value) > 0 {
+		outputBuf.WriteString("\n")
+	}
+
+	for _, k := range ks {
+		raw, err := formatNestedResult(value[k])
+		if err != nil {
+			return "", err
+		}
+
+		outputBuf.WriteString(indent(fmt.Sprintf("%q = %s", k, raw)))
+		outputBuf.WriteString("\n")
+	}
+
+	outputBuf.WriteString("}")
+	return outputBuf.String(), nil
+}
+
+// formatNestedResult formats a value within a list or map, where strings
+// are quoted.
+func formatNestedResult(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s), nil
+	}
+
+	return FormatResult(value)
+}
+
+// indent indents every line of the given string by two spaces.
+func indent(s string) string {
+	var buf bytes.Buffer
+	sc := bufio.NewScanner(strings.NewReader(s))
+	first := true
+	for sc.Scan() {
+		if !first {
+			buf.WriteString("\n")
+		}
+		first = false
+
+		buf.WriteString("  ")
+		buf.WriteString(sc.Text())
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,48 @@
+package repl
+
+import (
+	"testing"
+)
+
+func TestFormatResult(t *testing.T) {
+	cases := []struct {
+		Input    interface{}
+		Expected string
+	}{
+		{
+			"foo",
+			"foo",
+		},
+
+		{
+			[]interface{}{},
+			"[]",
+		},
+
+		{
+			[]interface{}{"foo", "bar"},
+			"[\n  \"foo\",\n  \"bar\",\n]",
+		},
+
+		{
+			map[string]interface{}{"b": "2", "a": "1"},
+			"{\n  \"a\" = \"1\"\n  \"b\" = \"2\"\n}",
+		},
+
+		{
+			[]interface{}{map[string]interface{}{"a": []interface{}{"1"}}},
+			"[\n  {\n    \"a\" = [\n      \"1\",\n    ]\n  },\n]",
+		},
+	}
+
+	for i, tc := range cases {
+		actual, err := FormatResult(tc.Input)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("%d: bad:\n\n%s\n\nexpected:\n\n%s", i, actual, tc.Expected)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package repl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// ErrSessionExit is returned by Handle when the user asked to exit the
+// session.
+var ErrSessionExit = errors.New("session exit")
+
+// Session represents the state of a REPL session. Every line handled by
+// the session is evaluated as an interpolation in the root module.
+type Session struct {
+	// Interpolater is used to find the values of the variables used in
+	// the expressions.
+	Interpolater *terraform.Interpolater
+}
+
+// Handle handles a single line of input. The returned string is the
+// result to show to the user, which is empty if there is nothing to show.
+func (s *Session) Handle(line string) (string, error) {
+	switch strings.TrimSpace(line) {
+	case "":
+		return "", nil
+	case "exit":
+		return "", ErrSessionExit
+	case "help":
+		return s.handleHelp()
+	default:
+		return s.handleEval(line)
+	}
+}
+
+func (s *Session) handleEval(line string) (string, error) {
+	// Wrap the line so that it is interpolated like a value in the
+	// configuration.
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"value": fmt.Sprintf("${%s}", line),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	scope := &terraform.InterpolationScope{Path: terraform.RootModulePath}
+	vars, err := s.Interpolater.Values(scope, raw.Variables)
+	if err != nil {
+		return "", err
+	}
+
+	if err := raw.Interpolate(vars); err != nil {
+		return "", err
+	}
+
+	// Values that are only known after apply can't be shown
+	if len(raw.UnknownKeys()) > 0 {
+		return "<computed>", nil
+	}
+
+	return FormatResult(raw.Config()["value"])
+}
+
+func (s *Session) handleHelp() (string, error) {
+	text := `
+The Terraform console allows you to experiment with Terraform interpolations.
+You may access resources in the state (if you have one) just as you would
+from a configuration. For example: "aws_instance.foo.id" would evaluate
+to the ID of "aws_instance.foo" if it exists in your state.
+
+Type in the interpolation to test and hit <enter> to see the result.
+
+To exit the console, type "exit" and hit <enter>, or use Control-C or
+Control-D.
+`
+
+	return strings.TrimSpace(text), nil
+}
@@ -0,0 +1,142 @@
+package repl
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/config/module"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSession_basicState(t *testing.T) {
+	state := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.foo": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "bar",
+							Attributes: map[string]string{
+								"id": "bar",
+							},
+						},
+					},
+				},
+				Outputs: map[string]*terraform.OutputState{
+					"addr": &terraform.OutputState{
+						Type:  "string",
+						Value: "10.0.0.1",
+					},
+				},
+			},
+		},
+	}
+
+	testSession(t, testSessionTest{
+		Module: "basic",
+		State:  state,
+		Inputs: []testSessionInput{
+			{
+				Input:  "test_instance.foo.id",
+				Output: "bar",
+			},
+			{
+				Input:  "upper(test_instance.foo.id)",
+				Output: "BAR",
+			},
+			{
+				Input: "test_instance.bar.id",
+				Error: true,
+			},
+		},
+	})
+}
+
+func TestSession_noState(t *testing.T) {
+	testSession(t, testSessionTest{
+		Inputs: []testSessionInput{
+			{
+				Input:  "",
+				Output: "",
+			},
+			{
+				Input:  "1 + 5",
+				Output: "6",
+			},
+			{
+				Input:  `list("a", "b")`,
+				Output: "[\n  \"a\",\n  \"b\",\n]",
+			},
+			{
+				Input:  "help",
+				Output: "",
+			},
+		},
+	})
+}
+
+func TestSession_exit(t *testing.T) {
+	testSession(t, testSessionTest{
+		Inputs: []testSessionInput{
+			{
+				Input:    "exit",
+				ExitCode: true,
+			},
+		},
+	})
+}
+
+type testSessionTest struct {
+	Module string
+	State  *terraform.State
+	Inputs []testSessionInput
+}
+
+type testSessionInput struct {
+	Input    string
+	Output   string
+	Error    bool
+	ExitCode bool
+}
+
+func testSession(t *testing.T, test testSessionTest) {
+	mod := module.NewEmptyTree()
+	if test.Module != "" {
+		var err error
+		mod, err = module.NewTreeModule("", filepath.Join("test-fixtures", test.Module))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if err := mod.Load(nil, module.GetModeNone); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	ctx, err := terraform.NewContext(&terraform.ContextOpts{
+		Module: mod,
+		State:  test.State,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	session := &Session{Interpolater: ctx.Interpolater()}
+
+	for i, input := range test.Inputs {
+		output, err := session.Handle(input.Input)
+		if input.ExitCode {
+			if err != ErrSessionExit {
+				t.Fatalf("%d: expected exit, got: %s", i, err)
+			}
+			continue
+		}
+		if (err != nil) != input.Error {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if input.Output != "" && output != input.Output {
+			t.Fatalf("%d: bad:\n\n%s\n\nexpected:\n\n%s", i, output, input.Output)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+resource "test_instance" "foo" {}
+
+output "id" {
+  value = "${test_instance.foo.id}"
+}
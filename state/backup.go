@@ -43,6 +43,20 @@ func (s *BackupState) PersistState() error {
 	return s.Real.PersistState()
 }
 
+func (s *BackupState) Lock(info *LockInfo) (string, error) {
+	if l, ok := s.Real.(Locker); ok {
+		return l.Lock(info)
+	}
+	return "", nil
+}
+
+func (s *BackupState) Unlock(id string) error {
+	if l, ok := s.Real.(Locker); ok {
+		return l.Unlock(id)
+	}
+	return nil
+}
+
 func (s *BackupState) backup() error {
 	state := s.Real.State()
 	if state == nil {
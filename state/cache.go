@@ -131,6 +131,25 @@ func (s *CacheState) PersistState() error {
 	return s.Durable.PersistState()
 }
 
+// Lock locks the durable storage, since the cache is only local to this
+// machine.
+//
+// Locker impl.
+func (s *CacheState) Lock(info *LockInfo) (string, error) {
+	if durable, ok := s.Durable.(Locker); ok {
+		return durable.Lock(info)
+	}
+	return "", nil
+}
+
+// Locker impl.
+func (s *CacheState) Unlock(id string) error {
+	if durable, ok := s.Durable.(Locker); ok {
+		return durable.Unlock(id)
+	}
+	return nil
+}
+
 // CacheStateCache is the meta-interface that must be implemented for
 // the cache for the CacheState.
 type CacheStateCache interface {
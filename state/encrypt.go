@@ -0,0 +1,108 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/hashicorp/terraform/state/encryption"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Encryption, if set, encrypts the states written by LocalState, the
+// remote states and the plan files at rest. It's required to read encrypted
+// states and plans.
+var Encryption *encryption.Encryption
+
+// ReadState reads a state like terraform.ReadState, decrypting it if it's
+// encrypted.
+func ReadState(src io.Reader) (*terraform.State, error) {
+	data, err := ioutil.ReadAll(src)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return terraform.ReadState(bytes.NewReader(data))
+}
+
+// decrypt decrypts the given state or plan if it's encrypted, and returns
+// it as is otherwise.
+func decrypt(data []byte) ([]byte, error) {
+	if !encryption.IsEncrypted(data) {
+		return data, nil
+	}
+
+	if Encryption == nil {
+		return nil, fmt.Errorf(
+			"The state is encrypted, but state encryption isn't configured.\n"+
+				"Set the %s environment variable to the key provider the\n"+
+				"state was encrypted with to read it.", encryption.ProviderEnvVar)
+	}
+
+	return Encryption.Decrypt(data)
+}
+
+// WriteState writes a state like terraform.WriteState, encrypting it if
+// Encryption is set.
+func WriteState(s *terraform.State, dst io.Writer) error {
+	if Encryption == nil {
+		return terraform.WriteState(s, dst)
+	}
+
+	var buf bytes.Buffer
+	if err := terraform.WriteState(s, &buf); err != nil {
+		return err
+	}
+
+	data, err := Encryption.Encrypt(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = dst.Write(data)
+	return err
+}
+
+// ReadPlan reads a plan like terraform.ReadPlan, decrypting it if it's
+// encrypted.
+func ReadPlan(src io.Reader) (*terraform.Plan, error) {
+	data, err := ioutil.ReadAll(src)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return terraform.ReadPlan(bytes.NewReader(data))
+}
+
+// WritePlan writes a plan like terraform.WritePlan, encrypting it if
+// Encryption is set. The whole plan is encrypted, since both the state
+// and the diff in it can contain secrets.
+func WritePlan(p *terraform.Plan, dst io.Writer) error {
+	if Encryption == nil {
+		return terraform.WritePlan(p, dst)
+	}
+
+	var buf bytes.Buffer
+	if err := terraform.WritePlan(p, &buf); err != nil {
+		return err
+	}
+
+	data, err := Encryption.Encrypt(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = dst.Write(data)
+	return err
+}
@@ -0,0 +1,111 @@
+package state
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/state/encryption"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testEncryption(t *testing.T) func() {
+	enc, err := encryption.New("passphrase", map[string]string{
+		"passphrase": "correct horse battery staple",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	Encryption = enc
+	return func() { Encryption = nil }
+}
+
+func TestLocalState_encryption(t *testing.T) {
+	defer testEncryption(t)()
+
+	ls := testLocalState(t)
+	defer os.Remove(ls.Path)
+	TestState(t, ls)
+
+	// The state is encrypted on disk
+	data, err := ioutil.ReadFile(ls.Path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !encryption.IsEncrypted(data) {
+		t.Fatalf("state should be encrypted: %s", data)
+	}
+}
+
+func TestReadState_plaintext(t *testing.T) {
+	defer testEncryption(t)()
+
+	// Plaintext states are still read, to be encrypted when written again
+	var buf bytes.Buffer
+	if err := terraform.WriteState(TestStateInitial(), &buf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	s, err := ReadState(&buf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !s.Equal(TestStateInitial()) {
+		t.Fatalf("bad: %s", s)
+	}
+}
+
+func TestReadState_notConfigured(t *testing.T) {
+	restore := testEncryption(t)
+
+	var buf bytes.Buffer
+	if err := WriteState(TestStateInitial(), &buf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	restore()
+
+	if _, err := ReadState(&buf); err == nil {
+		t.Fatal("should error without encryption configured")
+	}
+}
+
+func TestWritePlan_encryption(t *testing.T) {
+	defer testEncryption(t)()
+
+	plan := &terraform.Plan{
+		State:     TestStateInitial(),
+		Workspace: "default",
+	}
+
+	var buf bytes.Buffer
+	if err := WritePlan(plan, &buf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !encryption.IsEncrypted(buf.Bytes()) {
+		t.Fatalf("plan should be encrypted: %s", buf.String())
+	}
+
+	actual, err := ReadPlan(&buf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !actual.State.Equal(plan.State) {
+		t.Fatalf("bad: %s", actual.State)
+	}
+}
+
+func TestReadPlan_plaintext(t *testing.T) {
+	defer testEncryption(t)()
+
+	var buf bytes.Buffer
+	plan := &terraform.Plan{State: TestStateInitial()}
+	if err := terraform.WritePlan(plan, &buf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := ReadPlan(&buf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -0,0 +1,107 @@
+package encryption
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/go-cleanhttp"
+	terraformAws "github.com/hashicorp/terraform/builtin/providers/aws"
+)
+
+// awsKMSEncryptionContext is the encryption context of the data keys,
+// which must be given again to decrypt them.
+var awsKMSEncryptionContext = map[string]*string{
+	"terraform": aws.String("state"),
+}
+
+func awsKMSFactory(conf map[string]string) (KeyProvider, error) {
+	keyID, ok := conf["key_id"]
+	if !ok {
+		keyID = os.Getenv("TF_STATE_AWS_KMS_KEY_ID")
+	}
+
+	regionName, ok := conf["region"]
+	if !ok {
+		regionName = os.Getenv("AWS_DEFAULT_REGION")
+		if regionName == "" {
+			return nil, fmt.Errorf(
+				"missing 'region' configuration or AWS_DEFAULT_REGION environment variable")
+		}
+	}
+
+	endpoint, ok := conf["endpoint"]
+	if !ok {
+		endpoint = os.Getenv("AWS_KMS_ENDPOINT")
+	}
+
+	creds := terraformAws.GetCredentials(&terraformAws.Config{
+		AccessKey:     conf["access_key"],
+		SecretKey:     conf["secret_key"],
+		Token:         conf["token"],
+		Profile:       conf["profile"],
+		CredsFilename: conf["shared_credentials_file"],
+	})
+	// Call Get to check for credential provider. If nothing found, we'll get an
+	// error, and we can present it nicely to the user
+	if _, err := creds.Get(); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+			return nil, fmt.Errorf("No valid credential sources found for AWS KMS state encryption.")
+		}
+		return nil, fmt.Errorf("Error loading credentials for AWS KMS state encryption: %s", err)
+	}
+
+	awsConfig := &aws.Config{
+		Credentials: creds,
+		Endpoint:    aws.String(endpoint),
+		Region:      aws.String(regionName),
+		HTTPClient:  cleanhttp.DefaultClient(),
+	}
+	sess := session.New(awsConfig)
+
+	return &awsKMSProvider{
+		keyID:     keyID,
+		kmsClient: kms.New(sess),
+	}, nil
+}
+
+// awsKMSProvider wraps the data keys with a customer master key of AWS KMS.
+// The wrapped keys identify the master key they were wrapped with, so the
+// key ID is only required to encrypt states.
+type awsKMSProvider struct {
+	keyID     string
+	kmsClient *kms.KMS
+}
+
+func (p *awsKMSProvider) WrapKey(key []byte) ([]byte, string, error) {
+	if p.keyID == "" {
+		return nil, "", fmt.Errorf(
+			"missing 'key_id' configuration or TF_STATE_AWS_KMS_KEY_ID environment variable")
+	}
+
+	resp, err := p.kmsClient.Encrypt(&kms.EncryptInput{
+		KeyId:             aws.String(p.keyID),
+		Plaintext:         key,
+		EncryptionContext: awsKMSEncryptionContext,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resp.CiphertextBlob, aws.StringValue(resp.KeyId), nil
+}
+
+func (p *awsKMSProvider) UnwrapKey(wrapped []byte, keyID string) ([]byte, error) {
+	resp, err := p.kmsClient.Decrypt(&kms.DecryptInput{
+		CiphertextBlob:    wrapped,
+		EncryptionContext: awsKMSEncryptionContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Plaintext, nil
+}
@@ -0,0 +1,101 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testAWSKMSServer returns a fake AWS KMS server "encrypting" the keys by
+// prefixing them with the key ID.
+func testAWSKMSServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			KeyId             string
+			Plaintext         []byte
+			CiphertextBlob    []byte
+			EncryptionContext map[string]string
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if req.EncryptionContext["terraform"] != "state" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type": "InvalidCiphertextException"}`))
+			return
+		}
+
+		var resp interface{}
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.Encrypt":
+			resp = map[string]interface{}{
+				"KeyId":          "arn:" + req.KeyId,
+				"CiphertextBlob": append([]byte("arn:"+req.KeyId+":"), req.Plaintext...),
+			}
+		case "TrentService.Decrypt":
+			i := bytes.LastIndexByte(req.CiphertextBlob, ':')
+			resp = map[string]interface{}{
+				"KeyId":     string(req.CiphertextBlob[:i]),
+				"Plaintext": req.CiphertextBlob[i+1:],
+			}
+		default:
+			t.Fatalf("unexpected request: %s", r.Header.Get("X-Amz-Target"))
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestAWSKMSProvider(t *testing.T) {
+	srv := testAWSKMSServer(t)
+	defer srv.Close()
+
+	p, err := awsKMSFactory(map[string]string{
+		"key_id":     "alias/terraform",
+		"region":     "us-east-1",
+		"endpoint":   srv.URL,
+		"access_key": "foo",
+		"secret_key": "bar",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, keyID, err := p.WrapKey(key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if keyID != "arn:alias/terraform" {
+		t.Fatalf("bad: %s", keyID)
+	}
+
+	actual, err := p.UnwrapKey(wrapped, keyID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, key) {
+		t.Fatalf("bad: %s", actual)
+	}
+}
+
+func TestAWSKMSProvider_noKeyID(t *testing.T) {
+	p, err := awsKMSFactory(map[string]string{
+		"key_id":     "",
+		"region":     "us-east-1",
+		"access_key": "foo",
+		"secret_key": "bar",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The key ID is only required to encrypt
+	if _, _, err := p.WrapKey([]byte("key")); err == nil {
+		t.Fatal("should error without a key ID")
+	}
+}
@@ -0,0 +1,187 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	azureKeyVaultAPIVersion = "2016-10-01"
+	azureKeyVaultResource   = "https://vault.azure.net"
+
+	// azureKeyVaultAlgorithm is the algorithm wrapping the data keys with
+	// the RSA keys of Key Vault.
+	azureKeyVaultAlgorithm = "RSA-OAEP"
+)
+
+func azureKeyVaultFactory(conf map[string]string) (KeyProvider, error) {
+	keyID, ok := conf["key_id"]
+	if !ok {
+		keyID = os.Getenv("TF_STATE_AZURE_KEY_ID")
+	}
+	if keyID == "" {
+		return nil, fmt.Errorf(
+			"missing 'key_id' configuration or TF_STATE_AZURE_KEY_ID environment variable")
+	}
+
+	vault, err := azureKeyVaultURL(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID, ok := conf["client_id"]
+	if !ok {
+		clientID = os.Getenv("ARM_CLIENT_ID")
+	}
+	clientSecret, ok := conf["client_secret"]
+	if !ok {
+		clientSecret = os.Getenv("ARM_CLIENT_SECRET")
+	}
+	tenantID, ok := conf["tenant_id"]
+	if !ok {
+		tenantID = os.Getenv("ARM_TENANT_ID")
+	}
+	if clientID == "" || clientSecret == "" || tenantID == "" {
+		return nil, fmt.Errorf(
+			"missing 'client_id', 'client_secret' or 'tenant_id' configuration, or " +
+				"ARM_CLIENT_ID, ARM_CLIENT_SECRET or ARM_TENANT_ID environment variables")
+	}
+
+	oauthConfig, err := azure.PublicCloud.OAuthConfigForTenant(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := azure.NewServicePrincipalToken(
+		*oauthConfig, clientID, clientSecret, azureKeyVaultResource)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating the Azure service principal token: %s", err)
+	}
+
+	return &azureKeyVaultProvider{
+		keyID:  keyID,
+		vault:  vault,
+		token:  token,
+		client: cleanhttp.DefaultClient(),
+	}, nil
+}
+
+// azureKeyVaultURL returns the URL of the vault holding the key with the
+// given identifier, such as https://VAULT.vault.azure.net/keys/NAME.
+func azureKeyVaultURL(keyID string) (*url.URL, error) {
+	u, err := url.Parse(keyID)
+	if err != nil || u.Scheme == "" || u.Host == "" || !strings.HasPrefix(u.Path, "/keys/") {
+		return nil, fmt.Errorf(
+			"invalid Azure Key Vault key identifier %q, expected https://VAULT.vault.azure.net/keys/NAME", keyID)
+	}
+
+	return &url.URL{Scheme: u.Scheme, Host: u.Host}, nil
+}
+
+// azureKeyVaultProvider wraps the data keys with an RSA key of Azure Key
+// Vault. The wrapped keys are unwrapped with the version of the key they
+// were wrapped with, so new versions of the key can be created to rotate
+// it.
+type azureKeyVaultProvider struct {
+	keyID  string
+	vault  *url.URL
+	token  *azure.ServicePrincipalToken
+	client *http.Client
+}
+
+func (p *azureKeyVaultProvider) WrapKey(key []byte) ([]byte, string, error) {
+	kid, wrapped, err := p.call(p.keyID, "wrapkey", key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return wrapped, kid, nil
+}
+
+func (p *azureKeyVaultProvider) UnwrapKey(wrapped []byte, keyID string) ([]byte, error) {
+	if keyID == "" {
+		keyID = p.keyID
+	}
+
+	// The access token is only sent to the configured vault.
+	vault, err := azureKeyVaultURL(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if vault.String() != p.vault.String() {
+		return nil, fmt.Errorf(
+			"the key %q isn't in the configured vault %s", keyID, p.vault)
+	}
+
+	_, key, err := p.call(keyID, "unwrapkey", wrapped)
+	return key, err
+}
+
+// call calls the given operation of the key with the given identifier,
+// returning the versioned identifier of the key and the resulting value.
+func (p *azureKeyVaultProvider) call(keyID, operation string, value []byte) (string, []byte, error) {
+	body, err := json.Marshal(map[string]string{
+		"alg":   azureKeyVaultAlgorithm,
+		"value": base64.RawURLEncoding.EncodeToString(value),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s?api-version=%s",
+		strings.TrimSuffix(keyID, "/"), operation, azureKeyVaultAPIVersion)
+	req, err := http.NewRequest("POST", u, bytes.NewReader(body))
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := p.token.EnsureFresh(); err != nil {
+		return "", nil, fmt.Errorf("Error refreshing the Azure access token: %s", err)
+	}
+	req, err = autorest.Prepare(req, p.token.WithAuthorization())
+	if err != nil {
+		return "", nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("HTTP error: %d: %s", resp.StatusCode, data)
+	}
+
+	var result struct {
+		KeyID string `json:"kid"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return "", nil, err
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(result.Value, "="))
+	if err != nil {
+		return "", nil, fmt.Errorf("Error decoding the %s result: %s", operation, err)
+	}
+
+	return result.KeyID, decoded, nil
+}
@@ -0,0 +1,127 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestAzureKeyVaultURL(t *testing.T) {
+	cases := []struct {
+		KeyID    string
+		Expected string
+	}{
+		{"https://foo.vault.azure.net/keys/bar", "https://foo.vault.azure.net"},
+		{"https://foo.vault.azure.net/keys/bar/0123", "https://foo.vault.azure.net"},
+		{"https://foo.vault.azure.net/secrets/bar", ""},
+		{"bar", ""},
+	}
+
+	for _, tc := range cases {
+		u, err := azureKeyVaultURL(tc.KeyID)
+		if tc.Expected == "" {
+			if err == nil {
+				t.Fatalf("%s: should error", tc.KeyID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.KeyID, err)
+		}
+		if u.String() != tc.Expected {
+			t.Fatalf("%s: bad: %s", tc.KeyID, u)
+		}
+	}
+}
+
+func TestAzureKeyVaultProvider(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var req struct {
+			Algorithm string `json:"alg"`
+			Value     string `json:"value"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if req.Algorithm != azureKeyVaultAlgorithm {
+			t.Fatalf("bad: %s", req.Algorithm)
+		}
+
+		value, err := base64.RawURLEncoding.DecodeString(req.Value)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		switch r.URL.Path {
+		case "/keys/foo/wrapkey":
+			value = append([]byte("v1:"), value...)
+		case "/keys/foo/v1/unwrapkey":
+			value = bytes.TrimPrefix(value, []byte("v1:"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]string{
+			"kid":   srv.URL + "/keys/foo/v1",
+			"value": base64.RawURLEncoding.EncodeToString(value),
+		})
+	}))
+	defer srv.Close()
+
+	vault, _ := url.Parse(srv.URL)
+	token, err := azure.NewServicePrincipalTokenFromManualToken(
+		azure.OAuthConfig{}, "client", azureKeyVaultResource, azure.Token{
+			AccessToken: "token",
+			ExpiresOn:   strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+		})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	p := &azureKeyVaultProvider{
+		keyID:  srv.URL + "/keys/foo",
+		vault:  vault,
+		token:  token,
+		client: http.DefaultClient,
+	}
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, keyID, err := p.WrapKey(key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if keyID != srv.URL+"/keys/foo/v1" {
+		t.Fatalf("bad: %s", keyID)
+	}
+
+	actual, err := p.UnwrapKey(wrapped, keyID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, key) {
+		t.Fatalf("bad: %s", actual)
+	}
+
+	// The token is only sent to the configured vault
+	_, err = p.UnwrapKey(wrapped, "https://other.vault.azure.net/keys/foo/v1")
+	if err == nil || !strings.Contains(err.Error(), "configured vault") {
+		t.Fatalf("bad: %s", err)
+	}
+}
@@ -0,0 +1,229 @@
+// Package encryption encrypts Terraform states at rest.
+//
+// States are encrypted with envelope encryption: every time a state is
+// written it's encrypted with a new random data key using AES-256-GCM, and
+// the data key is wrapped with a key encryption key held by a KeyProvider,
+// such as a KMS. Since the data key is wrapped with the configured key on
+// every write, rotating the key encryption key only requires writing the
+// states again.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ProviderEnvVar is the environment variable selecting the key provider
+// used to encrypt the states. States aren't encrypted if it isn't set.
+const ProviderEnvVar = "TF_STATE_ENCRYPTION"
+
+// envelopeVersion is the version of the format of encrypted states.
+const envelopeVersion = 1
+
+// KeyProvider is the interface implemented by the providers of the key
+// encryption keys, which wrap the data keys the states are encrypted with.
+type KeyProvider interface {
+	// WrapKey encrypts the given data key with the key encryption key,
+	// returning the wrapped key along with the ID of the key encryption key
+	// that was used, which is given back to UnwrapKey.
+	WrapKey(key []byte) (wrapped []byte, keyID string, err error)
+
+	// UnwrapKey decrypts a data key wrapped by WrapKey.
+	UnwrapKey(wrapped []byte, keyID string) ([]byte, error)
+}
+
+// Factory is the factory function to create a key provider.
+type Factory func(map[string]string) (KeyProvider, error)
+
+// BuiltinProviders is the list of built-in key providers, by name.
+var BuiltinProviders = map[string]Factory{
+	"aws_kms":        awsKMSFactory,
+	"azure_keyvault": azureKeyVaultFactory,
+	"gcp_kms":        gcpKMSFactory,
+	"passphrase":     passphraseFactory,
+}
+
+// Encryption encrypts and decrypts states with a key provider.
+type Encryption struct {
+	// Provider is the name of the key provider, and KeyProvider the
+	// provider itself.
+	Provider    string
+	KeyProvider KeyProvider
+}
+
+// New returns the Encryption using the key provider with the given name
+// and configuration. The provider is looked up in BuiltinProviders.
+func New(provider string, conf map[string]string) (*Encryption, error) {
+	keyProvider, err := newKeyProvider(provider, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Encryption{
+		Provider:    provider,
+		KeyProvider: keyProvider,
+	}, nil
+}
+
+// FromEnv returns the Encryption using the key provider selected by the
+// TF_STATE_ENCRYPTION environment variable and configured by the
+// environment, or nil if it isn't set.
+func FromEnv() (*Encryption, error) {
+	provider := os.Getenv(ProviderEnvVar)
+	if provider == "" {
+		return nil, nil
+	}
+
+	enc, err := New(provider, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error configuring the state encryption: %s", err)
+	}
+
+	return enc, nil
+}
+
+func newKeyProvider(provider string, conf map[string]string) (KeyProvider, error) {
+	f, ok := BuiltinProviders[strings.ToLower(provider)]
+	if !ok {
+		return nil, fmt.Errorf("unknown state encryption key provider: %s", provider)
+	}
+
+	if conf == nil {
+		conf = make(map[string]string)
+	}
+
+	return f(conf)
+}
+
+// envelope is the format of the encrypted states.
+type envelope struct {
+	Encryption *envelopeHeader `json:"encryption"`
+
+	// Data is the nonce followed by the encrypted state.
+	Data []byte `json:"data"`
+}
+
+// envelopeHeader describes how a state was encrypted.
+type envelopeHeader struct {
+	Version      int    `json:"version"`
+	Provider     string `json:"provider"`
+	KeyID        string `json:"key_id,omitempty"`
+	EncryptedKey []byte `json:"encrypted_key"`
+}
+
+// IsEncrypted returns whether the given data is an encrypted state.
+func IsEncrypted(data []byte) bool {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return false
+	}
+
+	return env.Encryption != nil
+}
+
+// Encrypt encrypts the given state with a new data key.
+func (e *Encryption) Encrypt(plaintext []byte) ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	wrapped, keyID, err := e.KeyProvider.WrapKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("Error wrapping the state encryption key with %s: %s", e.Provider, err)
+	}
+
+	env := &envelope{
+		Encryption: &envelopeHeader{
+			Version:      envelopeVersion,
+			Provider:     e.Provider,
+			KeyID:        keyID,
+			EncryptedKey: wrapped,
+		},
+		Data: gcm.Seal(nonce, nonce, plaintext, nil),
+	}
+
+	data, err := json.MarshalIndent(env, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+// Decrypt decrypts a state encrypted by Encrypt. States encrypted with
+// another key provider than the configured one are decrypted with that
+// provider, configured by the environment, so that states can be moved
+// from one provider to another.
+func (e *Encryption) Decrypt(data []byte) ([]byte, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("Error decoding the encrypted state: %s", err)
+	}
+
+	header := env.Encryption
+	if header == nil {
+		return nil, fmt.Errorf("state is not encrypted")
+	}
+	if header.Version != envelopeVersion {
+		return nil, fmt.Errorf("unsupported encrypted state version: %d", header.Version)
+	}
+
+	keyProvider := e.KeyProvider
+	if !strings.EqualFold(header.Provider, e.Provider) {
+		var err error
+		keyProvider, err = newKeyProvider(header.Provider, nil)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Error configuring the key provider %s the state was encrypted with: %s",
+				header.Provider, err)
+		}
+	}
+
+	key, err := keyProvider.UnwrapKey(header.EncryptedKey, header.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("Error unwrapping the state encryption key with %s: %s", header.Provider, err)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(env.Data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted state is truncated")
+	}
+
+	nonce, ciphertext := env.Data[:gcm.NonceSize()], env.Data[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error decrypting the state: %s", err)
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,167 @@
+package encryption
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+// testKeyProvider is a KeyProvider "wrapping" the keys by prefixing them
+// with its key ID.
+type testKeyProvider struct {
+	keyID string
+}
+
+func (p *testKeyProvider) WrapKey(key []byte) ([]byte, string, error) {
+	return append([]byte(p.keyID+":"), key...), p.keyID, nil
+}
+
+func (p *testKeyProvider) UnwrapKey(wrapped []byte, keyID string) ([]byte, error) {
+	prefix := []byte(keyID + ":")
+	if !bytes.HasPrefix(wrapped, prefix) {
+		return nil, fmt.Errorf("key not wrapped with %q", keyID)
+	}
+	return wrapped[len(prefix):], nil
+}
+
+func TestEncryption(t *testing.T) {
+	enc := &Encryption{
+		Provider:    "test",
+		KeyProvider: &testKeyProvider{keyID: "foo"},
+	}
+
+	plaintext := []byte(`{"version": 3, "serial": 1}`)
+	data, err := enc.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !IsEncrypted(data) {
+		t.Fatalf("should be encrypted: %s", data)
+	}
+	if IsEncrypted(plaintext) {
+		t.Fatal("plaintext shouldn't be encrypted")
+	}
+	if bytes.Contains(data, []byte("serial")) {
+		t.Fatalf("state should be encrypted: %s", data)
+	}
+
+	// A new data key is used for every write
+	other, err := enc.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if bytes.Equal(data, other) {
+		t.Fatal("encrypted states should differ")
+	}
+
+	actual, err := enc.Decrypt(data)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, plaintext) {
+		t.Fatalf("bad: %s", actual)
+	}
+
+	// The key the state was encrypted with is used to decrypt it, so the
+	// configured key can be rotated.
+	enc.KeyProvider = &testKeyProvider{keyID: "bar"}
+	actual, err = enc.Decrypt(data)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, plaintext) {
+		t.Fatalf("bad: %s", actual)
+	}
+}
+
+func TestEncryption_tampered(t *testing.T) {
+	enc := &Encryption{
+		Provider:    "test",
+		KeyProvider: &testKeyProvider{keyID: "foo"},
+	}
+
+	data, err := enc.Encrypt([]byte("state"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Change a character of the encrypted data
+	i := bytes.Index(data, []byte(`"data": "`)) + len(`"data": "`)
+	if data[i] == 'A' {
+		data[i] = 'B'
+	} else {
+		data[i] = 'A'
+	}
+
+	if _, err := enc.Decrypt(data); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestEncryption_otherProvider(t *testing.T) {
+	defer os.Setenv("TF_STATE_PASSPHRASE", os.Getenv("TF_STATE_PASSPHRASE"))
+	os.Setenv("TF_STATE_PASSPHRASE", "correct horse battery staple")
+
+	passphrase, err := New("passphrase", nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	data, err := passphrase.Encrypt([]byte("state"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// States encrypted with another provider are decrypted with that
+	// provider, configured by the environment.
+	enc := &Encryption{
+		Provider:    "test",
+		KeyProvider: &testKeyProvider{keyID: "foo"},
+	}
+	actual, err := enc.Decrypt(data)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if string(actual) != "state" {
+		t.Fatalf("bad: %s", actual)
+	}
+}
+
+func TestNew_unknown(t *testing.T) {
+	_, err := New("unknown", nil)
+	if err == nil || !strings.Contains(err.Error(), "unknown") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	defer os.Setenv(ProviderEnvVar, os.Getenv(ProviderEnvVar))
+	defer os.Setenv("TF_STATE_PASSPHRASE", os.Getenv("TF_STATE_PASSPHRASE"))
+
+	os.Setenv(ProviderEnvVar, "")
+	enc, err := FromEnv()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if enc != nil {
+		t.Fatalf("bad: %#v", enc)
+	}
+
+	os.Setenv(ProviderEnvVar, "passphrase")
+	os.Setenv("TF_STATE_PASSPHRASE", "")
+	if _, err := FromEnv(); err == nil {
+		t.Fatal("should error without a passphrase")
+	}
+
+	os.Setenv("TF_STATE_PASSPHRASE", "correct horse battery staple")
+	enc, err = FromEnv()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if enc.Provider != "passphrase" {
+		t.Fatalf("bad: %s", enc.Provider)
+	}
+}
@@ -0,0 +1,130 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/pathorcontents"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const gcpKMSEndpoint = "https://cloudkms.googleapis.com/v1/"
+
+func gcpKMSFactory(conf map[string]string) (KeyProvider, error) {
+	scopes := []string{"https://www.googleapis.com/auth/cloud-platform"}
+
+	key, ok := conf["key"]
+	if !ok {
+		key = os.Getenv("TF_STATE_GCP_KMS_KEY")
+	}
+
+	credentials, ok := conf["credentials"]
+	if !ok {
+		credentials = os.Getenv("GOOGLE_CREDENTIALS")
+	}
+
+	var client *http.Client
+	if credentials != "" {
+		contents, _, err := pathorcontents.Read(credentials)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading credentials: %s", err)
+		}
+
+		jwtConfig, err := google.JWTConfigFromJSON([]byte(contents), scopes...)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing credentials: %s", err)
+		}
+
+		client = jwtConfig.Client(oauth2.NoContext)
+	} else {
+		var err error
+		client, err = google.DefaultClient(oauth2.NoContext, scopes...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &gcpKMSProvider{
+		key:      key,
+		endpoint: gcpKMSEndpoint,
+		client:   client,
+	}, nil
+}
+
+// gcpKMSProvider wraps the data keys with a crypto key of Google Cloud KMS,
+// identified by its resource name:
+//
+//	projects/PROJECT/locations/LOCATION/keyRings/RING/cryptoKeys/KEY
+//
+// Cloud KMS decrypts the keys with the version of the crypto key they were
+// encrypted with, so the versions of the key can be rotated.
+type gcpKMSProvider struct {
+	key      string
+	endpoint string
+	client   *http.Client
+}
+
+func (p *gcpKMSProvider) WrapKey(key []byte) ([]byte, string, error) {
+	if p.key == "" {
+		return nil, "", fmt.Errorf(
+			"missing 'key' configuration or TF_STATE_GCP_KMS_KEY environment variable")
+	}
+
+	var resp struct {
+		Ciphertext []byte `json:"ciphertext"`
+	}
+	req := map[string][]byte{"plaintext": key}
+	if err := p.call(p.key+":encrypt", req, &resp); err != nil {
+		return nil, "", err
+	}
+
+	return resp.Ciphertext, p.key, nil
+}
+
+func (p *gcpKMSProvider) UnwrapKey(wrapped []byte, keyID string) ([]byte, error) {
+	if keyID == "" {
+		keyID = p.key
+	}
+
+	var resp struct {
+		Plaintext []byte `json:"plaintext"`
+	}
+	req := map[string][]byte{"ciphertext": wrapped}
+	if err := p.call(keyID+":decrypt", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Plaintext, nil
+}
+
+// call calls the Cloud KMS method with the given path.
+func (p *gcpKMSProvider) call(path string, req, resp interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	httpResp, err := p.client.Post(
+		p.endpoint+strings.TrimPrefix(path, "/"), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	data, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP error: %d: %s", httpResp.StatusCode, data)
+	}
+
+	return json.Unmarshal(data, resp)
+}
@@ -0,0 +1,68 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGCPKMSProvider(t *testing.T) {
+	const keyName = "projects/p/locations/global/keyRings/r/cryptoKeys/k"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string][]byte
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		var resp interface{}
+		switch r.URL.Path {
+		case "/" + keyName + ":encrypt":
+			resp = map[string]interface{}{
+				"name":       keyName + "/cryptoKeyVersions/1",
+				"ciphertext": append([]byte("v1:"), req["plaintext"]...),
+			}
+		case "/" + keyName + ":decrypt":
+			resp = map[string]interface{}{
+				"plaintext": bytes.TrimPrefix(req["ciphertext"], []byte("v1:")),
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	p := &gcpKMSProvider{
+		key:      keyName,
+		endpoint: srv.URL + "/",
+		client:   http.DefaultClient,
+	}
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, keyID, err := p.WrapKey(key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if keyID != keyName {
+		t.Fatalf("bad: %s", keyID)
+	}
+
+	actual, err := p.UnwrapKey(wrapped, keyID)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, key) {
+		t.Fatalf("bad: %s", actual)
+	}
+
+	// Errors of Cloud KMS are reported
+	if _, err := p.UnwrapKey(wrapped, "projects/p/other"); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("bad: %s", err)
+	}
+}
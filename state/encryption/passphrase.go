@@ -0,0 +1,140 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// passphraseMinLength is the minimum length of the passphrases.
+	passphraseMinLength = 16
+
+	// passphraseIterations is the number of PBKDF2 iterations deriving the
+	// key encryption key from the passphrase.
+	passphraseIterations = 100000
+
+	passphraseSaltSize = 16
+)
+
+func passphraseFactory(conf map[string]string) (KeyProvider, error) {
+	passphrase, ok := conf["passphrase"]
+	if !ok {
+		passphrase = os.Getenv("TF_STATE_PASSPHRASE")
+	}
+	if len(passphrase) < passphraseMinLength {
+		return nil, fmt.Errorf(
+			"the 'passphrase' configuration or TF_STATE_PASSPHRASE environment "+
+				"variable must be at least %d characters long", passphraseMinLength)
+	}
+
+	oldPassphrase, ok := conf["old_passphrase"]
+	if !ok {
+		oldPassphrase = os.Getenv("TF_STATE_OLD_PASSPHRASE")
+	}
+
+	return &passphraseProvider{
+		passphrase:    passphrase,
+		oldPassphrase: oldPassphrase,
+	}, nil
+}
+
+// passphraseProvider derives the key encryption key from a passphrase. The
+// previous passphrase can be given while rotating the passphrase, to read
+// the states that weren't written again yet.
+type passphraseProvider struct {
+	passphrase    string
+	oldPassphrase string
+}
+
+// WrapKey returns the salt of the key encryption key followed by the nonce
+// and the encrypted data key.
+func (p *passphraseProvider) WrapKey(key []byte) ([]byte, string, error) {
+	salt := make([]byte, passphraseSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, "", err
+	}
+
+	gcm, err := newGCM(pbkdf2([]byte(p.passphrase), salt, passphraseIterations, 32))
+	if err != nil {
+		return nil, "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, "", err
+	}
+
+	wrapped := append(salt, nonce...)
+	return gcm.Seal(wrapped, nonce, key, nil), "", nil
+}
+
+func (p *passphraseProvider) UnwrapKey(wrapped []byte, keyID string) ([]byte, error) {
+	key, err := unwrapPassphraseKey(p.passphrase, wrapped)
+	if err != nil && p.oldPassphrase != "" {
+		key, err = unwrapPassphraseKey(p.oldPassphrase, wrapped)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("wrong passphrase or corrupted key")
+	}
+
+	return key, nil
+}
+
+func unwrapPassphraseKey(passphrase string, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < passphraseSaltSize {
+		return nil, fmt.Errorf("wrapped key is truncated")
+	}
+	salt, wrapped := wrapped[:passphraseSaltSize], wrapped[passphraseSaltSize:]
+
+	gcm, err := newGCM(pbkdf2([]byte(passphrase), salt, passphraseIterations, 32))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is truncated")
+	}
+	nonce, ciphertext := wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():]
+
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// pbkdf2 derives a key from the password as described by RFC 2898, using
+// HMAC-SHA256.
+func pbkdf2(password, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(sha256.New, password)
+	hashLen := prf.Size()
+	blocks := (keyLen + hashLen - 1) / hashLen
+
+	var buf [4]byte
+	dk := make([]byte, 0, blocks*hashLen)
+	u := make([]byte, hashLen)
+	for block := 1; block <= blocks; block++ {
+		// U1 = PRF(password, salt || INT(block))
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(buf[:], uint32(block))
+		prf.Write(buf[:4])
+		dk = prf.Sum(dk)
+		t := dk[len(dk)-hashLen:]
+		copy(u, t)
+
+		// Un = PRF(password, Un-1), T = U1 ^ U2 ^ ... ^ Un
+		for n := 2; n <= iterations; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = u[:0]
+			u = prf.Sum(u)
+			for i := range u {
+				t[i] ^= u[i]
+			}
+		}
+	}
+
+	return dk[:keyLen]
+}
@@ -0,0 +1,80 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestPBKDF2(t *testing.T) {
+	// Test vectors for PBKDF2-HMAC-SHA256
+	cases := []struct {
+		Iterations int
+		Expected   string
+	}{
+		{1, "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b"},
+		{2, "ae4d0c95af6b46d32d0adff928f06dd02a303f8ef3c251dfd6e2d85a95474c43"},
+		{4096, "c5e478d59288c841aa530db6845c4c8d962893a001ce4e11a4963873aa98134a"},
+	}
+
+	for _, tc := range cases {
+		actual := hex.EncodeToString(pbkdf2([]byte("password"), []byte("salt"), tc.Iterations, 32))
+		if actual != tc.Expected {
+			t.Fatalf("%d: expected %s, got %s", tc.Iterations, tc.Expected, actual)
+		}
+	}
+}
+
+func TestPassphraseProvider(t *testing.T) {
+	if _, err := passphraseFactory(map[string]string{"passphrase": "short"}); err == nil {
+		t.Fatal("should error with a short passphrase")
+	}
+
+	p, err := passphraseFactory(map[string]string{
+		"passphrase": "correct horse battery staple",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, _, err := p.WrapKey(key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual, err := p.UnwrapKey(wrapped, "")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, key) {
+		t.Fatalf("bad: %x", actual)
+	}
+
+	// The passphrase was changed
+	p, err = passphraseFactory(map[string]string{
+		"passphrase": "another correct horse battery staple",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := p.UnwrapKey(wrapped, ""); err == nil {
+		t.Fatal("should error with the wrong passphrase")
+	}
+
+	// The previous passphrase is used while rotating it
+	p, err = passphraseFactory(map[string]string{
+		"passphrase":     "another correct horse battery staple",
+		"old_passphrase": "correct horse battery staple",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	actual, err = p.UnwrapKey(wrapped, "")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(actual, key) {
+		t.Fatalf("bad: %x", actual)
+	}
+}
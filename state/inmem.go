@@ -1,12 +1,18 @@
 package state
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/hashicorp/terraform/terraform"
 )
 
 // InmemState is an in-memory state storage.
 type InmemState struct {
 	state *terraform.State
+
+	mu       sync.Mutex
+	lockInfo *LockInfo
 }
 
 func (s *InmemState) State() *terraform.State {
@@ -26,3 +32,37 @@ func (s *InmemState) WriteState(state *terraform.State) error {
 func (s *InmemState) PersistState() error {
 	return nil
 }
+
+func (s *InmemState) Lock(info *LockInfo) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lockInfo != nil {
+		return "", &LockError{
+			Info: s.lockInfo,
+			Err:  fmt.Errorf("state locked"),
+		}
+	}
+
+	s.lockInfo = info
+	return info.ID, nil
+}
+
+func (s *InmemState) Unlock(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lockInfo == nil {
+		return fmt.Errorf("state not locked")
+	}
+
+	if id != s.lockInfo.ID {
+		return &LockError{
+			Info: s.lockInfo,
+			Err:  fmt.Errorf("invalid lock id %q", id),
+		}
+	}
+
+	s.lockInfo = nil
+	return nil
+}
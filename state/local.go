@@ -1,6 +1,8 @@
 package state
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -15,6 +17,13 @@ type LocalState struct {
 	Path    string
 	PathOut string
 
+	// stateFileOut is the open and locked state file while the state is
+	// locked, and all writes go through it. created records whether the
+	// file was created in order to lock it.
+	stateFileOut *os.File
+	created      bool
+	lockID       string
+
 	state     *terraform.State
 	readState *terraform.State
 	written   bool
@@ -37,13 +46,14 @@ func (s *LocalState) State() *terraform.State {
 func (s *LocalState) WriteState(state *terraform.State) error {
 	s.state = state
 
-	path := s.PathOut
-	if path == "" {
-		path = s.Path
-	}
+	path := s.path()
 
 	// If we don't have any state, we actually delete the file if it exists
 	if state == nil {
+		if s.stateFileOut != nil {
+			return s.stateFileOut.Truncate(0)
+		}
+
 		err := os.Remove(path)
 		if err != nil && os.IsNotExist(err) {
 			return nil
@@ -52,6 +62,28 @@ func (s *LocalState) WriteState(state *terraform.State) error {
 		return err
 	}
 
+	s.state.IncrementSerialMaybe(s.readState)
+	s.readState = s.state
+
+	if s.stateFileOut != nil {
+		// The state is locked, so the locked file is rewritten in place.
+		if err := s.stateFileOut.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := s.stateFileOut.Seek(0, os.SEEK_SET); err != nil {
+			return err
+		}
+		if err := WriteState(s.state, s.stateFileOut); err != nil {
+			return err
+		}
+		if err := s.stateFileOut.Sync(); err != nil {
+			return err
+		}
+
+		s.written = true
+		return nil
+	}
+
 	// Create all the directories
 	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
 		return err
@@ -63,10 +95,7 @@ func (s *LocalState) WriteState(state *terraform.State) error {
 	}
 	defer f.Close()
 
-	s.state.IncrementSerialMaybe(s.readState)
-	s.readState = s.state
-
-	if err := terraform.WriteState(s.state, f); err != nil {
+	if err := WriteState(s.state, f); err != nil {
 		return err
 	}
 
@@ -90,26 +119,170 @@ func (s *LocalState) RefreshState() error {
 		path = s.PathOut
 	}
 
-	f, err := os.Open(path)
-	if err != nil {
-		// It is okay if the file doesn't exist, we treat that as a nil state
-		if !os.IsNotExist(err) {
+	var f *os.File
+	if s.stateFileOut != nil && path == s.path() {
+		// The state file is locked, so it is read through the locked file
+		if _, err := s.stateFileOut.Seek(0, os.SEEK_SET); err != nil {
 			return err
 		}
+		f = s.stateFileOut
+	} else {
+		var err error
+		f, err = os.Open(path)
+		if err != nil {
+			// It is okay if the file doesn't exist, we treat that as a nil state
+			if !os.IsNotExist(err) {
+				return err
+			}
+
+			f = nil
+		}
 
-		f = nil
+		if f != nil {
+			defer f.Close()
+		}
 	}
 
 	var state *terraform.State
 	if f != nil {
-		defer f.Close()
-		state, err = terraform.ReadState(f)
+		// An empty file is created to lock a state that doesn't exist yet,
+		// which is treated as a nil state as well.
+		fi, err := f.Stat()
 		if err != nil {
 			return err
 		}
+
+		if fi.Size() > 0 {
+			state, err = ReadState(f)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	s.state = state
 	s.readState = state
 	return nil
 }
+
+// Lock implements Locker using an exclusive OS-level lock on the state
+// file. The lock information is written to a separate file next to the
+// state, so that it can be reported by other processes.
+func (s *LocalState) Lock(info *LockInfo) (string, error) {
+	if s.stateFileOut != nil {
+		return "", &LockError{
+			Info: s.lockInfo(),
+			Err:  fmt.Errorf("state file %q already locked", s.path()),
+		}
+	}
+
+	path := s.path()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	_, err := os.Stat(path)
+	created := os.IsNotExist(err)
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return "", err
+	}
+
+	if err := lockFile(f); err != nil {
+		f.Close()
+		if created {
+			os.Remove(path)
+		}
+
+		return "", &LockError{
+			Info: s.lockInfo(),
+			Err:  fmt.Errorf("state file %q locked: %s", path, err),
+		}
+	}
+
+	s.stateFileOut = f
+	s.created = created
+
+	info.Path = path
+	if err := ioutil.WriteFile(s.lockInfoPath(), info.Marshal(), 0600); err != nil {
+		s.unlock()
+		return "", fmt.Errorf("could not write lock info for %q: %s", path, err)
+	}
+
+	s.lockID = info.ID
+	return s.lockID, nil
+}
+
+// Unlock implements Locker.
+func (s *LocalState) Unlock(id string) error {
+	if s.stateFileOut == nil {
+		return fmt.Errorf("state file %q is not locked", s.path())
+	}
+
+	if id != s.lockID {
+		return &LockError{
+			Info: s.lockInfo(),
+			Err:  fmt.Errorf("invalid lock id %q for state file %q", id, s.path()),
+		}
+	}
+
+	os.Remove(s.lockInfoPath())
+	return s.unlock()
+}
+
+// unlock releases the lock on the state file and closes it. A state file
+// that was only created to be locked is removed again.
+func (s *LocalState) unlock() error {
+	f := s.stateFileOut
+	s.stateFileOut = nil
+	s.lockID = ""
+
+	var size int64
+	if fi, err := f.Stat(); err == nil {
+		size = fi.Size()
+	}
+
+	err := unlockFile(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if s.created && size == 0 {
+		os.Remove(f.Name())
+	}
+
+	return err
+}
+
+// path returns the path of the state file that is written, which is also
+// the file that is locked.
+func (s *LocalState) path() string {
+	if s.PathOut != "" {
+		return s.PathOut
+	}
+
+	return s.Path
+}
+
+// lockInfoPath returns the path to the lock info file, which is a hidden
+// file next to the state file.
+func (s *LocalState) lockInfoPath() string {
+	dir, file := filepath.Split(s.path())
+	return filepath.Join(dir, fmt.Sprintf(".%s.lock.info", file))
+}
+
+// lockInfo returns the information of the current lock, if it can be read.
+func (s *LocalState) lockInfo() *LockInfo {
+	data, err := ioutil.ReadFile(s.lockInfoPath())
+	if err != nil {
+		return nil
+	}
+
+	info, err := UnmarshalLockInfo(data)
+	if err != nil {
+		return nil
+	}
+
+	return info
+}
@@ -0,0 +1,56 @@
+// +build windows
+
+package state
+
+import (
+	"math"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	modkernel32      = syscall.NewLazyDLL("kernel32.dll")
+	procLockFileEx   = modkernel32.NewProc("LockFileEx")
+	procUnlockFileEx = modkernel32.NewProc("UnlockFileEx")
+)
+
+const (
+	// dwFlags defined for LockFileEx
+	// https://msdn.microsoft.com/en-us/library/windows/desktop/aa365203(v=vs.85).aspx
+	_LOCKFILE_FAIL_IMMEDIATELY = 1
+	_LOCKFILE_EXCLUSIVE_LOCK   = 2
+)
+
+// lockFile takes an exclusive, non-blocking lock on the whole given file.
+func lockFile(f *os.File) error {
+	ol := new(syscall.Overlapped)
+	r1, _, err := procLockFileEx.Call(
+		f.Fd(),
+		uintptr(_LOCKFILE_EXCLUSIVE_LOCK|_LOCKFILE_FAIL_IMMEDIATELY),
+		0,
+		uintptr(math.MaxUint32),
+		uintptr(math.MaxUint32),
+		uintptr(unsafe.Pointer(ol)),
+	)
+	if r1 == 0 {
+		return err
+	}
+	return nil
+}
+
+// unlockFile releases a lock taken with lockFile.
+func unlockFile(f *os.File) error {
+	ol := new(syscall.Overlapped)
+	r1, _, err := procUnlockFileEx.Call(
+		f.Fd(),
+		0,
+		uintptr(math.MaxUint32),
+		uintptr(math.MaxUint32),
+		uintptr(unsafe.Pointer(ol)),
+	)
+	if r1 == 0 {
+		return err
+	}
+	return nil
+}
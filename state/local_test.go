@@ -3,6 +3,7 @@ package state
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform/terraform"
@@ -45,6 +46,91 @@ func TestLocalState_impl(t *testing.T) {
 	var _ StateWriter = new(LocalState)
 	var _ StatePersister = new(LocalState)
 	var _ StateRefresher = new(LocalState)
+	var _ Locker = new(LocalState)
+}
+
+func TestLocalState_lock(t *testing.T) {
+	ls := testLocalState(t)
+	defer os.Remove(ls.Path)
+
+	TestLocker(t, ls, &LocalState{Path: ls.Path})
+}
+
+func TestLocalState_lockWrite(t *testing.T) {
+	ls := testLocalState(t)
+	defer os.Remove(ls.Path)
+
+	info := NewLockInfo()
+	info.Operation = "test"
+	id, err := ls.Lock(info)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The lock info must be readable by others while locked
+	other := &LocalState{Path: ls.Path}
+	if got := other.lockInfo(); got == nil || got.ID != id {
+		t.Fatalf("bad lock info: %#v", got)
+	}
+
+	// Writes go through the locked file
+	current := TestStateInitial()
+	current.Modules[0].Outputs["foo"].Value = "baz"
+	if err := ls.WriteState(current); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := ls.RefreshState(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !ls.State().Equal(current) {
+		t.Fatalf("bad: %#v", ls.State())
+	}
+
+	if err := ls.Unlock(id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := os.Stat(ls.lockInfoPath()); !os.IsNotExist(err) {
+		t.Fatalf("lock info not removed: %v", err)
+	}
+
+	if err := other.RefreshState(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !other.State().Equal(current) {
+		t.Fatalf("bad: %#v", other.State())
+	}
+}
+
+func TestLocalState_lockNonExist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "terraform.tfstate")
+	ls := &LocalState{Path: path}
+	id, err := ls.Lock(NewLockInfo())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The empty file created for the lock is a nil state
+	if err := ls.RefreshState(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if state := ls.State(); state != nil {
+		t.Fatalf("bad: %#v", state)
+	}
+
+	if err := ls.Unlock(id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("state file should be removed: %v", err)
+	}
 }
 
 func testLocalState(t *testing.T) *LocalState {
@@ -0,0 +1,203 @@
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/user"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Locker is the interface for state implementations that can be locked to
+// prevent concurrent modifications of the state.
+//
+// Lock attempts to lock the state with the given information, returning the
+// ID of the lock on success. If the state is already locked, a *LockError
+// holding the information of the existing lock should be returned. Unlock
+// releases the lock with the given ID.
+type Locker interface {
+	Lock(info *LockInfo) (string, error)
+	Unlock(id string) error
+}
+
+// LockInfo stores lock metadata.
+//
+// Only Operation and Info are required to be set by the caller of Lock.
+type LockInfo struct {
+	// Unique ID for the lock. NewLockInfo provides a random ID, but this may
+	// be overridden by the lock implementation. The final value of ID will be
+	// returned by the call to Lock.
+	ID string
+
+	// Terraform operation, provided by the caller.
+	Operation string
+
+	// Extra information to store with the lock, provided by the caller.
+	Info string
+
+	// user@hostname when available
+	Who string
+
+	// Terraform version
+	Version string
+
+	// Time that the lock was taken.
+	Created time.Time
+
+	// Path to the state file when applicable. Set by the Lock implementation.
+	Path string
+}
+
+// NewLockInfo creates a LockInfo object and populates many of its fields
+// with sensible default values.
+func NewLockInfo() *LockInfo {
+	// This guarantees a unique lock ID across processes.
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		panic(err)
+	}
+
+	info := &LockInfo{
+		ID:      id,
+		Who:     lockWho(),
+		Version: terraform.VersionString(),
+		Created: time.Now().UTC(),
+	}
+
+	return info
+}
+
+// Err returns the lock info formatted in an error.
+func (l *LockInfo) Err() error {
+	return fmt.Errorf("%s", l.String())
+}
+
+// Marshal returns a string JSON representation of the LockInfo.
+func (l *LockInfo) Marshal() []byte {
+	js, err := json.Marshal(l)
+	if err != nil {
+		panic(err)
+	}
+	return js
+}
+
+// String returns a multi-line string representation of LockInfo.
+func (l *LockInfo) String() string {
+	tmpl := `Lock Info:
+  ID:        {{.ID}}
+  Path:      {{.Path}}
+  Operation: {{.Operation}}
+  Who:       {{.Who}}
+  Version:   {{.Version}}
+  Created:   {{.Created}}
+  Info:      {{.Info}}
+`
+
+	t := template.Must(template.New("LockInfo").Parse(tmpl))
+	var out bytes.Buffer
+	if err := t.Execute(&out, l); err != nil {
+		panic(err)
+	}
+	return out.String()
+}
+
+// UnmarshalLockInfo decodes lock information written by LockInfo.Marshal.
+func UnmarshalLockInfo(data []byte) (*LockInfo, error) {
+	info := &LockInfo{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// LockError is returned by Lock when the state is already locked, and by
+// Unlock when the given ID doesn't match the lock.
+type LockError struct {
+	Info *LockInfo
+	Err  error
+}
+
+func (e *LockError) Error() string {
+	var out []string
+	if e.Err != nil {
+		out = append(out, e.Err.Error())
+	}
+
+	if e.Info != nil {
+		out = append(out, e.Info.String())
+	}
+	return strings.Join(out, "\n")
+}
+
+// LockWithTimeout locks the state if it implements Locker, retrying until
+// the given timeout has passed. A timeout of zero makes a single attempt.
+// If the state doesn't support locking, an empty ID is returned.
+func LockWithTimeout(s State, info *LockInfo, timeout time.Duration) (string, error) {
+	l, ok := s.(Locker)
+	if !ok {
+		return "", nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	delay := time.Second
+	for {
+		id, err := l.Lock(info)
+		if err == nil {
+			return id, nil
+		}
+
+		// Only retry if the state is locked by someone else, any other
+		// error is returned right away.
+		if _, ok := err.(*LockError); !ok {
+			return "", err
+		}
+
+		remaining := deadline.Sub(time.Now())
+		if remaining <= 0 {
+			return "", err
+		}
+
+		log.Printf("[DEBUG] State is locked, retrying: %s", err)
+		if delay > remaining {
+			delay = remaining
+		}
+		time.Sleep(delay)
+
+		// Back off up to 16 seconds between attempts
+		if delay < 16*time.Second {
+			delay *= 2
+		}
+	}
+}
+
+// Unlock unlocks the state if it implements Locker.
+func Unlock(s State, id string) error {
+	l, ok := s.(Locker)
+	if !ok {
+		return nil
+	}
+
+	return l.Unlock(id)
+}
+
+// lockWho returns the user@hostname of the current process for LockInfo.
+func lockWho() string {
+	name := "unknown"
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		return name
+	}
+
+	return fmt.Sprintf("%s@%s", name, host)
+}
@@ -0,0 +1,89 @@
+package state
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLockWithTimeout(t *testing.T) {
+	s := &InmemState{state: TestStateInitial()}
+
+	id, err := LockWithTimeout(s, NewLockInfo(), 0)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// A second attempt without a timeout fails right away
+	_, err = LockWithTimeout(s, NewLockInfo(), 0)
+	if _, ok := err.(*LockError); !ok {
+		t.Fatalf("expected a *LockError, got: %v", err)
+	}
+
+	// With a timeout the lock is retried until it's released
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		Unlock(s, id)
+	}()
+
+	id, err = LockWithTimeout(s, NewLockInfo(), 5*time.Second)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := Unlock(s, id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestLockWithTimeout_notLocker(t *testing.T) {
+	inmem := &InmemState{state: TestStateInitial()}
+	s := &nonLockingState{inmem, inmem, inmem, inmem}
+
+	id, err := LockWithTimeout(s, NewLockInfo(), 0)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if id != "" {
+		t.Fatalf("bad: %q", id)
+	}
+
+	if err := Unlock(s, id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestLockError(t *testing.T) {
+	info := NewLockInfo()
+	info.Operation = "test"
+
+	err := &LockError{Info: info, Err: errors.New("locked")}
+	if !strings.Contains(err.Error(), info.ID) {
+		t.Fatalf("lock ID missing from error: %s", err)
+	}
+}
+
+func TestUnmarshalLockInfo(t *testing.T) {
+	info := NewLockInfo()
+	info.Operation = "test"
+	info.Info = "info"
+
+	actual, err := UnmarshalLockInfo(info.Marshal())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if actual.ID != info.ID || actual.Operation != info.Operation ||
+		actual.Info != info.Info || !actual.Created.Equal(info.Created) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+// nonLockingState hides the Locker implementation of the InmemState.
+type nonLockingState struct {
+	StateReader
+	StateWriter
+	StateRefresher
+	StatePersister
+}
@@ -60,7 +60,7 @@ func artifactoryFactory(conf map[string]string) (Client, error) {
 		password:     password,
 		url:          url,
 		repo:         repo,
-		subpath:      subpath,
+		subpath:      workspaceKey(conf, subpath),
 	}, nil
 
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/go-rootcerts"
+	"github.com/hashicorp/terraform/state"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -71,6 +72,12 @@ func atlasFactory(conf map[string]string) (Client, error) {
 	client.User = parts[0]
 	client.Name = parts[1]
 
+	// Atlas names can't contain colons, so the workspace is appended to the
+	// environment name with a dash.
+	if workspace := workspaceName(conf); workspace != "" {
+		client.Name = fmt.Sprintf("%s-%s", client.Name, workspace)
+	}
+
 	return &client, nil
 }
 
@@ -314,7 +321,7 @@ func (c *AtlasClient) http() (*retryablehttp.Client, error) {
 //
 // In other words, in this situation Terraform can override Atlas's detected
 // conflict by asserting that the state it is pushing is indeed correct.
-func (c *AtlasClient) handleConflict(msg string, state []byte) error {
+func (c *AtlasClient) handleConflict(msg string, data []byte) error {
 	log.Printf("[DEBUG] Handling Atlas conflict response: %s", msg)
 
 	if c.conflictHandlingAttempted {
@@ -328,12 +335,12 @@ func (c *AtlasClient) handleConflict(msg string, state []byte) error {
 			return conflictHandlingError(err)
 		}
 
-		currentState, err := terraform.ReadState(bytes.NewReader(payload.Data))
+		currentState, err := state.ReadState(bytes.NewReader(payload.Data))
 		if err != nil {
 			return conflictHandlingError(err)
 		}
 
-		proposedState, err := terraform.ReadState(bytes.NewReader(state))
+		proposedState, err := state.ReadState(bytes.NewReader(data))
 		if err != nil {
 			return conflictHandlingError(err)
 		}
@@ -342,7 +349,7 @@ func (c *AtlasClient) handleConflict(msg string, state []byte) error {
 			log.Printf("[DEBUG] States are equivalent, incrementing serial and retrying.")
 			proposedState.Serial++
 			var buf bytes.Buffer
-			if err := terraform.WriteState(proposedState, &buf); err != nil {
+			if err := state.WriteState(proposedState, &buf); err != nil {
 				return conflictHandlingError(err)
 
 			}
@@ -2,13 +2,21 @@ package remote
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"log"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/storage"
 	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform/state"
 	riviera "github.com/jen20/riviera/azure"
 )
 
@@ -26,6 +34,34 @@ func azureFactory(conf map[string]string) (Client, error) {
 		return nil, fmt.Errorf("missing 'key' configuration")
 	}
 
+	snapshot := false
+	if raw, ok := conf["snapshot"]; ok {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"'snapshot' field couldn't be parsed as bool: %s", err)
+		}
+
+		snapshot = v
+	}
+
+	keyName = workspaceKey(conf, keyName)
+
+	client := &AzureClient{
+		containerName: containerName,
+		keyName:       keyName,
+		snapshot:      snapshot,
+	}
+
+	// A SAS token is used as is, so neither the access key nor the ARM
+	// credentials are needed.
+	if sasToken, ok := confOrEnv(conf, "sas_token", "ARM_SAS_TOKEN"); ok {
+		client.sasToken = strings.TrimPrefix(sasToken, "?")
+		client.blobURL = fmt.Sprintf("https://%s.blob.%s/%s/%s",
+			storageAccountName, mainStorage.DefaultBaseURL, containerName, keyName)
+		return client, nil
+	}
+
 	accessKey, ok := confOrEnv(conf, "access_key", "ARM_ACCESS_KEY")
 	if !ok {
 		resourceGroupName, ok := conf["resource_group_name"]
@@ -46,12 +82,9 @@ func azureFactory(conf map[string]string) (Client, error) {
 	}
 
 	blobClient := storageClient.GetBlobService()
+	client.blobClient = &blobClient
 
-	return &AzureClient{
-		blobClient:    &blobClient,
-		containerName: containerName,
-		keyName:       keyName,
-	}, nil
+	return client, nil
 }
 
 func getStorageAccountAccessKey(conf map[string]string, resourceGroupName, storageAccountName string) (string, error) {
@@ -126,26 +159,40 @@ func confOrEnv(conf map[string]string, confKey, envVar string) (string, bool) {
 	return value, value != ""
 }
 
+// AzureClient stores the state in a block blob. All requests are authorized
+// with a Shared Access Signature, either the configured SAS token or a
+// short-lived one signed with the storage account access key, since the
+// vendored storage client supports neither leases nor snapshots.
 type AzureClient struct {
 	blobClient    *mainStorage.BlobStorageClient
 	containerName string
 	keyName       string
+	leaseID       string
+
+	// blobURL and sasToken are set instead of blobClient when a SAS token
+	// is configured.
+	blobURL  string
+	sasToken string
+
+	// snapshot enables taking a snapshot of the blob before each write.
+	snapshot bool
 }
 
 func (c *AzureClient) Get() (*Payload, error) {
-	blob, err := c.blobClient.GetBlob(c.containerName, c.keyName)
+	resp, err := c.blobRequest("GET", "", nil, nil)
 	if err != nil {
-		if storErr, ok := err.(mainStorage.AzureStorageServiceError); ok {
-			if storErr.Code == "BlobNotFound" {
-				return nil, nil
-			}
-		}
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	defer blob.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, azureResponseError(resp)
+	}
 
-	data, err := ioutil.ReadAll(blob)
+	data, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -163,17 +210,309 @@ func (c *AzureClient) Get() (*Payload, error) {
 }
 
 func (c *AzureClient) Put(data []byte) error {
-	return c.blobClient.CreateBlockBlobFromReader(
-		c.containerName,
-		c.keyName,
-		uint64(len(data)),
-		bytes.NewReader(data),
-		map[string]string{
-			"Content-Type": "application/json",
-		},
-	)
+	if c.snapshot {
+		if err := c.snapshotBlob(); err != nil {
+			return fmt.Errorf("Error creating a snapshot of %s/%s: %s", c.containerName, c.keyName, err)
+		}
+	}
+
+	headers := map[string]string{
+		"Content-Type":   "application/json",
+		"x-ms-blob-type": "BlockBlob",
+	}
+
+	// Writing a leased blob requires the lease, and since the metadata is
+	// replaced with the blob it has to keep the lock info as well.
+	if c.leaseID != "" {
+		headers["x-ms-lease-id"] = c.leaseID
+
+		metadata, err := c.getMetadata()
+		if err != nil {
+			return err
+		}
+		if v, ok := metadata[azureLockInfoMetadataKey]; ok {
+			headers["x-ms-meta-"+azureLockInfoMetadataKey] = v
+		}
+	}
+
+	resp, err := c.blobRequest("PUT", "", headers, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return azureResponseError(resp)
+	}
+
+	return nil
 }
 
 func (c *AzureClient) Delete() error {
-	return c.blobClient.DeleteBlob(c.containerName, c.keyName, nil)
+	var headers map[string]string
+	if c.leaseID != "" {
+		headers = map[string]string{"x-ms-lease-id": c.leaseID}
+	}
+
+	resp, err := c.blobRequest("DELETE", "", headers, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNotFound {
+		return azureResponseError(resp)
+	}
+
+	return nil
+}
+
+// snapshotBlob creates a read-only snapshot of the current state blob, so
+// that previous versions of the state can be recovered. Nothing is done if
+// the blob doesn't exist or is empty.
+func (c *AzureClient) snapshotBlob() error {
+	resp, err := c.blobRequest("HEAD", "", nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.ContentLength == 0 {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return azureResponseError(resp)
+	}
+
+	resp, err = c.blobRequest("PUT", "snapshot", nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return azureResponseError(resp)
+	}
+
+	log.Printf("[DEBUG] Created snapshot %s of %s/%s",
+		resp.Header.Get("x-ms-snapshot"), c.containerName, c.keyName)
+	return nil
+}
+
+// azureLockInfoMetadataKey is the blob metadata key holding the lock info
+// while the state blob is leased.
+const azureLockInfoMetadataKey = "terraformlockinfo"
+
+// Lock acquires an infinite lease on the state blob, using the lock ID as
+// the lease ID. The blob is created first if it doesn't exist, since only
+// existing blobs can be leased.
+func (c *AzureClient) Lock(info *state.LockInfo) (string, error) {
+	info.Path = fmt.Sprintf("%s/%s", c.containerName, c.keyName)
+
+	resp, err := c.blobRequest("HEAD", "", nil, nil)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		resp, err := c.blobRequest("PUT", "", map[string]string{"x-ms-blob-type": "BlockBlob"}, nil)
+		if err != nil {
+			return "", err
+		}
+		resp.Body.Close()
+
+		// Another client may have created and leased the blob in the
+		// meantime, which is reported when acquiring the lease below.
+		if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusPreconditionFailed {
+			return "", azureResponseError(resp)
+		}
+	default:
+		return "", azureResponseError(resp)
+	}
+
+	resp, err = c.leaseBlob(map[string]string{
+		"x-ms-lease-action":      "acquire",
+		"x-ms-lease-duration":    "-1",
+		"x-ms-proposed-lease-id": info.ID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error acquiring the lease on %s: %s", info.Path, err)
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		lockErr := &state.LockError{
+			Err: fmt.Errorf("state blob %q already leased", info.Path),
+		}
+		lockErr.Info, _ = c.getLockInfo()
+		return "", lockErr
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf(
+			"Error acquiring the lease on %s: unexpected status %s", info.Path, resp.Status)
+	}
+
+	c.leaseID = resp.Header.Get("x-ms-lease-id")
+
+	metadata := map[string]string{
+		azureLockInfoMetadataKey: base64.StdEncoding.EncodeToString(info.Marshal()),
+	}
+	if err := c.setMetadata(metadata, c.leaseID); err != nil {
+		c.Unlock(c.leaseID)
+		return "", fmt.Errorf("Error writing the lock info to %s: %s", info.Path, err)
+	}
+
+	return c.leaseID, nil
+}
+
+// Unlock clears the lock info and releases the lease with the given ID.
+func (c *AzureClient) Unlock(id string) error {
+	lockErr := &state.LockError{}
+	lockErr.Info, _ = c.getLockInfo()
+
+	if err := c.setMetadata(map[string]string{}, id); err != nil {
+		lockErr.Err = fmt.Errorf("lock id %q does not match the lease: %s", id, err)
+		return lockErr
+	}
+
+	resp, err := c.leaseBlob(map[string]string{
+		"x-ms-lease-action": "release",
+		"x-ms-lease-id":     id,
+	})
+	if err != nil {
+		lockErr.Err = err
+		return lockErr
+	}
+	if resp.StatusCode != http.StatusOK {
+		lockErr.Err = fmt.Errorf("Error releasing the lease: unexpected status %s", resp.Status)
+		return lockErr
+	}
+
+	c.leaseID = ""
+	return nil
+}
+
+func (c *AzureClient) getLockInfo() (*state.LockInfo, error) {
+	metadata, err := c.getMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, ok := metadata[azureLockInfoMetadataKey]
+	if !ok || raw == "" {
+		return nil, fmt.Errorf("no lock info found for %s/%s", c.containerName, c.keyName)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.UnmarshalLockInfo(data)
+}
+
+// getMetadata returns the metadata of the state blob, keyed by the lower
+// case name without the x-ms-meta- prefix.
+func (c *AzureClient) getMetadata() (map[string]string, error) {
+	resp, err := c.blobRequest("GET", "metadata", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, azureResponseError(resp)
+	}
+
+	metadata := make(map[string]string)
+	for k := range resp.Header {
+		k = strings.ToLower(k)
+		if strings.HasPrefix(k, "x-ms-meta-") {
+			metadata[strings.TrimPrefix(k, "x-ms-meta-")] = resp.Header.Get(k)
+		}
+	}
+
+	return metadata, nil
+}
+
+// setMetadata replaces the metadata of the state blob, which is leased with
+// the given lease ID.
+func (c *AzureClient) setMetadata(metadata map[string]string, leaseID string) error {
+	headers := map[string]string{"x-ms-lease-id": leaseID}
+	for k, v := range metadata {
+		headers["x-ms-meta-"+k] = v
+	}
+
+	resp, err := c.blobRequest("PUT", "metadata", headers, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return azureResponseError(resp)
+	}
+
+	return nil
+}
+
+// leaseBlob sends a Lease Blob request with the given headers.
+func (c *AzureClient) leaseBlob(headers map[string]string) (*http.Response, error) {
+	resp, err := c.blobRequest("PUT", "lease", headers, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return resp, nil
+}
+
+// blobURI returns the URI of the state blob, including the Shared Access
+// Signature authorizing the request.
+func (c *AzureClient) blobURI() (string, error) {
+	if c.sasToken != "" {
+		return c.blobURL + "?" + c.sasToken, nil
+	}
+
+	return c.blobClient.GetBlobSASURI(
+		c.containerName, c.keyName, time.Now().Add(10*time.Minute), "rwd")
+}
+
+// blobRequest sends a request for the state blob, or for the given
+// component of it such as its lease or metadata. The caller must close the
+// body of the returned response.
+func (c *AzureClient) blobRequest(method, comp string, headers map[string]string, body []byte) (*http.Response, error) {
+	uri, err := c.blobURI()
+	if err != nil {
+		return nil, err
+	}
+	if comp != "" {
+		uri += "&comp=" + comp
+	}
+
+	req, err := http.NewRequest(method, uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+
+	req.Header.Set("x-ms-version", mainStorage.DefaultAPIVersion)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	return cleanhttp.DefaultClient().Do(req)
+}
+
+// azureResponseError builds an error from an unexpected response of the
+// storage service, which describes the error in the body.
+func azureResponseError(resp *http.Response) error {
+	body, _ := ioutil.ReadAll(resp.Body)
+	if len(body) == 0 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return fmt.Errorf("unexpected status %s: %s", resp.Status, body)
 }
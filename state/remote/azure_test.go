@@ -2,18 +2,120 @@ package remote
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/state"
 	riviera "github.com/jen20/riviera/azure"
 	"github.com/jen20/riviera/storage"
 )
 
 func TestAzureClient_impl(t *testing.T) {
 	var _ Client = new(AzureClient)
+	var _ ClientLocker = new(AzureClient)
+}
+
+func TestAzureFactory_sasToken(t *testing.T) {
+	client, err := azureFactory(map[string]string{
+		"storage_account_name": "terraform123abc",
+		"container_name":       "terraform-state",
+		"key":                  "prod.terraform.tfstate",
+		"sas_token":            "?sv=2015-04-05&sr=c&sp=rwdl&sig=abc",
+		"snapshot":             "true",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	c := client.(*AzureClient)
+	uri, err := c.blobURI()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := "https://terraform123abc.blob.core.windows.net/terraform-state/prod.terraform.tfstate?sv=2015-04-05&sr=c&sp=rwdl&sig=abc"
+	if uri != expected {
+		t.Fatalf("bad: %s", uri)
+	}
+	if !c.snapshot {
+		t.Fatal("expected snapshots to be enabled")
+	}
+}
+
+func TestAzureClient_sasToken(t *testing.T) {
+	blob := newFakeAzureBlob(t)
+	ts := blob.Server()
+	defer ts.Close()
+
+	testClient(t, blob.Client(ts))
+}
+
+func TestAzureClient_sasTokenLocks(t *testing.T) {
+	blob := newFakeAzureBlob(t)
+	ts := blob.Server()
+	defer ts.Close()
+
+	a := blob.Client(ts)
+	b := blob.Client(ts)
+	state.TestLocker(t, a, b)
+
+	// The state can still be written while it's locked.
+	id, err := a.Lock(state.NewLockInfo())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := a.Put([]byte("{}")); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := b.Put([]byte("{}")); err == nil {
+		t.Fatal("expected error writing the state without the lease")
+	}
+
+	// The lock info has to survive the write.
+	info, err := b.getLockInfo()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if info.ID != id {
+		t.Fatalf("bad: %#v", info)
+	}
+
+	if err := a.Unlock(id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAzureClient_snapshot(t *testing.T) {
+	blob := newFakeAzureBlob(t)
+	ts := blob.Server()
+	defer ts.Close()
+
+	c := blob.Client(ts)
+	c.snapshot = true
+
+	for _, data := range []string{"one", "two", "three"} {
+		if err := c.Put([]byte(data)); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	// The blob didn't exist before the first write.
+	if len(blob.snapshots) != 2 {
+		t.Fatalf("bad: %q", blob.snapshots)
+	}
+	if string(blob.snapshots[0]) != "one" || string(blob.snapshots[1]) != "two" {
+		t.Fatalf("bad: %q", blob.snapshots)
+	}
+	if string(blob.data) != "three" {
+		t.Fatalf("bad: %q", blob.data)
+	}
 }
 
 func TestAzureClient(t *testing.T) {
@@ -153,3 +255,141 @@ func getRivieraClient(credentials *riviera.AzureResourceManagerCredentials) (*ri
 
 	return rivieraClient, nil
 }
+
+// fakeAzureBlob is a minimal Azure Blob service holding a single blob, which
+// only accepts requests authorized with a Shared Access Signature.
+type fakeAzureBlob struct {
+	t *testing.T
+
+	exists    bool
+	data      []byte
+	metadata  map[string]string
+	leaseID   string
+	snapshots [][]byte
+}
+
+func newFakeAzureBlob(t *testing.T) *fakeAzureBlob {
+	return &fakeAzureBlob{
+		t:        t,
+		metadata: make(map[string]string),
+	}
+}
+
+func (f *fakeAzureBlob) Server() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(f.handler))
+}
+
+// Client returns a client for the blob using a SAS token.
+func (f *fakeAzureBlob) Client(ts *httptest.Server) *AzureClient {
+	return &AzureClient{
+		containerName: "terraform",
+		keyName:       "test.tfstate",
+		blobURL:       ts.URL + "/terraform/test.tfstate",
+		sasToken:      "sv=2015-04-05&sr=b&sp=rwd&sig=abc",
+	}
+}
+
+func (f *fakeAzureBlob) handler(resp http.ResponseWriter, req *http.Request) {
+	if req.URL.Query().Get("sig") == "" {
+		http.Error(resp, "missing signature", http.StatusForbidden)
+		return
+	}
+	if req.Header.Get("x-ms-version") == "" {
+		http.Error(resp, "missing version", http.StatusBadRequest)
+		return
+	}
+
+	comp := req.URL.Query().Get("comp")
+	if !f.exists && !(req.Method == "PUT" && comp == "") {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Writes of a leased blob require its lease.
+	leaseID := req.Header.Get("x-ms-lease-id")
+	leaseMismatch := f.leaseID != "" && leaseID != f.leaseID
+
+	switch {
+	case req.Method == "HEAD" && comp == "":
+		resp.Header().Set("Content-Length", strconv.Itoa(len(f.data)))
+	case req.Method == "GET" && comp == "":
+		resp.Write(f.data)
+	case req.Method == "PUT" && comp == "":
+		if leaseMismatch {
+			resp.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			f.t.Fatalf("err: %s", err)
+		}
+		if req.Header.Get("x-ms-blob-type") != "BlockBlob" {
+			f.t.Fatalf("bad blob type: %q", req.Header.Get("x-ms-blob-type"))
+		}
+
+		f.exists = true
+		f.data = data
+		f.metadata = requestMetadata(req)
+		resp.WriteHeader(http.StatusCreated)
+	case req.Method == "DELETE" && comp == "":
+		if leaseMismatch {
+			resp.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		f.exists = false
+		f.data = nil
+		f.metadata = make(map[string]string)
+		resp.WriteHeader(http.StatusAccepted)
+	case req.Method == "GET" && comp == "metadata":
+		for k, v := range f.metadata {
+			resp.Header().Set("x-ms-meta-"+k, v)
+		}
+	case req.Method == "PUT" && comp == "metadata":
+		if leaseMismatch {
+			resp.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		f.metadata = requestMetadata(req)
+	case req.Method == "PUT" && comp == "snapshot":
+		f.snapshots = append(f.snapshots, f.data)
+		resp.Header().Set("x-ms-snapshot", time.Now().UTC().Format(time.RFC3339Nano))
+		resp.WriteHeader(http.StatusCreated)
+	case req.Method == "PUT" && comp == "lease":
+		switch req.Header.Get("x-ms-lease-action") {
+		case "acquire":
+			if f.leaseID != "" {
+				resp.WriteHeader(http.StatusConflict)
+				return
+			}
+
+			f.leaseID = req.Header.Get("x-ms-proposed-lease-id")
+			resp.Header().Set("x-ms-lease-id", f.leaseID)
+			resp.WriteHeader(http.StatusCreated)
+		case "release":
+			if leaseMismatch {
+				resp.WriteHeader(http.StatusConflict)
+				return
+			}
+
+			f.leaseID = ""
+		default:
+			resp.WriteHeader(http.StatusBadRequest)
+		}
+	default:
+		f.t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+	}
+}
+
+func requestMetadata(req *http.Request) map[string]string {
+	metadata := make(map[string]string)
+	for k := range req.Header {
+		k = strings.ToLower(k)
+		if strings.HasPrefix(k, "x-ms-meta-") {
+			metadata[strings.TrimPrefix(k, "x-ms-meta-")] = req.Header.Get(k)
+		}
+	}
+	return metadata
+}
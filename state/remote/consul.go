@@ -4,8 +4,10 @@ import (
 	"crypto/md5"
 	"fmt"
 	"strings"
+	"time"
 
 	consulapi "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/terraform/state"
 )
 
 func consulFactory(conf map[string]string) (Client, error) {
@@ -46,7 +48,7 @@ func consulFactory(conf map[string]string) (Client, error) {
 
 	return &ConsulClient{
 		Client: client,
-		Path:   path,
+		Path:   workspacePath(conf, path),
 	}, nil
 }
 
@@ -54,6 +56,9 @@ func consulFactory(conf map[string]string) (Client, error) {
 type ConsulClient struct {
 	Client *consulapi.Client
 	Path   string
+
+	consulLock *consulapi.Lock
+	lockID     string
 }
 
 func (c *ConsulClient) Get() (*Payload, error) {
@@ -86,3 +91,103 @@ func (c *ConsulClient) Delete() error {
 	_, err := kv.Delete(c.Path, nil)
 	return err
 }
+
+// Lock locks the state with a Consul lock on the state path suffixed with
+// "/.lock", holding the lock information as its value.
+func (c *ConsulClient) Lock(info *state.LockInfo) (string, error) {
+	if c.consulLock != nil {
+		return "", fmt.Errorf("state %q already locked by this process", c.Path)
+	}
+
+	info.Path = c.Path
+
+	opts := &consulapi.LockOptions{
+		Key:          c.lockPath(),
+		Value:        info.Marshal(),
+		SessionName:  fmt.Sprintf("terraform-%s", info.ID),
+		LockWaitTime: time.Second,
+		LockTryOnce:  true,
+	}
+
+	lock, err := c.Client.LockOpts(opts)
+	if err != nil {
+		return "", err
+	}
+
+	lockCh, err := lock.Lock(make(chan struct{}))
+	if err != nil {
+		return "", err
+	}
+
+	if lockCh == nil {
+		lockErr := &state.LockError{
+			Err: fmt.Errorf("state %q already locked", c.Path),
+		}
+		lockErr.Info, _ = c.getLockInfo()
+		return "", lockErr
+	}
+
+	c.consulLock = lock
+	c.lockID = info.ID
+	return info.ID, nil
+}
+
+// Unlock releases the lock. A lock held by another process is released by
+// destroying the Consul session that holds it.
+func (c *ConsulClient) Unlock(id string) error {
+	info, err := c.getLockInfo()
+	if err != nil {
+		return &state.LockError{
+			Err: fmt.Errorf("failed to retrieve lock info: %s", err),
+		}
+	}
+
+	if info.ID != id {
+		return &state.LockError{
+			Info: info,
+			Err:  fmt.Errorf("lock id %q does not match existing lock", id),
+		}
+	}
+
+	if c.consulLock != nil && c.lockID == id {
+		if err := c.consulLock.Unlock(); err != nil {
+			return err
+		}
+
+		// Destroy removes the lock key once it's no longer held, which may
+		// fail if another process is already waiting on the lock.
+		c.consulLock.Destroy()
+		c.consulLock = nil
+		c.lockID = ""
+		return nil
+	}
+
+	pair, _, err := c.Client.KV().Get(c.lockPath(), nil)
+	if err != nil {
+		return err
+	}
+	if pair != nil && pair.Session != "" {
+		if _, err := c.Client.Session().Destroy(pair.Session, nil); err != nil {
+			return err
+		}
+	}
+
+	_, err = c.Client.KV().Delete(c.lockPath(), nil)
+	return err
+}
+
+func (c *ConsulClient) getLockInfo() (*state.LockInfo, error) {
+	pair, _, err := c.Client.KV().Get(c.lockPath(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if pair == nil || len(pair.Value) == 0 {
+		return nil, fmt.Errorf("no lock info found for %q", c.Path)
+	}
+
+	return state.UnmarshalLockInfo(pair.Value)
+}
+
+func (c *ConsulClient) lockPath() string {
+	return strings.TrimRight(c.Path, "/") + "/.lock"
+}
@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/state"
 )
 
 func TestConsulClient_impl(t *testing.T) {
 	var _ Client = new(ConsulClient)
+	var _ ClientLocker = new(ConsulClient)
 }
 
 func TestConsulClient(t *testing.T) {
@@ -25,3 +27,25 @@ func TestConsulClient(t *testing.T) {
 
 	testClient(t, client)
 }
+
+func TestConsulClient_locks(t *testing.T) {
+	acctest.RemoteTestPrecheck(t)
+
+	path := fmt.Sprintf("tf-unit/%s", time.Now().String())
+	conf := map[string]string{
+		"address": "demo.consul.io:80",
+		"path":    path,
+	}
+
+	a, err := consulFactory(conf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	b, err := consulFactory(conf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state.TestLocker(t, a.(*ConsulClient), b.(*ConsulClient))
+}
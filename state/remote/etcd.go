@@ -37,7 +37,7 @@ func etcdFactory(conf map[string]string) (Client, error) {
 
 	return &EtcdClient{
 		Client: client,
-		Path:   path,
+		Path:   workspacePath(conf, path),
 	}, nil
 }
 
@@ -15,7 +15,7 @@ func fileFactory(conf map[string]string) (Client, error) {
 	}
 
 	return &FileClient{
-		Path: path,
+		Path: workspacePath(conf, path),
 	}, nil
 }
 
@@ -2,6 +2,8 @@ package remote
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/pathorcontents"
@@ -41,6 +44,12 @@ type GCSClient struct {
 	path          string
 	clientStorage *storage.Service
 	context       context.Context
+
+	// generation is the generation of the state object when it was last
+	// read or written, which is required to match when writing it again so
+	// that concurrent changes aren't overwritten. Zero means that the
+	// object didn't exist, and -1 that it hasn't been read yet.
+	generation int64
 }
 
 func gcsFactory(conf map[string]string) (Client, error) {
@@ -55,9 +64,14 @@ func gcsFactory(conf map[string]string) (Client, error) {
 		return nil, fmt.Errorf("missing 'bucket' configuration")
 	}
 
-	pathName, ok := conf["path"]
+	pathName, err := gcsStatePath(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptionKey, ok := conf["encryption_key"]
 	if !ok {
-		return nil, fmt.Errorf("missing 'path' configuration")
+		encryptionKey = os.Getenv("GOOGLE_ENCRYPTION_KEY")
 	}
 
 	credentials, ok := conf["credentials"]
@@ -99,6 +113,15 @@ func gcsFactory(conf map[string]string) (Client, error) {
 			return nil, err
 		}
 	}
+
+	if encryptionKey != "" {
+		transport, err := newGCSEncryptionTransport(client.Transport, encryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		client.Transport = transport
+	}
+
 	versionString := terraform.Version
 	userAgent := fmt.Sprintf(
 		"(%s %s) Terraform/%s", runtime.GOOS, runtime.GOARCH, versionString)
@@ -114,10 +137,38 @@ func gcsFactory(conf map[string]string) (Client, error) {
 		clientStorage: clientStorage,
 		bucket:        bucketName,
 		path:          pathName,
+		generation:    -1,
 	}, nil
 
 }
 
+// gcsStatePath returns the name of the state object, which is either given
+// as the path, or made of the prefix and the name of the workspace.
+func gcsStatePath(conf map[string]string) (string, error) {
+	pathName, hasPath := conf["path"]
+	prefix, hasPrefix := conf["prefix"]
+
+	switch {
+	case hasPath && hasPrefix:
+		return "", fmt.Errorf("only one of 'path' and 'prefix' can be configured")
+	case hasPath:
+		return workspaceKey(conf, pathName), nil
+	case hasPrefix:
+		workspace, ok := conf["workspace"]
+		if !ok || workspace == "" {
+			workspace = terraform.DefaultWorkspace
+		}
+
+		prefix = strings.TrimSuffix(prefix, "/")
+		if prefix == "" {
+			return workspace + ".tfstate", nil
+		}
+		return fmt.Sprintf("%s/%s.tfstate", prefix, workspace), nil
+	default:
+		return "", fmt.Errorf("missing 'path' or 'prefix' configuration")
+	}
+}
+
 func (c *GCSClient) Get() (*Payload, error) {
 	// Read the object from bucket.
 	log.Printf("[INFO] Reading %s/%s", c.bucket, c.path)
@@ -127,6 +178,7 @@ func (c *GCSClient) Get() (*Payload, error) {
 		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
 			log.Printf("[INFO] %s/%s not found", c.bucket, c.path)
 
+			c.generation = 0
 			return nil, nil
 		}
 
@@ -134,6 +186,12 @@ func (c *GCSClient) Get() (*Payload, error) {
 	}
 	defer resp.Body.Close()
 
+	generation, err := strconv.ParseInt(resp.Header.Get("X-Goog-Generation"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the generation of %s/%s: %s", c.bucket, c.path, err)
+	}
+	c.generation = generation
+
 	var buf []byte
 	w := bytes.NewBuffer(buf)
 	n, err := io.Copy(w, resp.Body)
@@ -158,18 +216,92 @@ func (c *GCSClient) Put(data []byte) error {
 	log.Printf("[INFO] Writing %s/%s", c.bucket, c.path)
 
 	r := bytes.NewReader(data)
-	_, err := c.clientStorage.Objects.Insert(c.bucket, &storage.Object{Name: c.path}).Media(r).Do()
+	call := c.clientStorage.Objects.Insert(c.bucket, &storage.Object{Name: c.path}).Media(r)
+	if c.generation >= 0 {
+		call = call.IfGenerationMatch(c.generation)
+	}
+
+	object, err := call.Do()
 	if err != nil {
-		return err
+		return c.preconditionError(err)
 	}
 
+	c.generation = object.Generation
 	return nil
 }
 
 func (c *GCSClient) Delete() error {
 	log.Printf("[INFO] Deleting %s/%s", c.bucket, c.path)
 
-	err := c.clientStorage.Objects.Delete(c.bucket, c.path).Do()
+	call := c.clientStorage.Objects.Delete(c.bucket, c.path)
+	if c.generation > 0 {
+		call = call.IfGenerationMatch(c.generation)
+	}
+
+	if err := call.Do(); err != nil {
+		if gerr, ok := err.(*googleapi.Error); !ok || gerr.Code != 404 {
+			return c.preconditionError(err)
+		}
+	}
+
+	c.generation = 0
+	return nil
+}
+
+// preconditionError explains failures of the generation preconditions,
+// which mean that the state was changed since it was last read.
+func (c *GCSClient) preconditionError(err error) error {
+	if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == http.StatusPreconditionFailed {
+		return fmt.Errorf(
+			"The state in %s/%s was modified by someone else since it was read.\n"+
+				"Refresh the state and try again.", c.bucket, c.path)
+	}
+
 	return err
+}
+
+// gcsEncryptionTransport adds the headers for a customer-supplied encryption
+// key to every request, since the storage client doesn't support them.
+type gcsEncryptionTransport struct {
+	transport http.RoundTripper
+	key       string
+	keyHash   string
+}
+
+func newGCSEncryptionTransport(transport http.RoundTripper, key string) (*gcsEncryptionTransport, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding 'encryption_key': %s", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("'encryption_key' must be a base64 encoded 256-bit key, got %d bytes", len(raw))
+	}
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	hash := sha256.Sum256(raw)
+	return &gcsEncryptionTransport{
+		transport: transport,
+		key:       key,
+		keyHash:   base64.StdEncoding.EncodeToString(hash[:]),
+	}, nil
+}
+
+func (t *gcsEncryptionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Copy the request rather than modifying it, as required by
+	// http.RoundTripper.
+	r := new(http.Request)
+	*r = *req
+	r.Header = make(http.Header, len(req.Header)+3)
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+
+	r.Header.Set("x-goog-encryption-algorithm", "AES256")
+	r.Header.Set("x-goog-encryption-key", t.key)
+	r.Header.Set("x-goog-encryption-key-sha256", t.keyHash)
 
+	return t.transport.RoundTrip(r)
 }
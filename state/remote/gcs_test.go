@@ -1,8 +1,16 @@
 package remote
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +21,131 @@ func TestGCSClient_impl(t *testing.T) {
 	var _ Client = new(GCSClient)
 }
 
+func TestGCSStatePath(t *testing.T) {
+	cases := []struct {
+		Conf     map[string]string
+		Expected string
+		Err      bool
+	}{
+		{
+			map[string]string{"path": "network/terraform.tfstate"},
+			"network/terraform.tfstate",
+			false,
+		},
+		{
+			map[string]string{"prefix": "network"},
+			"network/default.tfstate",
+			false,
+		},
+		{
+			map[string]string{"prefix": "network/", "workspace": "staging"},
+			"network/staging.tfstate",
+			false,
+		},
+		{
+			map[string]string{"prefix": "", "workspace": "staging"},
+			"staging.tfstate",
+			false,
+		},
+		{
+			map[string]string{"path": "terraform.tfstate", "prefix": "network"},
+			"",
+			true,
+		},
+		{
+			map[string]string{},
+			"",
+			true,
+		},
+	}
+
+	for i, tc := range cases {
+		actual, err := gcsStatePath(tc.Conf)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if actual != tc.Expected {
+			t.Fatalf("%d: bad: %q", i, actual)
+		}
+	}
+}
+
+func TestGCSClient_fake(t *testing.T) {
+	gcs := newFakeGCS(t)
+	ts := gcs.Server()
+	defer ts.Close()
+
+	testClient(t, gcs.Client(ts, http.DefaultTransport))
+}
+
+func TestGCSClient_generation(t *testing.T) {
+	gcs := newFakeGCS(t)
+	ts := gcs.Server()
+	defer ts.Close()
+
+	a := gcs.Client(ts, http.DefaultTransport)
+	b := gcs.Client(ts, http.DefaultTransport)
+
+	// Both clients read the state before it exists.
+	for _, c := range []*GCSClient{a, b} {
+		if p, err := c.Get(); err != nil || p != nil {
+			t.Fatalf("bad: %#v %s", p, err)
+		}
+	}
+
+	if err := a.Put([]byte("a")); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := a.Put([]byte("aa")); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The state has been created by client A since B read it.
+	if err := b.Put([]byte("b")); err == nil {
+		t.Fatal("expected precondition error")
+	}
+
+	if _, err := b.Get(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := b.Put([]byte("b")); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := a.Delete(); err == nil {
+		t.Fatal("expected precondition error")
+	}
+	if string(gcs.data) != "b" {
+		t.Fatalf("bad: %q", gcs.data)
+	}
+}
+
+func TestGCSClient_encryptionKey(t *testing.T) {
+	gcs := newFakeGCS(t)
+	ts := gcs.Server()
+	defer ts.Close()
+
+	key := "FDtPYrTgsh4Ej8S0Zi5xSX+4Opk6iu4BTY9bTlcRq9U="
+	gcs.encryptionKey = key
+
+	if _, err := newGCSEncryptionTransport(nil, "c2hvcnQ="); err == nil {
+		t.Fatal("expected error for a short key")
+	}
+
+	transport, err := newGCSEncryptionTransport(nil, key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	testClient(t, gcs.Client(ts, transport))
+
+	// Requests without the key are refused.
+	c := gcs.Client(ts, http.DefaultTransport)
+	if err := c.Put([]byte("data")); err == nil {
+		t.Fatal("expected error without the encryption key")
+	}
+}
+
 func TestGCSClient(t *testing.T) {
 	// This test creates a bucket in GCS and populates it.
 	// It may incur costs, so it will only run if GCS credential environment
@@ -67,3 +200,106 @@ func TestGCSClient(t *testing.T) {
 
 	testClient(t, client)
 }
+
+// fakeGCS is a minimal Google Cloud Storage JSON API holding a single
+// object, which checks the generation preconditions of requests.
+type fakeGCS struct {
+	t *testing.T
+
+	data       []byte
+	generation int64
+	writes     int64
+
+	// If set, requests must include the customer-supplied encryption key.
+	encryptionKey string
+}
+
+func newFakeGCS(t *testing.T) *fakeGCS {
+	return &fakeGCS{t: t}
+}
+
+func (f *fakeGCS) Server() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(f.handler))
+}
+
+func (f *fakeGCS) Client(ts *httptest.Server, transport http.RoundTripper) *GCSClient {
+	clientStorage, err := storage.New(&http.Client{Transport: transport})
+	if err != nil {
+		f.t.Fatalf("err: %s", err)
+	}
+	clientStorage.BasePath = ts.URL + "/storage/v1/"
+
+	return &GCSClient{
+		clientStorage: clientStorage,
+		bucket:        "terraform-state",
+		path:          "network/default.tfstate",
+		generation:    -1,
+	}
+}
+
+func (f *fakeGCS) handler(resp http.ResponseWriter, req *http.Request) {
+	if f.encryptionKey != "" && req.Header.Get("x-goog-encryption-key") != f.encryptionKey {
+		http.Error(resp, `{"error": {"code": 400, "message": "missing encryption key"}}`, http.StatusBadRequest)
+		return
+	}
+
+	if raw := req.URL.Query().Get("ifGenerationMatch"); raw != "" {
+		generation, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			f.t.Fatalf("err: %s", err)
+		}
+		if generation != f.generation {
+			http.Error(resp, `{"error": {"code": 412, "message": "precondition failed"}}`, http.StatusPreconditionFailed)
+			return
+		}
+	}
+
+	switch {
+	case req.Method == "GET" && strings.HasPrefix(req.URL.Path, "/storage/v1/b/terraform-state/o/"):
+		if f.generation == 0 {
+			http.Error(resp, `{"error": {"code": 404, "message": "not found"}}`, http.StatusNotFound)
+			return
+		}
+
+		resp.Header().Set("X-Goog-Generation", strconv.FormatInt(f.generation, 10))
+		resp.Write(f.data)
+	case req.Method == "POST" && req.URL.Path == "/storage/v1/b/terraform-state/o":
+		_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		if err != nil {
+			f.t.Fatalf("err: %s", err)
+		}
+
+		// The object metadata is followed by its contents.
+		r := multipart.NewReader(req.Body, params["boundary"])
+		if _, err := r.NextPart(); err != nil {
+			f.t.Fatalf("err: %s", err)
+		}
+		part, err := r.NextPart()
+		if err != nil {
+			f.t.Fatalf("err: %s", err)
+		}
+		data, err := ioutil.ReadAll(part)
+		if err != nil {
+			f.t.Fatalf("err: %s", err)
+		}
+
+		f.writes++
+		f.data = data
+		f.generation = f.writes
+		json.NewEncoder(resp).Encode(&storage.Object{
+			Name:       "network/default.tfstate",
+			Generation: f.generation,
+		})
+	case req.Method == "DELETE":
+		if f.generation == 0 {
+			http.Error(resp, `{"error": {"code": 404, "message": "not found"}}`, http.StatusNotFound)
+			return
+		}
+
+		f.data = nil
+		f.generation = 0
+		resp.WriteHeader(http.StatusNoContent)
+	default:
+		f.t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+	}
+}
@@ -26,6 +26,14 @@ func httpFactory(conf map[string]string) (Client, error) {
 		return nil, fmt.Errorf("address must be HTTP or HTTPS")
 	}
 
+	// The server is told about the workspace with a query parameter, since
+	// the address can't be changed in a way that works for every server.
+	if workspace := workspaceName(conf); workspace != "" {
+		query := url.Query()
+		query.Set("workspace", workspace)
+		url.RawQuery = query.Encode()
+	}
+
 	client := &http.Client{}
 	if skipRaw, ok := conf["skip_cert_verification"]; ok {
 		skip, err := strconv.ParseBool(skipRaw)
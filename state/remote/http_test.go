@@ -53,3 +53,18 @@ func (h *testHTTPHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte(fmt.Sprintf("Unknown method: %s", r.Method)))
 	}
 }
+
+func TestHTTPClient_workspace(t *testing.T) {
+	client, err := httpFactory(map[string]string{
+		"address":   "http://127.0.0.1:8080/state?project=foo",
+		"workspace": "staging",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	query := client.(*HTTPClient).URL.Query()
+	if query.Get("workspace") != "staging" || query.Get("project") != "foo" {
+		t.Fatalf("bad: %s", client.(*HTTPClient).URL)
+	}
+}
@@ -0,0 +1,249 @@
+package remote
+
+import (
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/lib/pq"
+)
+
+const pgDefaultSchemaName = "terraform_remote_state"
+
+func pgFactory(conf map[string]string) (Client, error) {
+	connStr, ok := conf["conn_str"]
+	if !ok {
+		connStr = os.Getenv("PG_CONN_STR")
+		if connStr == "" {
+			return nil, fmt.Errorf(
+				"missing 'conn_str' configuration or PG_CONN_STR environment variable")
+		}
+	}
+
+	schemaName, ok := conf["schema_name"]
+	if !ok || schemaName == "" {
+		schemaName = pgDefaultSchemaName
+	}
+
+	name, ok := conf["workspace"]
+	if !ok || name == "" {
+		name = terraform.DefaultWorkspace
+	}
+
+	skipSchemaCreation := false
+	if raw, ok := conf["skip_schema_creation"]; ok {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"'skip_schema_creation' field couldn't be parsed as bool: %s", err)
+		}
+
+		skipSchemaCreation = v
+	}
+
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return nil, fmt.Errorf("Error connecting to PostgreSQL: %s", err)
+	}
+
+	client := &PgClient{
+		db:         db,
+		schemaName: schemaName,
+		name:       name,
+	}
+
+	if !skipSchemaCreation {
+		if err := client.createSchema(); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// PgClient is a remote client that stores the state as a row of a table in
+// PostgreSQL, using advisory locks for locking.
+type PgClient struct {
+	db         *sql.DB
+	schemaName string
+	name       string
+
+	// lockTx is the transaction holding the advisory lock. Advisory locks
+	// belong to a database session, and a transaction is the only way to
+	// keep using the same session with database/sql.
+	lockTx *sql.Tx
+	lockID string
+}
+
+// createSchema creates the schema along with the tables holding the states
+// and the information about their locks.
+func (c *PgClient) createSchema() error {
+	queries := []string{
+		fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS %s`, pq.QuoteIdentifier(c.schemaName)),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			name TEXT PRIMARY KEY,
+			data TEXT NOT NULL
+		)`, c.table("states")),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			name TEXT PRIMARY KEY,
+			info TEXT NOT NULL
+		)`, c.table("locks")),
+	}
+
+	for _, query := range queries {
+		if _, err := c.db.Exec(query); err != nil {
+			return fmt.Errorf("Error creating the schema %q: %s", c.schemaName, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *PgClient) Get() (*Payload, error) {
+	var data string
+	query := fmt.Sprintf(`SELECT data FROM %s WHERE name = $1`, c.table("states"))
+	err := c.db.QueryRow(query, c.name).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the state %q: %s", c.name, err)
+	}
+
+	// If there was no data, then return nil
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	return &Payload{
+		Data: []byte(data),
+	}, nil
+}
+
+func (c *PgClient) Put(data []byte) error {
+	query := fmt.Sprintf(`INSERT INTO %s (name, data) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET data = EXCLUDED.data`, c.table("states"))
+	if _, err := c.db.Exec(query, c.name, string(data)); err != nil {
+		return fmt.Errorf("Error writing the state %q: %s", c.name, err)
+	}
+
+	return nil
+}
+
+func (c *PgClient) Delete() error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE name = $1`, c.table("states"))
+	if _, err := c.db.Exec(query, c.name); err != nil {
+		return fmt.Errorf("Error deleting the state %q: %s", c.name, err)
+	}
+
+	return nil
+}
+
+// Lock takes a transaction level advisory lock for the state, which is
+// released when Unlock ends the transaction, or when the session is closed
+// if Terraform exits without unlocking. The lock info is stored separately
+// so that it can be read by other clients.
+func (c *PgClient) Lock(info *state.LockInfo) (string, error) {
+	info.Path = fmt.Sprintf("%s/%s", c.schemaName, c.name)
+
+	if c.lockTx != nil {
+		return "", fmt.Errorf("state %q is already locked by this client", info.Path)
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return "", err
+	}
+
+	var locked bool
+	err = tx.QueryRow(`SELECT pg_try_advisory_xact_lock($1)`, c.lockKey()).Scan(&locked)
+	if err != nil {
+		tx.Rollback()
+		return "", fmt.Errorf("Error locking the state %q: %s", info.Path, err)
+	}
+
+	if !locked {
+		tx.Rollback()
+
+		lockErr := &state.LockError{
+			Err: fmt.Errorf("state %q is already locked", info.Path),
+		}
+		lockErr.Info, _ = c.getLockInfo()
+		return "", lockErr
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (name, info) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET info = EXCLUDED.info`, c.table("locks"))
+	if _, err := c.db.Exec(query, c.name, string(info.Marshal())); err != nil {
+		tx.Rollback()
+		return "", fmt.Errorf("Error writing the lock info of %q: %s", info.Path, err)
+	}
+
+	c.lockTx = tx
+	c.lockID = info.ID
+	return info.ID, nil
+}
+
+// Unlock releases the lock with the given ID. Since advisory locks can only
+// be released by the session holding them, a lock held by another client
+// can't be unlocked.
+func (c *PgClient) Unlock(id string) error {
+	lockErr := &state.LockError{}
+	lockErr.Info, _ = c.getLockInfo()
+
+	if c.lockTx == nil {
+		lockErr.Err = fmt.Errorf(
+			"state %q is not locked by this client, the lock is released when "+
+				"the PostgreSQL session holding it ends", c.name)
+		return lockErr
+	}
+	if id != c.lockID {
+		lockErr.Err = fmt.Errorf("lock id %q does not match existing lock", id)
+		return lockErr
+	}
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE name = $1`, c.table("locks"))
+	if _, err := c.db.Exec(query, c.name); err != nil {
+		lockErr.Err = fmt.Errorf("Error deleting the lock info: %s", err)
+		return lockErr
+	}
+
+	// Ending the transaction releases the advisory lock.
+	err := c.lockTx.Rollback()
+	c.lockTx = nil
+	c.lockID = ""
+	if err != nil {
+		lockErr.Err = err
+		return lockErr
+	}
+
+	return nil
+}
+
+func (c *PgClient) getLockInfo() (*state.LockInfo, error) {
+	var info string
+	query := fmt.Sprintf(`SELECT info FROM %s WHERE name = $1`, c.table("locks"))
+	if err := c.db.QueryRow(query, c.name).Scan(&info); err != nil {
+		return nil, err
+	}
+
+	return state.UnmarshalLockInfo([]byte(info))
+}
+
+// lockKey returns the advisory lock key of the state, derived from the
+// schema and the state name.
+func (c *PgClient) lockKey() int64 {
+	h := fnv.New64a()
+	h.Write([]byte(c.schemaName + "/" + c.name))
+	return int64(h.Sum64())
+}
+
+// table returns the quoted name of the given table in the schema.
+func (c *PgClient) table(name string) string {
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(c.schemaName), pq.QuoteIdentifier(name))
+}
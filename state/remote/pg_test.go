@@ -0,0 +1,87 @@
+package remote
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/lib/pq"
+)
+
+func TestPgClient_impl(t *testing.T) {
+	var _ Client = new(PgClient)
+	var _ ClientLocker = new(PgClient)
+}
+
+func TestPgFactory_missingConnStr(t *testing.T) {
+	old := os.Getenv("PG_CONN_STR")
+	os.Setenv("PG_CONN_STR", "")
+	defer os.Setenv("PG_CONN_STR", old)
+
+	if _, err := pgFactory(map[string]string{}); err == nil {
+		t.Fatal("expected error without a connection string")
+	}
+}
+
+func TestPgClient_lockKey(t *testing.T) {
+	a := &PgClient{schemaName: pgDefaultSchemaName, name: "default"}
+	b := &PgClient{schemaName: pgDefaultSchemaName, name: "staging"}
+
+	if a.lockKey() != a.lockKey() {
+		t.Fatal("lock key isn't stable")
+	}
+	if a.lockKey() == b.lockKey() {
+		t.Fatal("expected different lock keys for different states")
+	}
+}
+
+func TestPgClient(t *testing.T) {
+	// This test uses the PostgreSQL database given by PG_CONN_STR, and
+	// creates a schema for the test in it.
+
+	if os.Getenv("PG_CONN_STR") == "" {
+		t.Skipf("skipping; PG_CONN_STR must be set")
+	}
+
+	client, err := pgFactory(map[string]string{
+		"schema_name": fmt.Sprintf("terraform_test_%x", time.Now().Unix()),
+	})
+	if err != nil {
+		t.Fatalf("Error for valid config: %v", err)
+	}
+	defer dropPgSchema(t, client.(*PgClient))
+
+	testClient(t, client)
+}
+
+func TestPgClient_locks(t *testing.T) {
+	if os.Getenv("PG_CONN_STR") == "" {
+		t.Skipf("skipping; PG_CONN_STR must be set")
+	}
+
+	conf := map[string]string{
+		"schema_name": fmt.Sprintf("terraform_test_%x", time.Now().Unix()),
+	}
+
+	a, err := pgFactory(conf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer dropPgSchema(t, a.(*PgClient))
+
+	b, err := pgFactory(conf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state.TestLocker(t, a.(*PgClient), b.(*PgClient))
+}
+
+func dropPgSchema(t *testing.T, c *PgClient) {
+	query := fmt.Sprintf(`DROP SCHEMA %s CASCADE`, pq.QuoteIdentifier(c.schemaName))
+	if _, err := c.db.Exec(query); err != nil {
+		t.Logf("WARNING: Failed to drop the test schema %q: %s", c.schemaName, err)
+	}
+}
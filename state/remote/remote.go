@@ -2,6 +2,10 @@ package remote
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 // Client is the interface that must be implemented for a remote state
@@ -13,6 +17,13 @@ type Client interface {
 	Delete() error
 }
 
+// ClientLocker is an optional interface that allows a remote state
+// driver to lock the state, to prevent concurrent modifications.
+type ClientLocker interface {
+	Client
+	state.Locker
+}
+
 // Payload is the return value from the remote state storage.
 type Payload struct {
 	MD5  []byte
@@ -38,10 +49,12 @@ func NewClient(t string, conf map[string]string) (Client, error) {
 var BuiltinClients = map[string]Factory{
 	"atlas":       atlasFactory,
 	"azure":       azureFactory,
+	"azurerm":     azureFactory,
 	"consul":      consulFactory,
 	"etcd":        etcdFactory,
 	"gcs":         gcsFactory,
 	"http":        httpFactory,
+	"pg":          pgFactory,
 	"s3":          s3Factory,
 	"swift":       swiftFactory,
 	"artifactory": artifactoryFactory,
@@ -49,3 +62,41 @@ var BuiltinClients = map[string]Factory{
 	// This is used for development purposes only.
 	"_local": fileFactory,
 }
+
+// The state of each workspace other than the default one is stored
+// separately. Clients get the name of the workspace in the "workspace"
+// configuration, and derive the location of its state from the location
+// configured for the default workspace with the helpers below.
+
+// workspaceName returns the name of the configured workspace, or an empty
+// string for the default workspace.
+func workspaceName(conf map[string]string) string {
+	workspace := conf["workspace"]
+	if workspace == terraform.DefaultWorkspace {
+		return ""
+	}
+	return workspace
+}
+
+// workspaceKey returns the key of the state of the configured workspace in
+// object stores, which is the given key under the "env:/<workspace>/"
+// prefix.
+func workspaceKey(conf map[string]string, key string) string {
+	workspace := workspaceName(conf)
+	if workspace == "" {
+		return key
+	}
+	return fmt.Sprintf("env:/%s/%s", workspace, strings.TrimPrefix(key, "/"))
+}
+
+// workspacePath returns the path of the state of the configured workspace,
+// which is the given path with the "-env-<workspace>" suffix. The suffix
+// has no ":" since it's also used for file names, which can't contain one
+// on Windows.
+func workspacePath(conf map[string]string, path string) string {
+	workspace := workspaceName(conf)
+	if workspace == "" {
+		return path
+	}
+	return fmt.Sprintf("%s-env-%s", path, workspace)
+}
@@ -133,3 +133,42 @@ func TestRemoteClient_stateInit(t *testing.T) {
 		t.Fatal("failed to initialize remote state")
 	}
 }
+
+func TestWorkspaceKey(t *testing.T) {
+	cases := []struct {
+		Workspace string
+		Key       string
+		Expected  string
+	}{
+		{"", "prod/terraform.tfstate", "prod/terraform.tfstate"},
+		{"default", "prod/terraform.tfstate", "prod/terraform.tfstate"},
+		{"staging", "prod/terraform.tfstate", "env:/staging/prod/terraform.tfstate"},
+		{"staging", "/prod/terraform.tfstate", "env:/staging/prod/terraform.tfstate"},
+	}
+
+	for i, tc := range cases {
+		conf := map[string]string{"workspace": tc.Workspace}
+		if actual := workspaceKey(conf, tc.Key); actual != tc.Expected {
+			t.Fatalf("%d: bad: %q", i, actual)
+		}
+	}
+}
+
+func TestWorkspacePath(t *testing.T) {
+	cases := []struct {
+		Workspace string
+		Path      string
+		Expected  string
+	}{
+		{"", "tf/state", "tf/state"},
+		{"default", "tf/state", "tf/state"},
+		{"staging", "tf/state", "tf/state-env-staging"},
+	}
+
+	for i, tc := range cases {
+		conf := map[string]string{"workspace": tc.Workspace}
+		if actual := workspacePath(conf, tc.Path); actual != tc.Expected {
+			t.Fatalf("%d: bad: %q", i, actual)
+		}
+	}
+}
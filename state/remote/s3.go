@@ -11,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-multierror"
 	terraformAws "github.com/hashicorp/terraform/builtin/providers/aws"
+	"github.com/hashicorp/terraform/state"
 )
 
 func s3Factory(conf map[string]string) (Client, error) {
@@ -58,6 +60,7 @@ func s3Factory(conf map[string]string) (Client, error) {
 		acl = raw
 	}
 	kmsKeyID := conf["kms_key_id"]
+	lockTable := conf["lock_table"]
 
 	var errs []error
 	creds := terraformAws.GetCredentials(&terraformAws.Config{
@@ -89,14 +92,17 @@ providing credentials for the AWS S3 remote`))
 	}
 	sess := session.New(awsConfig)
 	nativeClient := s3.New(sess)
+	dynClient := dynamodb.New(sess)
 
 	return &S3Client{
 		nativeClient:         nativeClient,
 		bucketName:           bucketName,
-		keyName:              keyName,
+		keyName:              workspaceKey(conf, keyName),
 		serverSideEncryption: serverSideEncryption,
 		acl:                  acl,
 		kmsKeyID:             kmsKeyID,
+		dynClient:            dynClient,
+		lockTable:            lockTable,
 	}, nil
 }
 
@@ -107,6 +113,8 @@ type S3Client struct {
 	serverSideEncryption bool
 	acl                  string
 	kmsKeyID             string
+	dynClient            *dynamodb.DynamoDB
+	lockTable            string
 }
 
 func (c *S3Client) Get() (*Payload, error) {
@@ -188,3 +196,102 @@ func (c *S3Client) Delete() error {
 
 	return err
 }
+
+// Lock locks the state using a conditional write to the DynamoDB table
+// configured with lock_table. The table must have a string hash key named
+// "LockID". Locking is skipped if no lock table is configured.
+func (c *S3Client) Lock(info *state.LockInfo) (string, error) {
+	if c.lockTable == "" {
+		return "", nil
+	}
+
+	info.Path = c.lockPath()
+
+	putParams := &dynamodb.PutItemInput{
+		Item: map[string]*dynamodb.AttributeValue{
+			"LockID": {S: aws.String(c.lockPath())},
+			"Info":   {S: aws.String(string(info.Marshal()))},
+		},
+		TableName:           aws.String(c.lockTable),
+		ConditionExpression: aws.String("attribute_not_exists(LockID)"),
+	}
+	_, err := c.dynClient.PutItem(putParams)
+	if err != nil {
+		lockErr := &state.LockError{Err: err}
+
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ConditionalCheckFailedException" {
+			lockErr.Err = fmt.Errorf("state %q already locked", c.lockPath())
+			lockErr.Info, _ = c.getLockInfo()
+			return "", lockErr
+		}
+
+		return "", fmt.Errorf("Error acquiring the state lock: %s", err)
+	}
+
+	return info.ID, nil
+}
+
+// Unlock removes the lock from the DynamoDB table, after verifying that the
+// given ID matches the lock.
+func (c *S3Client) Unlock(id string) error {
+	if c.lockTable == "" {
+		return nil
+	}
+
+	lockErr := &state.LockError{}
+
+	info, err := c.getLockInfo()
+	if err != nil {
+		lockErr.Err = fmt.Errorf("failed to retrieve lock info: %s", err)
+		return lockErr
+	}
+	lockErr.Info = info
+
+	if info.ID != id {
+		lockErr.Err = fmt.Errorf("lock id %q does not match existing lock", id)
+		return lockErr
+	}
+
+	params := &dynamodb.DeleteItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockID": {S: aws.String(c.lockPath())},
+		},
+		TableName: aws.String(c.lockTable),
+	}
+	if _, err := c.dynClient.DeleteItem(params); err != nil {
+		lockErr.Err = err
+		return lockErr
+	}
+
+	return nil
+}
+
+func (c *S3Client) getLockInfo() (*state.LockInfo, error) {
+	getParams := &dynamodb.GetItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockID": {S: aws.String(c.lockPath())},
+		},
+		ProjectionExpression: aws.String("LockID, Info"),
+		TableName:            aws.String(c.lockTable),
+		ConsistentRead:       aws.Bool(true),
+	}
+
+	resp, err := c.dynClient.GetItem(getParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var infoData string
+	if v, ok := resp.Item["Info"]; ok && v.S != nil {
+		infoData = *v.S
+	}
+	if infoData == "" {
+		return nil, fmt.Errorf("no lock info found for %q", c.lockPath())
+	}
+
+	return state.UnmarshalLockInfo([]byte(infoData))
+}
+
+func (c *S3Client) lockPath() string {
+	return fmt.Sprintf("%s/%s", c.bucketName, c.keyName)
+}
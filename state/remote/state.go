@@ -3,6 +3,7 @@ package remote
 import (
 	"bytes"
 
+	"github.com/hashicorp/terraform/state"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -39,13 +40,13 @@ func (s *State) RefreshState() error {
 		return nil
 	}
 
-	state, err := terraform.ReadState(bytes.NewReader(payload.Data))
+	remoteState, err := state.ReadState(bytes.NewReader(payload.Data))
 	if err != nil {
 		return err
 	}
 
-	s.state = state
-	s.readState = state
+	s.state = remoteState
+	s.readState = remoteState
 	return nil
 }
 
@@ -54,9 +55,27 @@ func (s *State) PersistState() error {
 	s.state.IncrementSerialMaybe(s.readState)
 
 	var buf bytes.Buffer
-	if err := terraform.WriteState(s.state, &buf); err != nil {
+	if err := state.WriteState(s.state, &buf); err != nil {
 		return err
 	}
 
 	return s.Client.Put(buf.Bytes())
 }
+
+// Lock locks the remote state if the client supports locking.
+//
+// state.Locker impl.
+func (s *State) Lock(info *state.LockInfo) (string, error) {
+	if c, ok := s.Client.(ClientLocker); ok {
+		return c.Lock(info)
+	}
+	return "", nil
+}
+
+// state.Locker impl.
+func (s *State) Unlock(id string) error {
+	if c, ok := s.Client.(ClientLocker); ok {
+		return c.Unlock(id)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/state/encryption"
 )
 
 func TestState(t *testing.T) {
@@ -19,9 +20,58 @@ func TestState(t *testing.T) {
 	state.TestState(t, s)
 }
 
+func TestState_encryption(t *testing.T) {
+	enc, err := encryption.New("passphrase", map[string]string{
+		"passphrase": "correct horse battery staple",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	state.Encryption = enc
+	defer func() { state.Encryption = nil }()
+
+	client := new(InmemClient)
+	s := &State{
+		Client:    client,
+		state:     state.TestStateInitial(),
+		readState: state.TestStateInitial(),
+	}
+	if err := s.PersistState(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state.TestState(t, s)
+
+	// The state is encrypted remotely
+	payload, err := client.Get()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !encryption.IsEncrypted(payload.Data) {
+		t.Fatalf("state should be encrypted: %s", payload.Data)
+	}
+}
+
 func TestState_impl(t *testing.T) {
 	var _ state.StateReader = new(State)
 	var _ state.StateWriter = new(State)
 	var _ state.StatePersister = new(State)
 	var _ state.StateRefresher = new(State)
+	var _ state.Locker = new(State)
+}
+
+func TestState_lockNotSupported(t *testing.T) {
+	s := &State{Client: new(InmemClient)}
+
+	id, err := s.Lock(state.NewLockInfo())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if id != "" {
+		t.Fatalf("bad: %q", id)
+	}
+
+	if err := s.Unlock(id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
 }
@@ -60,7 +60,7 @@ func (c *SwiftClient) validateConfig(conf map[string]string) (err error) {
 		return err
 	}
 
-	c.path = path
+	c.path = workspacePath(conf, path)
 	c.client, err = openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{
 		Region: os.Getenv("OS_REGION_NAME"),
 	})
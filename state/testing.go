@@ -150,3 +150,52 @@ func TestStateInitial() *terraform.State {
 	terraform.WriteState(initial, &scratch)
 	return initial
 }
+
+// TestLocker is a helper for testing Locker implementations. The two given
+// lockers must lock the same underlying state, for example two clients
+// for the same remote state.
+func TestLocker(t *testing.T, a, b Locker) {
+	infoA := NewLockInfo()
+	infoA.Operation = "test"
+	infoA.Who = "clientA"
+
+	infoB := NewLockInfo()
+	infoB.Operation = "test"
+	infoB.Who = "clientB"
+
+	lockIDA, err := a.Lock(infoA)
+	if err != nil {
+		t.Fatalf("unable to get initial lock: %s", err)
+	}
+
+	_, err = b.Lock(infoB)
+	if err == nil {
+		a.Unlock(lockIDA)
+		t.Fatal("client B obtained lock while held by client A")
+	}
+
+	lockErr, ok := err.(*LockError)
+	if !ok {
+		t.Fatalf("expected a *LockError, got %T: %s", err, err)
+	}
+	if lockErr.Info != nil && lockErr.Info.ID != lockIDA {
+		t.Fatalf("expected the lock info of client A, got %#v", lockErr.Info)
+	}
+
+	if err := a.Unlock("not-the-lock-id"); err == nil {
+		t.Fatal("unlocked with an invalid lock id")
+	}
+
+	if err := a.Unlock(lockIDA); err != nil {
+		t.Fatalf("error unlocking client A: %s", err)
+	}
+
+	lockIDB, err := b.Lock(infoB)
+	if err != nil {
+		t.Fatalf("unable to obtain lock from client B: %s", err)
+	}
+
+	if err := b.Unlock(lockIDB); err != nil {
+		t.Fatalf("error unlocking client B: %s", err)
+	}
+}
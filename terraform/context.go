@@ -33,6 +33,10 @@ const (
 	InputModeStd = InputModeVar | InputModeProvider
 )
 
+// DefaultWorkspace is the name of the workspace that is used when no other
+// workspace has been selected.
+const DefaultWorkspace = "default"
+
 // ContextOpts are the user-configurable options to create a context with
 // NewContext.
 type ContextOpts struct {
@@ -48,6 +52,14 @@ type ContextOpts struct {
 	Targets            []string
 	Variables          map[string]interface{}
 
+	// AllowDestroyTargets allows the resources that are targeted directly
+	// by Targets to be destroyed even if they have prevent_destroy set.
+	AllowDestroyTargets bool
+
+	// Workspace is the name of the workspace the operations run in, which
+	// is available as terraform.workspace. It defaults to "default".
+	Workspace string
+
 	UIInput UIInput
 }
 
@@ -70,6 +82,9 @@ type Context struct {
 	targets      []string
 	uiInput      UIInput
 	variables    map[string]interface{}
+	workspace    string
+
+	allowDestroyTargets bool
 
 	l                   sync.Mutex // Lock acquired during any task
 	parallelSem         Semaphore
@@ -120,6 +135,11 @@ func NewContext(opts *ContextOpts) (*Context, error) {
 		par = 10
 	}
 
+	workspace := opts.Workspace
+	if workspace == "" {
+		workspace = DefaultWorkspace
+	}
+
 	// Set up the variables in the following sequence:
 	//    0 - Take default values from the configuration
 	//    1 - Take values from TF_VAR_x environment variables
@@ -246,6 +266,9 @@ func NewContext(opts *ContextOpts) (*Context, error) {
 		targets:      opts.Targets,
 		uiInput:      opts.UIInput,
 		variables:    variables,
+		workspace:    workspace,
+
+		allowDestroyTargets: opts.AllowDestroyTargets,
 
 		parallelSem:         NewSemaphore(par),
 		providerInputConfig: make(map[string]map[string]interface{}),
@@ -287,6 +310,8 @@ func (c *Context) graphBuilder(g *ContextGraphOpts) GraphBuilder {
 		Destroy:      c.destroy,
 		Validate:     g.Validate,
 		Verbose:      g.Verbose,
+
+		AllowDestroyTargets: c.allowDestroyTargets,
 	}
 }
 
@@ -456,10 +481,11 @@ func (c *Context) Plan() (*Plan, error) {
 	defer c.releaseRun(v)
 
 	p := &Plan{
-		Module:  c.module,
-		Vars:    c.variables,
-		State:   c.state,
-		Targets: c.targets,
+		Module:    c.module,
+		Vars:      c.variables,
+		State:     c.state,
+		Targets:   c.targets,
+		Workspace: c.workspace,
 	}
 
 	var operation walkOperation
@@ -617,6 +643,29 @@ func (c *Context) Module() *module.Tree {
 	return c.module
 }
 
+// Interpolater returns an Interpolater built on a copy of the state that
+// can be used to interpolate values in the root module, as they are
+// known in the state.
+func (c *Context) Interpolater() *Interpolater {
+	var varLock sync.Mutex
+	var stateLock sync.RWMutex
+
+	variables := make(map[string]interface{}, len(c.variables))
+	for k, v := range c.variables {
+		variables[k] = v
+	}
+
+	return &Interpolater{
+		Operation:          walkApply,
+		Module:             c.module,
+		State:              c.state.DeepCopy(),
+		StateLock:          &stateLock,
+		VariableValues:     variables,
+		VariableValuesLock: &varLock,
+		Workspace:          c.workspace,
+	}
+}
+
 // Variables will return the mapping of variables that were defined
 // for this Context. If Input was called, this mapping may be different
 // than what was given.
@@ -23,6 +23,11 @@ type ImportTarget struct {
 
 	// ID is the ID of the resource to import. This is resource-specific.
 	ID string
+
+	// Provider is the name of the provider to use for the import, such as
+	// an alias like "aws.west". If empty, the default provider for the
+	// resource type is used.
+	Provider string
 }
 
 // Import takes already-created external resources and brings them
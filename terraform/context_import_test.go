@@ -175,6 +175,59 @@ func TestContextImport_moduleProvider(t *testing.T) {
 	}
 }
 
+func TestContextImport_providerAlias(t *testing.T) {
+	p := testProvider("aws")
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	p.ImportStateReturn = []*InstanceState{
+		&InstanceState{
+			ID:        "foo",
+			Ephemeral: EphemeralState{Type: "aws_instance"},
+		},
+	}
+
+	configured := false
+	p.ConfigureFn = func(c *ResourceConfig) error {
+		configured = true
+
+		if v, ok := c.Get("foo"); !ok || v.(string) != "bar" {
+			return fmt.Errorf("bad")
+		}
+
+		return nil
+	}
+
+	m := testModule(t, "import-provider-aliased")
+
+	state, err := ctx.Import(&ImportOpts{
+		Module: m,
+		Targets: []*ImportTarget{
+			&ImportTarget{
+				Addr:     "aws_instance.foo",
+				ID:       "bar",
+				Provider: "aws.alias",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !configured {
+		t.Fatal("didn't configure provider")
+	}
+
+	actual := strings.TrimSpace(state.String())
+	expected := strings.TrimSpace(testImportProviderAliasStr)
+	if actual != expected {
+		t.Fatalf("bad: \n%s", actual)
+	}
+}
+
 func TestContextImport_refresh(t *testing.T) {
 	p := testProvider("aws")
 	ctx := testContext2(t, &ContextOpts{
@@ -508,6 +561,12 @@ aws_instance.foo:
   provider = aws
 `
 
+const testImportProviderAliasStr = `
+aws_instance.foo:
+  ID = foo
+  provider = aws.alias
+`
+
 const testImportCollisionStr = `
 aws_instance.foo:
   ID = bar
@@ -75,6 +75,62 @@ STATE:
 	}
 }
 
+func TestContext2Plan_createBeforeDestroy_deposed(t *testing.T) {
+	m := testModule(t, "plan-cbd-deposed")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	s := &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"aws_instance.foo": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "baz",
+							Attributes: map[string]string{
+								"type": "aws_instance",
+							},
+						},
+						Deposed: []*InstanceState{
+							&InstanceState{ID: "foo"},
+						},
+					},
+				},
+			},
+		},
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		State: s,
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual := strings.TrimSpace(plan.String())
+	expected := strings.TrimSpace(`
+DIFF:
+
+DESTROY: aws_instance.foo (deposed only)
+
+STATE:
+
+aws_instance.foo: (1 deposed)
+  ID = baz
+  type = aws_instance
+  Deposed ID 1 = foo
+		`)
+	if actual != expected {
+		t.Fatalf("expected:\n%s, got:\n%s", expected, actual)
+	}
+}
+
 func TestContext2Plan_emptyDiff(t *testing.T) {
 	m := testModule(t, "plan-empty")
 	p := testProvider("aws")
@@ -499,6 +555,54 @@ func TestContext2Plan_moduleProviderInherit(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_moduleProviderMap(t *testing.T) {
+	var l sync.Mutex
+	var calls []string
+
+	m := testModule(t, "plan-module-provider-map")
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": func() (ResourceProvider, error) {
+				var region string
+
+				p := testProvider("aws")
+				p.ConfigureFn = func(c *ResourceConfig) error {
+					if v, ok := c.Get("region"); ok {
+						region = v.(string)
+					}
+
+					return nil
+				}
+				p.DiffFn = func(
+					info *InstanceInfo,
+					state *InstanceState,
+					c *ResourceConfig) (*InstanceDiff, error) {
+					l.Lock()
+					defer l.Unlock()
+
+					calls = append(calls, fmt.Sprintf(
+						"%s: %s", strings.Join(info.ModulePath, "."), region))
+					return testDiffFn(info, state, c)
+				}
+				return p, nil
+			},
+		},
+	})
+
+	_, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual := calls
+	sort.Strings(actual)
+	expected := []string{"root.east: east", "root.west: west"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
 func TestContext2Plan_moduleProviderDefaults(t *testing.T) {
 	var l sync.Mutex
 	var calls []string
@@ -768,6 +872,71 @@ func TestContext2Plan_preventDestroy_bad(t *testing.T) {
 		t.Fatalf("expected err would contain %q\nerr: %s\nplan: %s",
 			expectedErr, err, plan)
 	}
+
+	expectedReason := `require_new: "" => "yes"`
+	if !strings.Contains(err.Error(), expectedReason) {
+		t.Fatalf("expected err would contain %q\nerr: %s", expectedReason, err)
+	}
+}
+
+func TestContext2Plan_preventDestroy_allowDestroyTargets(t *testing.T) {
+	m := testModule(t, "plan-prevent-destroy-bad")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	s := &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"aws_instance.foo": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "i-abc123",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Targets []string
+		Allow   bool
+		Destroy bool
+		Err     bool
+	}{
+		{nil, true, false, true},
+		{[]string{"aws_instance.foo"}, false, false, true},
+		{[]string{"aws_instance.foo"}, true, false, false},
+		{[]string{"aws_instance.foo"}, false, true, true},
+		{[]string{"aws_instance.foo"}, true, true, false},
+	}
+
+	for i, tc := range cases {
+		ctx := testContext2(t, &ContextOpts{
+			Module: m,
+			Providers: map[string]ResourceProviderFactory{
+				"aws": testProviderFuncFixed(p),
+			},
+			State:               s,
+			Targets:             tc.Targets,
+			AllowDestroyTargets: tc.Allow,
+			Destroy:             tc.Destroy,
+		})
+
+		plan, err := ctx.Plan()
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if err != nil {
+			continue
+		}
+
+		actual := plan.Diff.RootModule().Resources["aws_instance.foo"]
+		if actual == nil || !actual.GetDestroy() {
+			t.Fatalf("%d: bad: %s", i, plan)
+		}
+	}
 }
 
 func TestContext2Plan_preventDestroy_good(t *testing.T) {
@@ -1065,6 +1234,198 @@ func TestContext2Plan_dataResourceBecomesComputed(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_dataResourceDependsOnChanges(t *testing.T) {
+	m := testModule(t, "plan-data-resource-depends-on")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	p.ReadDataDiffReturn = &InstanceDiff{
+		Attributes: map[string]*ResourceAttrDiff{
+			"foo": &ResourceAttrDiff{
+				Old: "",
+				New: "bar",
+			},
+		},
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		// Pretend like we ran a Refresh and the data resource was read.
+		State: &State{
+			Modules: []*ModuleState{
+				&ModuleState{
+					Path: rootModulePath,
+					Resources: map[string]*ResourceState{
+						"aws_instance.foo": &ResourceState{
+							Type: "aws_instance",
+							Primary: &InstanceState{
+								ID: "i-abc123",
+							},
+						},
+						"data.aws_data_resource.bar": &ResourceState{
+							Type: "aws_data_resource",
+							Primary: &InstanceState{
+								ID: "data-id",
+								Attributes: map[string]string{
+									"id":  "data-id",
+									"foo": "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	moduleDiff := plan.Diff.RootModule()
+	if _, ok := moduleDiff.Resources["aws_instance.foo"]; !ok {
+		t.Fatalf("missing diff for aws_instance.foo")
+	}
+
+	// aws_instance.foo is going to change, so the read must be deferred
+	// until apply.
+	iDiff, ok := moduleDiff.Resources["data.aws_data_resource.bar"]
+	if !ok {
+		t.Fatalf("missing diff for data.aws_data_resource.bar")
+	}
+
+	expectedDiff := &InstanceDiff{
+		Attributes: map[string]*ResourceAttrDiff{
+			"foo": {
+				Old: "",
+				New: "bar",
+			},
+			"id": {
+				NewComputed: true,
+				RequiresNew: true,
+				Type:        DiffAttrOutput,
+			},
+		},
+	}
+	if same, _ := expectedDiff.Same(iDiff); !same {
+		t.Fatalf(
+			"incorrect diff for data.aws_data_resource.bar\ngot:  %#v\nwant: %#v",
+			iDiff, expectedDiff,
+		)
+	}
+}
+
+func TestContext2Plan_dataResourceDependsOnNoChanges(t *testing.T) {
+	m := testModule(t, "plan-data-resource-depends-on")
+	p := testProvider("aws")
+	p.DiffFn = func(*InstanceInfo, *InstanceState, *ResourceConfig) (*InstanceDiff, error) {
+		return nil, nil
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		// Pretend like we ran a Refresh and the data resource was read.
+		State: &State{
+			Modules: []*ModuleState{
+				&ModuleState{
+					Path: rootModulePath,
+					Resources: map[string]*ResourceState{
+						"aws_instance.foo": &ResourceState{
+							Type: "aws_instance",
+							Primary: &InstanceState{
+								ID: "i-abc123",
+							},
+						},
+						"data.aws_data_resource.bar": &ResourceState{
+							Type: "aws_data_resource",
+							Primary: &InstanceState{
+								ID: "data-id",
+								Attributes: map[string]string{
+									"id":  "data-id",
+									"foo": "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// None of the dependencies are changing, so the state populated
+	// during refresh is used as-is.
+	if p.ReadDataDiffCalled {
+		t.Fatal("ReadDataDiff was called, but shouldn't have been")
+	}
+
+	if !plan.Diff.Empty() {
+		t.Fatalf("expected an empty diff, got:\n%s", plan.Diff)
+	}
+}
+
+func TestContext2Plan_dataResourceDependsOnCountZero(t *testing.T) {
+	m := testModule(t, "plan-data-resource-depends-on-count-zero")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	p.ReadDataDiffReturn = &InstanceDiff{
+		Attributes: map[string]*ResourceAttrDiff{
+			"id": &ResourceAttrDiff{
+				Old: "",
+				New: "data-id",
+			},
+		},
+	}
+	p.ReadDataApplyReturn = &InstanceState{
+		ID: "data-id",
+		Attributes: map[string]string{
+			"id":  "data-id",
+			"foo": "bar",
+		},
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	if _, err := ctx.Refresh(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The dependency has no instances, so it will never be in the state
+	// and mustn't keep the data resource from being read during refresh.
+	if !p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply wasn't called, but should have been")
+	}
+
+	p.ReadDataDiffCalled = false
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if p.ReadDataDiffCalled {
+		t.Fatal("ReadDataDiff was called, but shouldn't have been")
+	}
+
+	if !plan.Diff.Empty() {
+		t.Fatalf("expected an empty diff, got:\n%s", plan.Diff)
+	}
+}
+
 func TestContext2Plan_computedList(t *testing.T) {
 	m := testModule(t, "plan-computed-list")
 	p := testProvider("aws")
@@ -1132,6 +1493,91 @@ func TestContext2Plan_countComputed(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_countDataResource(t *testing.T) {
+	m := testModule(t, "plan-count-data-resource")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	p.ReadDataDiffReturn = &InstanceDiff{
+		Attributes: map[string]*ResourceAttrDiff{
+			"foo": &ResourceAttrDiff{
+				Old: "",
+				New: "bar",
+			},
+		},
+	}
+	p.ReadDataApplyReturn = &InstanceState{
+		ID: "data-id",
+		Attributes: map[string]string{
+			"id":      "data-id",
+			"foo":     "bar",
+			"names.#": "2",
+			"names.0": "a",
+			"names.1": "b",
+		},
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	// The data source is read during refresh, so the count is known
+	// by the time the plan is made.
+	if _, err := ctx.Refresh(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resources := plan.Diff.RootModule().Resources
+	if got := len(resources); got != 2 {
+		t.Fatalf("got %d resource diffs; want 2:\n%s", got, plan.Diff)
+	}
+	for i, ami := range []string{"a", "b"} {
+		id := fmt.Sprintf("aws_instance.bar.%d", i)
+		rd, ok := resources[id]
+		if !ok {
+			t.Fatalf("missing diff for %s", id)
+		}
+		if got := rd.Attributes["ami"].New; got != ami {
+			t.Fatalf("%s: ami is %q; want %q", id, got, ami)
+		}
+	}
+}
+
+func TestContext2Plan_countDataResourceComputed(t *testing.T) {
+	m := testModule(t, "plan-count-data-resource-computed")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	p.ReadDataDiffReturn = &InstanceDiff{
+		Attributes: map[string]*ResourceAttrDiff{
+			"names.#": &ResourceAttrDiff{
+				NewComputed: true,
+			},
+		},
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	// The data source can only be read during apply, so the count can't
+	// be known.
+	_, err := ctx.Plan()
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "value of 'count' cannot be computed") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
 func TestContext2Plan_countIndex(t *testing.T) {
 	m := testModule(t, "plan-count-index")
 	p := testProvider("aws")
@@ -1690,6 +2136,34 @@ func TestContext2Plan_pathVar(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_workspaceVar(t *testing.T) {
+	m := testModule(t, "plan-workspace-var")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		Workspace: "staging",
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if plan.Workspace != "staging" {
+		t.Fatalf("bad: %q", plan.Workspace)
+	}
+
+	actual := strings.TrimSpace(plan.String())
+	expected := strings.TrimSpace(testTerraformPlanWorkspaceVarStr)
+	if actual != expected {
+		t.Fatalf("bad:\n%s\n\nexpected:\n\n%s", actual, expected)
+	}
+}
+
 func TestContext2Plan_diffVar(t *testing.T) {
 	m := testModule(t, "plan-diffvar")
 	p := testProvider("aws")
@@ -2152,6 +2626,49 @@ STATE:
 	}
 }
 
+func TestContext2Plan_targetedModuleNested(t *testing.T) {
+	m := testModule(t, "plan-targeted-module-nested")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		Targets: []string{"module.child"},
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual := strings.TrimSpace(plan.String())
+	expected := strings.TrimSpace(`
+DIFF:
+
+CREATE: aws_instance.foo
+  num:  "" => "2"
+  type: "" => "aws_instance"
+
+module.child:
+  CREATE: aws_instance.bar
+    foo:  "" => "2"
+    type: "" => "aws_instance"
+module.child.grandchild:
+  CREATE: aws_instance.baz
+    num:  "" => "4"
+    type: "" => "aws_instance"
+
+STATE:
+
+<no state>
+	`)
+	if actual != expected {
+		t.Fatalf("expected:\n%s\n\ngot:\n%s", expected, actual)
+	}
+}
+
 // https://github.com/hashicorp/terraform/issues/4515
 func TestContext2Plan_targetedOverTen(t *testing.T) {
 	m := testModule(t, "plan-targeted-over-ten")
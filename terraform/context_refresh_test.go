@@ -640,6 +640,35 @@ func TestContext2Refresh_dataState(t *testing.T) {
 	}
 }
 
+func TestContext2Refresh_dataDependsOn(t *testing.T) {
+	p := testProvider("aws")
+	m := testModule(t, "refresh-data-resource-depends-on")
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	s, err := ctx.Refresh()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Whether the dependencies will change isn't known until plan, so
+	// the read must not happen during refresh.
+	if p.ReadDataDiffCalled {
+		t.Fatal("ReadDataDiff shouldn't have been called")
+	}
+	if p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply shouldn't have been called")
+	}
+
+	if rs := s.RootModule().Resources["data.aws_data_resource.bar"]; rs != nil {
+		t.Fatalf("unexpected state for data.aws_data_resource.bar: %#v", rs)
+	}
+}
+
 func TestContext2Refresh_tainted(t *testing.T) {
 	p := testProvider("aws")
 	m := testModule(t, "refresh-basic")
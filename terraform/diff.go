@@ -221,14 +221,25 @@ func (d *ModuleDiff) String() string {
 			crud = "DESTROY/CREATE"
 		case rdiff.GetDestroy():
 			crud = "DESTROY"
+		case rdiff.GetDestroyDeposed() && rdiff.GetAttributesLen() == 0:
+			crud = "DESTROY"
 		case rdiff.RequiresNew():
 			crud = "CREATE"
 		}
 
+		extra := ""
+		if !rdiff.GetDestroy() && rdiff.GetDestroyDeposed() {
+			extra = " (deposed)"
+			if rdiff.GetAttributesLen() == 0 {
+				extra = " (deposed only)"
+			}
+		}
+
 		buf.WriteString(fmt.Sprintf(
-			"%s: %s\n",
+			"%s: %s%s\n",
 			crud,
-			name))
+			name,
+			extra))
 
 		keyLen := 0
 		rdiffAttrs := rdiff.CopyAttributes()
@@ -284,7 +295,14 @@ type InstanceDiff struct {
 	mu             sync.Mutex
 	Attributes     map[string]*ResourceAttrDiff
 	Destroy        bool
+	DestroyDeposed bool
 	DestroyTainted bool
+
+	// Meta is a simple K/V map that is stored in the diff for the provider
+	// to carry information from the diff to the apply, such as the timeouts
+	// configured for the resource. It doesn't affect whether the diff is
+	// empty.
+	Meta map[string]string
 }
 
 // ResourceAttrDiff is the diff of a single attribute of a resource.
@@ -342,7 +360,8 @@ func (d *InstanceDiff) ChangeType() DiffChangeType {
 		return DiffDestroyCreate
 	}
 
-	if d.GetDestroy() {
+	// Destroying only the deposed objects leaves the primary one as it is
+	if d.GetDestroy() || (d.GetDestroyDeposed() && d.GetAttributesLen() == 0) {
 		return DiffDestroy
 	}
 
@@ -361,7 +380,7 @@ func (d *InstanceDiff) Empty() bool {
 
 	d.mu.Lock()
 	defer d.mu.Unlock()
-	return !d.Destroy && len(d.Attributes) == 0
+	return !d.Destroy && !d.DestroyDeposed && len(d.Attributes) == 0
 }
 
 func (d *InstanceDiff) GoString() string {
@@ -416,6 +435,23 @@ func (d *InstanceDiff) GetDestroyTainted() bool {
 	return d.DestroyTainted
 }
 
+func (d *InstanceDiff) SetDestroyDeposed(b bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.DestroyDeposed = b
+}
+
+// GetDestroyDeposed returns true if the deposed objects of the resource,
+// which are left over from a create_before_destroy replacement, will be
+// destroyed.
+func (d *InstanceDiff) GetDestroyDeposed() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.DestroyDeposed
+}
+
 func (d *InstanceDiff) SetDestroy(b bool) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
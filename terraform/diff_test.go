@@ -232,6 +232,22 @@ func TestInstanceDiff_ChangeType(t *testing.T) {
 			},
 			DiffDestroyCreate,
 		},
+		{
+			&InstanceDiff{DestroyDeposed: true},
+			DiffDestroy,
+		},
+		{
+			&InstanceDiff{
+				DestroyDeposed: true,
+				Attributes: map[string]*ResourceAttrDiff{
+					"foo": &ResourceAttrDiff{
+						Old: "",
+						New: "bar",
+					},
+				},
+			},
+			DiffUpdate,
+		},
 	}
 
 	for i, tc := range cases {
@@ -261,6 +277,12 @@ func TestInstanceDiff_Empty(t *testing.T) {
 		t.Fatal("should not be empty")
 	}
 
+	rd = &InstanceDiff{DestroyDeposed: true}
+
+	if rd.Empty() {
+		t.Fatal("should not be empty")
+	}
+
 	rd = &InstanceDiff{
 		Attributes: map[string]*ResourceAttrDiff{
 			"foo": &ResourceAttrDiff{
@@ -2,6 +2,8 @@ package terraform
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform/config"
 )
@@ -12,6 +14,10 @@ import (
 type EvalCheckPreventDestroy struct {
 	Resource *config.Resource
 	Diff     **InstanceDiff
+
+	// Allowed is set if the resource can be destroyed anyways, which is
+	// the case when it is targeted with -allow-destroy-targets.
+	Allowed bool
 }
 
 func (n *EvalCheckPreventDestroy) Eval(ctx EvalContext) (interface{}, error) {
@@ -22,11 +28,55 @@ func (n *EvalCheckPreventDestroy) Eval(ctx EvalContext) (interface{}, error) {
 	diff := *n.Diff
 	preventDestroy := n.Resource.Lifecycle.PreventDestroy
 
-	if diff.GetDestroy() && preventDestroy {
-		return nil, fmt.Errorf(preventDestroyErrStr, n.Resource.Id())
+	if diff.GetDestroy() && preventDestroy && !n.Allowed {
+		return nil, fmt.Errorf(
+			preventDestroyErrStr, n.Resource.Id(), preventDestroyReason(diff))
 	}
 
 	return nil, nil
 }
 
-const preventDestroyErrStr = `%s: the plan would destroy this resource, but it currently has lifecycle.prevent_destroy set to true. To avoid this error and continue with the plan, either disable lifecycle.prevent_destroy or adjust the scope of the plan using the -target flag.`
+// preventDestroyReason describes the change in the diff that destroys the
+// resource, so that the user knows what to change to avoid it.
+func preventDestroyReason(diff *InstanceDiff) string {
+	attrs := diff.CopyAttributes()
+	names := make([]string, 0, len(attrs))
+	for name, attr := range attrs {
+		if attr.RequiresNew {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		if diff.GetDestroyTainted() {
+			return "The resource is tainted, so it must be replaced."
+		}
+
+		return "The resource is removed by the plan."
+	}
+
+	changes := make([]string, len(names))
+	for i, name := range names {
+		attr := attrs[name]
+		old, new := attr.Old, attr.New
+		if attr.NewComputed {
+			new = "<computed>"
+		}
+		if attr.Sensitive {
+			old, new = "<sensitive>", "<sensitive>"
+		}
+
+		changes[i] = fmt.Sprintf("  %s: %q => %q", name, old, new)
+	}
+
+	return fmt.Sprintf(
+		"The resource must be replaced because of changes to:\n\n%s",
+		strings.Join(changes, "\n"))
+}
+
+const preventDestroyErrStr = `%s: the plan would destroy this resource, but it currently has lifecycle.prevent_destroy set to true.
+
+%s
+
+To avoid this error and continue with the plan, either disable lifecycle.prevent_destroy or adjust the scope of the plan using the -target flag. To destroy the resource anyways, target it with -target and use -allow-destroy-targets.`
@@ -212,11 +212,40 @@ func (ctx *BuiltinEvalContext) ParentProviderConfig(n string) *ResourceConfig {
 		if v, ok := ctx.ProviderConfigCache[k]; ok {
 			return v
 		}
+
+		// In the parent module the provider may have another name if
+		// it was passed in with the module's providers map.
+		n = ctx.parentProviderName(path[:i+1], n)
 	}
 
 	return nil
 }
 
+// parentProviderName returns the name of the provider in the parent of the
+// module at the given path that the provider n is inherited from.
+func (ctx *BuiltinEvalContext) parentProviderName(path []string, n string) string {
+	if len(path) < 2 || ctx.Interpolater == nil || ctx.Interpolater.Module == nil {
+		return n
+	}
+
+	parent := ctx.Interpolater.Module.Child(path[1 : len(path)-1])
+	if parent == nil {
+		return n
+	}
+
+	for _, m := range parent.Config().Modules {
+		if m.Name != path[len(path)-1] {
+			continue
+		}
+
+		if p, ok := m.Providers[n]; ok {
+			return p
+		}
+	}
+
+	return n
+}
+
 func (ctx *BuiltinEvalContext) InitProvisioner(
 	n string) (ResourceProvisioner, error) {
 	ctx.once.Do(ctx.init)
@@ -13,6 +13,12 @@ type EvalCountFixZeroOneBoundary struct {
 
 // TODO: test
 func (n *EvalCountFixZeroOneBoundary) Eval(ctx EvalContext) (interface{}, error) {
+	// If the count isn't known yet we can't tell which side of the
+	// boundary we're on. Expanding the resource reports the error.
+	if n.Resource.CountComputed() {
+		return nil, nil
+	}
+
 	// Get the count, important for knowing whether we're supposed to
 	// be adding the zero, or trimming it.
 	count, err := n.Resource.Count()
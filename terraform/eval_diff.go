@@ -3,6 +3,7 @@ package terraform
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform/config"
@@ -185,15 +186,61 @@ func (n *EvalDiff) processIgnoreChanges(diff *InstanceDiff) error {
 		return nil
 	}
 
+	ignoreAll := n.Resource.Lifecycle.IgnoreAllChanges()
+	ignorePaths, err := n.Resource.Lifecycle.IgnoreChangesPaths()
+	if err != nil {
+		return fmt.Errorf("%s: %s", n.Resource.Id(), err)
+	}
+
 	ignorableAttrKeys := make(map[string]bool)
-	for _, ignoredKey := range ignoreChanges {
-		for k := range diff.CopyAttributes() {
-			if strings.HasPrefix(k, ignoredKey) {
+	for k := range diff.CopyAttributes() {
+		if ignoreAll {
+			ignorableAttrKeys[k] = true
+			continue
+		}
+
+		for _, path := range ignorePaths {
+			if ignoreChangesMatch(path, k) {
 				ignorableAttrKeys[k] = true
+				break
 			}
 		}
 	}
 
+	// The count of a map or list changes when an ignored key is added or
+	// removed. It's ignored as well, unless there are other changes to the
+	// map or list that aren't ignored. The keys are visited in reverse
+	// order so that the counts of nested maps and lists come first.
+	attrs := diff.CopyAttributes()
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	for _, k := range keys {
+		if !strings.HasSuffix(k, ".%") && !strings.HasSuffix(k, ".#") {
+			continue
+		}
+
+		prefix := k[:len(k)-1]
+		ignored := false
+		for other := range attrs {
+			if other == k || !strings.HasPrefix(other, prefix) {
+				continue
+			}
+
+			if !ignorableAttrKeys[other] {
+				ignored = false
+				break
+			}
+			ignored = true
+		}
+
+		if ignored {
+			ignorableAttrKeys[k] = true
+		}
+	}
+
 	// If we are replacing the resource, then we expect there to be a bunch of
 	// extraneous attribute diffs we need to filter out for the other
 	// non-requires-new attributes going from "" -> "configval" or "" ->
@@ -247,6 +294,89 @@ func (n *EvalDiff) processIgnoreChanges(diff *InstanceDiff) error {
 	return nil
 }
 
+// ignoreChangesMatch returns true if the flatmapped attribute key is the
+// attribute of the ignore_changes path or nested in it, such as
+// "tags.owner" for the path ["tags"]. A "*" step of the path matches any
+// single step of the key.
+func ignoreChangesMatch(path []string, k string) bool {
+	for i, step := range path {
+		var rest string
+		if step == "*" {
+			idx := strings.IndexRune(k, '.')
+			if idx == 0 {
+				return false
+			}
+			if idx != -1 {
+				rest = k[idx:]
+			}
+		} else {
+			if !strings.HasPrefix(k, step) {
+				return false
+			}
+			rest = k[len(step):]
+		}
+
+		if rest == "" {
+			return i == len(path)-1
+		}
+		if rest[0] != '.' {
+			return false
+		}
+		if i == len(path)-1 {
+			return true
+		}
+
+		k = rest[1:]
+	}
+
+	return false
+}
+
+// EvalDiffDeposed is an EvalNode implementation that marks the diff of a
+// resource to destroy its deposed objects, if it has any in the state.
+// These are left over from a create_before_destroy replacement that
+// failed to destroy them, and are destroyed on the next apply.
+type EvalDiffDeposed struct {
+	Name string
+	Diff **InstanceDiff
+}
+
+// TODO: test
+func (n *EvalDiffDeposed) Eval(ctx EvalContext) (interface{}, error) {
+	state, lock := ctx.State()
+
+	// Get a read lock so we can access the resource
+	lock.RLock()
+	defer lock.RUnlock()
+
+	mod := state.ModuleByPath(ctx.Path())
+	if mod == nil {
+		return nil, nil
+	}
+	rs := mod.Resources[n.Name]
+	if rs == nil {
+		return nil, nil
+	}
+
+	deposed := false
+	for _, is := range rs.Deposed {
+		if is != nil && is.ID != "" {
+			deposed = true
+			break
+		}
+	}
+	if !deposed {
+		return nil, nil
+	}
+
+	if *n.Diff == nil {
+		*n.Diff = new(InstanceDiff)
+	}
+	(*n.Diff).SetDestroyDeposed(true)
+
+	return nil, nil
+}
+
 // EvalDiffDestroy is an EvalNode implementation that returns a plain
 // destroy diff.
 type EvalDiffDestroy struct {
@@ -2,7 +2,10 @@ package terraform
 
 import (
 	"reflect"
+	"sort"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
 )
 
 func TestEvalFilterDiff(t *testing.T) {
@@ -76,3 +79,123 @@ func TestEvalFilterDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestEvalDiffProcessIgnoreChanges(t *testing.T) {
+	cases := map[string]struct {
+		Ignore   []string
+		Attrs    map[string]*ResourceAttrDiff
+		Expected []string
+	}{
+		"top-level": {
+			[]string{"ami"},
+			map[string]*ResourceAttrDiff{
+				"ami":      &ResourceAttrDiff{Old: "a", New: "b"},
+				"ami_name": &ResourceAttrDiff{Old: "a", New: "b"},
+			},
+			[]string{"ami_name"},
+		},
+
+		"map key": {
+			[]string{`tags["owner"]`},
+			map[string]*ResourceAttrDiff{
+				"tags.owner": &ResourceAttrDiff{Old: "bob", New: "alice"},
+				"tags.env":   &ResourceAttrDiff{Old: "dev", New: "prod"},
+			},
+			[]string{"tags.env"},
+		},
+
+		"map key removed": {
+			[]string{"tags.owner"},
+			map[string]*ResourceAttrDiff{
+				"tags.%":     &ResourceAttrDiff{Old: "2", New: "1"},
+				"tags.owner": &ResourceAttrDiff{Old: "bob", NewRemoved: true},
+			},
+			nil,
+		},
+
+		"map key removed with other changes": {
+			[]string{"tags.owner"},
+			map[string]*ResourceAttrDiff{
+				"tags.%":     &ResourceAttrDiff{Old: "2", New: "1"},
+				"tags.owner": &ResourceAttrDiff{Old: "bob", NewRemoved: true},
+				"tags.env":   &ResourceAttrDiff{Old: "dev", New: "prod"},
+			},
+			[]string{"tags.%", "tags.env"},
+		},
+
+		"wildcard": {
+			[]string{"frontend_ip_configuration.*.private_ip_address"},
+			map[string]*ResourceAttrDiff{
+				"frontend_ip_configuration.0.private_ip_address": &ResourceAttrDiff{Old: "10.0.0.1", New: "10.0.0.2"},
+				"frontend_ip_configuration.1.private_ip_address": &ResourceAttrDiff{Old: "10.0.0.3", New: "10.0.0.4"},
+				"frontend_ip_configuration.1.name":               &ResourceAttrDiff{Old: "a", New: "b"},
+			},
+			[]string{"frontend_ip_configuration.1.name"},
+		},
+
+		"all": {
+			[]string{"*"},
+			map[string]*ResourceAttrDiff{
+				"ami":    &ResourceAttrDiff{Old: "a", New: "b"},
+				"tags.%": &ResourceAttrDiff{Old: "1", New: "2"},
+			},
+			nil,
+		},
+	}
+
+	for name, tc := range cases {
+		n := &EvalDiff{
+			Resource: &config.Resource{
+				Mode: config.ManagedResourceMode,
+				Name: "foo",
+				Type: "aws_instance",
+				Lifecycle: config.ResourceLifecycle{
+					IgnoreChanges: tc.Ignore,
+				},
+			},
+		}
+
+		diff := &InstanceDiff{Attributes: tc.Attrs}
+		if err := n.processIgnoreChanges(diff); err != nil {
+			t.Fatalf("%s: err: %s", name, err)
+		}
+
+		var actual []string
+		for k := range diff.Attributes {
+			actual = append(actual, k)
+		}
+		sort.Strings(actual)
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%s: bad: %#v", name, actual)
+		}
+	}
+}
+
+func TestIgnoreChangesMatch(t *testing.T) {
+	cases := []struct {
+		Path     []string
+		Key      string
+		Expected bool
+	}{
+		{[]string{"tags"}, "tags", true},
+		{[]string{"tags"}, "tags.%", true},
+		{[]string{"tags"}, "tags.owner", true},
+		{[]string{"tags"}, "tags_all.owner", false},
+		{[]string{"tags", "owner"}, "tags.owner", true},
+		{[]string{"tags", "owner"}, "tags.owners", false},
+		{[]string{"tags", "owner"}, "tags", false},
+		{[]string{"tags", "a.b"}, "tags.a.b", true},
+		{[]string{"disk", "*", "size"}, "disk.0.size", true},
+		{[]string{"disk", "*", "size"}, "disk.1234.size", true},
+		{[]string{"disk", "*", "size"}, "disk.0.type", false},
+		{[]string{"disk", "*", "size"}, "disk.#", false},
+		{[]string{"disk", "*"}, "disk.0.type", true},
+	}
+
+	for _, tc := range cases {
+		if actual := ignoreChangesMatch(tc.Path, tc.Key); actual != tc.Expected {
+			t.Fatalf("%#v %q: expected %t", tc.Path, tc.Key, tc.Expected)
+		}
+	}
+}
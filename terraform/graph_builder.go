@@ -77,6 +77,10 @@ type BuiltinGraphBuilder struct {
 	// `terraform plan -destroy`
 	Destroy bool
 
+	// AllowDestroyTargets allows the resources that are targeted directly
+	// to be destroyed even if they have prevent_destroy set.
+	AllowDestroyTargets bool
+
 	// Determines whether the GraphBuilder should perform graph validation before
 	// returning the Graph. Generally you want this to be done, except when you'd
 	// like to inspect a problematic graph.
@@ -144,12 +148,23 @@ func (b *BuiltinGraphBuilder) Steps(path []string) []GraphTransformer {
 		steps = append(steps,
 			// Optionally reduces the graph to a user-specified list of targets and
 			// their dependencies.
-			&TargetsTransformer{Targets: b.Targets, Destroy: b.Destroy},
+			&TargetsTransformer{
+				Targets:      b.Targets,
+				Destroy:      b.Destroy,
+				AllowDestroy: b.AllowDestroyTargets,
+			},
 
 			// Prune the providers. This must happen only once because flattened
 			// modules might depend on empty providers.
 			&PruneProviderTransformer{},
 
+			// Enable create_before_destroy on the dependencies of the
+			// resources that have it, before the destroy nodes copy it.
+			b.conditional(&conditionalOpts{
+				If:   func() bool { return !b.Destroy },
+				Then: &CreateBeforeDestroyInheritTransformer{},
+			}),
+
 			// Create the destruction nodes
 			&DestroyTransformer{FullDestroy: b.Destroy},
 			b.conditional(&conditionalOpts{
@@ -173,16 +173,29 @@ func TestBuiltinGraphBuilder_cbdDepNonCbd(t *testing.T) {
 	}
 }
 
-func TestBuiltinGraphBuilder_cbdDepNonCbd_errorsWhenVerbose(t *testing.T) {
+// The cycle is also gone in the worst case graph, since the non-CBD
+// dependency inherits create_before_destroy.
+func TestBuiltinGraphBuilder_cbdDepNonCbd_verbose(t *testing.T) {
 	b := &BuiltinGraphBuilder{
 		Root:     testModule(t, "graph-builder-cbd-non-cbd"),
 		Validate: true,
 		Verbose:  true,
 	}
 
-	_, err := b.Build(RootModulePath)
-	if err == nil {
-		t.Fatalf("expected err, got none")
+	g, err := b.Build(RootModulePath)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, v := range g.Vertices() {
+		dn, ok := v.(GraphNodeDestroy)
+		if !ok {
+			continue
+		}
+
+		if !dn.CreateBeforeDestroy() {
+			t.Fatalf("%s: create_before_destroy should be enabled", dag.VertexName(v))
+		}
 	}
 }
 
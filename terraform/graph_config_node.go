@@ -39,3 +39,10 @@ type GraphNodeTargetable interface {
 
 	SetTargets([]ResourceAddress)
 }
+
+// GraphNodeDestroyAllowable is an interface for nodes that can be allowed
+// to destroy their resources even if they have prevent_destroy set. This is
+// done for the nodes that are targeted directly when the user asks for it.
+type GraphNodeDestroyAllowable interface {
+	AllowDestroy()
+}
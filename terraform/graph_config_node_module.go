@@ -89,8 +89,14 @@ func (n *GraphNodeConfigModule) ProvidedBy() []string {
 
 	// Turn the map into a string. This makes sure that the list is
 	// de-dupped since we could be going over potentially many resources.
+	// Providers passed in explicitly come from the provider they're
+	// mapped to in our providers map.
 	result := make([]string, 0, len(providers))
 	for p, _ := range providers {
+		if parent, ok := n.Module.Providers[p]; ok {
+			p = parent
+		}
+
 		result = append(result, p)
 	}
 
@@ -183,6 +189,17 @@ func (n *graphNodeModuleExpanded) FlattenGraph() *Graph {
 				vn.Value = config
 			}
 		}
+
+		// If this is a provider passed in explicitly, then it inherits
+		// from the provider it is mapped to instead of the one with the
+		// same name.
+		if pn, ok := v.(graphNodeParentProvider); ok {
+			if gp, ok := v.(GraphNodeProvider); ok {
+				if parent, ok := n.Original.Module.Providers[gp.ProviderName()]; ok {
+					pn.SetParentProviderName(parent)
+				}
+			}
+		}
 	}
 
 	return graph
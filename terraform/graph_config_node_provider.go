@@ -13,6 +13,10 @@ import (
 // explicit `provider` configuration block is in the configuration.
 type GraphNodeConfigProvider struct {
 	Provider *config.ProviderConfig
+
+	// ParentProvider is the name of the provider in the parent module
+	// this provider inherits from, if it differs from our name.
+	ParentProvider string
 }
 
 func (n *GraphNodeConfigProvider) Name() string {
@@ -58,6 +62,20 @@ func (n *GraphNodeConfigProvider) ProviderConfig() *config.RawConfig {
 	return n.Provider.RawConfig
 }
 
+// graphNodeParentProvider implementation
+func (n *GraphNodeConfigProvider) ParentProviderName() string {
+	if n.ParentProvider != "" {
+		return n.ParentProvider
+	}
+
+	return n.ProviderName()
+}
+
+// graphNodeParentProvider implementation
+func (n *GraphNodeConfigProvider) SetParentProviderName(v string) {
+	n.ParentProvider = v
+}
+
 // GraphNodeDotter impl.
 func (n *GraphNodeConfigProvider) DotNode(name string, opts *GraphDotOpts) *dot.Node {
 	return dot.NewNode(name, map[string]string{
@@ -117,8 +135,8 @@ func (n *GraphNodeConfigProviderFlat) DependentOn() []string {
 		}
 
 		result = append(result, fmt.Sprintf(
-			"%s%s",
-			prefix, n.GraphNodeConfigProvider.Name()))
+			"%sprovider.%s",
+			prefix, n.GraphNodeConfigProvider.ParentProviderName()))
 	}
 
 	return result
@@ -27,6 +27,10 @@ type GraphNodeConfigResource struct {
 	// Used during DynamicExpand to target indexes
 	Targets []ResourceAddress
 
+	// DestroyAllowed is set if the resource is targeted directly and
+	// can be destroyed even if it has prevent_destroy set.
+	DestroyAllowed bool
+
 	Path []string
 }
 
@@ -36,6 +40,8 @@ func (n *GraphNodeConfigResource) Copy() *GraphNodeConfigResource {
 		Destroy:  n.Destroy,
 		Targets:  make([]ResourceAddress, 0, len(n.Targets)),
 		Path:     make([]string, 0, len(n.Path)),
+
+		DestroyAllowed: n.DestroyAllowed,
 	}
 	for _, t := range n.Targets {
 		ncr.Targets = append(ncr.Targets, *t.Copy())
@@ -160,6 +166,8 @@ func (n *GraphNodeConfigResource) DynamicExpand(ctx EvalContext) (*Graph, error)
 		Resource: n.Resource,
 		Destroy:  n.Destroy,
 		Targets:  n.Targets,
+
+		DestroyAllowed: n.DestroyAllowed,
 	})
 
 	// Additional destroy modifications.
@@ -209,6 +217,11 @@ func (n *GraphNodeConfigResource) SetTargets(targets []ResourceAddress) {
 	n.Targets = targets
 }
 
+// GraphNodeDestroyAllowable impl.
+func (n *GraphNodeConfigResource) AllowDestroy() {
+	n.DestroyAllowed = true
+}
+
 // GraphNodeEvalable impl.
 func (n *GraphNodeConfigResource) EvalTree() EvalNode {
 	return &EvalSequence{
@@ -254,6 +267,23 @@ func (n *GraphNodeConfigResource) DestroyNode() GraphNodeDestroy {
 	return result
 }
 
+// GraphNodeCreateBeforeDestroyInheritable impl.
+func (n *GraphNodeConfigResource) CreateBeforeDestroyEnabled() bool {
+	return !n.Destroy && n.Resource.Lifecycle.CreateBeforeDestroy
+}
+
+// GraphNodeCreateBeforeDestroyInheritable impl.
+func (n *GraphNodeConfigResource) ForceCreateBeforeDestroy() {
+	// Data sources are never replaced
+	if n.Destroy || n.Resource.Mode != config.ManagedResourceMode {
+		return
+	}
+
+	// Copy the resource so that the configuration isn't modified
+	n.Resource = n.Resource.Copy()
+	n.Resource.Lifecycle.CreateBeforeDestroy = true
+}
+
 // GraphNodeNoopPrunable
 func (n *GraphNodeConfigResource) Noop(opts *NoopOpts) bool {
 	log.Printf("[DEBUG] Checking resource noop: %s", n.Name())
@@ -458,9 +488,12 @@ func (n *graphNodeResourceDestroy) destroyInclude(
 	//       applies, and it isn't acceptable for TF to ignore this request,
 	//       since it can result in unexpected downtime.
 	//
-	// Therefore, we compromise with this edge case here: if there is
-	// a static count of "1", we prune the diff to remove cycles during a
-	// graph optimization path if we don't see the resource in the diff.
+	// The dependencies of a CBD resource inherit CBD from it (see
+	// CreateBeforeDestroyInheritTransformer), which does #1 automatically
+	// for managed resources. For anything else, we compromise with this
+	// edge case here: if there is a static count of "1", we prune the diff
+	// to remove cycles during a graph optimization path if we don't see the
+	// resource in the diff.
 	// If the count is set to ANYTHING other than a static "1" (variable,
 	// computed attribute, static number greater than 1), then we keep the
 	// destroy, since it is required for dynamic graph expansion to find
@@ -485,7 +518,7 @@ func (n *graphNodeResourceDestroy) destroyInclude(
 	if d != nil {
 		for k, v := range d.Resources {
 			match := k == prefix || strings.HasPrefix(k, prefix+".")
-			if match && v.GetDestroy() {
+			if match && (v.GetDestroy() || v.GetDestroyDeposed()) {
 				return true
 			}
 		}
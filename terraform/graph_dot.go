@@ -69,35 +69,13 @@ func graphDotSubgraph(
 		sg.AddAttr("label", modName)
 	}
 
-	origins, err := graphDotFindOrigins(g)
+	drawable, err := graphDotDrawable(g, opts)
 	if err != nil {
 		return err
 	}
-
-	drawableVertices := make(map[dag.Vertex]struct{})
-	toDraw := make([]dag.Vertex, 0, len(g.Vertices()))
-	subgraphVertices := make(map[dag.Vertex]*Graph)
-
-	walk := func(v dag.Vertex, depth int) error {
-		// We only care about nodes that yield non-empty Dot strings.
-		if dn, ok := v.(GraphNodeDotter); !ok {
-			return nil
-		} else if dn.DotNode("fake", opts) == nil {
-			return nil
-		}
-
-		drawableVertices[v] = struct{}{}
-		toDraw = append(toDraw, v)
-
-		if sn, ok := v.(GraphNodeSubgraph); ok {
-			subgraphVertices[v] = sn.Subgraph()
-		}
-		return nil
-	}
-
-	if err := g.ReverseDepthFirstWalk(origins, walk); err != nil {
-		return err
-	}
+	toDraw := drawable.Vertices
+	drawableVertices := drawable.Set
+	subgraphVertices := drawable.Subgraphs
 
 	for _, v := range toDraw {
 		dn := v.(GraphNodeDotter)
@@ -162,6 +140,57 @@ func graphDotSubgraph(
 	return nil
 }
 
+// graphDotDrawableVertices are the vertices of a graph that are drawn.
+type graphDotDrawableVertices struct {
+	// Vertices are the drawn vertices, in the order they are drawn.
+	Vertices []dag.Vertex
+
+	// Set contains the same vertices as Vertices.
+	Set map[dag.Vertex]struct{}
+
+	// Subgraphs are the subgraphs of the drawn vertices that have them.
+	Subgraphs map[dag.Vertex]*Graph
+}
+
+// graphDotDrawable returns the vertices of the graph that are drawn, which
+// are the vertices reachable from the origins that yield non-empty Dot
+// nodes.
+func graphDotDrawable(g *Graph, opts *GraphDotOpts) (*graphDotDrawableVertices, error) {
+	origins, err := graphDotFindOrigins(g)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &graphDotDrawableVertices{
+		Vertices:  make([]dag.Vertex, 0, len(g.Vertices())),
+		Set:       make(map[dag.Vertex]struct{}),
+		Subgraphs: make(map[dag.Vertex]*Graph),
+	}
+
+	walk := func(v dag.Vertex, depth int) error {
+		// We only care about nodes that yield non-empty Dot strings.
+		if dn, ok := v.(GraphNodeDotter); !ok {
+			return nil
+		} else if dn.DotNode("fake", opts) == nil {
+			return nil
+		}
+
+		result.Set[v] = struct{}{}
+		result.Vertices = append(result.Vertices, v)
+
+		if sn, ok := v.(GraphNodeSubgraph); ok {
+			result.Subgraphs[v] = sn.Subgraph()
+		}
+		return nil
+	}
+
+	if err := g.ReverseDepthFirstWalk(origins, walk); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func graphDotNodeName(modName, v dag.Vertex) string {
 	return fmt.Sprintf("[%s] %s", modName, dag.VertexName(v))
 }
@@ -0,0 +1,137 @@
+package terraform
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform/dag"
+)
+
+// graphJSON is the JSON representation of a graph.
+type graphJSON struct {
+	Nodes []graphJSONNode `json:"nodes"`
+
+	// Cycles are the cycles in the graph, each a list of the IDs of the
+	// nodes in the cycle.
+	Cycles [][]string `json:"cycles"`
+}
+
+// graphJSONNode is a node of the graph with the nodes it depends on.
+type graphJSONNode struct {
+	ID           string   `json:"id"`
+	Module       string   `json:"module"`
+	Name         string   `json:"name"`
+	Dependencies []string `json:"dependencies"`
+	InCycle      bool     `json:"in_cycle,omitempty"`
+}
+
+// GraphJSON returns the JSON representation of the given Terraform graph,
+// which is an adjacency list of the same nodes that GraphDot draws, and the
+// cycles in the graph. The nodes in a cycle are marked as such. The
+// DrawCycles option is ignored since cycles are always included.
+func GraphJSON(g *Graph, opts *GraphDotOpts) (string, error) {
+	result := &graphJSON{
+		Nodes:  make([]graphJSONNode, 0),
+		Cycles: make([][]string, 0),
+	}
+
+	if err := graphJSONSubgraph(result, "root", g, opts, 0); err != nil {
+		return "", err
+	}
+
+	inCycle := make(map[string]struct{})
+	for _, cycle := range result.Cycles {
+		for _, id := range cycle {
+			inCycle[id] = struct{}{}
+		}
+	}
+	for i, n := range result.Nodes {
+		if _, ok := inCycle[n.ID]; ok {
+			result.Nodes[i].InCycle = true
+		}
+	}
+
+	sort.Sort(graphJSONNodesByID(result.Nodes))
+	sort.Sort(graphJSONCycles(result.Cycles))
+
+	raw, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw) + "\n", nil
+}
+
+func graphJSONSubgraph(
+	result *graphJSON, modName string, g *Graph, opts *GraphDotOpts, modDepth int) error {
+	// Respect user-specified module depth
+	if opts.MaxDepth >= 0 && modDepth > opts.MaxDepth {
+		return nil
+	}
+
+	drawable, err := graphDotDrawable(g, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range drawable.Vertices {
+		node := graphJSONNode{
+			ID:           graphDotNodeName(modName, v),
+			Module:       modName,
+			Name:         dag.VertexName(v),
+			Dependencies: make([]string, 0),
+		}
+
+		// Only include dependencies that are drawn as well
+		for _, t := range dag.AsVertexList(g.DownEdges(v)) {
+			if _, ok := drawable.Set[t]; !ok {
+				continue
+			}
+
+			node.Dependencies = append(
+				node.Dependencies, graphDotNodeName(modName, t))
+		}
+		sort.Strings(node.Dependencies)
+
+		result.Nodes = append(result.Nodes, node)
+	}
+
+	for _, cycle := range g.Cycles() {
+		ids := make([]string, len(cycle))
+		for i, v := range cycle {
+			ids[i] = graphDotNodeName(modName, v)
+		}
+		sort.Strings(ids)
+
+		result.Cycles = append(result.Cycles, ids)
+	}
+
+	// Recurse into any subgraphs
+	for _, v := range drawable.Vertices {
+		subgraph, ok := drawable.Subgraphs[v]
+		if !ok {
+			continue
+		}
+
+		err := graphJSONSubgraph(result, dag.VertexName(v), subgraph, opts, modDepth+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type graphJSONNodesByID []graphJSONNode
+
+func (s graphJSONNodesByID) Len() int           { return len(s) }
+func (s graphJSONNodesByID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s graphJSONNodesByID) Less(i, j int) bool { return s[i].ID < s[j].ID }
+
+type graphJSONCycles [][]string
+
+func (s graphJSONCycles) Len() int      { return len(s) }
+func (s graphJSONCycles) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s graphJSONCycles) Less(i, j int) bool {
+	return s[i][0] < s[j][0]
+}
@@ -0,0 +1,104 @@
+package terraform
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGraphJSON(t *testing.T) {
+	var g Graph
+	g.Add(&testDrawableOrigin{"root"})
+	g.Add(&testDrawable{
+		VertexName:      "A",
+		DependentOnMock: []string{"root", "C"},
+	})
+	g.Add(&testDrawable{
+		VertexName:      "B",
+		DependentOnMock: []string{"A"},
+	})
+	g.Add(&testDrawable{
+		VertexName:      "C",
+		DependentOnMock: []string{"B"},
+	})
+	g.Add(&testDrawable{
+		VertexName:      "D",
+		DependentOnMock: []string{"root"},
+	})
+
+	var sub Graph
+	sub.Add(&testDrawableOrigin{"sub_root"})
+	g.Add(&testDrawableSubgraph{
+		VertexName:      "sub",
+		SubgraphMock:    &sub,
+		DependentOnMock: []string{"root"},
+	})
+	g.ConnectDependents()
+
+	raw, err := GraphJSON(&g, &GraphDotOpts{MaxDepth: -1})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var actual graphJSON
+	if err := json.Unmarshal([]byte(raw), &actual); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := graphJSON{
+		Nodes: []graphJSONNode{
+			{
+				ID:           "[root] A",
+				Module:       "root",
+				Name:         "A",
+				Dependencies: []string{"[root] C", "[root] root"},
+				InCycle:      true,
+			},
+			{
+				ID:           "[root] B",
+				Module:       "root",
+				Name:         "B",
+				Dependencies: []string{"[root] A"},
+				InCycle:      true,
+			},
+			{
+				ID:           "[root] C",
+				Module:       "root",
+				Name:         "C",
+				Dependencies: []string{"[root] B"},
+				InCycle:      true,
+			},
+			{
+				ID:           "[root] D",
+				Module:       "root",
+				Name:         "D",
+				Dependencies: []string{"[root] root"},
+			},
+			{
+				ID:           "[root] root",
+				Module:       "root",
+				Name:         "root",
+				Dependencies: []string{},
+			},
+			{
+				ID:           "[root] sub",
+				Module:       "root",
+				Name:         "sub",
+				Dependencies: []string{"[root] root"},
+			},
+			{
+				ID:           "[sub] sub_root",
+				Module:       "sub",
+				Name:         "sub_root",
+				Dependencies: []string{},
+			},
+		},
+		Cycles: [][]string{
+			{"[root] A", "[root] B", "[root] C"},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad:\n\n%s", raw)
+	}
+}
@@ -87,6 +87,7 @@ func (w *ContextGraphWalker) EnterPath(path []string) EvalContext {
 			StateLock:          &w.Context.stateLock,
 			VariableValues:     variables,
 			VariableValuesLock: &w.interpolaterVarLock,
+			Workspace:          w.Context.workspace,
 		},
 		InterpolaterVars:    w.interpolaterVars,
 		InterpolaterVarLock: &w.interpolaterVarLock,
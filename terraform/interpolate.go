@@ -31,6 +31,7 @@ type Interpolater struct {
 	StateLock          *sync.RWMutex
 	VariableValues     map[string]interface{}
 	VariableValuesLock *sync.Mutex
+	Workspace          string
 }
 
 // InterpolationScope is the current scope of execution. This is required
@@ -84,6 +85,8 @@ func (i *Interpolater) Values(
 			err = i.valueSelfVar(scope, n, v, result)
 		case *config.SimpleVariable:
 			err = i.valueSimpleVar(scope, n, v, result)
+		case *config.TerraformVariable:
+			err = i.valueTerraformVar(scope, n, v, result)
 		case *config.UserVariable:
 			err = i.valueUserVar(scope, n, v, result)
 		default:
@@ -206,6 +209,27 @@ func (i *Interpolater) valuePathVar(
 
 }
 
+func (i *Interpolater) valueTerraformVar(
+	scope *InterpolationScope,
+	n string,
+	v *config.TerraformVariable,
+	result map[string]ast.Variable) error {
+	if v.Field != "workspace" {
+		return fmt.Errorf("%s: unknown terraform variable: %s", n, v.Field)
+	}
+
+	workspace := i.Workspace
+	if workspace == "" {
+		workspace = DefaultWorkspace
+	}
+
+	result[n] = ast.Variable{
+		Value: workspace,
+		Type:  ast.TypeString,
+	}
+	return nil
+}
+
 func (i *Interpolater) valueResourceVar(
 	scope *InterpolationScope,
 	n string,
@@ -249,6 +273,17 @@ func (i *Interpolater) valueResourceVar(
 			return nil
 		}
 
+		// Data sources may not have been read yet, e.g. when a destroy
+		// node evaluates a count that depends on one. Treat the value
+		// as computed until the data source is in the state.
+		if v.Mode == config.DataResourceMode {
+			result[n] = ast.Variable{
+				Value: config.UnknownVariableValue,
+				Type:  ast.TypeString,
+			}
+			return nil
+		}
+
 		return fmt.Errorf("variable %q is nil, but no error was reported", v.Name)
 	}
 
@@ -109,6 +109,22 @@ func TestInterpolater_pathCwd(t *testing.T) {
 	})
 }
 
+func TestInterpolater_terraformWorkspace(t *testing.T) {
+	i := &Interpolater{}
+	scope := &InterpolationScope{}
+
+	testInterpolate(t, i, scope, "terraform.workspace", ast.Variable{
+		Value: DefaultWorkspace,
+		Type:  ast.TypeString,
+	})
+
+	i.Workspace = "staging"
+	testInterpolate(t, i, scope, "terraform.workspace", ast.Variable{
+		Value: "staging",
+		Type:  ast.TypeString,
+	})
+}
+
 func TestInterpolater_pathModule(t *testing.T) {
 	mod := testModule(t, "interpolate-path-module")
 	i := &Interpolater{
@@ -27,18 +27,22 @@ type Plan struct {
 	Vars    map[string]interface{}
 	Targets []string
 
+	// Workspace is the name of the workspace the plan was created in.
+	Workspace string
+
 	once sync.Once
 }
 
 // Context returns a Context with the data encapsulated in this plan.
 //
 // The following fields in opts are overridden by the plan: Config,
-// Diff, State, Variables.
+// Diff, State, Variables, Workspace.
 func (p *Plan) Context(opts *ContextOpts) (*Context, error) {
 	opts.Diff = p.Diff
 	opts.Module = p.Module
 	opts.State = p.State
 	opts.Targets = p.Targets
+	opts.Workspace = p.Workspace
 
 	opts.Variables = make(map[string]interface{})
 	for k, v := range p.Vars {
@@ -75,9 +79,16 @@ func (p *Plan) init() {
 	})
 }
 
-// The format byte is prefixed into the plan file format so that we have
-// the ability in the future to change the file format if we want for any
-// reason.
+// The plan file format is the magic bytes "tfplan", followed by the format
+// version byte, followed by the gob encoding of the Plan. The format byte
+// is prefixed into the plan file format so that we have the ability in the
+// future to change the file format if we want for any reason, and must be
+// incremented when the Plan changes in a way gob can't decode older plans
+// with, such as the type of a field changing. Fields can be added without
+// a new version.
+//
+// The plan file format isn't meant to be read by other tools, which should
+// use the JSON representation output by `terraform show -json` instead.
 const planFormatMagic = "tfplan"
 const planFormatVersion byte = 1
 
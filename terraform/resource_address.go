@@ -154,8 +154,24 @@ func (addr *ResourceAddress) Equals(raw interface{}) bool {
 		modeMatch
 }
 
+// TargetContains returns true if the given address is selected when this
+// address is used as a target. In addition to the addresses it Equals, a
+// module address targets every resource in that module and the modules
+// nested within it.
+func (addr *ResourceAddress) TargetContains(other *ResourceAddress) bool {
+	if addr.Type == "" && addr.Name == "" && len(addr.Path) > 0 &&
+		len(other.Path) > len(addr.Path) &&
+		reflect.DeepEqual(addr.Path, other.Path[:len(addr.Path)]) {
+		return true
+	}
+
+	return addr.Equals(other)
+}
+
+// ParseResourceIndex parses the index of an address. An empty index and the
+// "*" wildcard both address every instance of the resource.
 func ParseResourceIndex(s string) (int, error) {
-	if s == "" {
+	if s == "" || s == "*" {
 		return -1, nil
 	}
 	return strconv.Atoi(s)
@@ -203,8 +219,8 @@ func tokenizeResourceAddress(s string) (map[string]string, error) {
 		`(?:(?P<type>[^.]+)\.(?P<name>[^.[]+))?` +
 		// "tainted" (optional, omission implies: "primary")
 		`(?:\.(?P<instance_type>\w+))?` +
-		// "1" (optional, omission implies: "0")
-		`(?:\[(?P<index>\d+)\])?` +
+		// "1" or "*" (optional, omission implies all instances)
+		`(?:\[(?P<index>\d+|\*)\])?` +
 		`\z`)
 	groupNames := re.SubexpNames()
 	rawMatches := re.FindAllStringSubmatch(s, -1)
@@ -41,6 +41,10 @@ type ResourceProvider interface {
 	// knows how to manage.
 	Resources() []ResourceType
 
+	// GetSchema returns the schema of the configuration of the provider
+	// and of all the resources and data sources it implements.
+	GetSchema() (*ProviderSchema, error)
+
 	/*********************************************************************
 	* Functions related to individual resources
 	*********************************************************************/
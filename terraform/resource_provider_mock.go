@@ -42,6 +42,9 @@ type MockResourceProvider struct {
 	RefreshFn                      func(*InstanceInfo, *InstanceState) (*InstanceState, error)
 	RefreshReturn                  *InstanceState
 	RefreshReturnError             error
+	GetSchemaCalled                bool
+	GetSchemaReturn                *ProviderSchema
+	GetSchemaReturnError           error
 	ResourcesCalled                bool
 	ResourcesReturn                []ResourceType
 	ReadDataApplyCalled            bool
@@ -203,6 +206,14 @@ func (p *MockResourceProvider) Resources() []ResourceType {
 	return p.ResourcesReturn
 }
 
+func (p *MockResourceProvider) GetSchema() (*ProviderSchema, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.GetSchemaCalled = true
+	return p.GetSchemaReturn, p.GetSchemaReturnError
+}
+
 func (p *MockResourceProvider) ImportState(info *InstanceInfo, id string) ([]*InstanceState, error) {
 	p.Lock()
 	defer p.Unlock()
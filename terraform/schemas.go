@@ -0,0 +1,53 @@
+package terraform
+
+// ProviderSchema is the schema of the configuration of a provider and of
+// the resources and data sources it implements.
+type ProviderSchema struct {
+	Provider      *SchemaBlock
+	ResourceTypes map[string]*SchemaBlock
+	DataSources   map[string]*SchemaBlock
+
+	// ResourceTypeSchemaVersions is the version of the schema of each
+	// resource type, which is increased when the state of the resource
+	// has to be migrated.
+	ResourceTypeSchemaVersions map[string]int
+}
+
+// SchemaBlock is the schema of a configuration block: its attributes and
+// the blocks that can be nested in it.
+type SchemaBlock struct {
+	Attributes map[string]*SchemaAttribute
+	BlockTypes map[string]*SchemaNestedBlock
+}
+
+// SchemaAttribute is the schema of an attribute of a configuration block.
+type SchemaAttribute struct {
+	// Type is the type of the value, which is "string", "number" or
+	// "bool", or a collection of one of these like "list(string)",
+	// "set(number)" or "map(string)".
+	Type string
+
+	Description string
+
+	Required  bool
+	Optional  bool
+	Computed  bool
+	Sensitive bool
+
+	// Deprecated is the deprecation message of the attribute, if it is
+	// deprecated.
+	Deprecated string
+}
+
+// SchemaNestedBlock is the schema of a block that can be nested in another
+// block, such as the "ebs_block_device" blocks of an "aws_instance".
+type SchemaNestedBlock struct {
+	SchemaBlock
+
+	// Nesting is how the nested blocks are collected, which is "list" if
+	// their order matters and "set" otherwise.
+	Nesting string
+
+	MinItems int
+	MaxItems int
+}
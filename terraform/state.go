@@ -1224,6 +1224,11 @@ type InstanceState struct {
 	// ${resourcetype.name.attribute}.
 	Attributes map[string]string `json:"attributes,omitempty"`
 
+	// SensitiveAttributes lists the keys of the attributes whose values are
+	// sensitive, such as passwords. Their values are stored in the state like
+	// any other attribute, but aren't displayed in the UI.
+	SensitiveAttributes []string `json:"sensitive_attributes,omitempty"`
+
 	// Ephemeral is used to store any state associated with this instance
 	// that is necessary for the Terraform run to complete, but is not
 	// persisted to a state file.
@@ -1347,6 +1352,21 @@ func (s *InstanceState) MergeDiff(d *InstanceDiff) *InstanceState {
 	return result
 }
 
+// IsSensitive returns true if the attribute with the given key is sensitive.
+func (s *InstanceState) IsSensitive(key string) bool {
+	if s == nil {
+		return false
+	}
+
+	for _, k := range s.SensitiveAttributes {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (i *InstanceState) GoString() string {
 	return fmt.Sprintf("*%#v", *i)
 }
@@ -1349,6 +1349,18 @@ STATE:
 <no state>
 `
 
+const testTerraformPlanWorkspaceVarStr = `
+DIFF:
+
+CREATE: aws_instance.foo
+  type:      "" => "aws_instance"
+  workspace: "" => "staging"
+
+STATE:
+
+<no state>
+`
+
 const testTerraformPlanPathVarStr = `
 DIFF:
 
@@ -0,0 +1,5 @@
+resource "aws_instance" "foo" {
+    lifecycle {
+        create_before_destroy = true
+    }
+}
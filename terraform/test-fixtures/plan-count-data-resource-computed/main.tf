@@ -0,0 +1,11 @@
+resource "aws_instance" "foo" {
+  compute = "foo"
+}
+
+data "aws_data_resource" "foo" {
+  foo = "${aws_instance.foo.foo}"
+}
+
+resource "aws_instance" "bar" {
+  count = "${length(data.aws_data_resource.foo.names)}"
+}
@@ -0,0 +1,8 @@
+data "aws_data_resource" "foo" {
+  foo = "bar"
+}
+
+resource "aws_instance" "bar" {
+  count = "${length(data.aws_data_resource.foo.names)}"
+  ami   = "${element(data.aws_data_resource.foo.names, count.index)}"
+}
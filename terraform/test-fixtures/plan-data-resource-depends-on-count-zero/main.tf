@@ -0,0 +1,10 @@
+resource "aws_instance" "foo" {
+  count = 0
+  num   = "2"
+}
+
+data "aws_data_resource" "bar" {
+  foo = "bar"
+
+  depends_on = ["aws_instance.foo"]
+}
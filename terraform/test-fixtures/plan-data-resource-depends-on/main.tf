@@ -0,0 +1,9 @@
+resource "aws_instance" "foo" {
+  num = "2"
+}
+
+data "aws_data_resource" "bar" {
+  foo = "bar"
+
+  depends_on = ["aws_instance.foo"]
+}
@@ -0,0 +1,20 @@
+provider "aws" {
+    region = "east"
+}
+
+provider "aws" {
+    alias  = "west"
+    region = "west"
+}
+
+module "east" {
+    source = "./child"
+}
+
+module "west" {
+    source = "./child"
+
+    providers = {
+        aws = "aws.west"
+    }
+}
@@ -0,0 +1,9 @@
+variable "foo" {}
+
+resource "aws_instance" "bar" {
+    foo = "${var.foo}"
+}
+
+module "grandchild" {
+    source = "./grandchild"
+}
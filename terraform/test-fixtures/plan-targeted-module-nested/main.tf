@@ -0,0 +1,12 @@
+resource "aws_instance" "foo" {
+    num = "2"
+}
+
+resource "aws_instance" "bar" {
+    num = "3"
+}
+
+module "child" {
+    source = "./child"
+    foo = "${aws_instance.foo.num}"
+}
@@ -0,0 +1,17 @@
+resource "aws_vpc" "foo" {}
+
+resource "aws_security_group" "foo" {
+    vpc = "${aws_vpc.foo.id}"
+}
+
+resource "aws_instance" "web" {
+    security_groups = ["${aws_security_group.foo.id}"]
+
+    lifecycle {
+        create_before_destroy = true
+    }
+}
+
+resource "aws_load_balancer" "lb" {
+    member = "${aws_instance.web.id}"
+}
@@ -1,6 +1,8 @@
 package terraform
 
 import (
+	"log"
+
 	"github.com/hashicorp/terraform/dag"
 )
 
@@ -132,6 +134,61 @@ func (t *DestroyTransformer) Transform(g *Graph) error {
 	return nil
 }
 
+// GraphNodeCreateBeforeDestroyInheritable is the interface that nodes
+// must implement to inherit create_before_destroy from the nodes that
+// depend on them.
+type GraphNodeCreateBeforeDestroyInheritable interface {
+	dag.Vertex
+
+	// CreateBeforeDestroyEnabled returns true if the node has
+	// create_before_destroy enabled.
+	CreateBeforeDestroyEnabled() bool
+
+	// ForceCreateBeforeDestroy enables create_before_destroy for the
+	// node, if it can be enabled.
+	ForceCreateBeforeDestroy()
+}
+
+// CreateBeforeDestroyInheritTransformer is a GraphTransformer that enables
+// create_before_destroy for all the dependencies of the nodes that have it
+// enabled.
+//
+// A create_before_destroy resource that depends on a resource that doesn't
+// have it enabled results in a cycle when both are replaced: the new
+// dependent must be created before the old one is destroyed, but the old
+// dependency can only be destroyed after the old dependent, and must be
+// destroyed before the new dependency, that the new dependent needs, is
+// created. Replacing the dependencies first breaks the cycle.
+type CreateBeforeDestroyInheritTransformer struct{}
+
+func (t *CreateBeforeDestroyInheritTransformer) Transform(g *Graph) error {
+	for _, v := range g.Vertices() {
+		n, ok := v.(GraphNodeCreateBeforeDestroyInheritable)
+		if !ok || !n.CreateBeforeDestroyEnabled() {
+			continue
+		}
+
+		deps, err := g.Ancestors(v)
+		if err != nil {
+			return err
+		}
+
+		for _, raw := range deps.List() {
+			dep, ok := raw.(GraphNodeCreateBeforeDestroyInheritable)
+			if !ok || dep.CreateBeforeDestroyEnabled() {
+				continue
+			}
+
+			log.Printf(
+				"[DEBUG] %s: create_before_destroy enabled, since %s depends on it",
+				dag.VertexName(dep), dag.VertexName(v))
+			dep.ForceCreateBeforeDestroy()
+		}
+	}
+
+	return nil
+}
+
 // CreateBeforeDestroyTransformer is a GraphTransformer that modifies
 // the destroys of some nodes so that the creation happens before the
 // destroy.
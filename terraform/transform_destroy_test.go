@@ -3,6 +3,8 @@ package terraform
 import (
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/dag"
 )
 
 func TestDestroyTransformer(t *testing.T) {
@@ -94,6 +96,69 @@ func TestCreateBeforeDestroyTransformer_twice(t *testing.T) {
 	}
 }
 
+func TestCreateBeforeDestroyInheritTransformer(t *testing.T) {
+	mod := testModule(t, "transform-create-before-destroy-inherit")
+
+	g := Graph{Path: RootModulePath}
+	{
+		tf := &ConfigTransformer{Module: mod}
+		if err := tf.Transform(&g); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	{
+		tf := &CreateBeforeDestroyInheritTransformer{}
+		if err := tf.Transform(&g); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	expected := map[string]bool{
+		"aws_vpc.foo":            true,
+		"aws_security_group.foo": true,
+		"aws_instance.web":       true,
+		"aws_load_balancer.lb":   false,
+	}
+	for _, v := range g.Vertices() {
+		n, ok := v.(GraphNodeCreateBeforeDestroyInheritable)
+		if !ok {
+			continue
+		}
+
+		name := dag.VertexName(v)
+		if actual := n.CreateBeforeDestroyEnabled(); actual != expected[name] {
+			t.Fatalf("%s: expected create_before_destroy %t", name, expected[name])
+		}
+	}
+
+	// The configuration itself must not be modified
+	for _, r := range mod.Config().Resources {
+		if r.Id() != "aws_instance.web" && r.Lifecycle.CreateBeforeDestroy {
+			t.Fatalf("%s: configuration was modified", r.Id())
+		}
+	}
+
+	// Replacing all of them must not result in a cycle
+	{
+		tf := &DestroyTransformer{}
+		if err := tf.Transform(&g); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	{
+		tf := &CreateBeforeDestroyTransformer{}
+		if err := tf.Transform(&g); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	if err := g.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
 func TestPruneDestroyTransformer(t *testing.T) {
 	var diff *Diff
 	mod := testModule(t, "transform-destroy-basic")
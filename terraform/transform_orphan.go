@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/config/module"
 	"github.com/hashicorp/terraform/dag"
+	"github.com/hashicorp/terraform/dot"
 )
 
 // GraphNodeStateRepresentative is an interface that can be implemented by
@@ -198,6 +199,15 @@ func (n *graphNodeOrphanResource) Name() string {
 	return fmt.Sprintf("%s (orphan)", n.ResourceKey)
 }
 
+// GraphNodeDotter impl.
+func (n *graphNodeOrphanResource) DotNode(name string, opts *GraphDotOpts) *dot.Node {
+	return dot.NewNode(name, map[string]string{
+		"label": n.Name(),
+		"shape": "box",
+		"style": "dashed",
+	})
+}
+
 func (n *graphNodeOrphanResource) ProvidedBy() []string {
 	return []string{resourceProvider(n.ResourceKey.Type, n.Provider)}
 }
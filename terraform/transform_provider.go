@@ -33,6 +33,15 @@ type GraphNodeProviderConsumer interface {
 	ProvidedBy() []string
 }
 
+// graphNodeParentProvider is implemented by provider nodes that inherit
+// the configuration of a provider in the parent module. That is the
+// provider with the same name, unless the module block passes in another
+// one with its providers map.
+type graphNodeParentProvider interface {
+	ParentProviderName() string
+	SetParentProviderName(string)
+}
+
 // DisableProviderTransformer "disables" any providers that are only
 // depended on by modules.
 type DisableProviderTransformer struct{}
@@ -371,6 +380,22 @@ func (n *graphNodeDisabledProvider) ProviderConfig() *config.RawConfig {
 	return n.GraphNodeProvider.ProviderConfig()
 }
 
+// graphNodeParentProvider impl.
+func (n *graphNodeDisabledProvider) ParentProviderName() string {
+	if pn, ok := n.GraphNodeProvider.(graphNodeParentProvider); ok {
+		return pn.ParentProviderName()
+	}
+
+	return n.ProviderName()
+}
+
+// graphNodeParentProvider impl.
+func (n *graphNodeDisabledProvider) SetParentProviderName(v string) {
+	if pn, ok := n.GraphNodeProvider.(graphNodeParentProvider); ok {
+		pn.SetParentProviderName(v)
+	}
+}
+
 // Same as graphNodeDisabledProvider, but for flattening
 type graphNodeDisabledProviderFlat struct {
 	*graphNodeDisabledProvider
@@ -407,7 +432,8 @@ func (n *graphNodeDisabledProviderFlat) DependentOn() []string {
 	if len(n.PathValue) > 1 {
 		prefix := modulePrefixStr(n.PathValue[:len(n.PathValue)-1])
 		result = modulePrefixList(
-			n.graphNodeDisabledProvider.DependableName(), prefix)
+			[]string{"provider." + n.graphNodeDisabledProvider.ParentProviderName()},
+			prefix)
 	}
 
 	return result
@@ -448,6 +474,10 @@ func (n *graphNodeCloseProvider) DotNode(name string, opts *GraphDotOpts) *dot.N
 
 type graphNodeProvider struct {
 	ProviderNameValue string
+
+	// ParentProviderNameValue is the name of the provider in the parent
+	// module this provider inherits from, if it differs from our name.
+	ParentProviderNameValue string
 }
 
 func (n *graphNodeProvider) Name() string {
@@ -468,6 +498,20 @@ func (n *graphNodeProvider) ProviderName() string {
 	return n.ProviderNameValue
 }
 
+// graphNodeParentProvider impl.
+func (n *graphNodeProvider) ParentProviderName() string {
+	if n.ParentProviderNameValue != "" {
+		return n.ParentProviderNameValue
+	}
+
+	return n.ProviderNameValue
+}
+
+// graphNodeParentProvider impl.
+func (n *graphNodeProvider) SetParentProviderName(v string) {
+	n.ParentProviderNameValue = v
+}
+
 func (n *graphNodeProvider) ProviderConfig() *config.RawConfig {
 	return nil
 }
@@ -526,7 +570,9 @@ func (n *graphNodeProviderFlat) DependentOn() []string {
 	// If we're in a module, then depend on our parent's provider
 	if len(n.PathValue) > 1 {
 		prefix := modulePrefixStr(n.PathValue[:len(n.PathValue)-1])
-		result = modulePrefixList(n.graphNodeProvider.DependableName(), prefix)
+		result = modulePrefixList(
+			[]string{"provider." + n.graphNodeProvider.ParentProviderName()},
+			prefix)
 	}
 
 	return result
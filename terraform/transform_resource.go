@@ -14,9 +14,20 @@ type ResourceCountTransformer struct {
 	Resource *config.Resource
 	Destroy  bool
 	Targets  []ResourceAddress
+
+	// DestroyAllowed allows the expanded resources to be destroyed even
+	// if they have prevent_destroy set.
+	DestroyAllowed bool
 }
 
 func (t *ResourceCountTransformer) Transform(g *Graph) error {
+	// Destroy nodes can be walked before the data sources the count
+	// depends on are read. Existing instances are still found in the
+	// state as orphans, so there is nothing to expand here.
+	if t.Destroy && t.Resource.CountComputed() {
+		return nil
+	}
+
 	// Expand the resource count
 	count, err := t.Resource.Count()
 	if err != nil {
@@ -45,6 +56,8 @@ func (t *ResourceCountTransformer) Transform(g *Graph) error {
 			Index:    index,
 			Resource: t.Resource,
 			Path:     g.Path,
+
+			DestroyAllowed: t.DestroyAllowed,
 		}
 		if t.Destroy {
 			node = &graphNodeExpandedResourceDestroy{
@@ -84,7 +97,7 @@ func (t *ResourceCountTransformer) nodeIsTargeted(node dag.Vertex) bool {
 
 	addr := addressable.ResourceAddress()
 	for _, targetAddr := range t.Targets {
-		if targetAddr.Equals(addr) {
+		if targetAddr.TargetContains(addr) {
 			return true
 		}
 	}
@@ -95,6 +108,8 @@ type graphNodeExpandedResource struct {
 	Index    int
 	Resource *config.Resource
 	Path     []string
+
+	DestroyAllowed bool
 }
 
 func (n *graphNodeExpandedResource) Name() string {
@@ -354,6 +369,11 @@ func (n *graphNodeExpandedResource) managedResourceEvalNodes(resource *Resource,
 				&EvalCheckPreventDestroy{
 					Resource: n.Resource,
 					Diff:     &diff,
+					Allowed:  n.DestroyAllowed,
+				},
+				&EvalDiffDeposed{
+					Name: n.stateId(),
+					Diff: &diff,
 				},
 				&EvalWriteState{
 					Name:         n.stateId(),
@@ -387,6 +407,7 @@ func (n *graphNodeExpandedResource) managedResourceEvalNodes(resource *Resource,
 				&EvalCheckPreventDestroy{
 					Resource: n.Resource,
 					Diff:     &diff,
+					Allowed:  n.DestroyAllowed,
 				},
 				&EvalWriteDiff{
 					Name: n.stateId(),
@@ -418,7 +439,10 @@ func (n *graphNodeExpandedResource) managedResourceEvalNodes(resource *Resource,
 							return true, EvalEarlyExitError{}
 						}
 
-						if diffApply.GetDestroy() && diffApply.GetAttributesLen() == 0 {
+						// Only the deposed objects are destroyed, which is
+						// done by the destroy node.
+						destroy := diffApply.GetDestroy() || diffApply.GetDestroyDeposed()
+						if destroy && diffApply.GetAttributesLen() == 0 {
 							return true, EvalEarlyExitError{}
 						}
 
@@ -596,8 +620,9 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 				},
 
 				// The rest of this pass can proceed only if there are no
-				// computed values in our config.
-				// (If there are, we'll deal with this during the plan and
+				// computed values in our config, and if all of the
+				// resources we explicitly depend on already exist.
+				// (If not, we'll deal with this during the plan and
 				// apply phases.)
 				&EvalIf{
 					If: func(ctx EvalContext) (bool, error) {
@@ -605,6 +630,10 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 							return true, EvalEarlyExitError{}
 						}
 
+						if n.dependsOnMissingState(ctx) {
+							return true, EvalEarlyExitError{}
+						}
+
 						return true, nil
 					},
 					Then: EvalNoop{},
@@ -674,11 +703,13 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 					If: func(ctx EvalContext) (bool, error) {
 						computed := config.ComputedKeys != nil && len(config.ComputedKeys) > 0
 
-						// If the configuration is complete and we
-						// already have a state then we don't need to
-						// do any further work during apply, because we
-						// already populated the state during refresh.
-						if !computed && state != nil {
+						// If the configuration is complete, none of the
+						// resources we explicitly depend on have pending
+						// changes and we already have a state then we
+						// don't need to do any further work during apply,
+						// because we already populated the state during
+						// refresh.
+						if !computed && state != nil && !n.dependsOnHasChanges(ctx) {
 							return true, EvalEarlyExitError{}
 						}
 
@@ -825,6 +856,125 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 	return nodes
 }
 
+// dependsOnMissingState returns true if any of the resources listed in the
+// depends_on of the resource don't exist in the state yet.
+func (n *graphNodeExpandedResource) dependsOnMissingState(ctx EvalContext) bool {
+	if len(n.Resource.DependsOn) == 0 {
+		return false
+	}
+
+	state, lock := ctx.State()
+	lock.RLock()
+	var mod *ModuleState
+	if state != nil {
+		mod = state.ModuleByPath(ctx.Path())
+	}
+
+	var missing []string
+	for _, dep := range n.Resource.DependsOn {
+		found := false
+		if mod != nil {
+			for id, rs := range mod.Resources {
+				// The states of counted resources are keyed by their index.
+				if id != dep && !strings.HasPrefix(id, dep+".") {
+					continue
+				}
+
+				if rs != nil && rs.Primary != nil {
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			missing = append(missing, dep)
+		}
+	}
+	lock.RUnlock()
+
+	// A resource with a count of zero never appears in the state, so as
+	// long as it isn't about to be created it doesn't hold us up.
+	for _, dep := range missing {
+		if !n.dependencyIsEmpty(ctx, dep) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dependencyIsEmpty returns true if the given resource from depends_on
+// has no pending changes in the diff and no instances in the configuration.
+func (n *graphNodeExpandedResource) dependencyIsEmpty(ctx EvalContext, dep string) bool {
+	diff, lock := ctx.Diff()
+	lock.RLock()
+	modDiff := diff.ModuleByPath(ctx.Path())
+	lock.RUnlock()
+	if modDiff != nil {
+		for id, rdiff := range modDiff.Resources {
+			if id != dep && !strings.HasPrefix(id, dep+".") {
+				continue
+			}
+
+			if !rdiff.Empty() {
+				return false
+			}
+		}
+	}
+
+	// A multi-variable for a resource with a count of zero interpolates
+	// to an empty list; otherwise it is unknown until the resource exists.
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"ids": fmt.Sprintf("${%s.*.id}", dep),
+	})
+	if err != nil {
+		return false
+	}
+	rc, err := ctx.Interpolate(raw, nil)
+	if err != nil || len(rc.ComputedKeys) > 0 {
+		return false
+	}
+
+	ids, ok := rc.Config["ids"].([]interface{})
+	return ok && len(ids) == 0
+}
+
+// dependsOnHasChanges returns true if any of the resources listed in the
+// depends_on of the resource have pending changes in the diff.
+func (n *graphNodeExpandedResource) dependsOnHasChanges(ctx EvalContext) bool {
+	if len(n.Resource.DependsOn) == 0 {
+		return false
+	}
+
+	diff, lock := ctx.Diff()
+	lock.RLock()
+	defer lock.RUnlock()
+
+	if diff == nil {
+		return false
+	}
+	modDiff := diff.ModuleByPath(ctx.Path())
+	if modDiff == nil {
+		return false
+	}
+
+	for _, dep := range n.Resource.DependsOn {
+		for id, rdiff := range modDiff.Resources {
+			// The diffs of counted resources are keyed by their index.
+			if id != dep && !strings.HasPrefix(id, dep+".") {
+				continue
+			}
+
+			if !rdiff.Empty() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // instanceInfo is used for EvalTree.
 func (n *graphNodeExpandedResource) instanceInfo() *InstanceInfo {
 	return &InstanceInfo{Id: n.stateId(), Type: n.Resource.Type}
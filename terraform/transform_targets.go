@@ -20,6 +20,10 @@ type TargetsTransformer struct {
 	// Set to true when we're in a `terraform destroy` or a
 	// `terraform plan -destroy`
 	Destroy bool
+
+	// AllowDestroy is set to true to allow the resources that are targeted
+	// directly to be destroyed even if they have prevent_destroy set.
+	AllowDestroy bool
 }
 
 func (t *TargetsTransformer) Transform(g *Graph) error {
@@ -81,6 +85,11 @@ func (t *TargetsTransformer) selectTargetedNodes(
 			if tn, ok := v.(GraphNodeTargetable); ok {
 				tn.SetTargets(addrs)
 			}
+			if t.AllowDestroy {
+				if dn, ok := v.(GraphNodeDestroyAllowable); ok {
+					dn.AllowDestroy()
+				}
+			}
 
 			var deps *dag.Set
 			var err error
@@ -109,7 +118,7 @@ func (t *TargetsTransformer) nodeIsTarget(
 	}
 	addr := r.ResourceAddress()
 	for _, targetAddr := range addrs {
-		if targetAddr.Equals(addr) {
+		if targetAddr.TargetContains(addr) {
 			return true
 		}
 	}
@@ -32,6 +32,22 @@ func TestSemaphore(t *testing.T) {
 	s.Release()
 }
 
+func TestResourceProvider(t *testing.T) {
+	cases := []struct {
+		Type, Alias, Expected string
+	}{
+		{"aws_instance", "", "aws"},
+		{"aws_instance", "aws.west", "aws.west"},
+		{"http", "", "http"},
+	}
+
+	for _, tc := range cases {
+		if actual := resourceProvider(tc.Type, tc.Alias); actual != tc.Expected {
+			t.Fatalf("%s, %s: expected %q, got %q", tc.Type, tc.Alias, tc.Expected, actual)
+		}
+	}
+}
+
 func TestStrSliceContains(t *testing.T) {
 	if strSliceContains(nil, "foo") {
 		t.Fatalf("Bad")